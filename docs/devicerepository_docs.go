@@ -566,6 +566,123 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
+        "/aspects/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "merges the aspect into the target aspect; requires admin rights.\nsub-aspects of the merged aspect are moved under the target, references in device-types and device-type-templates are replaced by the target,\nthe merged aspect is removed and aspect nodes and device-group criteria are rebuilt. all changes are published.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "aspects"
+                ],
+                "summary": "merge aspects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id of the aspect, that is merged into the target",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Id of the aspect, that remains",
+                        "name": "target",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only compute the impact",
+                        "name": "dry-run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AspectChangeImpact"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/aspects/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "moves the aspect with its sub-aspects under the parent aspect, which may belong to another root aspect; requires admin rights.\nwithout parent, the aspect becomes a root aspect. aspect ids stay unchanged; aspect nodes and device-group criteria are rebuilt. all changes are published.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "aspects"
+                ],
+                "summary": "move aspect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Aspect Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Id of the new parent aspect; empty to create a root aspect",
+                        "name": "parent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only compute the impact",
+                        "name": "dry-run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AspectChangeImpact"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
         "/characteristics": {
             "get": {
                 "description": "list characteristics",
@@ -852,6 +969,140 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
+        "/characteristics/{id}/pattern": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "get the pattern string values of the characteristic have to match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "characteristics"
+                ],
+                "summary": "get characteristic pattern",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Characteristic Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacteristicPattern"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "restricts string values of the characteristic to a regular expression (RE2 syntax), that has to match the whole value; requires admin rights.\nonly allowed for string characteristics; value and allowed_values of the characteristic have to match the pattern.\nfixed content-variable values and payloads are checked against the pattern; existing device-types are checked on their next update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "characteristics"
+                ],
+                "summary": "set characteristic pattern",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Characteristic Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "pattern",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacteristicPattern"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacteristicPattern"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "removes the pattern of a characteristic; requires admin rights",
+                "tags": [
+                    "characteristics"
+                ],
+                "summary": "remove characteristic pattern",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Characteristic Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
         "/concepts": {
             "put": {
                 "description": "validate concept",
@@ -1988,74 +2239,93 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/device-types": {
-            "put": {
-                "description": "validate device-type",
-                "consumes": [
+        "/device-type-templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "list device-type-templates",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "device-type-templates"
                 ],
-                "summary": "validate device-type",
+                "summary": "list device-type-templates",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "must be true; reminder, that this is not an update but a validation",
-                        "name": "dry-run",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "default 100",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "Device-Type to be validated",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
-                        }
-                    }
-                ],
+                        "type": "integer",
+                        "description": "default 0",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceTypeTemplate"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "post": {
-                "description": "create device-type",
+                ],
+                "description": "create device-type-template; requires admin rights",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "device-type-templates"
                 ],
-                "summary": "create device-type",
+                "summary": "create device-type-template",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
-                        "name": "distinct_attributes",
-                        "in": "query"
-                    },
                     {
                         "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
+                            "$ref": "#/definitions/model.DeviceTypeTemplate"
                         }
                     }
                 ],
@@ -2063,7 +2333,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
+                            "$ref": "#/definitions/model.DeviceTypeTemplateUpdateResult"
                         }
                     },
                     "400": {
@@ -2075,34 +2345,31 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-type-templates/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/device-types/{id}": {
-            "get": {
-                "description": "get device-type",
+                ],
+                "description": "get device-type-template",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "device-type-templates"
                 ],
-                "summary": "get device-type",
+                "summary": "get device-type-template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device-Type Id",
+                        "description": "DeviceTypeTemplate Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2112,7 +2379,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
+                            "$ref": "#/definitions/model.DeviceTypeTemplate"
                         }
                     },
                     "400": {
@@ -2121,52 +2388,46 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "put": {
-                "description": "set device-type",
+                ],
+                "description": "updates a device-type-template; requires admin rights.\nall device-types extending the template are re-validated first; if one of them would become invalid, the update is rejected.\nthe effective device-types are re-materialized and published before the template is stored; if one of them can not be stored, the already updated device-types are restored.\nthe response contains the template and the ids of the updated device-types.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "device-type-templates"
                 ],
-                "summary": "set device-type",
+                "summary": "set device-type-template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "DeviceType Id",
+                        "description": "DeviceTypeTemplate Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
-                        "name": "distinct_attributes",
-                        "in": "query"
-                    },
                     {
                         "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
+                            "$ref": "#/definitions/model.DeviceTypeTemplate"
                         }
                     }
                 ],
@@ -2174,7 +2435,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceType"
+                            "$ref": "#/definitions/model.DeviceTypeTemplateUpdateResult"
                         }
                     },
                     "400": {
@@ -2186,32 +2447,26 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "delete": {
-                "description": "delete device-type",
-                "produces": [
-                    "application/json"
                 ],
+                "description": "delete device-type-template; requires admin rights; templates that are still extended by device-types may not be deleted",
                 "tags": [
-                    "device-types"
+                    "device-type-templates"
                 ],
-                "summary": "delete device-type",
+                "summary": "delete device-type-template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "DeviceType Id",
+                        "description": "DeviceTypeTemplate Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2230,118 +2485,66 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-type-templates/{id}/extensions": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/devices": {
-            "get": {
-                "description": "list devices",
+                ],
+                "description": "creates a new device-type extending the template; the effective device-type is returned and stored as a normal device-type",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-type-templates",
+                    "device-types"
                 ],
-                "summary": "list devices",
+                "summary": "create device-type from template",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "in combination with owner; fills ids filter; comma-separated list",
-                        "name": "local_ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "used in combination with local_ids to fill ids filter; defaults to requesting user",
-                        "name": "owner",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list",
-                        "name": "device-type-ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
-                        "name": "attr-keys",
-                        "in": "query"
+                        "description": "DeviceTypeTemplate Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
-                        "name": "attr-values",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
-                        "name": "connection-state",
+                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
+                        "name": "distinct_attributes",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
+                        "type": "boolean",
+                        "description": "reject the device-type if the linter reports errors; default is configured per installation",
+                        "name": "lint",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted",
-                        "name": "device-attribute-blacklist",
-                        "in": "query"
+                        "description": "extension; device_type_id and template_id may be omitted",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeviceTypeExtension"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Device"
-                            }
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -2353,28 +2556,22 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
-            },
+                }
+            }
+        },
+        "/device-types": {
             "put": {
-                "description": "validate device",
+                "description": "validate device-type",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "validate device",
+                "summary": "validate device-type",
                 "parameters": [
                     {
                         "type": "boolean",
@@ -2384,12 +2581,12 @@ const docTemplatedevicerepository = `{
                         "required": true
                     },
                     {
-                        "description": "Device to be validated",
+                        "description": "Device-Type to be validated",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     }
                 ],
@@ -2411,22 +2608,34 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "post": {
-                "description": "create device",
+                "description": "create device-type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "create device",
+                "summary": "create device-type",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
+                        "name": "distinct_attributes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "reject the device-type if the linter reports errors; default is configured per installation",
+                        "name": "lint",
+                        "in": "query"
+                    },
                     {
                         "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     }
                 ],
@@ -2434,7 +2643,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -2458,99 +2667,32 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
-                "description": "delete multiple devices",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "devices"
-                ],
-                "summary": "delete multiple devices",
-                "parameters": [
-                    {
-                        "description": "ids to be deleted",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request"
-                    },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
             }
         },
-        "/devices/{id}": {
+        "/device-types/{id}": {
             "get": {
-                "description": "get device",
+                "description": "get device-type; the id may contain id-modifiers after a '$' as url-encoded query (e.g. {id}$service_group_selection=left\u0026name=Left%20Lamp).\nknown modifiers: service_group_selection, interaction_filter (event, request, event+request), function_filter (function ids), name, attribute (key=value); unknown modifiers are ignored",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "get device",
+                "summary": "get device-type",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "Device-Type Id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "interprets the id as local_id if as=='local_id'",
-                        "name": "as",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default requesting user; used in combination with local_id (as=='local_id') to identify the device",
-                        "name": "owner_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -2576,26 +2718,32 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "put": {
-                "description": "set device; admins may create new devices but only without using the UpdateOnlySameOriginAttributesKey query parameter",
+                "description": "set device-type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "set device",
+                "summary": "set device-type",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "DeviceType Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
-                        "name": "update-only-same-origin-attributes",
+                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
+                        "name": "distinct_attributes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "reject the device-type if the linter reports errors; default is configured per installation",
+                        "name": "lint",
                         "in": "query"
                     },
                     {
@@ -2604,7 +2752,7 @@ const docTemplatedevicerepository = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     }
                 ],
@@ -2612,7 +2760,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -2638,18 +2786,18 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "delete": {
-                "description": "delete device",
+                "description": "delete device-type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "delete device",
+                "summary": "delete device-type",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "DeviceType Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2682,40 +2830,51 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/devices/{id}/attributes": {
-            "put": {
-                "description": "set device attributes",
+        "/device-types/{id}/clone": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "stores a copy of the device-type with a new id; service, content and content-variable ids are regenerated, service-group keys are kept.\nname and attributes of the copy may be changed with the body; a clone of an extended device-type is not linked to the template",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "set device attributes",
+                "summary": "clone device-type",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "DeviceType Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
-                        "name": "update-only-same-origin-attributes",
+                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
+                        "name": "distinct_attributes",
                         "in": "query"
                     },
                     {
-                        "description": "attributes",
+                        "type": "boolean",
+                        "description": "reject the device-type if the linter reports errors; default is configured per installation",
+                        "name": "lint",
+                        "in": "query"
+                    },
+                    {
+                        "description": "changes applied to the copy",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Attribute"
-                            }
+                            "$ref": "#/definitions/model.DeviceTypeCloneOptions"
                         }
                     }
                 ],
@@ -2723,7 +2882,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -2741,35 +2900,51 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/diff": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/devices/{id}/connection-state": {
-            "put": {
-                "description": "set device connection-state",
+                ],
+                "description": "compares the stored device-type with the submitted device-type (e.g. the body of a planned PUT /device-types/{id}).\nservices are matched by id or else by local_id, contents by id or else by direction and content-variable name, content-variables by id or else by path.\nthe impact lists the device-group criteria gained or lost by the change and the device-groups, whose criteria would change",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "set device connection-state",
+                "summary": "diff device-type",
                 "parameters": [
                     {
-                        "description": "connected true/false",
+                        "type": "string",
+                        "description": "Device Type Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "device-type",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DeviceTypeDiff"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -2777,116 +2952,97 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/diff/{other_id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/devices/{id}/display_name": {
-            "put": {
-                "description": "set device display name",
+                ],
+                "description": "compares two stored device-types; the impact describes replacing the device-type id with other_id (ref POST /device-types/{id}/diff)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "device-types"
                 ],
-                "summary": "set device display name",
+                "summary": "diff stored device-types",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "Device Type Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "display name",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "Device Type Id",
+                        "name": "other_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/model.DeviceTypeDiff"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request"
-                    },
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/examples": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/export": {
-            "get": {
-                "description": "export",
+                ],
+                "description": "renders example messages for all services of the device-type (ref GET /services/{id}/examples); use a device-type id with the service_group_selection modifier to get only the services of a service-group",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "import/export"
+                    "device-types"
                 ],
-                "summary": "export",
+                "summary": "get device-type examples",
                 "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "default false; if true, export includes resources like devices, hubs and locations",
-                        "name": "include_owned_information",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "comma separated list of resource-types; export only given resource-types (device-types,aspects,functions...)",
-                        "name": "filter_resource_types",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "comma separated list of ids; export only given ids",
-                        "name": "filter_ids",
-                        "in": "query"
+                        "description": "Device-Type Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_SENERGY-Platform_device-repository_lib_model.ImportExport"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ServiceExample"
+                            }
                         }
                     },
                     "400": {
@@ -2904,124 +3060,39 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/extension": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/extended-devices": {
-            "get": {
-                "description": "list extended-device",
+                ],
+                "description": "returns the template reference and overrides of a device-type; 404 if the device-type does not extend a template",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "extended-devices"
+                    "device-type-templates",
+                    "device-types"
                 ],
-                "summary": "list extended-device",
+                "summary": "get device-type extension",
                 "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "if true, result contains full device-type",
-                        "name": "fulldt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "in combination with owner; fills ids filter; comma-separated list",
-                        "name": "local_ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "used in combination with local_ids to fill ids filter; defaults to requesting user",
-                        "name": "owner",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list",
-                        "name": "device-type-ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
-                        "name": "attr-keys",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
-                        "name": "attr-values",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted",
-                        "name": "device-attribute-blacklist",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
-                        "name": "connection-state",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
+                        "description": "DeviceType Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ExtendedDevice"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/model.DeviceTypeExtension"
                         }
                     },
                     "400": {
@@ -3030,71 +3101,67 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/extended-devices/{id}": {
-            "get": {
-                "description": "get extended-device",
+                ],
+                "description": "sets the template and overrides of a device-type; an existing device-type is converted into an extension.\nthe effective device-type is materialized, validated, stored and published like with PUT /device-types/{id}",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "extended-devices"
+                    "device-type-templates",
+                    "device-types"
                 ],
-                "summary": "get extended-device",
+                "summary": "set device-type extension",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Id",
+                        "description": "DeviceType Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "interprets the id as local_id if as=='local_id'",
-                        "name": "as",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default requesting user; used in combination with local_id (as=='local_id') to identify the device",
-                        "name": "owner_id",
+                        "description": "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist",
+                        "name": "distinct_attributes",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
+                        "type": "boolean",
+                        "description": "reject the device-type if the linter reports errors; default is configured per installation",
+                        "name": "lint",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "if true, result contains full device-type",
-                        "name": "fulldt",
-                        "in": "query"
+                        "description": "extension",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeviceTypeExtension"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     },
                     "400": {
@@ -3106,89 +3173,35 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/extended-hubs": {
-            "get": {
-                "description": "list extended-hubs",
-                "produces": [
-                    "application/json"
                 ],
+                "description": "detaches the device-type from its template; requires admin rights; the device-type itself is kept unchanged",
                 "tags": [
-                    "extended-hubs"
+                    "device-type-templates",
+                    "device-types"
                 ],
-                "summary": "list extended-hubs",
+                "summary": "remove device-type extension",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
-                        "name": "connection-state",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
+                        "description": "DeviceType Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ExtendedHub"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -3199,50 +3212,41 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/schema": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/extended-hubs/{id}": {
-            "get": {
-                "description": "get extended-hubs",
+                ],
+                "description": "describes the contents of all services of the device-type as JSON Schema (ref GET /services/{id}/schema)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "extended-hubs"
+                    "device-types"
                 ],
-                "summary": "get extended-hubs",
+                "summary": "get device-type json schema",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hub Id",
+                        "description": "Device-Type Id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ExtendedHub"
+                            "$ref": "#/definitions/jsonschema.DeviceTypeSchema"
                         }
                     },
                     "400": {
@@ -3260,76 +3264,38 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/device-types/{id}/wot-tm": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/extended-locations": {
-            "get": {
-                "description": "list extended locations",
+                ],
+                "description": "renders the device-type as W3C Web of Things Thing Model; services with inputs or controlling functions become actions, other services become properties (request), events (event) or observable properties (event+request); content-variables become data schemas; form hrefs use the protocol handler as scheme and {{DEVICE_LOCAL_ID}} as placeholder",
                 "produces": [
-                    "application/json"
+                    "application/tm+json"
                 ],
                 "tags": [
-                    "locations"
+                    "device-types"
                 ],
-                "summary": "list extended locations",
+                "summary": "get device-type as wot thing model",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
+                        "description": "Device-Type Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ExtendedLocation"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/wot.Thing"
                         }
                     },
                     "400": {
@@ -3347,24 +3313,19 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/functions": {
+        "/devices": {
             "get": {
-                "description": "list functions",
+                "description": "list devices",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "devices"
                 ],
-                "summary": "list functions",
+                "summary": "list devices",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3378,12 +3339,6 @@ const docTemplatedevicerepository = `{
                         "name": "offset",
                         "in": "query"
                     },
-                    {
-                        "type": "string",
-                        "description": "filter; https://senergy.infai.org/ontology/ControllingFunction || https://senergy.infai.org/ontology/MeasuringFunction",
-                        "name": "rdf_type",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "description": "filter",
@@ -3401,6 +3356,60 @@ const docTemplatedevicerepository = `{
                         "description": "filter; ignores limit/offset; comma-separated list",
                         "name": "ids",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "in combination with owner; fills ids filter; comma-separated list",
+                        "name": "local_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "used in combination with local_ids to fill ids filter; defaults to requesting user",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list",
+                        "name": "device-type-ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
+                        "name": "attr-keys",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
+                        "name": "attr-values",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
+                        "name": "connection-state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted",
+                        "name": "device-attribute-blacklist",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3409,13 +3418,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Function"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
+                                "$ref": "#/definitions/models.Device"
                             }
                         }
                     },
@@ -3442,14 +3445,14 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "put": {
-                "description": "validate location",
+                "description": "validate device",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "devices"
                 ],
-                "summary": "validate location",
+                "summary": "validate device",
                 "parameters": [
                     {
                         "type": "boolean",
@@ -3459,12 +3462,12 @@ const docTemplatedevicerepository = `{
                         "required": true
                     },
                     {
-                        "description": "Location to be validated",
+                        "description": "Device to be validated",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Device"
                         }
                     }
                 ],
@@ -3486,14 +3489,14 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "post": {
-                "description": "create function",
+                "description": "create device",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "devices"
                 ],
-                "summary": "create function",
+                "summary": "create device",
                 "parameters": [
                     {
                         "description": "element",
@@ -3501,7 +3504,7 @@ const docTemplatedevicerepository = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Function"
+                            "$ref": "#/definitions/models.Device"
                         }
                     }
                 ],
@@ -3509,8 +3512,57 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Function"
+                            "$ref": "#/definitions/models.Device"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "delete multiple devices",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "delete multiple devices",
+                "parameters": [
+                    {
+                        "description": "ids to be deleted",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -3535,30 +3587,48 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/functions/{id}": {
+        "/devices/{id}": {
             "get": {
-                "description": "get function",
+                "description": "get device",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "devices"
                 ],
-                "summary": "get function",
+                "summary": "get device",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Function Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "interprets the id as local_id if as=='local_id'",
+                        "name": "as",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default requesting user; used in combination with local_id (as=='local_id') to identify the device",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeviceClass"
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -3584,29 +3654,35 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "put": {
-                "description": "set function",
+                "description": "set device; admins may create new devices but only without using the UpdateOnlySameOriginAttributesKey query parameter",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "devices"
                 ],
-                "summary": "set function",
+                "summary": "set device",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Function Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
+                        "name": "update-only-same-origin-attributes",
+                        "in": "query"
+                    },
                     {
                         "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Function"
+                            "$ref": "#/definitions/models.Device"
                         }
                     }
                 ],
@@ -3614,7 +3690,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Function"
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -3640,21 +3716,18 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "delete": {
-                "description": "delete function; may only be called by admins; can also be used to only validate deletes",
-                "tags": [
-                    "functions"
+                "description": "delete device",
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "delete function",
+                "tags": [
+                    "devices"
+                ],
+                "summary": "delete device",
                 "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "only validate deletion",
-                        "name": "dry-run",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Functions Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3667,6 +3740,15 @@ const docTemplatedevicerepository = `{
                     "400": {
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -3678,86 +3760,48 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/graphs": {
-            "get": {
-                "description": "list graph",
+        "/devices/{id}/attributes": {
+            "put": {
+                "description": "set device attributes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "graphs"
+                    "devices"
                 ],
-                "summary": "list graph",
+                "summary": "set device attributes",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter; comma-separated list of device ids that must be found in a node resource_id with resource_type 'device'",
-                        "name": "device_ids",
-                        "in": "query"
+                        "description": "Device Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "filter; comma-separated list of key value pairs, value is optional; lists elements only if they have an attribute that is in the given list, example: ?attributes=attrKey1,attrKey2:attrVal2,attrKey3",
-                        "name": "attributes",
+                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
+                        "name": "update-only-same-origin-attributes",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "filter; json encoded []model.Attribute, alternative for attributes query parameter, may be useful if if the attribute origin is important or the key/value contains ',' or ':'",
-                        "name": "attributes_json",
-                        "in": "query"
+                        "description": "attributes",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Attribute"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Graph"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -3781,32 +3825,40 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "post": {
-                "description": "create graph",
+            }
+        },
+        "/devices/{id}/configuration": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "get the configuration values of the device; values are identified by service id and content-variable path (e.g. \"payload.duration\")",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "graphs"
+                    "devices"
                 ],
-                "summary": "create graph",
+                "summary": "get device configuration",
                 "parameters": [
                     {
-                        "description": "graph",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Graph"
-                        }
+                        "type": "string",
+                        "description": "Device Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graph"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceConfigurationValue"
+                            }
                         }
                     },
                     "400": {
@@ -3824,39 +3876,95 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/graphs/{id}": {
-            "get": {
-                "description": "get graph",
+                ],
+                "description": "replaces the configuration values of the device; requires write rights on the device.\neach value references a configurable content-variable (input, leaf, not void) by service id and content-variable path (e.g. \"payload.duration\").\nvalues are checked against the content-variable type, the characteristic constraints and the characteristic pattern.\nthe configuration is returned by the extended-devices endpoints, preserved on device updates and published with the device.\nvalues, that no longer match the device-type (e.g. after a device-type update), are removed",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "graphs"
+                    "devices"
                 ],
-                "summary": "get graph",
+                "summary": "set device configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Graph Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "configuration",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceConfigurationValue"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graph"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceConfigurationValue"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/devices/{id}/connection-state": {
+            "put": {
+                "description": "set device connection-state",
+                "tags": [
+                    "devices"
+                ],
+                "summary": "set device connection-state",
+                "parameters": [
+                    {
+                        "description": "connected true/false",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "boolean"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -3879,31 +3987,33 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
+            }
+        },
+        "/devices/{id}/display_name": {
             "put": {
-                "description": "set graph",
+                "description": "set device display name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "graphs"
+                    "devices"
                 ],
-                "summary": "set graph",
+                "summary": "set device display name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Graph Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "element",
+                        "description": "display name",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Graph"
+                            "type": "string"
                         }
                     }
                 ],
@@ -3911,7 +4021,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graph"
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -3935,20 +4045,27 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
-                "description": "delete graph",
+            }
+        },
+        "/devices/{id}/wot-td": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "renders the device as W3C Web of Things Thing Description of its device-type (ref GET /device-types/{id}/wot-tm); requires read permission on the device",
                 "produces": [
-                    "application/json"
+                    "application/td+json"
                 ],
                 "tags": [
-                    "graphs"
+                    "devices"
                 ],
-                "summary": "delete graph",
+                "summary": "get device as wot thing description",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Graph Id",
+                        "description": "Device Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3956,7 +4073,10 @@ const docTemplatedevicerepository = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wot.Thing"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -3973,49 +4093,60 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/helper/id": {
+        "/export": {
             "get": {
-                "description": "transforms short id to long id",
+                "description": "export",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "helper"
+                    "import/export"
                 ],
-                "summary": "transforms short id to long id",
+                "summary": "export",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "default false; if true, export includes resources like devices, hubs and locations",
+                        "name": "include_owned_information",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "short id",
-                        "name": "short_id",
-                        "in": "query",
-                        "required": true
+                        "description": "comma separated list of resource-types; export only given resource-types (device-types,aspects,functions...)",
+                        "name": "filter_resource_types",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "prefix added to generated long id",
-                        "name": "prefix",
-                        "in": "query",
-                        "required": true
+                        "description": "comma separated list of ids; export only given ids",
+                        "name": "filter_ids",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_SENERGY-Platform_device-repository_lib_model.ImportExport"
                         }
                     },
                     "400": {
                         "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
                     }
                 },
                 "security": [
@@ -4025,17 +4156,81 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/hubs": {
+        "/export/rdf": {
             "get": {
-                "description": "list hubs",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "exports device-classes, functions, aspects (with sub-aspects), concepts, characteristics, protocols and device-types (with services, contents and content-variables) as rdf using the SENERGY ontology (https://senergy.infai.org/ontology/)",
+                "produces": [
+                    "text/turtle",
+                    "application/ld+json"
+                ],
+                "tags": [
+                    "import/export"
+                ],
+                "summary": "export rdf",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "'turtle' or 'jsonld'; defaults to the Accept header or 'turtle'",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list of resource-types; export only given resource-types (device-types,aspects,functions...)",
+                        "name": "filter_resource_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list of ids; export only given ids",
+                        "name": "filter_ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "turtle or json-ld document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/extended-devices": {
+            "get": {
+                "description": "list extended-device",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "extended-devices"
                 ],
-                "summary": "list hubs",
+                "summary": "list extended-device",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "if true, result contains full device-type",
+                        "name": "fulldt",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "default 100, will be ignored if 'ids' is set",
@@ -4066,6 +4261,42 @@ const docTemplatedevicerepository = `{
                         "name": "ids",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "in combination with owner; fills ids filter; comma-separated list",
+                        "name": "local_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "used in combination with local_ids to fill ids filter; defaults to requesting user",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list",
+                        "name": "device-type-ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
+                        "name": "attr-keys",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
+                        "name": "attr-values",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted",
+                        "name": "device-attribute-blacklist",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
@@ -4077,6 +4308,12 @@ const docTemplatedevicerepository = `{
                         "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
                         "name": "p",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4085,7 +4322,13 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Hub"
+                                "$ref": "#/definitions/model.ExtendedDevice"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
                             }
                         }
                     },
@@ -4110,76 +4353,56 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "validate hub",
-                "consumes": [
+            }
+        },
+        "/extended-devices/{id}": {
+            "get": {
+                "description": "get extended-device",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "extended-devices"
                 ],
-                "summary": "validate hub",
+                "summary": "get extended-device",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "must be true; reminder, that this is not an update but a validation",
-                        "name": "dry-run",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Device Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Hub to be validated",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Hub"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
+                        "type": "string",
+                        "description": "interprets the id as local_id if as=='local_id'",
+                        "name": "as",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request"
+                    {
+                        "type": "string",
+                        "description": "default requesting user; used in combination with local_id (as=='local_id') to identify the device",
+                        "name": "owner_id",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                },
-                "security": [
                     {
-                        "Bearer": []
-                    }
-                ]
-            },
-            "post": {
-                "description": "create hub",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "hubs"
-                ],
-                "summary": "create hub",
-                "parameters": [
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
+                        "in": "query"
+                    },
                     {
-                        "description": "element",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Hub"
-                        }
+                        "type": "boolean",
+                        "description": "if true, result contains full device-type",
+                        "name": "fulldt",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hub"
+                            "$ref": "#/definitions/model.ExtendedDevice"
                         }
                     },
                     "400": {
@@ -4205,36 +4428,74 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/hubs/{id}": {
+        "/extended-hubs": {
             "get": {
-                "description": "get hub",
+                "description": "list extended-hubs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "extended-hubs"
                 ],
-                "summary": "get hub",
+                "summary": "list extended-hubs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hub Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
-                        "in": "query"
-                    }
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
+                        "name": "connection-state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hub"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ExtendedHub"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
                         }
                     },
                     "400": {
@@ -4258,16 +4519,18 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "set hub",
+            }
+        },
+        "/extended-hubs/{id}": {
+            "get": {
+                "description": "get extended-hubs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "extended-hubs"
                 ],
-                "summary": "set hub",
+                "summary": "get extended-hubs",
                 "parameters": [
                     {
                         "type": "string",
@@ -4278,31 +4541,16 @@ const docTemplatedevicerepository = `{
                     },
                     {
                         "type": "string",
-                        "description": "only admins may set user_id; overwrites hub.OwnerId; defaults to existing hub.OwnerId and falls back to user-id of requesting user if hub does not exist",
-                        "name": "user_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
-                        "name": "update-only-same-origin-attributes",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
                         "in": "query"
-                    },
-                    {
-                        "description": "element",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Hub"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hub"
+                            "$ref": "#/definitions/models.ExtendedHub"
                         }
                     },
                     "400": {
@@ -4326,64 +4574,48 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
-                "description": "delete hub",
+            }
+        },
+        "/extended-locations": {
+            "get": {
+                "description": "list extended locations",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "locations"
                 ],
-                "summary": "delete hub",
+                "summary": "list extended locations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hub Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request"
-                    },
-                    "401": {
-                        "description": "Unauthorized"
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Forbidden"
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found"
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                },
-                "security": [
                     {
-                        "Bearer": []
-                    }
-                ]
-            },
-            "head": {
-                "description": "head hub",
-                "tags": [
-                    "hubs"
-                ],
-                "summary": "head hub",
-                "parameters": [
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Hub Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
                     },
                     {
                         "type": "string",
@@ -4394,7 +4626,19 @@ const docTemplatedevicerepository = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ExtendedLocation"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -4419,79 +4663,85 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/hubs/{id}/connection-state": {
-            "put": {
-                "description": "set hub connection-state",
-                "tags": [
-                    "hubs"
-                ],
-                "summary": "set hub connection-state",
-                "parameters": [
+        "/function-deprecations": {
+            "get": {
+                "security": [
                     {
-                        "description": "connected true/false",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "boolean"
-                        }
+                        "Bearer": []
                     }
                 ],
+                "description": "list all deprecated functions with their replacements",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "functions"
+                ],
+                "summary": "list function deprecations",
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.FunctionDeprecation"
+                            }
+                        }
                     },
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/hubs/{id}/devices": {
+        "/functions": {
             "get": {
-                "description": "get device ids of hub",
+                "description": "list functions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "functions"
                 ],
-                "summary": "get device ids of hub",
+                "summary": "list functions",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Hub Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "filter; https://senergy.infai.org/ontology/ControllingFunction || https://senergy.infai.org/ontology/MeasuringFunction",
+                        "name": "rdf_type",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "default 'local_id'; valid values 'local_id', 'localId', 'id'; selects if device ids or device local-ids should be returned",
-                        "name": "as",
+                        "description": "filter",
+                        "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
-                        "name": "p",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
                         "in": "query"
                     }
                 ],
@@ -4501,7 +4751,13 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/models.Function"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
                             }
                         }
                     },
@@ -4526,55 +4782,41 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/hubs/{id}/name": {
+            },
             "put": {
-                "description": "set hub name",
-                "produces": [
+                "description": "validate location",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "hubs"
+                    "locations"
                 ],
-                "summary": "set hub name",
+                "summary": "validate location",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hub Id",
-                        "name": "id",
-                        "in": "path",
+                        "type": "boolean",
+                        "description": "must be true; reminder, that this is not an update but a validation",
+                        "name": "dry-run",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "name",
+                        "description": "Location to be validated",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.Location"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.Hub"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -4584,33 +4826,34 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/import": {
-            "put": {
-                "description": "import",
+            },
+            "post": {
+                "description": "create function",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "import/export"
+                    "functions"
                 ],
-                "summary": "import",
+                "summary": "create function",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "default false; if true, import handles resources like devices, hubs and locations",
-                        "name": "include_owned_information",
-                        "in": "query"
-                    },
-                    {
-                        "description": "import",
+                        "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_SENERGY-Platform_device-repository_lib_model.ImportExport"
+                            "$ref": "#/definitions/models.Function"
                         }
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Function"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request"
                     },
@@ -4634,31 +4877,32 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/import-from": {
-            "post": {
-                "description": "import-from",
+        "/functions/{id}": {
+            "get": {
+                "description": "get function",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "import/export"
+                    "functions"
                 ],
-                "summary": "import-from",
+                "summary": "get function",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "default false; if true, import handles resources like devices, hubs and locations",
-                        "name": "include_owned_information",
-                        "in": "query"
-                    },
-                    {
-                        "description": "options containing information what should be imported from where; filter_resource_types and filter_ids are optional but empty lists result in empty results; filter_resource_types allows filtering by resource-types like 'aspects' (plural like the http endpoints); filter_ids allows filtering by resource id; ref documentation of GET /export",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ImportFromOptions"
-                        }
+                        "type": "string",
+                        "description": "Function Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeviceClass"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request"
                     },
@@ -4680,51 +4924,39 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/invalid/device-type": {
-            "get": {
-                "description": "validate existing device-types",
+            },
+            "put": {
+                "description": "set function",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "functions"
                 ],
+                "summary": "set function",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100; limit of checked device-types not of returned errors",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0",
-                        "name": "offset",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "Function Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "allow none leaf aspect nodes in device-types",
-                        "name": "allow_none_leaf_aspect_nodes_in_device_types",
-                        "in": "query"
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Function"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.ValidationError"
-                            }
+                            "$ref": "#/definitions/models.Function"
                         }
                     },
                     "400": {
@@ -4748,32 +4980,31 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/last-update-timestamps": {
-            "get": {
-                "description": "list last update timestamps for a user",
-                "produces": [
-                    "application/json"
+            },
+            "delete": {
+                "description": "delete function; may only be called by admins; can also be used to only validate deletes",
+                "tags": [
+                    "functions"
                 ],
-                "summary": "last update timestamps",
+                "summary": "delete function",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "admins may explicitly set the userId which is by default inferred from the jwt",
-                        "name": "user_id",
+                        "type": "boolean",
+                        "description": "only validate deletion",
+                        "name": "dry-run",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Functions Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.LastUpdateTimestamp"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -4789,90 +5020,80 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/local-devices/{id}": {
+        "/functions/{id}/deprecation": {
             "get": {
-                "description": "get device by local id",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "get the deprecation of a function",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "functions"
                 ],
-                "summary": "get device by local id",
+                "summary": "get function deprecation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Local Id",
+                        "description": "Function Id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "defaults to requesting user; used in combination with id to find device",
-                        "name": "owner_id",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/model.FunctionDeprecation"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request"
-                    },
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "put": {
-                "description": "set device (local-id variant)",
+                ],
+                "description": "marks the function as deprecated; requires admin rights.\nthe replacement must be an existing, not deprecated function of the same type (controlling/measuring).\ncharacteristic_mapping maps characteristics of the deprecated functions concept to characteristics of the replacement functions concept.\nusages are not changed until POST /functions/{id}/migrate is called",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "functions"
                 ],
-                "summary": "set device (local-id variant)",
+                "summary": "deprecate function",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Local Id",
+                        "description": "Function Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
-                        "name": "update-only-same-origin-attributes",
-                        "in": "query"
-                    },
-                    {
-                        "description": "element",
+                        "description": "deprecation",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/model.FunctionDeprecation"
                         }
                     }
                 ],
@@ -4880,7 +5101,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Device"
+                            "$ref": "#/definitions/model.FunctionDeprecation"
                         }
                     },
                     "400": {
@@ -4889,98 +5110,86 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "post": {
-                "description": "create device (local-id variant)",
-                "produces": [
-                    "application/json"
                 ],
+                "description": "removes the deprecation of a function; requires admin rights",
                 "tags": [
-                    "devices"
+                    "functions"
                 ],
-                "summary": "create device (local-id variant)",
+                "summary": "remove function deprecation",
                 "parameters": [
                     {
-                        "description": "element",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Device"
-                        }
+                        "type": "string",
+                        "description": "Function Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.Device"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request"
+                        "description": "OK"
                     },
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/functions/{id}/migrate": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "delete": {
-                "description": "delete device (local-id variant)",
+                ],
+                "description": "replaces every reference to the deprecated function in device-types, device-type-extensions and device-type-templates\nwith its replacement, maps characteristics by the deprecations characteristic_mapping and recomputes the criteria of affected device-groups; requires admin rights.\nfails without changes, if a used characteristic is not part of the replacement functions concept and has no mapping.\nrewritten_device_type_ids and rewritten_device_type_template_ids list the stored changes; an interrupted migration may be retried and only migrates the remaining usages.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "devices"
+                    "functions"
                 ],
-                "summary": "delete device (local-id variant)",
+                "summary": "migrate deprecated function",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device Local Id",
+                        "description": "Id of the deprecated function",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "defaults to requesting user; used in combination with id to find device",
-                        "name": "owner_id",
+                        "type": "boolean",
+                        "description": "only compute the impact",
+                        "name": "dry-run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.FunctionMigrationResult"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -4988,33 +5197,71 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "404": {
                         "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/functions/{id}/usage": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
+                ],
+                "description": "lists every device-type service content-variable referencing the function",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "functions"
+                ],
+                "summary": "function usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Function Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.FunctionUsage"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
             }
         },
-        "/locations": {
+        "/graphs": {
             "get": {
-                "description": "list location",
+                "description": "list graph",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "graphs"
                 ],
-                "summary": "list location",
+                "summary": "list graph",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5051,6 +5298,24 @@ const docTemplatedevicerepository = `{
                         "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
                         "name": "p",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list of device ids that must be found in a node resource_id with resource_type 'device'",
+                        "name": "device_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list of key value pairs, value is optional; lists elements only if they have an attribute that is in the given list, example: ?attributes=attrKey1,attrKey2:attrVal2,attrKey3",
+                        "name": "attributes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; json encoded []model.Attribute, alternative for attributes query parameter, may be useful if if the attribute origin is important or the key/value contains ',' or ':'",
+                        "name": "attributes_json",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5059,7 +5324,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Location"
+                                "$ref": "#/definitions/models.Graph"
                             }
                         },
                         "headers": {
@@ -5092,22 +5357,22 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "post": {
-                "description": "create location",
+                "description": "create graph",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "graphs"
                 ],
-                "summary": "create location",
+                "summary": "create graph",
                 "parameters": [
                     {
-                        "description": "location",
+                        "description": "graph",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Graph"
                         }
                     }
                 ],
@@ -5115,7 +5380,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Graph"
                         }
                     },
                     "400": {
@@ -5141,20 +5406,20 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/locations/{id}": {
+        "/graphs/{id}": {
             "get": {
-                "description": "get location",
+                "description": "get graph",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "graphs"
                 ],
-                "summary": "get location",
+                "summary": "get graph",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location Id",
+                        "description": "Graph Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5164,7 +5429,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Graph"
                         }
                     },
                     "400": {
@@ -5190,18 +5455,18 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "put": {
-                "description": "set location",
+                "description": "set graph",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "graphs"
                 ],
-                "summary": "set location",
+                "summary": "set graph",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location Id",
+                        "description": "Graph Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5212,7 +5477,7 @@ const docTemplatedevicerepository = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Graph"
                         }
                     }
                 ],
@@ -5220,7 +5485,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Location"
+                            "$ref": "#/definitions/models.Graph"
                         }
                     },
                     "400": {
@@ -5246,18 +5511,18 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "delete": {
-                "description": "delete location",
+                "description": "delete graph",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "locations"
+                    "graphs"
                 ],
-                "summary": "delete location",
+                "summary": "delete graph",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location Id",
+                        "description": "Graph Id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5290,97 +5555,87 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/measuring-functions": {
+        "/health/live": {
             "get": {
-                "description": "list measuring-functions",
+                "description": "returns 200 as long as the process is able to handle requests; does not check dependencies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "health"
                 ],
-                "summary": "list measuring-functions",
+                "summary": "liveness",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Function"
-                            }
+                            "$ref": "#/definitions/model.HealthReport"
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request"
-                    },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "checks mongodb, kafka (if configured), permissions-v2, the mirror source (if AsMgwMirror) and the startup (migrations, sync loop); the response contains a report per dependency; until the startup is finished, endpoints other than /health/* respond with 503",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "readiness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.HealthReport"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.HealthReport"
+                        }
                     }
-                ]
+                }
             }
         },
-        "/permissions/accessible/device-groups": {
+        "/helper/id": {
             "get": {
-                "description": "list accessible resource ids",
+                "description": "transforms short id to long id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "helper"
                 ],
-                "summary": "list accessible resource ids",
+                "summary": "transforms short id to long id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "short id",
+                        "name": "short_id",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "prefix added to generated long id",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request"
-                    },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
                 },
                 "security": [
@@ -5390,33 +5645,57 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/accessible/devices": {
+        "/hubs": {
             "get": {
-                "description": "list accessible resource ids",
+                "description": "list hubs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "hubs"
                 ],
-                "summary": "list accessible resource ids",
+                "summary": "list hubs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
+                        "description": "filter; valid values are 'online', 'offline' and an empty string for unknown states",
+                        "name": "connection-state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
                         "in": "query"
                     }
                 ],
@@ -5426,7 +5705,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/models.Hub"
                             }
                         }
                     },
@@ -5436,6 +5715,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5445,54 +5730,41 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/accessible/hubs": {
-            "get": {
-                "description": "list accessible resource ids",
-                "produces": [
+            },
+            "put": {
+                "description": "validate hub",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "hubs"
                 ],
-                "summary": "list accessible resource ids",
+                "summary": "validate hub",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "boolean",
+                        "description": "must be true; reminder, that this is not an update but a validation",
+                        "name": "dry-run",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Hub to be validated",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Hub"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5502,46 +5774,32 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/accessible/locations": {
-            "get": {
-                "description": "list accessible resource ids",
+            },
+            "post": {
+                "description": "create hub",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "hubs"
                 ],
-                "summary": "list accessible resource ids",
+                "summary": "create hub",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Hub"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.Hub"
                         }
                     },
                     "400": {
@@ -5550,6 +5808,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5561,28 +5825,28 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/check/device-groups": {
+        "/hubs/{id}": {
             "get": {
-                "description": "check multiple permissions",
+                "description": "get hub",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "hubs"
                 ],
-                "summary": "check multiple permissions",
+                "summary": "get hub",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Hub Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
                         "in": "query"
                     }
                 ],
@@ -5590,10 +5854,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "boolean"
-                            }
+                            "$ref": "#/definitions/models.Hub"
                         }
                     },
                     "400": {
@@ -5602,6 +5863,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5611,38 +5878,51 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/check/device-groups/{id}": {
-            "get": {
-                "description": "check permission",
+            },
+            "put": {
+                "description": "set hub",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "hubs"
                 ],
-                "summary": "check permission",
+                "summary": "set hub",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Hub Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
+                        "description": "only admins may set user_id; overwrites hub.OwnerId; defaults to existing hub.OwnerId and falls back to user-id of requesting user if hub does not exist",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
+                        "name": "update-only-same-origin-attributes",
                         "in": "query"
+                    },
+                    {
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Hub"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/models.Hub"
                         }
                     },
                     "400": {
@@ -5651,6 +5931,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5660,42 +5946,28 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/check/devices": {
-            "get": {
-                "description": "check multiple permissions",
+            },
+            "delete": {
+                "description": "delete hub",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "hubs"
                 ],
-                "summary": "check multiple permissions",
+                "summary": "delete hub",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Hub Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "boolean"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -5703,48 +5975,46 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                },
-                "security": [
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
                     {
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/check/devices/{id}": {
-            "get": {
-                "description": "check permission",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "head": {
+                "description": "head hub",
                 "tags": [
-                    "permissions-devices"
+                    "hubs"
                 ],
-                "summary": "check permission",
+                "summary": "head hub",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Hub Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "boolean"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -5752,6 +6022,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5763,40 +6039,27 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/check/hubs": {
-            "get": {
-                "description": "check multiple permissions",
-                "produces": [
-                    "application/json"
-                ],
+        "/hubs/{id}/connection-state": {
+            "put": {
+                "description": "set hub connection-state",
                 "tags": [
-                    "permissions-hubs"
+                    "hubs"
                 ],
-                "summary": "check multiple permissions",
+                "summary": "set hub connection-state",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
+                        "description": "connected true/false",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "boolean"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "boolean"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -5804,6 +6067,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5815,28 +6084,34 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/check/hubs/{id}": {
+        "/hubs/{id}/devices": {
             "get": {
-                "description": "check permission",
+                "description": "get device ids of hub",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "hubs"
                 ],
-                "summary": "check permission",
+                "summary": "get device ids of hub",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Hub Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
+                        "description": "default 'local_id'; valid values 'local_id', 'localId', 'id'; selects if device ids or device local-ids should be returned",
+                        "name": "as",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
                         "in": "query"
                     }
                 ],
@@ -5844,7 +6119,10 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "boolean"
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -5853,6 +6131,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5864,39 +6148,39 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/check/locations": {
-            "get": {
-                "description": "check multiple permissions",
+        "/hubs/{id}/name": {
+            "put": {
+                "description": "set hub name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "hubs"
                 ],
-                "summary": "check multiple permissions",
+                "summary": "set hub name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Hub Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
+                        "description": "name",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "boolean"
-                            }
+                            "$ref": "#/definitions/models.Hub"
                         }
                     },
                     "400": {
@@ -5905,6 +6189,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5916,44 +6206,43 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/check/locations/{id}": {
-            "get": {
-                "description": "check permission",
-                "produces": [
-                    "application/json"
-                ],
+        "/import": {
+            "put": {
+                "description": "import",
                 "tags": [
-                    "permissions-locations"
+                    "import/export"
                 ],
-                "summary": "check permission",
+                "summary": "import",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "default false; if true, import handles resources like devices, hubs and locations",
+                        "name": "include_owned_information",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
-                        "name": "permissions",
-                        "in": "query"
+                        "description": "import",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_SENERGY-Platform_device-repository_lib_model.ImportExport"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "boolean"
-                        }
-                    },
                     "400": {
                         "description": "Bad Request"
                     },
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -5965,40 +6254,31 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/manage/device-groups": {
-            "get": {
-                "description": "lists resources the user has admin rights to",
-                "produces": [
-                    "application/json"
-                ],
+        "/import-from": {
+            "post": {
+                "description": "import-from",
                 "tags": [
-                    "permissions-device-groups"
+                    "import/export"
                 ],
-                "summary": "lists resources the user has admin rights to",
+                "summary": "import-from",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
+                        "type": "boolean",
+                        "description": "default false; if true, import handles resources like devices, hubs and locations",
+                        "name": "include_owned_information",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "options containing information what should be imported from where; filter_resource_types and filter_ids are optional but empty lists result in empty results; filter_resource_types allows filtering by resource-types like 'aspects' (plural like the http endpoints); filter_ids allows filtering by resource id; ref documentation of GET /export",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportFromOptions"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Resource"
-                            }
-                        }
-                    },
                     "400": {
                         "description": "Bad Request"
                     },
@@ -6008,6 +6288,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6019,30 +6302,53 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/manage/device-groups/{id}": {
-            "get": {
-                "description": "get resource, requesting user must have admin right  on the resource",
+        "/import/rdf": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "imports functions, aspects (with sub-aspects), concepts, characteristics and device-classes from turtle or json-ld using the SENERGY ontology (https://senergy.infai.org/ontology/), as produced by GET /export/rdf; resources are validated like their individual PUT endpoints; existing concept conversions are kept; only admins may import",
+                "consumes": [
+                    "text/turtle",
+                    "application/ld+json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "import/export"
                 ],
-                "summary": "get resource",
+                "summary": "import rdf",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "'turtle' or 'jsonld'; defaults to the Content-Type header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only validate and list the resulting changes",
+                        "name": "dry-run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "turtle or json-ld document",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Resource"
+                            "$ref": "#/definitions/model.ImportResult"
                         }
                     },
                     "400": {
@@ -6057,46 +6363,48 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            }
+        },
+        "/import/wot-tm": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            },
-            "put": {
-                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (device-groups) to create",
+                ],
+                "description": "creates a device-type draft from a W3C Web of Things Thing Model; properties become request services (observable: event+request) and writable properties additional 'set_\u003cname\u003e' services; actions become request services; events become event services; data schemas become content-variables; object schemas with one property per protocol segment name are split into one content per segment; SENERGY terms like 'ses:function' are kept; content-variables without function get functions and aspects suggested by name matching; without save=true the draft is only validated and returned for review",
                 "consumes": [
-                    "application/json"
+                    "application/tm+json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "device-types"
                 ],
-                "summary": "set resource rights",
+                "summary": "import device-type from wot thing model",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
+                        "description": "protocol used by all services",
+                        "name": "protocol_id",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
-                        "name": "wait",
+                        "description": "save the device-type like POST /device-types",
+                        "name": "save",
                         "in": "query"
                     },
                     {
-                        "description": "Topic",
+                        "description": "Thing Model",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "$ref": "#/definitions/wot.Thing"
                         }
                     }
                 ],
@@ -6104,7 +6412,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "$ref": "#/definitions/model.WotImportResult"
                         }
                     },
                     "400": {
@@ -6119,36 +6427,42 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/permissions/manage/devices": {
+        "/invalid/device-type": {
             "get": {
-                "description": "lists resources the user has admin rights to",
+                "description": "validate existing device-types",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "device-types"
                 ],
-                "summary": "lists resources the user has admin rights to",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
+                        "description": "default 100; limit of checked device-types not of returned errors",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "offset to be used in combination with limit",
+                        "description": "default 0",
                         "name": "offset",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "allow none leaf aspect nodes in device-types",
+                        "name": "allow_none_leaf_aspect_nodes_in_device_types",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6157,7 +6471,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.Resource"
+                                "$ref": "#/definitions/api.ValidationError"
                             }
                         }
                     },
@@ -6170,6 +6484,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6181,41 +6498,34 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/manage/devices/{id}": {
+        "/last-update-timestamps": {
             "get": {
-                "description": "get resource, requesting user must have admin right  on the resource",
+                "description": "list last update timestamps for a user",
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "permissions-devices"
-                ],
-                "summary": "get resource",
+                "summary": "last update timestamps",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "admins may explicitly set the userId which is by default inferred from the jwt",
+                        "name": "user_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Resource"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.LastUpdateTimestamp"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request"
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6225,48 +6535,58 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (devices) to create",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/lint/device-types": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "checks existing device-types against the lint rules; only device-types with findings are returned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "device-types",
+                    "lint"
                 ],
-                "summary": "set resource rights",
+                "summary": "lint device-types",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "default 100; limit of checked device-types not of returned results",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
-                        "name": "wait",
+                        "type": "integer",
+                        "description": "default 0",
+                        "name": "offset",
                         "in": "query"
                     },
                     {
-                        "description": "Topic",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
-                        }
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "allow none leaf aspect nodes in device-types",
+                        "name": "allow_none_leaf_aspect_nodes_in_device_types",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.LintResult"
+                            }
                         }
                     },
                     "400": {
@@ -6275,52 +6595,51 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
+                }
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
-                ]
-            }
-        },
-        "/permissions/manage/hubs": {
-            "get": {
-                "description": "lists resources the user has admin rights to",
+                ],
+                "description": "checks a device-type draft against the lint rules (validation, measuring_function_without_aspect, characteristic_not_in_function_concept, duplicate_content_variable_path, service_without_function, inconsistent_naming, unused_service_group).\nthe severity of each rule (error, warning or off) is configured per installation; findings are returned with status 200 regardless of their severity",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "device-types",
+                    "lint"
                 ],
-                "summary": "lists resources the user has admin rights to",
+                "summary": "lint device-type draft",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "allow none leaf aspect nodes in device-types",
+                        "name": "allow_none_leaf_aspect_nodes_in_device_types",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "device-type draft",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeviceType"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Resource"
-                            }
+                            "$ref": "#/definitions/model.LintResult"
                         }
                     },
                     "400": {
@@ -6329,44 +6648,42 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/permissions/manage/hubs/{id}": {
+        "/local-devices/{id}": {
             "get": {
-                "description": "get resource, requesting user must have admin right  on the resource",
+                "description": "get device by local id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "devices"
                 ],
-                "summary": "get resource",
+                "summary": "get device by local id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Device Local Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "defaults to requesting user; used in combination with id to find device",
+                        "name": "owner_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Resource"
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -6378,6 +6695,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6389,38 +6709,35 @@ const docTemplatedevicerepository = `{
                 ]
             },
             "put": {
-                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (hubs) to create",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "set device (local-id variant)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "devices"
                 ],
-                "summary": "set resource rights",
+                "summary": "set device (local-id variant)",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Device Local Id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
-                        "name": "wait",
+                        "type": "string",
+                        "description": "comma separated list; ensure that no attribute from another origin is overwritten",
+                        "name": "update-only-same-origin-attributes",
                         "in": "query"
                     },
                     {
-                        "description": "Topic",
+                        "description": "element",
                         "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "$ref": "#/definitions/models.Device"
                         }
                     }
                 ],
@@ -6428,7 +6745,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -6440,6 +6757,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6449,40 +6769,32 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/manage/locations": {
-            "get": {
-                "description": "lists resources the user has admin rights to",
+            },
+            "post": {
+                "description": "create device (local-id variant)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "devices"
                 ],
-                "summary": "lists resources the user has admin rights to",
+                "summary": "create device (local-id variant)",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "limits size of result; 0 means unlimited",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "offset to be used in combination with limit",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Device"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Resource"
-                            }
+                            "$ref": "#/definitions/models.Device"
                         }
                     },
                     "400": {
@@ -6494,6 +6806,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6503,33 +6818,34 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/manage/locations/{id}": {
-            "get": {
-                "description": "get resource, requesting user must have admin right  on the resource",
+            },
+            "delete": {
+                "description": "delete device (local-id variant)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "devices"
                 ],
-                "summary": "get resource",
+                "summary": "delete device (local-id variant)",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Id",
+                        "description": "Device Local Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "defaults to requesting user; used in combination with id to find device",
+                        "name": "owner_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.Resource"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -6540,6 +6856,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6549,48 +6868,70 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (locations) to create",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/locations": {
+            "get": {
+                "description": "list location",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "locations"
                 ],
-                "summary": "set resource rights",
+                "summary": "list location",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Resource Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
-                        "name": "wait",
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
-                        "description": "Topic",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
-                        }
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate",
+                        "name": "p",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResourcePermissions"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Location"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
                         }
                     },
                     "400": {
@@ -6602,6 +6943,9 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6611,35 +6955,32 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/permissions/device-groups": {
-            "get": {
-                "description": "list the computed permissions to resources of the given topic (device-groups) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+            },
+            "post": {
+                "description": "create location",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
+                    "locations"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (device-groups) and ids",
+                "summary": "create location",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
-                        "required": true
+                        "description": "location",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Location"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
-                            }
+                            "$ref": "#/definitions/models.Location"
                         }
                     },
                     "400": {
@@ -6648,6 +6989,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6659,22 +7006,22 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/permissions/devices": {
+        "/locations/{id}": {
             "get": {
-                "description": "list the computed permissions to resources of the given topic (devices) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "description": "get location",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "locations"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (devices) and ids",
+                "summary": "get location",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Location Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -6682,10 +7029,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
-                            }
+                            "$ref": "#/definitions/models.Location"
                         }
                     },
                     "400": {
@@ -6694,6 +7038,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6703,35 +7053,39 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/permissions/hubs": {
-            "get": {
-                "description": "list the computed permissions to resources of the given topic (hubs) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+            },
+            "put": {
+                "description": "set location",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "locations"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (hubs) and ids",
+                "summary": "set location",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Location Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Location"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
-                            }
+                            "$ref": "#/definitions/models.Location"
                         }
                     },
                     "400": {
@@ -6740,6 +7094,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6749,36 +7109,28 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/permissions/permissions/locations": {
-            "get": {
-                "description": "list the computed permissions to resources of the given topic (locations) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+            },
+            "delete": {
+                "description": "delete location",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "locations"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (locations) and ids",
+                "summary": "delete location",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Ids, comma seperated",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Location Id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request"
@@ -6786,7 +7138,13 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "500": {
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
                         "description": "Internal Server Error"
                     }
                 },
@@ -6797,37 +7155,23 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/query/permissions/device-groups": {
-            "post": {
-                "description": "list the computed permissions to resources of the given topic (device-groups) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+        "/measuring-functions": {
+            "get": {
+                "description": "list measuring-functions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-device-groups"
-                ],
-                "summary": "list the computed permissions to resources of the given topic (device-groups) and ids",
-                "parameters": [
-                    {
-                        "description": "Resource Ids",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
-                    }
+                    "functions"
                 ],
+                "summary": "list measuring-functions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
+                                "$ref": "#/definitions/models.Function"
                             }
                         }
                     },
@@ -6837,6 +7181,12 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -6848,27 +7198,38 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/query/permissions/devices": {
+        "/modelling-suggestions/device-types": {
             "post": {
-                "description": "list the computed permissions to resources of the given topic (devices) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "suggests function, aspect and characteristic for every content-variable of the device-type draft without function.\nsuggestions are derived from similar content-variables (name, path, type and unit) of existing device-types; content-variables without similar annotated content-variables are omitted",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-devices"
+                    "device-types"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (devices) and ids",
+                "summary": "suggest device-type modelling",
                 "parameters": [
                     {
-                        "description": "Resource Ids",
-                        "name": "ids",
+                        "type": "integer",
+                        "description": "default 3; max suggestions per function, aspect and characteristic",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "description": "device-type draft",
+                        "name": "message",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.DeviceType"
                         }
                     }
                 ],
@@ -6878,7 +7239,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
+                                "$ref": "#/definitions/model.ModellingSuggestion"
                             }
                         }
                     },
@@ -6891,36 +7252,37 @@ const docTemplatedevicerepository = `{
                     "500": {
                         "description": "Internal Server Error"
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/permissions/query/permissions/hubs": {
-            "post": {
-                "description": "list the computed permissions to resources of the given topic (hubs) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+        "/permissions/accessible/device-groups": {
+            "get": {
+                "description": "list accessible resource ids",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-hubs"
+                    "permissions-device-groups"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (hubs) and ids",
+                "summary": "list accessible resource ids",
                 "parameters": [
                     {
-                        "description": "Resource Ids",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limits size of result; 0 means unlimited",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset to be used in combination with limit",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6929,7 +7291,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
+                                "type": "string"
                             }
                         }
                     },
@@ -6950,28 +7312,34 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/permissions/query/permissions/locations": {
-            "post": {
-                "description": "list the computed permissions to resources of the given topic (locations) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+        "/permissions/accessible/devices": {
+            "get": {
+                "description": "list accessible resource ids",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "permissions-locations"
+                    "permissions-devices"
                 ],
-                "summary": "list the computed permissions to resources of the given topic (locations) and ids",
+                "summary": "list accessible resource ids",
                 "parameters": [
                     {
-                        "description": "Resource Ids",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limits size of result; 0 means unlimited",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset to be used in combination with limit",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6980,7 +7348,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ComputedPermissions"
+                                "type": "string"
                             }
                         }
                     },
@@ -7001,33 +7369,33 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/protocols": {
+        "/permissions/accessible/hubs": {
             "get": {
-                "description": "get protocol",
+                "description": "list accessible resource ids",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-hubs"
                 ],
-                "summary": "get protocol",
+                "summary": "list accessible resource ids",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "default 100",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "default 0",
-                        "name": "offset",
+                        "description": "limits size of result; 0 means unlimited",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
+                        "type": "integer",
+                        "description": "offset to be used in combination with limit",
+                        "name": "offset",
                         "in": "query"
                     }
                 ],
@@ -7037,7 +7405,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Protocol"
+                                "type": "string"
                             }
                         }
                     },
@@ -7047,12 +7415,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7062,41 +7424,54 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "validate protocol",
-                "consumes": [
+            }
+        },
+        "/permissions/accessible/locations": {
+            "get": {
+                "description": "list accessible resource ids",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-locations"
                 ],
-                "summary": "validate protocol",
+                "summary": "list accessible resource ids",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "must be true; reminder, that this is not an update but a validation",
-                        "name": "dry-run",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     },
                     {
-                        "description": "Location to be validated",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Protocol"
-                        }
+                        "type": "integer",
+                        "description": "limits size of result; 0 means unlimited",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset to be used in combination with limit",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7106,32 +7481,41 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "post": {
-                "description": "create protocol",
+            }
+        },
+        "/permissions/check/device-groups": {
+            "get": {
+                "description": "check multiple permissions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-device-groups"
                 ],
-                "summary": "create protocol",
+                "summary": "check multiple permissions",
                 "parameters": [
                     {
-                        "description": "element",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Protocol"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "type": "string",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Protocol"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
+                            }
                         }
                     },
                     "400": {
@@ -7140,12 +7524,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7157,30 +7535,36 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/protocols/{id}": {
+        "/permissions/check/device-groups/{id}": {
             "get": {
-                "description": "get protocol",
+                "description": "check permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-device-groups"
                 ],
-                "summary": "get protocol",
+                "summary": "check permission",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Protocol Id",
+                        "description": "Resource Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Protocol"
+                            "type": "boolean"
                         }
                     },
                     "400": {
@@ -7189,12 +7573,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7204,39 +7582,41 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "description": "set protocol",
+            }
+        },
+        "/permissions/check/devices": {
+            "get": {
+                "description": "check multiple permissions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-devices"
                 ],
-                "summary": "set protocol",
+                "summary": "check multiple permissions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Protocol Id",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "element",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Protocol"
-                        }
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Protocol"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
+                            }
                         }
                     },
                     "400": {
@@ -7245,12 +7625,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7260,28 +7634,39 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
-                "description": "delete protocol",
+            }
+        },
+        "/permissions/check/devices/{id}": {
+            "get": {
+                "description": "check permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "protocols"
+                    "permissions-devices"
                 ],
-                "summary": "delete protocol",
+                "summary": "check permission",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Protocol Id",
+                        "description": "Resource Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "boolean"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -7289,12 +7674,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7306,37 +7685,38 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/query/aspect-nodes": {
-            "post": {
-                "description": "query aspect-nodes",
-                "consumes": [
-                    "application/json"
-                ],
+        "/permissions/check/hubs": {
+            "get": {
+                "description": "check multiple permissions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "aspect-nodes"
+                    "permissions-hubs"
                 ],
-                "summary": "query aspect-nodes",
+                "summary": "check multiple permissions",
                 "parameters": [
                     {
-                        "description": "AspectNodeQuery",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.AspectNodeQuery"
-                        }
+                        "type": "string",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.AspectNode"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
                             }
                         }
                     },
@@ -7346,12 +7726,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7363,48 +7737,28 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/query/device-type-selectables": {
-            "post": {
-                "description": "validate device-type",
-                "consumes": [
-                    "application/json"
-                ],
+        "/permissions/check/hubs/{id}": {
+            "get": {
+                "description": "check permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "permissions-hubs"
                 ],
-                "summary": "validate device-type",
+                "summary": "check permission",
                 "parameters": [
-                    {
-                        "description": "filtered by criteria",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.FilterCriteria"
-                            }
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "prefix added to variable paths",
-                        "name": "path-prefix",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "'event', 'request', 'event+request'",
-                        "name": "interactions-filter",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "include id-modified device-types",
-                        "name": "include_id_modified",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
                         "in": "query"
                     }
                 ],
@@ -7412,10 +7766,7 @@ const docTemplatedevicerepository = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.DeviceTypeSelectable"
-                            }
+                            "type": "boolean"
                         }
                     },
                     "400": {
@@ -7424,12 +7775,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7441,43 +7786,38 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/query/functions": {
-            "post": {
-                "description": "list functions",
-                "consumes": [
-                    "application/json"
-                ],
+        "/permissions/check/locations": {
+            "get": {
+                "description": "check multiple permissions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "functions"
+                    "permissions-locations"
                 ],
-                "summary": "list functions",
+                "summary": "check multiple permissions",
                 "parameters": [
                     {
-                        "description": "list options",
-                        "name": "query",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.FunctionListOptions"
-                        }
+                        "type": "string",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Function"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
                             }
                         }
                     },
@@ -7487,12 +7827,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7504,42 +7838,43 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/query/used-in-device-type": {
-            "post": {
-                "description": "query used-in-device-type",
-                "consumes": [
-                    "application/json"
-                ],
+        "/permissions/check/locations/{id}": {
+            "get": {
+                "description": "check permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "permissions-locations"
                 ],
-                "summary": "query used-in-device-type",
+                "summary": "check permission",
                 "parameters": [
                     {
-                        "description": "filter",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UsedInDeviceTypeQuery"
-                        }
+                        "type": "string",
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "checked permissions in the form of 'rwxa', defaults to 'r'",
+                        "name": "permissions",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.UsedInDeviceTypeResponse"
+                            "type": "boolean"
                         }
                     },
                     "400": {
                         "description": "Bad Request"
                     },
-                    "404": {
-                        "description": "Not Found"
+                    "401": {
+                        "description": "Unauthorized"
                     },
                     "500": {
                         "description": "Internal Server Error"
@@ -7552,30 +7887,38 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/services/{id}": {
+        "/permissions/manage/device-groups": {
             "get": {
-                "description": "get service",
+                "description": "lists resources the user has admin rights to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "services"
+                    "permissions-device-groups"
                 ],
-                "summary": "get service",
+                "summary": "lists resources the user has admin rights to",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Service Id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "limits size of result; 0 means unlimited",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset to be used in combination with limit",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Service"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Resource"
+                            }
                         }
                     },
                     "400": {
@@ -7587,9 +7930,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7601,92 +7941,30 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/user-device-types": {
+        "/permissions/manage/device-groups/{id}": {
             "get": {
-                "description": "list device-types used by the requesting user",
+                "description": "get resource, requesting user must have admin right  on the resource",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "permissions-device-groups"
                 ],
-                "summary": "list user device-types",
+                "summary": "get resource",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they use a protocol that is in the given list",
-                        "name": "protocol-ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
-                        "name": "attr-keys",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
-                        "name": "attr-values",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "include id-modified device-types",
-                        "name": "include-modified",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "no unmodified device-types",
-                        "name": "ignore-unmodified",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter; json encoded []model.FilterCriteria",
-                        "name": "criteria",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.DeviceType"
-                            }
+                            "$ref": "#/definitions/model.Resource"
                         }
                     },
                     "400": {
@@ -7698,9 +7976,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7710,31 +7985,59 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/users/{id}": {
-            "delete": {
-                "description": "delete user; only admins may use this method",
+            },
+            "put": {
+                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (device-groups) to create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "permissions-device-groups"
                 ],
-                "summary": "delete user",
+                "summary": "set resource rights",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User Id",
+                        "description": "Resource Id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
+                        "name": "wait",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Topic",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7746,46 +8049,28 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/aspect-nodes": {
+        "/permissions/manage/devices": {
             "get": {
-                "description": "list aspect-nodes",
+                "description": "lists resources the user has admin rights to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "aspect-nodes"
+                    "permissions-devices"
                 ],
-                "summary": "list aspect-nodes",
+                "summary": "lists resources the user has admin rights to",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
+                        "description": "limits size of result; 0 means unlimited",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
+                        "description": "offset to be used in combination with limit",
                         "name": "offset",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -7794,13 +8079,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.AspectNode"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
+                                "$ref": "#/definitions/model.Resource"
                             }
                         }
                     },
@@ -7813,9 +8092,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7827,62 +8103,30 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/aspects": {
+        "/permissions/manage/devices/{id}": {
             "get": {
-                "description": "list aspects",
+                "description": "get resource, requesting user must have admin right  on the resource",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "aspects"
+                    "permissions-devices"
                 ],
-                "summary": "list aspects",
+                "summary": "get resource",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Aspect"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/model.Resource"
                         }
                     },
                     "400": {
@@ -7894,9 +8138,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7906,64 +8147,48 @@ const docTemplatedevicerepository = `{
                         "Bearer": []
                     }
                 ]
-            }
-        },
-        "/v2/characteristics": {
-            "get": {
-                "description": "list characteristics",
+            },
+            "put": {
+                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (devices) to create",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "characteristics"
+                    "permissions-devices"
                 ],
-                "summary": "list characteristics",
+                "summary": "set resource rights",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
+                        "type": "boolean",
+                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
+                        "name": "wait",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
+                        "description": "Topic",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Characteristic"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/model.ResourcePermissions"
                         }
                     },
                     "400": {
@@ -7975,9 +8200,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -7989,46 +8211,28 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/concepts": {
+        "/permissions/manage/hubs": {
             "get": {
-                "description": "list concepts",
+                "description": "lists resources the user has admin rights to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "concepts"
+                    "permissions-hubs"
                 ],
-                "summary": "list concepts",
+                "summary": "lists resources the user has admin rights to",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
+                        "description": "limits size of result; 0 means unlimited",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
+                        "description": "offset to be used in combination with limit",
                         "name": "offset",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -8037,13 +8241,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Concept"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
+                                "$ref": "#/definitions/model.Resource"
                             }
                         }
                     },
@@ -8056,9 +8254,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -8070,62 +8265,92 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/concepts-with-characteristics": {
+        "/permissions/manage/hubs/{id}": {
             "get": {
-                "description": "list concepts with characteristics",
+                "description": "get resource, requesting user must have admin right  on the resource",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "concepts"
+                    "permissions-hubs"
                 ],
-                "summary": "list concepts with characteristics",
+                "summary": "get resource",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Resource"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (hubs) to create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-hubs"
+                ],
+                "summary": "set resource rights",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
+                        "type": "boolean",
+                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
+                        "name": "wait",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
+                        "description": "Topic",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ConceptWithCharacteristics"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
-                            }
+                            "$ref": "#/definitions/model.ResourcePermissions"
                         }
                     },
                     "400": {
@@ -8137,9 +8362,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -8151,52 +8373,28 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/device-classes": {
+        "/permissions/manage/locations": {
             "get": {
-                "description": "list device-classes",
+                "description": "lists resources the user has admin rights to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-classes"
+                    "permissions-locations"
                 ],
-                "summary": "list device-classes",
+                "summary": "lists resources the user has admin rights to",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
+                        "description": "limits size of result; 0 means unlimited",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
+                        "description": "offset to be used in combination with limit",
                         "name": "offset",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "filter; only 'true' is a valid value; if set, returns device-classes used in combination with controlling-function",
-                        "name": "used_with_controlling_function",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -8205,13 +8403,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.DeviceClass"
-                            }
-                        },
-                        "headers": {
-                            "X-Total-Count": {
-                                "type": "integer",
-                                "description": "count of all matching elements; used for pagination"
+                                "$ref": "#/definitions/model.Resource"
                             }
                         }
                     },
@@ -8224,9 +8416,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -8238,59 +8427,92 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v2/query/device-type-selectables": {
-            "post": {
-                "description": "validate device-type",
-                "consumes": [
-                    "application/json"
-                ],
+        "/permissions/manage/locations/{id}": {
+            "get": {
+                "description": "get resource, requesting user must have admin right  on the resource",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "permissions-locations"
                 ],
-                "summary": "validate device-type",
+                "summary": "get resource",
                 "parameters": [
                     {
-                        "description": "filtered by criteria",
-                        "name": "message",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.FilterCriteria"
-                            }
+                            "$ref": "#/definitions/model.Resource"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "get resource rights, requesting user must have admin right on resource to update, requesting user must have admin rights on topic (locations) to create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-locations"
+                ],
+                "summary": "set resource rights",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "prefix added to variable paths",
-                        "name": "path-prefix",
-                        "in": "query"
+                        "description": "Resource Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "toggle if filter criteria is 'and' or 'or' combination",
-                        "name": "services_must_match_all_criteria",
+                        "description": "if set to true, the response will be sent after the corresponding kafka done signal has been received",
+                        "name": "wait",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "include id-modified device-types",
-                        "name": "include_id_modified",
-                        "in": "query"
+                        "description": "Topic",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.DeviceTypeSelectable"
-                            }
+                            "$ref": "#/definitions/model.ResourcePermissions"
                         }
                     },
                     "400": {
@@ -8302,9 +8524,6 @@ const docTemplatedevicerepository = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -8316,82 +8535,69 @@ const docTemplatedevicerepository = `{
                 ]
             }
         },
-        "/v3/device-types": {
+        "/permissions/permissions/device-groups": {
             "get": {
-                "description": "list device-types",
+                "description": "list the computed permissions to resources of the given topic (device-groups) and ids, group and user permissions are merged, unknown ids will get entries in the result",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "device-types"
+                    "permissions-device-groups"
                 ],
-                "summary": "list device-types",
+                "summary": "list the computed permissions to resources of the given topic (device-groups) and ids",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "default 100, will be ignored if 'ids' is set",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "default 0, will be ignored if 'ids' is set",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "default name.asc",
-                        "name": "sort",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "description": "Resource Ids, comma seperated",
                         "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they use a protocol that is in the given list",
-                        "name": "protocol-ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
-                        "name": "attr-keys",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
-                        "name": "attr-values",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request"
                     },
-                    {
-                        "type": "boolean",
-                        "description": "include id-modified device-types",
-                        "name": "include-modified",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized"
                     },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
                     {
-                        "type": "boolean",
-                        "description": "no unmodified device-types",
-                        "name": "ignore-unmodified",
-                        "in": "query"
-                    },
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/permissions/devices": {
+            "get": {
+                "description": "list the computed permissions to resources of the given topic (devices) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-devices"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (devices) and ids",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "filter; json encoded []model.FilterCriteria",
-                        "name": "criteria",
-                        "in": "query"
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -8400,7 +8606,7 @@ const docTemplatedevicerepository = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.DeviceType"
+                                "$ref": "#/definitions/model.ComputedPermissions"
                             }
                         }
                     },
@@ -8410,12 +8616,6 @@ const docTemplatedevicerepository = `{
                     "401": {
                         "description": "Unauthorized"
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
@@ -8426,310 +8626,3967 @@ const docTemplatedevicerepository = `{
                     }
                 ]
             }
-        }
-    },
-    "definitions": {
-        "api.AspectNodeQuery": {
-            "type": "object",
+        },
+        "/permissions/permissions/hubs": {
+            "get": {
+                "description": "list the computed permissions to resources of the given topic (hubs) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-hubs"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (hubs) and ids",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/permissions/locations": {
+            "get": {
+                "description": "list the computed permissions to resources of the given topic (locations) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-locations"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (locations) and ids",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource Ids, comma seperated",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/query/permissions/device-groups": {
+            "post": {
+                "description": "list the computed permissions to resources of the given topic (device-groups) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-device-groups"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (device-groups) and ids",
+                "parameters": [
+                    {
+                        "description": "Resource Ids",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/query/permissions/devices": {
+            "post": {
+                "description": "list the computed permissions to resources of the given topic (devices) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-devices"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (devices) and ids",
+                "parameters": [
+                    {
+                        "description": "Resource Ids",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/query/permissions/hubs": {
+            "post": {
+                "description": "list the computed permissions to resources of the given topic (hubs) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-hubs"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (hubs) and ids",
+                "parameters": [
+                    {
+                        "description": "Resource Ids",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/permissions/query/permissions/locations": {
+            "post": {
+                "description": "list the computed permissions to resources of the given topic (locations) and ids, group and user permissions are merged, unknown ids will get entries in the result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions-locations"
+                ],
+                "summary": "list the computed permissions to resources of the given topic (locations) and ids",
+                "parameters": [
+                    {
+                        "description": "Resource Ids",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ComputedPermissions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/protocols": {
+            "get": {
+                "description": "get protocol",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "get protocol",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Protocol"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "validate protocol",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "validate protocol",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "must be true; reminder, that this is not an update but a validation",
+                        "name": "dry-run",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Location to be validated",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "create protocol",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "create protocol",
+                "parameters": [
+                    {
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/protocols/{id}": {
+            "get": {
+                "description": "get protocol",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "get protocol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Protocol Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "set protocol",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "set protocol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Protocol Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "element",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Protocol"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "delete protocol",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protocols"
+                ],
+                "summary": "delete protocol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Protocol Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/query/aspect-nodes": {
+            "post": {
+                "description": "query aspect-nodes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "aspect-nodes"
+                ],
+                "summary": "query aspect-nodes",
+                "parameters": [
+                    {
+                        "description": "AspectNodeQuery",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.AspectNodeQuery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AspectNode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/query/device-type-selectables": {
+            "post": {
+                "description": "validate device-type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "validate device-type",
+                "parameters": [
+                    {
+                        "description": "filtered by criteria",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.FilterCriteria"
+                            }
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "prefix added to variable paths",
+                        "name": "path-prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "'event', 'request', 'event+request'",
+                        "name": "interactions-filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include id-modified device-types",
+                        "name": "include_id_modified",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceTypeSelectable"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/query/devices": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "returns the devices the token can access, whose device-types match all criteria (aspects match their descendants), together with the matching service paths.\nresults may be filtered by location (including devices of its device-groups), hub, device-group and attributes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "query devices",
+                "parameters": [
+                    {
+                        "description": "criteria and filters",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeviceQuery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceQueryResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/query/functions": {
+            "post": {
+                "description": "list functions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "functions"
+                ],
+                "summary": "list functions",
+                "parameters": [
+                    {
+                        "description": "list options",
+                        "name": "query",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FunctionListOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Function"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/query/impact": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "lists device-types, services, devices, device-groups, hubs, locations and graphs affected by a proposed update or delete\nof a concept, characteristic, aspect (including its sub-aspects), function, device-class or device-type, with counts per owner; requires admin rights.\nfor device-types, an optional proposed device-type limits the affected services to changed or removed services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "query impact",
+                "parameters": [
+                    {
+                        "description": "resource and action",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImpactQuery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImpactResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/query/used-in-device-type": {
+            "post": {
+                "description": "query used-in-device-type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "query used-in-device-type",
+                "parameters": [
+                    {
+                        "description": "filter",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UsedInDeviceTypeQuery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UsedInDeviceTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/serializations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "lists the content serializations, that may be used in device-types, with the serialization_options of their content-variables.\nbinary serializations address the fields of fixed-layout frames with the options offset, length, byte_order and signed (e.g. [\"offset=2\", \"length=2\", \"byte_order=little\"]);\ntheir example payloads are base64 encoded",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "list serializations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SerializationInfo"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/services/{id}": {
+            "get": {
+                "description": "get service",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "get service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Service"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/services/{id}/examples": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "renders an example message for every non-void input and output content of the service in the content serialization; values are (in this order) the fixed content-variable value, the characteristic value, the first allowed characteristic value, the center of the characteristic min/max range or a default of the variable type; variable length lists contain one element and structures with the placeholder '*' use the key \"key\"; payloads of binary serializations are base64 encoded",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "get service examples",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceExample"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/services/{id}/schema": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "describes every non-void input and output content of the service as JSON Schema (draft 2020-12); structures become objects (sub content-variables without omit_empty are required, '*' describes additionalProperties), lists become arrays ('*' describes items, otherwise prefixItems of fixed length), fixed values become const, characteristic min/max values become minimum/maximum and allowed values become enum; xml and plain-text contents are described by their json equivalent with contentMediaType",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "get service json schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonschema.ServiceSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/services/{id}/validate-payload": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "decodes a raw message with the serialization (ref GET /serializations) of a service content and reports mismatches with the content-variables: type mismatches, missing fields, unexpected fields, values out of the characteristic min/max range and values not in the characteristic allowed values; the content is selected by content_id or, if omitted, is the only non-void content in the given direction; xml messages must use the content-variable name as root element, attributes are matched to content-variables with the prefix '-'",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "text/plain",
+                    "application/octet-stream",
+                    "application/cbor"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "validate payload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "'input' or 'output'; defaults to 'output'",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "content id; required if the service has multiple non-void contents in the direction",
+                        "name": "content_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "raw message",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.PayloadValidationResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/user-device-types": {
+            "get": {
+                "description": "list device-types used by the requesting user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "list user device-types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they use a protocol that is in the given list",
+                        "name": "protocol-ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
+                        "name": "attr-keys",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
+                        "name": "attr-values",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include id-modified device-types",
+                        "name": "include-modified",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "no unmodified device-types",
+                        "name": "ignore-unmodified",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; json encoded []model.FilterCriteria",
+                        "name": "criteria",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DeviceType"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/users/{id}": {
+            "delete": {
+                "description": "delete user; only admins may use this method",
+                "tags": [
+                    "users"
+                ],
+                "summary": "delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/aspect-nodes": {
+            "get": {
+                "description": "list aspect-nodes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "aspect-nodes"
+                ],
+                "summary": "list aspect-nodes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AspectNode"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/aspects": {
+            "get": {
+                "description": "list aspects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "aspects"
+                ],
+                "summary": "list aspects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Aspect"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/characteristics": {
+            "get": {
+                "description": "list characteristics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "characteristics"
+                ],
+                "summary": "list characteristics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Characteristic"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/concepts": {
+            "get": {
+                "description": "list concepts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "concepts"
+                ],
+                "summary": "list concepts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Concept"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/concepts-with-characteristics": {
+            "get": {
+                "description": "list concepts with characteristics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "concepts"
+                ],
+                "summary": "list concepts with characteristics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ConceptWithCharacteristics"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/device-classes": {
+            "get": {
+                "description": "list device-classes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-classes"
+                ],
+                "summary": "list device-classes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "filter; only 'true' is a valid value; if set, returns device-classes used in combination with controlling-function",
+                        "name": "used_with_controlling_function",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DeviceClass"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v2/query/device-type-selectables": {
+            "post": {
+                "description": "validate device-type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "validate device-type",
+                "parameters": [
+                    {
+                        "description": "filtered by criteria",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.FilterCriteria"
+                            }
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "prefix added to variable paths",
+                        "name": "path-prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "toggle if filter criteria is 'and' or 'or' combination",
+                        "name": "services_must_match_all_criteria",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include id-modified device-types",
+                        "name": "include_id_modified",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.DeviceTypeSelectable"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v3/device-types": {
+            "get": {
+                "description": "list device-types",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "device-types"
+                ],
+                "summary": "list device-types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100, will be ignored if 'ids' is set",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0, will be ignored if 'ids' is set",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default name.asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; ignores limit/offset; comma-separated list",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they use a protocol that is in the given list",
+                        "name": "protocol-ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute key that is in the given list",
+                        "name": "attr-keys",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; comma-separated list; lists elements only if they have an attribute value that is in the given list",
+                        "name": "attr-values",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include id-modified device-types",
+                        "name": "include-modified",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "no unmodified device-types",
+                        "name": "ignore-unmodified",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; json encoded []model.FilterCriteria",
+                        "name": "criteria",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DeviceType"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "lists the webhooks of the requesting user; admins see all webhooks; secrets are never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "list webhooks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter; e.g. devices, device-types, device-groups, hubs, protocols, concepts, characteristics, aspects, functions, device-classes, locations",
+                        "name": "resource_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Webhook"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "registers a webhook that receives a signed POST request for every change of a resource of the given resource_types the requesting user may read.\nthe body is a model.WebhookNotification. if a secret is set, the X-Webhook-Signature header contains \"sha256=\" followed by the hex encoded HMAC-SHA256 of the X-Webhook-Timestamp header value, a \".\" and the body.\nfailed deliveries are retried with exponential backoff; the webhook is disabled after repeated failed deliveries.\nurls resolving to addresses, which are not globally reachable (e.g. loopback, link-local, private, shared or multicast), are rejected, unless the address is part of the configured webhook_allowed_networks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "create webhook",
+                "parameters": [
+                    {
+                        "description": "webhook",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "get webhook; the secret is never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "get webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "updates a webhook; an empty secret keeps the stored secret; setting disabled=false re-enables the webhook and resets its failure count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "set webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "webhook",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "deletes the webhook and its delivery logs",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "delete webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "lists the delivery logs of the webhook, newest first; logs are removed after the configured retention",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "list webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook Id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default 0",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.WebhookDelivery"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "count of all matching elements; used for pagination"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.AspectNodeQuery": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.ValidationError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.Resource": {
+            "type": "object",
+            "properties": {
+                "group_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "role_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                },
+                "topic_id": {
+                    "type": "string"
+                },
+                "user_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                }
+            }
+        },
+        "github_com_SENERGY-Platform_device-repository_lib_model.ImportExport": {
+            "type": "object",
+            "properties": {
+                "aspects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Aspect"
+                    }
+                },
+                "characteristics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Characteristic"
+                    }
+                },
+                "concepts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Concept"
+                    }
+                },
+                "device_classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeviceClass"
+                    }
+                },
+                "device_configurations": {
+                    "description": "device id -\u003e configuration values (ref PUT /devices/{id}/configuration); exported and imported with 'devices'",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model.DeviceConfigurationValue"
+                        }
+                    }
+                },
+                "device_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeviceGroup"
+                    }
+                },
+                "device_type_extensions": {
+                    "description": "exported and imported with 'device-types'; filtered by DeviceTypeId",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DeviceTypeExtension"
+                    }
+                },
+                "device_type_templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DeviceTypeTemplate"
+                    }
+                },
+                "device_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeviceType"
+                    }
+                },
+                "devices": {
+                    "description": "include_owned_information == true",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Device"
+                    }
+                },
+                "functions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Function"
+                    }
+                },
+                "hubs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Hub"
+                    }
+                },
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Location"
+                    }
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.Resource"
+                    }
+                },
+                "protocols": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Protocol"
+                    }
+                }
+            }
+        },
+        "jsonschema.ContentSchema": {
+            "type": "object",
+            "properties": {
+                "content_id": {
+                    "type": "string"
+                },
+                "protocol_segment_id": {
+                    "type": "string"
+                },
+                "protocol_segment_name": {
+                    "type": "string"
+                },
+                "schema": {
+                    "$ref": "#/definitions/jsonschema.Schema"
+                },
+                "serialization": {
+                    "$ref": "#/definitions/models.Serialization"
+                }
+            }
+        },
+        "jsonschema.DeviceTypeSchema": {
+            "type": "object",
+            "properties": {
+                "device_type_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonschema.ServiceSchema"
+                    }
+                }
+            }
+        },
+        "jsonschema.Schema": {
+            "type": "object",
+            "properties": {
+                "$schema": {
+                    "type": "string"
+                },
+                "additionalProperties": {
+                    "$ref": "#/definitions/jsonschema.Schema"
+                },
+                "const": {},
+                "contentMediaType": {
+                    "type": "string"
+                },
+                "enum": {
+                    "type": "array",
+                    "items": {}
+                },
+                "items": {
+                    "$ref": "#/definitions/jsonschema.Schema"
+                },
+                "maxItems": {
+                    "type": "integer"
+                },
+                "maximum": {},
+                "minItems": {
+                    "type": "integer"
+                },
+                "minimum": {},
+                "prefixItems": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonschema.Schema"
+                    }
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/jsonschema.Schema"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonschema.ServiceSchema": {
+            "type": "object",
+            "properties": {
+                "inputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonschema.ContentSchema"
+                    }
+                },
+                "local_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "outputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonschema.ContentSchema"
+                    }
+                },
+                "service_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AnnotationChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "function_id, aspect_id or characteristic_id",
+                    "type": "string"
+                },
+                "new": {
+                    "type": "string"
+                },
+                "old": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AspectChangeImpact": {
+            "type": "object",
+            "properties": {
+                "changed_aspect_ids": {
+                    "description": "root aspects that are stored with a changed tree",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "deleted_aspect_ids": {
+                    "description": "root aspects that are removed, because they are merged or moved into another tree",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_group_ids": {
+                    "description": "device-groups with recomputed criteria",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type_ids": {
+                    "description": "device-types with rewritten aspect references",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type_template_ids": {
+                    "description": "device-type-templates with rewritten aspect references",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CharacteristicPattern": {
+            "type": "object",
+            "properties": {
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "description": "hint for users, e.g. \"hex color like #ff00ff\"",
+                    "type": "string"
+                },
+                "pattern": {
+                    "description": "has to match the whole value",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ComputedPermissions": {
+            "type": "object",
+            "properties": {
+                "administrate": {
+                    "type": "boolean"
+                },
+                "execute": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "write": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.Configurable": {
+            "type": "object",
+            "properties": {
+                "aspect_node": {
+                    "$ref": "#/definitions/models.AspectNode"
+                },
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "constraints": {
+                    "description": "constraints of the characteristic, nil if unconstrained",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ValueConstraints"
+                        }
+                    ]
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.Type"
+                },
+                "value": {}
+            }
+        },
+        "model.ContentDiff": {
+            "type": "object",
+            "properties": {
+                "added_variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffVariable"
+                    }
+                },
+                "changed_variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContentVariableDiff"
+                    }
+                },
+                "direction": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "changed content fields without the content-variable (e.g. \"serialization\")",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "other_id": {
+                    "description": "set if the matched content has a different id",
+                    "type": "string"
+                },
+                "removed_variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffVariable"
+                    }
+                }
+            }
+        },
+        "model.ContentExample": {
+            "type": "object",
+            "properties": {
+                "content_id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "payload_encoding": {
+                    "description": "\"base64\" for binary serializations (ref SerializationInfo.Binary)",
+                    "type": "string"
+                },
+                "protocol_segment_id": {
+                    "type": "string"
+                },
+                "protocol_segment_name": {
+                    "type": "string"
+                },
+                "serialization": {
+                    "$ref": "#/definitions/models.Serialization"
+                }
+            }
+        },
+        "model.ContentVariableDiff": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AnnotationChange"
+                    }
+                },
+                "fields": {
+                    "description": "changed fields without annotations and sub content-variables (e.g. \"type\", \"value\")",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "other_path": {
+                    "description": "set if the content-variable was renamed or moved",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeviceConfigurationValue": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "description": "content-variable path as in Configurable.Path (e.g. \"payload.duration\")",
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "value": {}
+            }
+        },
+        "model.DeviceQuery": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "description": "filter; every attribute must exist on the device; value and origin are only checked if set",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attribute"
+                    }
+                },
+                "criteria": {
+                    "description": "aspects match their descendants; a device-type must match every criteria",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FilterCriteria"
+                    }
+                },
+                "device_group_id": {
+                    "description": "filter",
+                    "type": "string"
+                },
+                "hub_id": {
+                    "description": "filter",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "0 returns all matches",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "filter; devices of the location and of its device-groups",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "permission": {
+                    "description": "defaults to read",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PermissionFlag"
+                        }
+                    ]
+                },
+                "services_must_match_all_criteria": {
+                    "description": "only services matching all criteria are returned (ref GetDeviceTypeSelectablesV2)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.DeviceQueryResult": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "$ref": "#/definitions/models.Device"
+                },
+                "service_path_options": {
+                    "description": "matching content-variable paths by service id",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model.ServicePathOption"
+                        }
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeCloneOptions": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "description": "replace source attributes with the same key or are added",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attribute"
+                    }
+                },
+                "name": {
+                    "description": "replaces the name of the source if set",
+                    "type": "string"
+                },
+                "removed_attributes": {
+                    "description": "keys of source attributes, that are not copied",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeDiff": {
+            "type": "object",
+            "properties": {
+                "added_services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffService"
+                    }
+                },
+                "changed_services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ServiceDiff"
+                    }
+                },
+                "device_type_id": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "changed device-type fields without services (e.g. \"name\", \"attributes[0].value\")",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "impact": {
+                    "$ref": "#/definitions/model.DeviceTypeDiffImpact"
+                },
+                "other_device_type_id": {
+                    "type": "string"
+                },
+                "removed_services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffService"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeDiffImpact": {
+            "type": "object",
+            "properties": {
+                "added_criteria": {
+                    "description": "device-group criteria, only provided by the other device-type",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeviceGroupFilterCriteria"
+                    }
+                },
+                "device_count": {
+                    "description": "devices of the stored device-type",
+                    "type": "integer"
+                },
+                "device_group_ids": {
+                    "description": "device-groups, whose criteria would change",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removed_criteria": {
+                    "description": "device-group criteria, only provided by the stored device-type",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeviceGroupFilterCriteria"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeExtension": {
+            "type": "object",
+            "properties": {
+                "device_type_id": {
+                    "type": "string"
+                },
+                "overrides": {
+                    "description": "name, description and device_class_id replace the template values if set\nservices (by local_id), service_groups (by key) and attributes (by key) replace template elements or are added",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DeviceType"
+                        }
+                    ]
+                },
+                "removed_attributes": {
+                    "description": "keys of template attributes, that are not inherited",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removed_service_groups": {
+                    "description": "keys of template service-groups, that are not inherited",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removed_services": {
+                    "description": "local ids of template services, that are not inherited",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "template_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeviceTypeReference": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "used_in": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ServiceReference"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeSelectable": {
+            "type": "object",
+            "properties": {
+                "device_type_id": {
+                    "type": "string"
+                },
+                "service_path_options": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model.ServicePathOption"
+                        }
+                    }
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Service"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeTemplate": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attribute"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "device_class_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ServiceGroup"
+                    }
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Service"
+                    }
+                }
+            }
+        },
+        "model.DeviceTypeTemplateUpdateResult": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attribute"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "device_class_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ServiceGroup"
+                    }
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Service"
+                    }
+                },
+                "updated_device_type_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.DiffContent": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "description": "PayloadDirectionInput or PayloadDirectionOutput",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "name of the root content-variable",
+                    "type": "string"
+                }
+            }
+        },
+        "model.DiffService": {
+            "type": "object",
             "properties": {
-                "ids": {
+                "id": {
+                    "type": "string"
+                },
+                "local_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DiffVariable": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "bad_request",
+                "unauthorized",
+                "forbidden",
+                "not_found",
+                "conflict",
+                "timeout",
+                "internal",
+                "unavailable",
+                "unknown",
+                "request.invalid_query_parameter",
+                "request.invalid_body",
+                "request.dry_run_required",
+                "request.id_mismatch",
+                "request.preset_id",
+                "request.missing_id",
+                "validation.missing_field",
+                "validation.invalid_field",
+                "device.local_id_conflict",
+                "device.invalid_local_id",
+                "device.configuration_unknown_service",
+                "device.configuration_unknown_path",
+                "device.configuration_duplicate",
+                "device.configuration_invalid_value",
+                "device_type.unknown_aspect",
+                "device_type.none_leaf_aspect",
+                "device_type.unknown_function",
+                "device_type.invalid_function_use",
+                "device_type.characteristic_function_mismatch",
+                "device_type.unknown_protocol",
+                "device_type.unknown_protocol_segment",
+                "device_type.unknown_serialization",
+                "device_type.serialization_mismatch",
+                "device_type.invalid_serialization_option",
+                "device_type.reused_service_id",
+                "device_type.reused_content_name",
+                "device_type.invalid_service_local_id",
+                "device_type.invalid_service_group",
+                "device_type.invalid_content_variable",
+                "device_type.invalid_content_variable_name",
+                "device_type.characteristic_type_mismatch",
+                "device_type.invalid_value",
+                "device_group.unknown_aspect",
+                "device_type.in_use",
+                "device_type.extends_template",
+                "device_type.lint",
+                "device_type_template.in_use",
+                "aspect.in_use",
+                "aspect.invalid_move",
+                "function.not_deprecated",
+                "function.invalid_replacement",
+                "function.missing_characteristic_mapping",
+                "characteristic.invalid_constraint",
+                "payload.invalid_serialization",
+                "payload.type_mismatch",
+                "payload.missing_field",
+                "payload.unexpected_field",
+                "payload.out_of_range",
+                "payload.not_allowed_value",
+                "payload.pattern_mismatch"
+            ],
+            "x-enum-comments": {
+                "ErrNotFoundCode": "ErrNotFound is the sentinel error used by the controller and database"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "ErrNotFound is the sentinel error used by the controller and database",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                ""
+            ],
+            "x-enum-varnames": [
+                "ErrBadRequest",
+                "ErrUnauthorized",
+                "ErrForbidden",
+                "ErrNotFoundCode",
+                "ErrConflict",
+                "ErrTimeout",
+                "ErrInternal",
+                "ErrUnavailable",
+                "ErrUnknown",
+                "ErrInvalidQueryParameter",
+                "ErrInvalidBody",
+                "ErrDryRunRequired",
+                "ErrIdMismatch",
+                "ErrPresetId",
+                "ErrMissingId",
+                "ErrMissingField",
+                "ErrInvalidField",
+                "ErrDeviceLocalIdConflict",
+                "ErrDeviceInvalidLocalId",
+                "ErrDeviceConfigurationUnknownService",
+                "ErrDeviceConfigurationUnknownPath",
+                "ErrDeviceConfigurationDuplicate",
+                "ErrDeviceConfigurationInvalidValue",
+                "ErrDeviceTypeUnknownAspect",
+                "ErrDeviceTypeNoneLeafAspect",
+                "ErrDeviceTypeUnknownFunction",
+                "ErrDeviceTypeFunctionUse",
+                "ErrDeviceTypeCharacteristicMismatch",
+                "ErrDeviceTypeUnknownProtocol",
+                "ErrDeviceTypeUnknownProtocolSegment",
+                "ErrDeviceTypeUnknownSerialization",
+                "ErrDeviceTypeSerializationMismatch",
+                "ErrDeviceTypeInvalidSerializationOption",
+                "ErrDeviceTypeReusedServiceId",
+                "ErrDeviceTypeReusedContentName",
+                "ErrDeviceTypeInvalidServiceLocalId",
+                "ErrDeviceTypeInvalidServiceGroup",
+                "ErrDeviceTypeInvalidContentVariable",
+                "ErrDeviceTypeInvalidContentVariableName",
+                "ErrDeviceTypeCharacteristicTypeMismatch",
+                "ErrDeviceTypeInvalidValue",
+                "ErrDeviceGroupUnknownAspect",
+                "ErrDeviceTypeInUse",
+                "ErrDeviceTypeExtendsTemplate",
+                "ErrDeviceTypeLint",
+                "ErrDeviceTypeTemplateInUse",
+                "ErrAspectInUse",
+                "ErrAspectInvalidMove",
+                "ErrFunctionNotDeprecated",
+                "ErrFunctionInvalidReplacement",
+                "ErrFunctionMissingCharacteristicMapping",
+                "ErrCharacteristicInvalidConstraint",
+                "ErrPayloadInvalidSerialization",
+                "ErrPayloadTypeMismatch",
+                "ErrPayloadMissingField",
+                "ErrPayloadUnexpectedField",
+                "ErrPayloadOutOfRange",
+                "ErrPayloadNotAllowedValue",
+                "ErrPayloadPatternMismatch"
+            ]
+        },
+        "model.ExtendedDevice": {
+            "type": "object",
+            "properties": {
+                "attributes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.Attribute"
+                    }
+                },
+                "configuration": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DeviceConfigurationValue"
                     }
+                },
+                "connection_state": {
+                    "type": "string"
+                },
+                "device_type": {
+                    "description": "optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DeviceType"
+                        }
+                    ]
+                },
+                "device_type_id": {
+                    "type": "string"
+                },
+                "device_type_name": {
+                    "description": "computed on request, not stored",
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "local_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "description": "computed on request, not stored",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Permissions"
+                        }
+                    ]
+                },
+                "shared": {
+                    "description": "computed on request, not stored",
+                    "type": "boolean"
                 }
             }
         },
-        "api.ValidationError": {
+        "model.FilterCriteria": {
             "type": "object",
             "properties": {
-                "error": {
+                "aspect_id": {
                     "type": "string"
                 },
-                "id": {
+                "device_class_id": {
                     "type": "string"
                 },
-                "name": {
+                "function_id": {
                     "type": "string"
+                },
+                "interaction": {
+                    "$ref": "#/definitions/models.Interaction"
                 }
             }
         },
-        "client.Resource": {
+        "model.FunctionDeprecation": {
             "type": "object",
             "properties": {
-                "group_permissions": {
+                "characteristic_mapping": {
+                    "description": "maps characteristic ids of the deprecated functions concept to characteristic ids of the replacement functions concept\nrequired for every used characteristic, if the concepts differ",
                     "type": "object",
                     "additionalProperties": {
-                        "$ref": "#/definitions/model.PermissionsMap"
+                        "type": "string"
                     }
                 },
-                "id": {
+                "function_id": {
                     "type": "string"
                 },
-                "role_permissions": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/model.PermissionsMap"
+                "reason": {
+                    "type": "string"
+                },
+                "replacement_function_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FunctionListOptions": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 },
-                "topic_id": {
+                "limit": {
+                    "description": "default 100, will be ignored if 'ids' is set (Ids != nil)",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "offset": {
+                    "description": "default 0, will be ignored if 'ids' is set (Ids != nil)",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "rdfType": {
+                    "description": "model.SES_ONTOLOGY_CONTROLLING_FUNCTION || model.SES_ONTOLOGY_MEASURING_FUNCTION",
                     "type": "string"
                 },
-                "user_permissions": {
+                "search": {
+                    "type": "string"
+                },
+                "sortBy": {
+                    "description": "default name.asc",
+                    "type": "string"
+                }
+            }
+        },
+        "model.FunctionMigrationResult": {
+            "type": "object",
+            "properties": {
+                "device_group_ids": {
+                    "description": "device-groups with recomputed criteria",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type_ids": {
+                    "description": "device-types with rewritten function references",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type_template_ids": {
+                    "description": "device-type-templates with rewritten function references",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "replacement_function_id": {
+                    "type": "string"
+                },
+                "rewritten_device_type_ids": {
+                    "description": "device-types stored by this migration; empty on dry-run",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rewritten_device_type_template_ids": {
+                    "description": "device-type-templates stored by this migration; empty on dry-run",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "usages": {
+                    "description": "migrated usages",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FunctionUsage"
+                    }
+                }
+            }
+        },
+        "model.FunctionUsage": {
+            "type": "object",
+            "properties": {
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "content_variable_id": {
+                    "type": "string"
+                },
+                "content_variable_path": {
+                    "type": "string"
+                },
+                "device_type_id": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HealthCheckResult": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HealthReport": {
+            "type": "object",
+            "properties": {
+                "checks": {
                     "type": "object",
                     "additionalProperties": {
-                        "$ref": "#/definitions/model.PermissionsMap"
+                        "$ref": "#/definitions/model.HealthCheckResult"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImpactCount": {
+            "type": "object",
+            "properties": {
+                "device_groups": {
+                    "type": "integer"
+                },
+                "devices": {
+                    "type": "integer"
+                },
+                "graphs": {
+                    "type": "integer"
+                },
+                "hubs": {
+                    "type": "integer"
+                },
+                "locations": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ImpactDeviceType": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_ids": {
+                    "description": "affected services",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 }
             }
         },
-        "github_com_SENERGY-Platform_device-repository_lib_model.ImportExport": {
+        "model.ImpactElement": {
             "type": "object",
             "properties": {
-                "aspects": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Aspect"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "characteristics": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Characteristic"
-                    }
+                "name": {
+                    "type": "string"
                 },
-                "concepts": {
+                "owner_ids": {
+                    "description": "owner of devices, hubs and graphs; users with administrate rights for device-groups and locations",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Concept"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "model.ImpactQuery": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "\"update\" or \"delete\"; defaults to \"update\"",
+                    "type": "string"
                 },
-                "device_classes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DeviceClass"
-                    }
+                "device_type": {
+                    "description": "optional proposed device-type for resource=\"device-types\" and action=\"update\"; limits the affected services to changed or removed services",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DeviceType"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "resource": {
+                    "description": "\"concepts\", \"characteristics\", \"aspects\", \"functions\", \"device-classes\" or \"device-types\"",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImpactResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
                 },
                 "device_groups": {
+                    "description": "device-groups containing affected devices; their criteria are affected",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DeviceGroup"
+                        "$ref": "#/definitions/model.ImpactElement"
                     }
                 },
                 "device_types": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DeviceType"
+                        "$ref": "#/definitions/model.ImpactDeviceType"
                     }
                 },
                 "devices": {
-                    "description": "include_owned_information == true",
+                    "description": "devices of affected device-types",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Device"
+                        "$ref": "#/definitions/model.ImpactElement"
                     }
                 },
-                "functions": {
+                "graphs": {
+                    "description": "graphs with nodes of affected devices",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Function"
+                        "$ref": "#/definitions/model.ImpactElement"
                     }
                 },
                 "hubs": {
+                    "description": "hubs containing affected devices",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Hub"
+                        "$ref": "#/definitions/model.ImpactElement"
                     }
                 },
+                "id": {
+                    "type": "string"
+                },
                 "locations": {
+                    "description": "locations containing affected devices or device-groups",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Location"
+                        "$ref": "#/definitions/model.ImpactElement"
                     }
                 },
-                "permissions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/client.Resource"
+                "owners": {
+                    "description": "counts per owner; elements with multiple owners (device-groups, locations) are counted for every owner",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ImpactCount"
                     }
                 },
-                "protocols": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Protocol"
-                    }
+                "resource": {
+                    "type": "string"
                 }
             }
         },
-        "model.ComputedPermissions": {
+        "model.ImportChange": {
             "type": "object",
             "properties": {
-                "administrate": {
-                    "type": "boolean"
+                "action": {
+                    "description": "ImportActionCreate, ImportActionUpdate or ImportActionUnchanged",
+                    "type": "string"
                 },
-                "execute": {
-                    "type": "boolean"
+                "fields": {
+                    "description": "json paths of changed fields, if Action == ImportActionUpdate",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "id": {
                     "type": "string"
                 },
-                "read": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "write": {
-                    "type": "boolean"
+                "resource_type": {
+                    "description": "plural like the http endpoints, e.g. 'aspects'",
+                    "type": "string"
                 }
             }
         },
-        "model.Configurable": {
+        "model.ImportFromOptions": {
             "type": "object",
             "properties": {
-                "aspect_node": {
-                    "$ref": "#/definitions/models.AspectNode"
+                "filter_ids": {
+                    "description": "ref ids of any resource-type; null-\u003eall; []-\u003enone",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "characteristic_id": {
-                    "type": "string"
+                "filter_resource_types": {
+                    "description": "ref resource types-like 'device-types' similar to http-endpoints; null-\u003eall; []-\u003enone",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "function_id": {
+                "remote_auth_token": {
+                    "description": "auth token used for requests to the remote device-repository",
                     "type": "string"
                 },
-                "path": {
+                "remote_device_repository": {
+                    "description": "address of a remote device-repository, where the data is imported from",
                     "type": "string"
-                },
-                "type": {
-                    "$ref": "#/definitions/models.Type"
-                },
-                "value": {}
+                }
             }
         },
-        "model.DeviceTypeReference": {
+        "model.ImportResult": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "used_in": {
+                "changes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.ServiceReference"
+                        "$ref": "#/definitions/model.ImportChange"
                     }
+                },
+                "dry_run": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.DeviceTypeSelectable": {
+        "model.LastUpdateTimestamp": {
             "type": "object",
             "properties": {
-                "device_type_id": {
+                "collection": {
                     "type": "string"
                 },
-                "service_path_options": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/model.ServicePathOption"
-                        }
-                    }
+                "unix_timestamp": {
+                    "type": "integer"
                 },
-                "services": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Service"
-                    }
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "model.FilterCriteria": {
+        "model.LintFinding": {
             "type": "object",
             "properties": {
-                "aspect_id": {
+                "field": {
                     "type": "string"
                 },
-                "device_class_id": {
+                "message": {
                     "type": "string"
                 },
-                "function_id": {
+                "rule": {
                     "type": "string"
                 },
-                "interaction": {
-                    "$ref": "#/definitions/models.Interaction"
+                "severity": {
+                    "description": "\"error\" or \"warning\"",
+                    "type": "string"
                 }
             }
         },
-        "model.FunctionListOptions": {
+        "model.LintResult": {
             "type": "object",
             "properties": {
-                "ids": {
-                    "description": "filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "limit": {
-                    "description": "default 100, will be ignored if 'ids' is set (Ids != nil)",
-                    "type": "integer",
-                    "format": "int64"
-                },
-                "offset": {
-                    "description": "default 0, will be ignored if 'ids' is set (Ids != nil)",
-                    "type": "integer",
-                    "format": "int64"
-                },
-                "rdfType": {
-                    "description": "model.SES_ONTOLOGY_CONTROLLING_FUNCTION || model.SES_ONTOLOGY_MEASURING_FUNCTION",
+                "device_type_id": {
                     "type": "string"
                 },
-                "search": {
+                "device_type_name": {
                     "type": "string"
                 },
-                "sortBy": {
-                    "description": "default name.asc",
-                    "type": "string"
+                "errors": {
+                    "type": "integer"
+                },
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LintFinding"
+                    }
+                },
+                "warnings": {
+                    "type": "integer"
                 }
             }
         },
-        "model.ImportFromOptions": {
+        "model.ModellingSuggestion": {
             "type": "object",
             "properties": {
-                "filter_ids": {
-                    "description": "ref ids of any resource-type; null-\u003eall; []-\u003enone",
+                "aspects": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.SuggestedReference"
                     }
                 },
-                "filter_resource_types": {
-                    "description": "ref resource types-like 'device-types' similar to http-endpoints; null-\u003eall; []-\u003enone",
+                "characteristics": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.SuggestedReference"
                     }
                 },
-                "remote_auth_token": {
-                    "description": "auth token used for requests to the remote device-repository",
+                "content_variable_id": {
                     "type": "string"
                 },
-                "remote_device_repository": {
-                    "description": "address of a remote device-repository, where the data is imported from",
+                "content_variable_path": {
+                    "type": "string"
+                },
+                "functions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SuggestedReference"
+                    }
+                },
+                "is_input": {
+                    "type": "boolean"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_local_id": {
                     "type": "string"
+                },
+                "support": {
+                    "description": "count of similar annotated content-variables in the repository",
+                    "type": "integer"
                 }
             }
         },
-        "model.LastUpdateTimestamp": {
+        "model.PayloadValidationResult": {
             "type": "object",
             "properties": {
-                "collection": {
+                "content_id": {
                     "type": "string"
                 },
-                "unix_timestamp": {
-                    "type": "integer"
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ProblemField"
+                    }
                 },
-                "user_id": {
-                    "type": "string"
+                "valid": {
+                    "type": "boolean"
                 }
             }
         },
@@ -8745,8 +12602,48 @@ const docTemplatedevicerepository = `{
                 "read": {
                     "type": "boolean"
                 },
-                "write": {
-                    "type": "boolean"
+                "write": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/model.ErrorCode"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ProblemField"
+                    }
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ProblemField": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/model.ErrorCode"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
                 }
             }
         },
@@ -8816,6 +12713,123 @@ const docTemplatedevicerepository = `{
                 }
             }
         },
+        "model.SerializationInfo": {
+            "type": "object",
+            "properties": {
+                "binary": {
+                    "description": "messages are no text; example payloads are base64 encoded",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "key": {
+                    "$ref": "#/definitions/models.Serialization"
+                },
+                "media_type": {
+                    "type": "string"
+                },
+                "options": {
+                    "description": "serialization_options of content-variables, used as \"key=value\"",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SerializationOption"
+                    }
+                },
+                "string_values": {
+                    "description": "primitive values are decoded as strings (e.g. xml)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.SerializationOption": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "required": {
+                    "description": "required for non-void primitive content-variables",
+                    "type": "boolean"
+                },
+                "values": {
+                    "description": "allowed values, if the option is an enumeration",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.ServiceDiff": {
+            "type": "object",
+            "properties": {
+                "added_contents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffContent"
+                    }
+                },
+                "changed_contents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContentDiff"
+                    }
+                },
+                "fields": {
+                    "description": "changed service fields without inputs and outputs (e.g. \"interaction\")",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "local_id": {
+                    "type": "string"
+                },
+                "other_id": {
+                    "description": "set if the matched service has a different id",
+                    "type": "string"
+                },
+                "removed_contents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiffContent"
+                    }
+                }
+            }
+        },
+        "model.ServiceExample": {
+            "type": "object",
+            "properties": {
+                "inputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContentExample"
+                    }
+                },
+                "local_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "outputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContentExample"
+                    }
+                },
+                "service_id": {
+                    "type": "string"
+                }
+            }
+        },
         "model.ServicePathOption": {
             "type": "object",
             "properties": {
@@ -8831,6 +12845,14 @@ const docTemplatedevicerepository = `{
                         "$ref": "#/definitions/model.Configurable"
                     }
                 },
+                "constraints": {
+                    "description": "constraints of the characteristic, nil if unconstrained",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ValueConstraints"
+                        }
+                    ]
+                },
                 "function_id": {
                     "type": "string"
                 },
@@ -8872,6 +12894,22 @@ const docTemplatedevicerepository = `{
                 }
             }
         },
+        "model.SuggestedReference": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "description": "between 0 and 1",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "support": {
+                    "description": "count of similar content-variables annotated with this id",
+                    "type": "integer"
+                }
+            }
+        },
         "model.UsedInDeviceTypeQuery": {
             "type": "object",
             "properties": {
@@ -8901,6 +12939,26 @@ const docTemplatedevicerepository = `{
                 "$ref": "#/definitions/model.RefInDeviceTypeResponseElement"
             }
         },
+        "model.ValueConstraints": {
+            "type": "object",
+            "properties": {
+                "allowed_values": {
+                    "type": "array",
+                    "items": {}
+                },
+                "max_value": {},
+                "min_value": {},
+                "pattern": {
+                    "type": "string"
+                },
+                "pattern_description": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.Type"
+                }
+            }
+        },
         "model.VariableReference": {
             "type": "object",
             "properties": {
@@ -8912,6 +12970,144 @@ const docTemplatedevicerepository = `{
                 }
             }
         },
+        "model.Webhook": {
+            "type": "object",
+            "properties": {
+                "consecutive_failures": {
+                    "description": "read only; reset on successful delivery or when the webhook is re-enabled",
+                    "type": "integer"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "disabled_reason": {
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/model.WebhookFilter"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "resource_types": {
+                    "description": "values of WebhookResourceTypes",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "description": "write only; used to sign notifications; an empty secret on update keeps the stored one",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "error": {
+                    "description": "error of the last attempt",
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "description": "status code of the last attempt",
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "time": {
+                    "description": "time of the last attempt",
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookFilter": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "optional; WebhookEventSet and/or WebhookEventDelete; defaults to all events",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ids": {
+                    "description": "optional; only notify for these resource ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.WotImportResult": {
+            "type": "object",
+            "properties": {
+                "device_type": {
+                    "$ref": "#/definitions/models.DeviceType"
+                },
+                "saved": {
+                    "type": "boolean"
+                },
+                "suggestions": {
+                    "description": "functions and aspects set in DeviceType by name matching",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WotImportSuggestion"
+                    }
+                },
+                "validation_problem": {
+                    "description": "set if the draft is not yet valid",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Problem"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.WotImportSuggestion": {
+            "type": "object",
+            "properties": {
+                "aspect_id": {
+                    "type": "string"
+                },
+                "content_variable_path": {
+                    "type": "string"
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "service_local_id": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Aspect": {
             "type": "object",
             "properties": {
@@ -9261,44 +13457,21 @@ const docTemplatedevicerepository = `{
                 "name": {
                     "type": "string"
                 },
-                "service_groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ServiceGroup"
-                    }
-                },
-                "services": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Service"
-                    }
-                }
-            }
-        },
-        "models.Edge": {
-            "type": "object",
-            "properties": {
-                "attributes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Attribute"
-                    }
-                },
-                "from_node_id": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "to_node_id": {
-                    "type": "string"
+                "service_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ServiceGroup"
+                    }
                 },
-                "weight": {
-                    "type": "integer"
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Service"
+                    }
                 }
             }
         },
-        "models.ExtendedDevice": {
+        "models.Edge": {
             "type": "object",
             "properties": {
                 "attributes": {
@@ -9307,50 +13480,17 @@ const docTemplatedevicerepository = `{
                         "$ref": "#/definitions/models.Attribute"
                     }
                 },
-                "connection_state": {
-                    "type": "string"
-                },
-                "device_type": {
-                    "description": "optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.DeviceType"
-                        }
-                    ]
-                },
-                "device_type_id": {
-                    "type": "string"
-                },
-                "device_type_name": {
-                    "description": "computed on request, not stored",
-                    "type": "string"
-                },
-                "display_name": {
+                "from_node_id": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "local_id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "owner_id": {
+                "to_node_id": {
                     "type": "string"
                 },
-                "permissions": {
-                    "description": "computed on request, not stored",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Permissions"
-                        }
-                    ]
-                },
-                "shared": {
-                    "description": "computed on request, not stored",
-                    "type": "boolean"
+                "weight": {
+                    "type": "integer"
                 }
             }
         },
@@ -9599,6 +13739,24 @@ const docTemplatedevicerepository = `{
                 }
             }
         },
+        "models.PermissionFlag": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                114,
+                119,
+                120,
+                97
+            ],
+            "x-enum-varnames": [
+                "UnsetPermissionFlag",
+                "Read",
+                "Write",
+                "Execute",
+                "Administrate"
+            ]
+        },
         "models.Permissions": {
             "type": "object",
             "properties": {
@@ -9742,6 +13900,282 @@ const docTemplatedevicerepository = `{
                 "List",
                 "Structure"
             ]
+        },
+        "wot.Action": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "forms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wot.Form"
+                    }
+                },
+                "input": {
+                    "$ref": "#/definitions/wot.DataSchema"
+                },
+                "output": {
+                    "$ref": "#/definitions/wot.DataSchema"
+                },
+                "ses:service": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "wot.DataSchema": {
+            "type": "object",
+            "properties": {
+                "const": {},
+                "description": {
+                    "type": "string"
+                },
+                "items": {
+                    "$ref": "#/definitions/wot.Items"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.DataSchema"
+                    }
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ses:aspect": {
+                    "type": "string"
+                },
+                "ses:characteristic": {
+                    "type": "string"
+                },
+                "ses:function": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "writeOnly": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "wot.Event": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/wot.DataSchema"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "forms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wot.Form"
+                    }
+                },
+                "ses:service": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "wot.Form": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "href": {
+                    "type": "string"
+                },
+                "op": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ses:protocol": {
+                    "type": "string"
+                }
+            }
+        },
+        "wot.Items": {
+            "type": "object",
+            "properties": {
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wot.DataSchema"
+                    }
+                },
+                "tuple": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "wot.Link": {
+            "type": "object",
+            "properties": {
+                "href": {
+                    "type": "string"
+                },
+                "rel": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "wot.Property": {
+            "type": "object",
+            "properties": {
+                "const": {},
+                "description": {
+                    "type": "string"
+                },
+                "forms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wot.Form"
+                    }
+                },
+                "items": {
+                    "$ref": "#/definitions/wot.Items"
+                },
+                "observable": {
+                    "type": "boolean"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.DataSchema"
+                    }
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ses:aspect": {
+                    "type": "string"
+                },
+                "ses:characteristic": {
+                    "type": "string"
+                },
+                "ses:function": {
+                    "type": "string"
+                },
+                "ses:service": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "writeOnly": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "wot.SecurityScheme": {
+            "type": "object",
+            "properties": {
+                "scheme": {
+                    "type": "string"
+                }
+            }
+        },
+        "wot.Thing": {
+            "type": "object",
+            "properties": {
+                "@context": {
+                    "type": "array",
+                    "items": {}
+                },
+                "@type": {
+                    "type": "string"
+                },
+                "actions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.Action"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.Event"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wot.Link"
+                    }
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.Property"
+                    }
+                },
+                "security": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "securityDefinitions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/wot.SecurityScheme"
+                    }
+                },
+                "ses:deviceClass": {
+                    "type": "string"
+                },
+                "ses:deviceType": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -9761,7 +14195,7 @@ var SwaggerInfodevicerepository = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{},
 	Title:            "Device-Repository API",
-	Description:      "",
+	Description:      "errors are returned as RFC 7807 problem details (Content-Type: application/problem+json) with a stable 'code' and, for validation errors, the json path of the invalid field in 'errors'; GET endpoints returning json accept a 'fields' query parameter (comma-separated dot-separated json paths, e.g. 'id,name,services.id') to reduce the response to the selected fields",
 	InfoInstanceName: "devicerepository",
 	SwaggerTemplate:  docTemplatedevicerepository,
 	LeftDelim:        "{{",
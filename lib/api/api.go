@@ -63,6 +63,7 @@ func Start(ctx context.Context, config configuration.Config, control Controller)
 // GetRouter doc
 // @title         Device-Repository API
 // @version       0.1
+// @description   errors are returned as RFC 7807 problem details (Content-Type: application/problem+json) with a stable 'code' and, for validation errors, the json path of the invalid field in 'errors'; GET endpoints returning json accept a 'fields' query parameter (comma-separated dot-separated json paths, e.g. 'id,name,services.id') to reduce the response to the selected fields
 // @license.name  Apache 2.0
 // @license.url   http://www.apache.org/licenses/LICENSE-2.0.html
 // @BasePath  /
@@ -72,6 +73,8 @@ func Start(ctx context.Context, config configuration.Config, control Controller)
 // @description Type "Bearer" followed by a space and JWT token.
 func GetRouter(config configuration.Config, control Controller) http.Handler {
 	handler := GetRouterWithoutMiddleware(config, control)
+	config.GetLogger().Info("add fields projection")
+	handler = util.NewFieldsMiddleware(handler)
 	config.GetLogger().Info("add permissions endpoints")
 	permForward := client.EmbedPermissionsClientIntoRouter(client.New(config.PermissionsV2Url), handler, "/permissions/", func(method string, path string) bool {
 		if method == http.MethodDelete {
@@ -91,11 +94,15 @@ func GetRouter(config configuration.Config, control Controller) http.Handler {
 	config.GetLogger().Info("add cors")
 	corsHandler := util.NewCors(permForward)
 	config.GetLogger().Info("add logging")
-	logger := accesslog.New(corsHandler)
+	handler = accesslog.New(corsHandler)
 	if config.AsMgwMirror {
-		return util.NewMirrorMiddleware(logger, config, control)
+		handler = util.NewMirrorMiddleware(handler, config, control)
 	}
-	return logger
+	if state, ok := control.(util.StartupState); ok {
+		config.GetLogger().Info("add startup gate")
+		handler = util.NewStartupMiddleware(handler, state)
+	}
+	return handler
 }
 
 func GetRouterWithoutMiddleware(config configuration.Config, command Controller) http.Handler {
@@ -21,10 +21,13 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -60,13 +63,13 @@ func (this *AspectNodeEndpoints) Query(config configuration.Config, router *http
 		query := AspectNodeQuery{}
 		err := json.NewDecoder(request.Body).Decode(&query)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if query.Ids != nil {
-			result, err, errCode := control.GetAspectNodesByIdList(*query.Ids)
+			result, err, errCode := control.GetAspectNodesByIdList(request.Context(), *query.Ids)
 			if err != nil {
-				http.Error(writer, err.Error(), errCode)
+				util.Error(writer, err, errCode)
 				return
 			}
 			writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -76,7 +79,7 @@ func (this *AspectNodeEndpoints) Query(config configuration.Config, router *http
 			}
 			return
 		}
-		http.Error(writer, "no known query content found", http.StatusBadRequest)
+		util.Error(writer, model.NewError(model.ErrInvalidBody, errors.New("no known query content found")), http.StatusBadRequest)
 		return
 	})
 }
@@ -112,7 +115,7 @@ func (this *AspectEndpoints) ListAspectNodes(config configuration.Config, router
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -121,7 +124,7 @@ func (this *AspectEndpoints) ListAspectNodes(config configuration.Config, router
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -139,9 +142,9 @@ func (this *AspectEndpoints) ListAspectNodes(config configuration.Config, router
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListAspectNodes(listoptions)
+		result, total, err, errCode := control.ListAspectNodes(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -181,9 +184,9 @@ func (this *AspectNodeEndpoints) List(config configuration.Config, router *http.
 		function := request.URL.Query().Get("function")
 
 		if function == "" {
-			result, err, errCode = control.GetAspectNodes()
+			result, err, errCode = control.GetAspectNodes(request.Context())
 			if err != nil {
-				http.Error(writer, err.Error(), errCode)
+				util.Error(writer, err, errCode)
 				return
 			}
 		} else {
@@ -193,7 +196,7 @@ func (this *AspectNodeEndpoints) List(config configuration.Config, router *http.
 			if ancestorsQuery != "" {
 				ancestors, err = strconv.ParseBool(ancestorsQuery)
 				if err != nil {
-					http.Error(writer, err.Error(), http.StatusBadRequest)
+					util.Error(writer, err, http.StatusBadRequest)
 					return
 				}
 			}
@@ -201,14 +204,14 @@ func (this *AspectNodeEndpoints) List(config configuration.Config, router *http.
 			if descendantsQuery != "" {
 				descendants, err = strconv.ParseBool(descendantsQuery)
 				if err != nil {
-					http.Error(writer, err.Error(), http.StatusBadRequest)
+					util.Error(writer, err, http.StatusBadRequest)
 					return
 				}
 			}
 			if function == "measuring-function" {
-				result, err, errCode = control.GetAspectNodesWithMeasuringFunction(ancestors, descendants)
+				result, err, errCode = control.GetAspectNodesWithMeasuringFunction(request.Context(), ancestors, descendants)
 				if err != nil {
-					http.Error(writer, err.Error(), errCode)
+					util.Error(writer, err, errCode)
 					return
 				}
 			}
@@ -240,9 +243,9 @@ func (this *AspectNodeEndpoints) List(config configuration.Config, router *http.
 func (this *AspectNodeEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /aspect-nodes/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetAspectNode(id)
+		result, err, errCode := control.GetAspectNode(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -280,7 +283,7 @@ func (this *AspectNodeEndpoints) ListMeasuringFunctions(config configuration.Con
 		if ancestorsQuery != "" {
 			ancestors, err = strconv.ParseBool(ancestorsQuery)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -288,13 +291,13 @@ func (this *AspectNodeEndpoints) ListMeasuringFunctions(config configuration.Con
 		if descendantsQuery != "" {
 			descendants, err = strconv.ParseBool(descendantsQuery)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
-		result, err, errCode := control.GetAspectNodesMeasuringFunctions(id, ancestors, descendants)
+		result, err, errCode := control.GetAspectNodesMeasuringFunctions(request.Context(), id, ancestors, descendants)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
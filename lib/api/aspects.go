@@ -21,6 +21,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -68,7 +70,7 @@ func (this *AspectEndpoints) ListAspects(config configuration.Config, router *ht
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -77,7 +79,7 @@ func (this *AspectEndpoints) ListAspects(config configuration.Config, router *ht
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -95,9 +97,9 @@ func (this *AspectEndpoints) ListAspects(config configuration.Config, router *ht
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListAspects(listoptions)
+		result, total, err, errCode := control.ListAspects(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -137,9 +139,9 @@ func (this *AspectEndpoints) List(config configuration.Config, router *http.Serv
 		function := request.URL.Query().Get("function")
 
 		if function == "" {
-			result, err, errCode = control.GetAspects()
+			result, err, errCode = control.GetAspects(request.Context())
 			if err != nil {
-				http.Error(writer, err.Error(), errCode)
+				util.Error(writer, err, errCode)
 				return
 			}
 		} else {
@@ -149,7 +151,7 @@ func (this *AspectEndpoints) List(config configuration.Config, router *http.Serv
 			if ancestorsQuery != "" {
 				ancestors, err = strconv.ParseBool(ancestorsQuery)
 				if err != nil {
-					http.Error(writer, err.Error(), http.StatusBadRequest)
+					util.Error(writer, err, http.StatusBadRequest)
 					return
 				}
 			}
@@ -157,14 +159,14 @@ func (this *AspectEndpoints) List(config configuration.Config, router *http.Serv
 			if descendantsQuery != "" {
 				descendants, err = strconv.ParseBool(descendantsQuery)
 				if err != nil {
-					http.Error(writer, err.Error(), http.StatusBadRequest)
+					util.Error(writer, err, http.StatusBadRequest)
 					return
 				}
 			}
 			if function == "measuring-function" {
-				result, err, errCode = control.GetAspectsWithMeasuringFunction(ancestors, descendants)
+				result, err, errCode = control.GetAspectsWithMeasuringFunction(request.Context(), ancestors, descendants)
 				if err != nil {
-					http.Error(writer, err.Error(), errCode)
+					util.Error(writer, err, errCode)
 					return
 				}
 			}
@@ -196,9 +198,9 @@ func (this *AspectEndpoints) List(config configuration.Config, router *http.Serv
 func (this *AspectEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /aspects/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetAspect(id)
+		result, err, errCode := control.GetAspect(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -226,22 +228,22 @@ func (this *AspectEndpoints) Validate(config configuration.Config, router *http.
 	router.HandleFunc("PUT /aspects", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		aspect := models.Aspect{}
 		err = json.NewDecoder(request.Body).Decode(&aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateAspect(aspect)
+		err, code := control.ValidateAspect(request.Context(), aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -269,20 +271,20 @@ func (this *AspectEndpoints) Set(config configuration.Config, router *http.Serve
 		aspect := models.Aspect{}
 		err := json.NewDecoder(request.Body).Decode(&aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if aspect.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetAspect(token, aspect)
+		result, err, errCode := control.SetAspect(request.Context(), token, aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -315,14 +317,14 @@ func (this *AspectEndpoints) Create(config configuration.Config, router *http.Se
 		aspect := models.Aspect{}
 		err := json.NewDecoder(request.Body).Decode(&aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetAspect(token, aspect)
+		result, err, errCode := control.SetAspect(request.Context(), token, aspect)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -353,23 +355,23 @@ func (this *AspectEndpoints) DeleteAspect(config configuration.Config, router *h
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		if dryRun {
-			err, code := control.ValidateAspectDelete(id)
+			err, code := control.ValidateAspectDelete(request.Context(), id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
 		token := util.GetAuthToken(request)
-		err, errCode := control.DeleteAspect(token, id)
+		err, errCode := control.DeleteAspect(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -407,7 +409,7 @@ func (this *AspectEndpoints) GetMeasuringFunctions(config configuration.Config,
 		if ancestorsQuery != "" {
 			ancestors, err = strconv.ParseBool(ancestorsQuery)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -415,13 +417,13 @@ func (this *AspectEndpoints) GetMeasuringFunctions(config configuration.Config,
 		if descendantsQuery != "" {
 			descendants, err = strconv.ParseBool(descendantsQuery)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
-		result, err, errCode := control.GetAspectNodesMeasuringFunctions(id, ancestors, descendants)
+		result, err, errCode := control.GetAspectNodesMeasuringFunctions(request.Context(), id, ancestors, descendants)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -432,3 +434,95 @@ func (this *AspectEndpoints) GetMeasuringFunctions(config configuration.Config,
 		return
 	})
 }
+
+// Merge godoc
+// @Summary      merge aspects
+// @Description  merges the aspect into the target aspect; requires admin rights.
+// @Description  sub-aspects of the merged aspect are moved under the target, references in device-types and device-type-templates are replaced by the target,
+// @Description  the merged aspect is removed and aspect nodes and device-group criteria are rebuilt. all changes are published.
+// @Tags         aspects
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Id of the aspect, that is merged into the target"
+// @Param        target query string true "Id of the aspect, that remains"
+// @Param        dry-run query bool false "only compute the impact"
+// @Success      200 {object}  model.AspectChangeImpact
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /aspects/{id}/merge [POST]
+func (this *AspectEndpoints) Merge(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /aspects/{id}/merge", func(writer http.ResponseWriter, request *http.Request) {
+		target := request.URL.Query().Get("target")
+		if target == "" {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "target", errors.New("missing target query parameter")), http.StatusBadRequest)
+			return
+		}
+		dryRun, err := parseOptionalDryRun(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.MergeAspects(request.Context(), util.GetAuthToken(request), request.PathValue("id"), target, dryRun)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Move godoc
+// @Summary      move aspect
+// @Description  moves the aspect with its sub-aspects under the parent aspect, which may belong to another root aspect; requires admin rights.
+// @Description  without parent, the aspect becomes a root aspect. aspect ids stay unchanged; aspect nodes and device-group criteria are rebuilt. all changes are published.
+// @Tags         aspects
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Aspect Id"
+// @Param        parent query string false "Id of the new parent aspect; empty to create a root aspect"
+// @Param        dry-run query bool false "only compute the impact"
+// @Success      200 {object}  model.AspectChangeImpact
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /aspects/{id}/move [POST]
+func (this *AspectEndpoints) Move(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /aspects/{id}/move", func(writer http.ResponseWriter, request *http.Request) {
+		dryRun, err := parseOptionalDryRun(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.MoveAspect(request.Context(), util.GetAuthToken(request), request.PathValue("id"), request.URL.Query().Get("parent"), dryRun)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+func parseOptionalDryRun(request *http.Request) (dryRun bool, err error) {
+	dryRunParam := request.URL.Query().Get("dry-run")
+	if dryRunParam == "" {
+		return false, nil
+	}
+	dryRun, err = strconv.ParseBool(dryRunParam)
+	if err != nil {
+		return false, model.NewFieldError(model.ErrInvalidQueryParameter, "dry-run", fmt.Errorf("unable to parse dry-run:%w", err))
+	}
+	return dryRun, nil
+}
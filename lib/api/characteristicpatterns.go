@@ -0,0 +1,131 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &CharacteristicPatternEndpoints{})
+}
+
+type CharacteristicPatternEndpoints struct{}
+
+// Get godoc
+// @Summary      get characteristic pattern
+// @Description  get the pattern string values of the characteristic have to match
+// @Tags         characteristics
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Characteristic Id"
+// @Success      200 {object}  model.CharacteristicPattern
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /characteristics/{id}/pattern [GET]
+func (this *CharacteristicPatternEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /characteristics/{id}/pattern", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetCharacteristicPattern(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Set godoc
+// @Summary      set characteristic pattern
+// @Description  restricts string values of the characteristic to a regular expression (RE2 syntax), that has to match the whole value; requires admin rights.
+// @Description  only allowed for string characteristics; value and allowed_values of the characteristic have to match the pattern.
+// @Description  fixed content-variable values and payloads are checked against the pattern; existing device-types are checked on their next update
+// @Tags         characteristics
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Characteristic Id"
+// @Param        message body model.CharacteristicPattern true "pattern"
+// @Success      200 {object}  model.CharacteristicPattern
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /characteristics/{id}/pattern [PUT]
+func (this *CharacteristicPatternEndpoints) Set(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /characteristics/{id}/pattern", func(writer http.ResponseWriter, request *http.Request) {
+		id := request.PathValue("id")
+		pattern := model.CharacteristicPattern{}
+		err := json.NewDecoder(request.Body).Decode(&pattern)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if pattern.CharacteristicId == "" {
+			pattern.CharacteristicId = id
+		}
+		if pattern.CharacteristicId != id {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "characteristic_id", errors.New("characteristic_id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetCharacteristicPattern(request.Context(), util.GetAuthToken(request), pattern)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Remove godoc
+// @Summary      remove characteristic pattern
+// @Description  removes the pattern of a characteristic; requires admin rights
+// @Tags         characteristics
+// @Security Bearer
+// @Param        id path string true "Characteristic Id"
+// @Success      200
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /characteristics/{id}/pattern [DELETE]
+func (this *CharacteristicPatternEndpoints) Remove(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("DELETE /characteristics/{id}/pattern", func(writer http.ResponseWriter, request *http.Request) {
+		err, errCode := control.RemoveCharacteristicPattern(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		return
+	})
+}
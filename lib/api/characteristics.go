@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -65,7 +67,7 @@ func (this *CharacteristicsEndpoints) ListCharacteristics(config configuration.C
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -74,7 +76,7 @@ func (this *CharacteristicsEndpoints) ListCharacteristics(config configuration.C
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -92,9 +94,9 @@ func (this *CharacteristicsEndpoints) ListCharacteristics(config configuration.C
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListCharacteristics(listoptions)
+		result, total, err, errCode := control.ListCharacteristics(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -130,14 +132,14 @@ func (this *CharacteristicsEndpoints) List(config configuration.Config, router *
 		if leafsOnlyStr != "" {
 			leafsOnly, err = strconv.ParseBool(leafsOnlyStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 
-		result, err, errCode := control.GetCharacteristics(leafsOnly)
+		result, err, errCode := control.GetCharacteristics(request.Context(), leafsOnly)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -167,9 +169,9 @@ func (this *CharacteristicsEndpoints) List(config configuration.Config, router *
 func (this *CharacteristicsEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /characteristics/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetCharacteristic(id)
+		result, err, errCode := control.GetCharacteristic(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -197,22 +199,22 @@ func (this *CharacteristicsEndpoints) Validate(config configuration.Config, rout
 	router.HandleFunc("PUT /characteristics", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		characteristic := models.Characteristic{}
 		err = json.NewDecoder(request.Body).Decode(&characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateCharacteristics(characteristic)
+		err, code := control.ValidateCharacteristics(request.Context(), characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -238,23 +240,23 @@ func (this *CharacteristicsEndpoints) Delete(config configuration.Config, router
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		if dryRun {
-			err, code := control.ValidateCharacteristicDelete(id)
+			err, code := control.ValidateCharacteristicDelete(request.Context(), id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
 		token := util.GetAuthToken(request)
-		err, errCode := control.DeleteCharacteristic(token, id)
+		err, errCode := control.DeleteCharacteristic(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -285,14 +287,14 @@ func (this *CharacteristicsEndpoints) Create(config configuration.Config, router
 		characteristic := models.Characteristic{}
 		err := json.NewDecoder(request.Body).Decode(&characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetCharacteristic(token, characteristic)
+		result, err, errCode := control.SetCharacteristic(request.Context(), token, characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -326,20 +328,20 @@ func (this *CharacteristicsEndpoints) Update(config configuration.Config, router
 		characteristic := models.Characteristic{}
 		err := json.NewDecoder(request.Body).Decode(&characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		if characteristic.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetCharacteristic(token, characteristic)
+		result, err, errCode := control.SetCharacteristic(request.Context(), token, characteristic)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
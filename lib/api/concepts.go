@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -65,7 +67,7 @@ func (this *ConceptEndpoints) ListConcepts(config configuration.Config, router *
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -74,7 +76,7 @@ func (this *ConceptEndpoints) ListConcepts(config configuration.Config, router *
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -92,9 +94,9 @@ func (this *ConceptEndpoints) ListConcepts(config configuration.Config, router *
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListConcepts(listoptions)
+		result, total, err, errCode := control.ListConcepts(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -139,7 +141,7 @@ func (this *ConceptEndpoints) ListConceptsWithCharacteristics(config configurati
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -148,7 +150,7 @@ func (this *ConceptEndpoints) ListConceptsWithCharacteristics(config configurati
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -166,9 +168,9 @@ func (this *ConceptEndpoints) ListConceptsWithCharacteristics(config configurati
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListConceptsWithCharacteristics(listoptions)
+		result, total, err, errCode := control.ListConceptsWithCharacteristics(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -207,7 +209,7 @@ func (this *ConceptEndpoints) Get(config configuration.Config, router *http.Serv
 		if subClassStr != "" {
 			subClass, err = strconv.ParseBool(subClassStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -215,12 +217,12 @@ func (this *ConceptEndpoints) Get(config configuration.Config, router *http.Serv
 		resultConcept := models.Concept{}
 		errCode := 0
 		if subClass {
-			resultConceptWithCharacteristics, err, errCode = control.GetConceptWithCharacteristics(id)
+			resultConceptWithCharacteristics, err, errCode = control.GetConceptWithCharacteristics(request.Context(), id)
 		} else {
-			resultConcept, err, errCode = control.GetConceptWithoutCharacteristics(id)
+			resultConcept, err, errCode = control.GetConceptWithoutCharacteristics(request.Context(), id)
 		}
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -252,22 +254,22 @@ func (this *ConceptEndpoints) Validate(config configuration.Config, router *http
 	router.HandleFunc("PUT /concepts", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		concept := models.Concept{}
 		err = json.NewDecoder(request.Body).Decode(&concept)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateConcept(concept)
+		err, code := control.ValidateConcept(request.Context(), concept)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -293,23 +295,23 @@ func (this *ConceptEndpoints) Delete(config configuration.Config, router *http.S
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		if dryRun {
-			err, code := control.ValidateConceptDelete(id)
+			err, code := control.ValidateConceptDelete(request.Context(), id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
 		token := util.GetAuthToken(request)
-		err, code := control.DeleteConcept(token, id)
+		err, code := control.DeleteConcept(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -335,14 +337,14 @@ func (this *ConceptEndpoints) Create(config configuration.Config, router *http.S
 		concept := models.Concept{}
 		err := json.NewDecoder(request.Body).Decode(&concept)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetConcept(token, concept)
+		result, err, errCode := control.SetConcept(request.Context(), token, concept)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -375,20 +377,20 @@ func (this *ConceptEndpoints) Set(config configuration.Config, router *http.Serv
 		concept := models.Concept{}
 		err := json.NewDecoder(request.Body).Decode(&concept)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if concept.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetConcept(token, concept)
+		result, err, errCode := control.SetConcept(request.Context(), token, concept)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -45,9 +45,9 @@ type DefaultsEndpoints struct{}
 // @Router       /defaults/devices/attributes [GET]
 func (this *DefaultsEndpoints) GetDefaultDeviceAttributes(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /defaults/devices/attributes", func(writer http.ResponseWriter, request *http.Request) {
-		result, err, errCode := control.GetDefaultDeviceAttributes(util.GetAuthToken(request))
+		result, err, errCode := control.GetDefaultDeviceAttributes(request.Context(), util.GetAuthToken(request))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -79,12 +79,12 @@ func (this *DefaultsEndpoints) SetDefaultDeviceAttributes(config configuration.C
 		var attributes []models.Attribute
 		err := json.NewDecoder(request.Body).Decode(&attributes)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, errCode := control.SetDefaultDeviceAttributes(util.GetAuthToken(request), attributes)
+		err, errCode := control.SetDefaultDeviceAttributes(request.Context(), util.GetAuthToken(request), attributes)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -21,6 +21,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -69,7 +71,7 @@ func (this *DeviceClassEndpoints) ListDeviceClasses(config configuration.Config,
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -78,7 +80,7 @@ func (this *DeviceClassEndpoints) ListDeviceClasses(config configuration.Config,
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -94,7 +96,7 @@ func (this *DeviceClassEndpoints) ListDeviceClasses(config configuration.Config,
 		if request.URL.Query().Has("used_with_controlling_function") {
 			listoptions.UsedWithControllingFunction, err = strconv.ParseBool(request.URL.Query().Get("used_with_controlling_function"))
 			if err != nil {
-				http.Error(writer, "unable to parse used_with_controlling_function as bool: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "used_with_controlling_function", fmt.Errorf("unable to parse used_with_controlling_function as bool: %w", err)), http.StatusBadRequest)
 				return
 			}
 		}
@@ -104,9 +106,9 @@ func (this *DeviceClassEndpoints) ListDeviceClasses(config configuration.Config,
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListDeviceClasses(listoptions)
+		result, total, err, errCode := control.ListDeviceClasses(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -144,16 +146,16 @@ func (this *DeviceClassEndpoints) List(config configuration.Config, router *http
 		function := request.URL.Query().Get("function")
 
 		if function == "" {
-			result, err, errCode = control.GetDeviceClasses()
+			result, err, errCode = control.GetDeviceClasses(request.Context())
 			if err != nil {
-				http.Error(writer, err.Error(), errCode)
+				util.Error(writer, err, errCode)
 				return
 			}
 		} else {
 			if function == "controlling-function" {
-				result, err, errCode = control.GetDeviceClassesWithControllingFunctions()
+				result, err, errCode = control.GetDeviceClassesWithControllingFunctions(request.Context())
 				if err != nil {
-					http.Error(writer, err.Error(), errCode)
+					util.Error(writer, err, errCode)
 					return
 				}
 			}
@@ -185,9 +187,9 @@ func (this *DeviceClassEndpoints) List(config configuration.Config, router *http
 func (this *DeviceClassEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /device-classes/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetDeviceClass(id)
+		result, err, errCode := control.GetDeviceClass(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -216,9 +218,9 @@ func (this *DeviceClassEndpoints) Get(config configuration.Config, router *http.
 func (this *DeviceClassEndpoints) GetFunctions(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /device-classes/{id}/functions", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetDeviceClassesFunctions(id)
+		result, err, errCode := control.GetDeviceClassesFunctions(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -247,9 +249,9 @@ func (this *DeviceClassEndpoints) GetFunctions(config configuration.Config, rout
 func (this *DeviceClassEndpoints) GetControllingFunctions(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /device-classes/{id}/controlling-functions", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetDeviceClassesControllingFunctions(id)
+		result, err, errCode := control.GetDeviceClassesControllingFunctions(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -277,22 +279,22 @@ func (this *DeviceClassEndpoints) Validate(config configuration.Config, router *
 	router.HandleFunc("PUT /device-classes", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		deviceclass := models.DeviceClass{}
 		err = json.NewDecoder(request.Body).Decode(&deviceclass)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateDeviceClass(deviceclass)
+		err, code := control.ValidateDeviceClass(request.Context(), deviceclass)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -318,23 +320,23 @@ func (this *DeviceClassEndpoints) Delete(config configuration.Config, router *ht
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		if dryRun {
-			err, code := control.ValidateDeviceClassDelete(id)
+			err, code := control.ValidateDeviceClassDelete(request.Context(), id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
 		token := util.GetAuthToken(request)
-		err, code := control.DeleteDeviceClass(token, id)
+		err, code := control.DeleteDeviceClass(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -360,14 +362,14 @@ func (this *DeviceClassEndpoints) Create(config configuration.Config, router *ht
 		deviceClass := models.DeviceClass{}
 		err := json.NewDecoder(request.Body).Decode(&deviceClass)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetDeviceClass(token, deviceClass)
+		result, err, errCode := control.SetDeviceClass(request.Context(), token, deviceClass)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -400,20 +402,20 @@ func (this *DeviceClassEndpoints) Set(config configuration.Config, router *http.
 		deviceClass := models.DeviceClass{}
 		err := json.NewDecoder(request.Body).Decode(&deviceClass)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if deviceClass.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetDeviceClass(token, deviceClass)
+		result, err, errCode := control.SetDeviceClass(request.Context(), token, deviceClass)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &DeviceConfigurationEndpoints{})
+}
+
+type DeviceConfigurationEndpoints struct{}
+
+// Get godoc
+// @Summary      get device configuration
+// @Description  get the configuration values of the device; values are identified by service id and content-variable path (e.g. "payload.duration")
+// @Tags         devices
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device Id"
+// @Success      200 {array}  model.DeviceConfigurationValue
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /devices/{id}/configuration [GET]
+func (this *DeviceConfigurationEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /devices/{id}/configuration", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetDeviceConfiguration(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Set godoc
+// @Summary      set device configuration
+// @Description  replaces the configuration values of the device; requires write rights on the device.
+// @Description  each value references a configurable content-variable (input, leaf, not void) by service id and content-variable path (e.g. "payload.duration").
+// @Description  values are checked against the content-variable type, the characteristic constraints and the characteristic pattern.
+// @Description  the configuration is returned by the extended-devices endpoints, preserved on device updates and published with the device.
+// @Description  values, that no longer match the device-type (e.g. after a device-type update), are removed
+// @Tags         devices
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device Id"
+// @Param        message body []model.DeviceConfigurationValue true "configuration"
+// @Success      200 {array}  model.DeviceConfigurationValue
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /devices/{id}/configuration [PUT]
+func (this *DeviceConfigurationEndpoints) Set(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /devices/{id}/configuration", func(writer http.ResponseWriter, request *http.Request) {
+		values := []model.DeviceConfigurationValue{}
+		err := json.NewDecoder(request.Body).Decode(&values)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetDeviceConfiguration(request.Context(), util.GetAuthToken(request), request.PathValue("id"), values)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -73,7 +75,7 @@ func (this *DeviceGroupEndpoints) List(config configuration.Config, router *http
 			deviceGroupListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -82,7 +84,7 @@ func (this *DeviceGroupEndpoints) List(config configuration.Config, router *http
 			deviceGroupListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -104,7 +106,7 @@ func (this *DeviceGroupEndpoints) List(config configuration.Config, router *http
 		if request.URL.Query().Has("ignore-generated") {
 			deviceGroupListOptions.IgnoreGenerated, err = strconv.ParseBool(request.URL.Query().Get("ignore-generated"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -114,7 +116,7 @@ func (this *DeviceGroupEndpoints) List(config configuration.Config, router *http
 			criteriaList := []model.FilterCriteria{}
 			err = json.Unmarshal([]byte(criteria), &criteriaList)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 			deviceGroupListOptions.Criteria = criteriaList
@@ -144,16 +146,16 @@ func (this *DeviceGroupEndpoints) List(config configuration.Config, router *http
 
 		deviceGroupListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if deviceGroupListOptions.Permission == models.UnsetPermissionFlag {
 			deviceGroupListOptions.Permission = model.READ
 		}
 
-		result, total, err, errCode := control.ListDeviceGroups(util.GetAuthToken(request), deviceGroupListOptions)
+		result, total, err, errCode := control.ListDeviceGroups(request.Context(), util.GetAuthToken(request), deviceGroupListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -189,9 +191,9 @@ func (this *DeviceGroupEndpoints) Get(config configuration.Config, router *http.
 		//ref https://bitnify.atlassian.net/browse/SNRGY-3027
 		filterGenericDuplicateCriteria := request.URL.Query().Get("filter_generic_duplicate_criteria") == "true"
 
-		result, err, errCode := control.ReadDeviceGroup(id, util.GetAuthToken(request), filterGenericDuplicateCriteria)
+		result, err, errCode := control.ReadDeviceGroup(request.Context(), id, util.GetAuthToken(request), filterGenericDuplicateCriteria)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -220,22 +222,22 @@ func (this *DeviceGroupEndpoints) Validate(config configuration.Config, router *
 	router.HandleFunc("PUT /device-groups", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		group := models.DeviceGroup{}
 		err = json.NewDecoder(request.Body).Decode(&group)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateDeviceGroup(util.GetAuthToken(request), group)
+		err, code := control.ValidateDeviceGroup(request.Context(), util.GetAuthToken(request), group)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -261,23 +263,23 @@ func (this *DeviceGroupEndpoints) Delete(config configuration.Config, router *ht
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		token := util.GetAuthToken(request)
 		if dryRun {
-			err, code := control.ValidateDeviceGroupDelete(token, id)
+			err, code := control.ValidateDeviceGroupDelete(request.Context(), token, id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
-		err, code := control.DeleteDeviceGroup(token, id)
+		err, code := control.DeleteDeviceGroup(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -303,19 +305,19 @@ func (this *DeviceGroupEndpoints) Create(config configuration.Config, router *ht
 		deviceGroup := models.DeviceGroup{}
 		err := json.NewDecoder(request.Body).Decode(&deviceGroup)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
 		if deviceGroup.Id != "" {
-			http.Error(writer, "body may not contain a preset id. please use the PUT method for updates", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("body may not contain a preset id. please use the PUT method for updates")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetDeviceGroup(token, deviceGroup)
+		result, err, errCode := control.SetDeviceGroup(request.Context(), token, deviceGroup)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -348,20 +350,20 @@ func (this *DeviceGroupEndpoints) Set(config configuration.Config, router *http.
 		deviceGroup := models.DeviceGroup{}
 		err := json.NewDecoder(request.Body).Decode(&deviceGroup)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if deviceGroup.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetDeviceGroup(token, deviceGroup)
+		result, err, errCode := control.SetDeviceGroup(request.Context(), token, deviceGroup)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
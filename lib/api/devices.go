@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"slices"
@@ -60,6 +62,7 @@ const DisplayNameAttributeOrigin = "shared"
 // @Param        connection-state query integer false "filter; valid values are 'online', 'offline' and an empty string for unknown states"
 // @Param        p query string false "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate"
 // @Param        device-attribute-blacklist query string false "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted"
+// @Param        fields query string false "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields"
 // @Success      200 {array}  models.Device
 // @Failure      400
 // @Failure      401
@@ -79,7 +82,7 @@ func (this *DeviceEndpoints) List(config configuration.Config, router *http.Serv
 			deviceListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -88,7 +91,7 @@ func (this *DeviceEndpoints) List(config configuration.Config, router *http.Serv
 			deviceListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -147,7 +150,7 @@ func (this *DeviceEndpoints) List(config configuration.Config, router *http.Serv
 		if request.URL.Query().Has("connection-state") {
 			searchedState := request.URL.Query().Get("connection-state")
 			if !slices.Contains([]models.ConnectionState{models.ConnectionStateOnline, models.ConnectionStateOffline, models.ConnectionStateUnknown}, searchedState) {
-				http.Error(writer, "invalid connection state:"+searchedState, http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "connection-state", errors.New("invalid connection state:"+searchedState)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.ConnectionState = &searchedState
@@ -155,7 +158,7 @@ func (this *DeviceEndpoints) List(config configuration.Config, router *http.Serv
 
 		deviceListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if deviceListOptions.Permission == models.UnsetPermissionFlag {
@@ -166,21 +169,27 @@ func (this *DeviceEndpoints) List(config configuration.Config, router *http.Serv
 		if deviceAttributeBlacklistParam != "" {
 			deviceAttributeBlacklistParam, err = url.QueryUnescape(deviceAttributeBlacklistParam)
 			if err != nil {
-				http.Error(writer, "unable to decode device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to decode device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			var blacklist []models.Attribute
 			err = json.Unmarshal([]byte(deviceAttributeBlacklistParam), &blacklist)
 			if err != nil {
-				http.Error(writer, "unable to parse device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to parse device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.DeviceAttributeBlacklist = blacklist
 		}
 
-		result, err, errCode := control.ListDevices(util.GetAuthToken(request), deviceListOptions)
+		deviceListOptions.Fields, err = model.ParseFields(request.URL.Query().Get("fields"))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+
+		result, err, errCode := control.ListDevices(request.Context(), util.GetAuthToken(request), deviceListOptions)
+		if err != nil {
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -218,14 +227,14 @@ func (this *DeviceEndpoints) Get(config configuration.Config, router *http.Serve
 		if ownerId == "" {
 			token, err := jwt.GetParsedToken(request)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusUnauthorized)
+				util.Error(writer, err, http.StatusUnauthorized)
 				return
 			}
 			ownerId = token.GetUserId()
 		}
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
@@ -234,12 +243,12 @@ func (this *DeviceEndpoints) Get(config configuration.Config, router *http.Serve
 		var result models.Device
 		var errCode int
 		if as == "local_id" {
-			result, err, errCode = control.ReadDeviceByLocalId(ownerId, id, util.GetAuthToken(request), permission)
+			result, err, errCode = control.ReadDeviceByLocalId(request.Context(), ownerId, id, util.GetAuthToken(request), permission)
 		} else {
-			result, err, errCode = control.ReadDevice(id, util.GetAuthToken(request), permission)
+			result, err, errCode = control.ReadDevice(request.Context(), id, util.GetAuthToken(request), permission)
 		}
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -267,22 +276,22 @@ func (this *DeviceEndpoints) Validate(config configuration.Config, router *http.
 	router.HandleFunc("PUT /devices", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		device := models.Device{}
 		err = json.NewDecoder(request.Body).Decode(&device)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateDevice(util.GetAuthToken(request), device)
+		err, code := control.ValidateDevice(request.Context(), util.GetAuthToken(request), device)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -308,20 +317,20 @@ func (this *DeviceEndpoints) Create(config configuration.Config, router *http.Se
 		device := models.Device{}
 		err := json.NewDecoder(request.Body).Decode(&device)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if device.Id != "" {
-			http.Error(writer, "body may not contain a preset id. please use the PUT method for updates", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("body may not contain a preset id. please use the PUT method for updates")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.CreateDevice(token, device)
+		result, err, errCode := control.CreateDevice(request.Context(), token, device)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -355,13 +364,13 @@ func (this *DeviceEndpoints) Set(config configuration.Config, router *http.Serve
 		device := models.Device{}
 		err := json.NewDecoder(request.Body).Decode(&device)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
 		if device.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
@@ -371,9 +380,9 @@ func (this *DeviceEndpoints) Set(config configuration.Config, router *http.Serve
 			options.UpdateOnlySameOriginAttributes = strings.Split(temp, ",")
 		}
 
-		result, err, errCode := control.SetDevice(token, device, options)
+		result, err, errCode := control.SetDevice(request.Context(), token, device, options)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -408,12 +417,12 @@ func (this *DeviceEndpoints) SetAttributes(config configuration.Config, router *
 		attributes := []models.Attribute{}
 		err := json.NewDecoder(request.Body).Decode(&attributes)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
@@ -423,16 +432,16 @@ func (this *DeviceEndpoints) SetAttributes(config configuration.Config, router *
 			options.UpdateOnlySameOriginAttributes = strings.Split(temp, ",")
 		}
 
-		device, err, errCode := control.ReadDevice(id, token, model.WRITE)
+		device, err, errCode := control.ReadDevice(request.Context(), id, token, model.WRITE)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		device.Attributes = attributes
 
-		result, err, errCode := control.SetDevice(token, device, options)
+		result, err, errCode := control.SetDevice(request.Context(), token, device, options)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -467,14 +476,14 @@ func (this *DeviceEndpoints) SetDisplayName(config configuration.Config, router
 
 		err := json.NewDecoder(request.Body).Decode(&displayName)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		device, err, errCode := control.ReadDevice(id, token, model.WRITE)
+		device, err, errCode := control.ReadDevice(request.Context(), id, token, model.WRITE)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -490,9 +499,9 @@ func (this *DeviceEndpoints) SetDisplayName(config configuration.Config, router
 			device.Attributes = append(device.Attributes, models.Attribute{Key: DisplayNameAttributeKey, Value: displayName, Origin: DisplayNameAttributeOrigin})
 		}
 
-		result, err, errCode := control.SetDevice(token, device, model.DeviceUpdateOptions{})
+		result, err, errCode := control.SetDevice(request.Context(), token, device, model.DeviceUpdateOptions{})
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -524,9 +533,9 @@ func (this *DeviceEndpoints) Delete(config configuration.Config, router *http.Se
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.DeleteDevice(token, id)
+		err, errCode := control.DeleteDevice(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -557,15 +566,15 @@ func (this *DeviceEndpoints) DeleteMany(config configuration.Config, router *htt
 		ids := []string{}
 		err := json.NewDecoder(request.Body).Decode(&ids)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
 		for _, id := range ids {
-			err, errCode := control.DeleteDevice(token, id)
+			err, errCode := control.DeleteDevice(request.Context(), token, id)
 			if err != nil {
-				http.Error(writer, err.Error(), errCode)
+				util.Error(writer, err, errCode)
 				return
 			}
 		}
@@ -595,20 +604,20 @@ func (this *DeviceEndpoints) SetConnectionState(config configuration.Config, rou
 	router.HandleFunc("PUT /devices/{id}/connection-state", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
 		if id == "" {
-			http.Error(writer, "missing id", http.StatusBadRequest)
+			util.Error(writer, model.NewError(model.ErrMissingId, errors.New("missing id")), http.StatusBadRequest)
 			return
 		}
 		connected := false
 		err := json.NewDecoder(request.Body).Decode(&connected)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.SetDeviceConnectionState(token, id, connected)
+		err, errCode := control.SetDeviceConnectionState(request.Context(), token, id, connected)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
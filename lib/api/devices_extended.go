@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"slices"
@@ -57,7 +59,8 @@ type ExtendedDeviceEndpoints struct{}
 // @Param        device-attribute-blacklist query string false "JSON encoded []models.Attribute, attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted"
 // @Param        connection-state query integer false "filter; valid values are 'online', 'offline' and an empty string for unknown states"
 // @Param        p query string false "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate"
-// @Success      200 {array}  models.ExtendedDevice
+// @Param        fields query string false "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields"
+// @Success      200 {array}  model.ExtendedDevice
 // @Header       200 {integer}  X-Total-Count  "count of all matching elements; used for pagination"
 // @Failure      400
 // @Failure      401
@@ -78,7 +81,7 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 			deviceListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -87,7 +90,7 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 			deviceListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -146,7 +149,7 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 		if request.URL.Query().Has("connection-state") {
 			searchedState := request.URL.Query().Get("connection-state")
 			if !slices.Contains([]models.ConnectionState{models.ConnectionStateOnline, models.ConnectionStateOffline, models.ConnectionStateUnknown}, searchedState) {
-				http.Error(writer, "invalid connection state:"+searchedState, http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "connection-state", errors.New("invalid connection state:"+searchedState)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.ConnectionState = &searchedState
@@ -154,7 +157,7 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 
 		deviceListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if deviceListOptions.Permission == models.UnsetPermissionFlag {
@@ -165,21 +168,27 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 		if deviceAttributeBlacklistParam != "" {
 			deviceAttributeBlacklistParam, err = url.QueryUnescape(deviceAttributeBlacklistParam)
 			if err != nil {
-				http.Error(writer, "unable to decode device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to decode device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			var blacklist []models.Attribute
 			err = json.Unmarshal([]byte(deviceAttributeBlacklistParam), &blacklist)
 			if err != nil {
-				http.Error(writer, "unable to parse device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to parse device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.DeviceAttributeBlacklist = blacklist
 		}
 
-		result, total, err, errCode := control.ListExtendedDevices(util.GetAuthToken(request), deviceListOptions)
+		deviceListOptions.Fields, err = model.ParseFields(request.URL.Query().Get("fields"))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+
+		result, total, err, errCode := control.ListExtendedDevicesWithConfiguration(request.Context(), util.GetAuthToken(request), deviceListOptions)
+		if err != nil {
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
@@ -203,7 +212,7 @@ func (this *ExtendedDeviceEndpoints) List(config configuration.Config, router *h
 // @Param        owner_id query string false "default requesting user; used in combination with local_id (as=='local_id') to identify the device"
 // @Param        p query string false "default 'r'; used to check permissions on request; valid values are 'r', 'w', 'x', 'a' for read, write, execute, administrate"
 // @Param        fulldt query bool false "if true, result contains full device-type"
-// @Success      200 {object}  models.Device
+// @Success      200 {object}  model.ExtendedDevice
 // @Failure      400
 // @Failure      401
 // @Failure      403
@@ -218,29 +227,29 @@ func (this *ExtendedDeviceEndpoints) Get(config configuration.Config, router *ht
 		if ownerId == "" {
 			token, err := jwt.GetParsedToken(request)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusUnauthorized)
+				util.Error(writer, err, http.StatusUnauthorized)
 				return
 			}
 			ownerId = token.GetUserId()
 		}
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
 			permission = model.READ
 		}
 		fulldt := request.URL.Query().Get("fulldt") == "true"
-		var result models.ExtendedDevice
+		var result model.ExtendedDevice
 		var errCode int
 		if as == "local_id" {
-			result, err, errCode = control.ReadExtendedDeviceByLocalId(ownerId, id, util.GetAuthToken(request), permission, fulldt)
+			result, err, errCode = control.ReadExtendedDeviceByLocalIdWithConfiguration(request.Context(), ownerId, id, util.GetAuthToken(request), permission, fulldt)
 		} else {
-			result, err, errCode = control.ReadExtendedDevice(id, util.GetAuthToken(request), permission, fulldt)
+			result, err, errCode = control.ReadExtendedDeviceWithConfiguration(request.Context(), id, util.GetAuthToken(request), permission, fulldt)
 		}
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
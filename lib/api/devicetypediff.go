@@ -0,0 +1,101 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func init() {
+	endpoints = append(endpoints, &DeviceTypeDiffEndpoints{})
+}
+
+type DeviceTypeDiffEndpoints struct{}
+
+// Diff godoc
+// @Summary      diff device-type
+// @Description  compares the stored device-type with the submitted device-type (e.g. the body of a planned PUT /device-types/{id}).
+// @Description  services are matched by id or else by local_id, contents by id or else by direction and content-variable name, content-variables by id or else by path.
+// @Description  the impact lists the device-group criteria gained or lost by the change and the device-groups, whose criteria would change
+// @Tags         device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device Type Id"
+// @Param        message body models.DeviceType true "device-type"
+// @Success      200 {object}  model.DeviceTypeDiff
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/diff [POST]
+func (this *DeviceTypeDiffEndpoints) Diff(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /device-types/{id}/diff", func(writer http.ResponseWriter, request *http.Request) {
+		dt := models.DeviceType{}
+		err := json.NewDecoder(request.Body).Decode(&dt)
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.DiffDeviceType(request.Context(), util.GetAuthToken(request), request.PathValue("id"), dt)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// DiffStored godoc
+// @Summary      diff stored device-types
+// @Description  compares two stored device-types; the impact describes replacing the device-type id with other_id (ref POST /device-types/{id}/diff)
+// @Tags         device-types
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device Type Id"
+// @Param        other_id path string true "Device Type Id"
+// @Success      200 {object}  model.DeviceTypeDiff
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/diff/{other_id} [GET]
+func (this *DeviceTypeDiffEndpoints) DiffStored(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-types/{id}/diff/{other_id}", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.DiffStoredDeviceTypes(request.Context(), util.GetAuthToken(request), request.PathValue("id"), request.PathValue("other_id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
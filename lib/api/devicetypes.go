@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -36,7 +38,8 @@ type DeviceTypeEndpoints struct{}
 
 // Get godoc
 // @Summary      get device-type
-// @Description  get device-type
+// @Description  get device-type; the id may contain id-modifiers after a '$' as url-encoded query (e.g. {id}$service_group_selection=left&name=Left%20Lamp).
+// @Description  known modifiers: service_group_selection, interaction_filter (event, request, event+request), function_filter (function ids), name, attribute (key=value); unknown modifiers are ignored
 // @Tags         device-types
 // @Produce      json
 // @Security Bearer
@@ -51,9 +54,9 @@ type DeviceTypeEndpoints struct{}
 func (this *DeviceTypeEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /device-types/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.ReadDeviceType(id, util.GetAuthToken(request))
+		result, err, errCode := control.ReadDeviceType(request.Context(), id, util.GetAuthToken(request))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -82,6 +85,7 @@ func (this *DeviceTypeEndpoints) Get(config configuration.Config, router *http.S
 // @Param        include-modified query bool false "include id-modified device-types"
 // @Param        ignore-unmodified query bool false "no unmodified device-types"
 // @Param        criteria query string false "filter; json encoded []model.FilterCriteria"
+// @Param        fields query string false "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields"
 // @Header       200 {integer}  X-Total-Count  "count of all matching elements; does not count modified elements; used for pagination"
 // @Success      200 {array}  models.DeviceType
 // @Failure      400
@@ -102,7 +106,7 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -111,7 +115,7 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -160,7 +164,7 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 		if includeModifiedStr != "" {
 			options.IncludeModified, err = strconv.ParseBool(includeModifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -169,7 +173,7 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 		if ignoreUnmodified != "" {
 			options.IgnoreUnmodified, err = strconv.ParseBool(ignoreUnmodified)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -179,15 +183,21 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 			criteriaList := []model.FilterCriteria{}
 			err = json.Unmarshal([]byte(criteria), &criteriaList)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 			options.Criteria = criteriaList
 		}
 
-		result, total, err, code := control.ListDeviceTypesV3(util.GetAuthToken(request), options)
+		options.Fields, err = model.ParseFields(request.URL.Query().Get("fields"))
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+
+		result, total, err, code := control.ListDeviceTypesV3(request.Context(), util.GetAuthToken(request), options)
+		if err != nil {
+			util.Error(writer, err, code)
 			return
 		}
 
@@ -218,6 +228,7 @@ func (this *DeviceTypeEndpoints) ListV3(config configuration.Config, router *htt
 // @Param        include-modified query bool false "include id-modified device-types"
 // @Param        ignore-unmodified query bool false "no unmodified device-types"
 // @Param        criteria query string false "filter; json encoded []model.FilterCriteria"
+// @Param        fields query string false "comma-separated list of json paths (e.g. id,name,services.id); reduces the response to the selected fields"
 // @Header       200 {integer}  X-Total-Count  "count of all matching elements; does not count modified elements; used for pagination"
 // @Success      200 {array}  models.DeviceType
 // @Failure      400
@@ -238,7 +249,7 @@ func (this *DeviceTypeEndpoints) ListDeviceTypesUsedByUser(config configuration.
 			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -247,7 +258,7 @@ func (this *DeviceTypeEndpoints) ListDeviceTypesUsedByUser(config configuration.
 			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -296,7 +307,7 @@ func (this *DeviceTypeEndpoints) ListDeviceTypesUsedByUser(config configuration.
 		if includeModifiedStr != "" {
 			options.IncludeModified, err = strconv.ParseBool(includeModifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -305,7 +316,7 @@ func (this *DeviceTypeEndpoints) ListDeviceTypesUsedByUser(config configuration.
 		if ignoreUnmodified != "" {
 			options.IgnoreUnmodified, err = strconv.ParseBool(ignoreUnmodified)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -315,15 +326,21 @@ func (this *DeviceTypeEndpoints) ListDeviceTypesUsedByUser(config configuration.
 			criteriaList := []model.FilterCriteria{}
 			err = json.Unmarshal([]byte(criteria), &criteriaList)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 			options.Criteria = criteriaList
 		}
 
-		result, total, err, code := control.ListDeviceTypesUsedByUser(util.GetAuthToken(request), options)
+		options.Fields, err = model.ParseFields(request.URL.Query().Get("fields"))
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+
+		result, total, err, code := control.ListDeviceTypesUsedByUser(request.Context(), util.GetAuthToken(request), options)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 
@@ -355,7 +372,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 					deprecated: use interactions field in filter (model.FilterCriteria.Interaction)
 					if set: returns only device-types with at least one matching interaction on criteria matching services
 					ignored if empty
-			- include_id_modified: bool; add service-group modified device-types to result
+			- include_id_modified: bool; add id-modified device-types (service_group_selection, interaction_filter, function_filter) to result
 	*/
 	router.HandleFunc("GET /device-types", func(writer http.ResponseWriter, request *http.Request) {
 		var err error
@@ -365,7 +382,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 			limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -375,7 +392,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 			offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -389,7 +406,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 		if includeModifiedStr != "" {
 			includeModified, err = strconv.ParseBool(includeModifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -399,7 +416,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 		if includeUnmodifiedStr != "" {
 			includeUnmodified, err = strconv.ParseBool(includeUnmodifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -409,7 +426,7 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 		if filter != "" {
 			err = json.Unmarshal([]byte(filter), &deviceTypesFilter)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -421,13 +438,13 @@ func (this *DeviceTypeEndpoints) List(config configuration.Config, router *http.
 			for _, interaction := range strings.Split(interactionsFilterStr, ",") {
 				interactionsFilter = append(interactionsFilter, strings.TrimSpace(interaction))
 			}
-			result, err, errCode = control.ListDeviceTypes(util.GetAuthToken(request), limit, offset, sort, deviceTypesFilter, interactionsFilter, includeModified, includeUnmodified)
+			result, err, errCode = control.ListDeviceTypes(request.Context(), util.GetAuthToken(request), limit, offset, sort, deviceTypesFilter, interactionsFilter, includeModified, includeUnmodified)
 		} else {
-			result, err, errCode = control.ListDeviceTypesV2(util.GetAuthToken(request), limit, offset, sort, deviceTypesFilter, includeModified, includeUnmodified)
+			result, err, errCode = control.ListDeviceTypesV2(request.Context(), util.GetAuthToken(request), limit, offset, sort, deviceTypesFilter, includeModified, includeUnmodified)
 		}
 
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -455,27 +472,27 @@ func (this *DeviceTypeEndpoints) Validate(config configuration.Config, router *h
 	router.HandleFunc("PUT /device-types", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		options, err := model.LoadDeviceTypeValidationOptions(request.URL.Query())
 		if err != nil {
-			http.Error(writer, "invalid validation options: "+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewError(model.ErrInvalidQueryParameter, fmt.Errorf("invalid validation options: %w", err)), http.StatusBadRequest)
 			return
 		}
 		dt := models.DeviceType{}
 		err = json.NewDecoder(request.Body).Decode(&dt)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateDeviceType(dt, options)
+		err, code := control.ValidateDeviceType(request.Context(), dt, options)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -489,6 +506,7 @@ func (this *DeviceTypeEndpoints) Validate(config configuration.Config, router *h
 // @Produce      json
 // @Security Bearer
 // @Param        distinct_attributes query string false "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist"
+// @Param        lint query bool false "reject the device-type if the linter reports errors; default is configured per installation"
 // @Param        message body models.DeviceType true "element"
 // @Success      200 {object}  models.DeviceType
 // @Failure      400
@@ -502,20 +520,66 @@ func (this *DeviceTypeEndpoints) Create(config configuration.Config, router *htt
 		devicetype := models.DeviceType{}
 		err := json.NewDecoder(request.Body).Decode(&devicetype)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		options := model.DeviceTypeUpdateOptions{}
-		distinctAttr := request.URL.Query().Get("distinct_attributes")
-		if distinctAttr != "" {
-			options.DistinctAttributes = strings.Split(distinctAttr, ",")
+		options, err := getDeviceTypeUpdateOptions(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
 		}
 
-		result, err, errCode := control.SetDeviceType(token, devicetype, options)
+		result, err, errCode := control.SetDeviceType(request.Context(), token, devicetype, options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Clone godoc
+// @Summary      clone device-type
+// @Description  stores a copy of the device-type with a new id; service, content and content-variable ids are regenerated, service-group keys are kept.
+// @Description  name and attributes of the copy may be changed with the body; a clone of an extended device-type is not linked to the template
+// @Tags         device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceType Id"
+// @Param        distinct_attributes query string false "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist"
+// @Param        lint query bool false "reject the device-type if the linter reports errors; default is configured per installation"
+// @Param        message body model.DeviceTypeCloneOptions true "changes applied to the copy"
+// @Success      200 {object}  models.DeviceType
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/clone [POST]
+func (this *DeviceTypeEndpoints) Clone(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /device-types/{id}/clone", func(writer http.ResponseWriter, request *http.Request) {
+		cloneOptions := model.DeviceTypeCloneOptions{}
+		err := json.NewDecoder(request.Body).Decode(&cloneOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		options, err := getDeviceTypeUpdateOptions(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.CloneDeviceType(request.Context(), util.GetAuthToken(request), request.PathValue("id"), cloneOptions, options)
+		if err != nil {
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -535,6 +599,7 @@ func (this *DeviceTypeEndpoints) Create(config configuration.Config, router *htt
 // @Security Bearer
 // @Param        id path string true "DeviceType Id"
 // @Param        distinct_attributes query string false "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist"
+// @Param        lint query bool false "reject the device-type if the linter reports errors; default is configured per installation"
 // @Param        message body models.DeviceType true "element"
 // @Success      200 {object}  models.DeviceType
 // @Failure      400
@@ -549,26 +614,26 @@ func (this *DeviceTypeEndpoints) Set(config configuration.Config, router *http.S
 		devicetype := models.DeviceType{}
 		err := json.NewDecoder(request.Body).Decode(&devicetype)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		if id != devicetype.Id || devicetype.Id == "" {
-			http.Error(writer, "expect body and path to contain the same device-type id", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("expect body and path to contain the same device-type id")), http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
-		options := model.DeviceTypeUpdateOptions{}
-		distinctAttr := request.URL.Query().Get("distinct_attributes")
-		if distinctAttr != "" {
-			options.DistinctAttributes = strings.Split(distinctAttr, ",")
+		options, err := getDeviceTypeUpdateOptions(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
 		}
 
-		result, err, errCode := control.SetDeviceType(token, devicetype, options)
+		result, err, errCode := control.SetDeviceType(request.Context(), token, devicetype, options)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -599,9 +664,9 @@ func (this *DeviceTypeEndpoints) Delete(config configuration.Config, router *htt
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.DeleteDeviceType(token, id)
+		err, errCode := control.DeleteDeviceType(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -612,3 +677,19 @@ func (this *DeviceTypeEndpoints) Delete(config configuration.Config, router *htt
 		return
 	})
 }
+
+func getDeviceTypeUpdateOptions(request *http.Request) (options model.DeviceTypeUpdateOptions, err error) {
+	distinctAttr := request.URL.Query().Get("distinct_attributes")
+	if distinctAttr != "" {
+		options.DistinctAttributes = strings.Split(distinctAttr, ",")
+	}
+	lintParam := request.URL.Query().Get("lint")
+	if lintParam != "" {
+		lint, err := strconv.ParseBool(lintParam)
+		if err != nil {
+			return options, model.NewFieldError(model.ErrInvalidQueryParameter, "lint", fmt.Errorf("unable to parse lint:%w", err))
+		}
+		options.Lint = &lint
+	}
+	return options, nil
+}
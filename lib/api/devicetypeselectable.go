@@ -18,6 +18,7 @@ package api
 
 import (
 	"encoding/json"
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"net/http"
@@ -54,7 +55,7 @@ func (this *DeviceTypeSelectableEndpoints) Query(config configuration.Config, ro
 		query := []model.FilterCriteria{}
 		err := json.NewDecoder(request.Body).Decode(&query)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		pathPrefix := request.URL.Query().Get("path-prefix")
@@ -70,13 +71,13 @@ func (this *DeviceTypeSelectableEndpoints) Query(config configuration.Config, ro
 		if includeModifiedStr != "" {
 			includeModified, err = strconv.ParseBool(includeModifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
-		result, err, errCode := control.GetDeviceTypeSelectables(query, pathPrefix, interactionsFilter, includeModified)
+		result, err, errCode := control.GetDeviceTypeSelectables(request.Context(), query, pathPrefix, interactionsFilter, includeModified)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -111,7 +112,7 @@ func (this *DeviceTypeSelectableEndpoints) QueryV2(config configuration.Config,
 		query := []model.FilterCriteria{}
 		err := json.NewDecoder(request.Body).Decode(&query)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		pathPrefix := request.URL.Query().Get("path-prefix")
@@ -120,7 +121,7 @@ func (this *DeviceTypeSelectableEndpoints) QueryV2(config configuration.Config,
 		if includeModifiedStr != "" {
 			includeModified, err = strconv.ParseBool(includeModifiedStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
@@ -130,14 +131,14 @@ func (this *DeviceTypeSelectableEndpoints) QueryV2(config configuration.Config,
 		if servicesMustMatchAllCriteriaStr != "" {
 			servicesMustMatchAllCriteria, err = strconv.ParseBool(servicesMustMatchAllCriteriaStr)
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 
-		result, err, errCode := control.GetDeviceTypeSelectablesV2(query, pathPrefix, includeModified, servicesMustMatchAllCriteria)
+		result, err, errCode := control.GetDeviceTypeSelectablesV2(request.Context(), query, pathPrefix, includeModified, servicesMustMatchAllCriteria)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
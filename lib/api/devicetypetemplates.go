@@ -0,0 +1,392 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &DeviceTypeTemplateEndpoints{})
+}
+
+type DeviceTypeTemplateEndpoints struct{}
+
+// List godoc
+// @Summary      list device-type-templates
+// @Description  list device-type-templates
+// @Tags         device-type-templates
+// @Produce      json
+// @Security Bearer
+// @Param        limit query integer false "default 100"
+// @Param        offset query integer false "default 0"
+// @Param        search query string false "filter by name"
+// @Success      200 {array}  model.DeviceTypeTemplate
+// @Header       200 {integer}  X-Total-Count  "count of all matching elements; used for pagination"
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /device-type-templates [GET]
+func (this *DeviceTypeTemplateEndpoints) List(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-type-templates", func(writer http.ResponseWriter, request *http.Request) {
+		options := model.DeviceTypeTemplateListOptions{
+			Limit:  100,
+			Offset: 0,
+		}
+		var err error
+		limitParam := request.URL.Query().Get("limit")
+		if limitParam != "" {
+			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
+			return
+		}
+		offsetParam := request.URL.Query().Get("offset")
+		if offsetParam != "" {
+			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
+			return
+		}
+		options.Search = request.URL.Query().Get("search")
+
+		result, total, err, errCode := control.ListDeviceTypeTemplates(request.Context(), options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Get godoc
+// @Summary      get device-type-template
+// @Description  get device-type-template
+// @Tags         device-type-templates
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceTypeTemplate Id"
+// @Success      200 {object}  model.DeviceTypeTemplate
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /device-type-templates/{id} [GET]
+func (this *DeviceTypeTemplateEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-type-templates/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetDeviceTypeTemplate(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Create godoc
+// @Summary      create device-type-template
+// @Description  create device-type-template; requires admin rights
+// @Tags         device-type-templates
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        message body model.DeviceTypeTemplate true "element"
+// @Success      200 {object}  model.DeviceTypeTemplateUpdateResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-type-templates [POST]
+func (this *DeviceTypeTemplateEndpoints) Create(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /device-type-templates", func(writer http.ResponseWriter, request *http.Request) {
+		template := model.DeviceTypeTemplate{}
+		err := json.NewDecoder(request.Body).Decode(&template)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if template.Id != "" {
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("device-type-template may not contain a preset id. please use PUT to update a device-type-template")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetDeviceTypeTemplate(request.Context(), util.GetAuthToken(request), template)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Set godoc
+// @Summary      set device-type-template
+// @Description  updates a device-type-template; requires admin rights.
+// @Description  all device-types extending the template are re-validated first; if one of them would become invalid, the update is rejected.
+// @Description  the effective device-types are re-materialized and published before the template is stored; if one of them can not be stored, the already updated device-types are restored.
+// @Description  the response contains the template and the ids of the updated device-types.
+// @Tags         device-type-templates
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceTypeTemplate Id"
+// @Param        message body model.DeviceTypeTemplate true "element"
+// @Success      200 {object}  model.DeviceTypeTemplateUpdateResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-type-templates/{id} [PUT]
+func (this *DeviceTypeTemplateEndpoints) Set(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /device-type-templates/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		id := request.PathValue("id")
+		template := model.DeviceTypeTemplate{}
+		err := json.NewDecoder(request.Body).Decode(&template)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if template.Id == "" {
+			template.Id = id
+		}
+		if template.Id != id {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetDeviceTypeTemplate(request.Context(), util.GetAuthToken(request), template)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Delete godoc
+// @Summary      delete device-type-template
+// @Description  delete device-type-template; requires admin rights; templates that are still extended by device-types may not be deleted
+// @Tags         device-type-templates
+// @Security Bearer
+// @Param        id path string true "DeviceTypeTemplate Id"
+// @Success      200
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-type-templates/{id} [DELETE]
+func (this *DeviceTypeTemplateEndpoints) Delete(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("DELETE /device-type-templates/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		err, errCode := control.DeleteDeviceTypeTemplate(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		return
+	})
+}
+
+// CreateExtension godoc
+// @Summary      create device-type from template
+// @Description  creates a new device-type extending the template; the effective device-type is returned and stored as a normal device-type
+// @Tags         device-type-templates, device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceTypeTemplate Id"
+// @Param        distinct_attributes query string false "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist"
+// @Param        lint query bool false "reject the device-type if the linter reports errors; default is configured per installation"
+// @Param        message body model.DeviceTypeExtension true "extension; device_type_id and template_id may be omitted"
+// @Success      200 {object}  models.DeviceType
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-type-templates/{id}/extensions [POST]
+func (this *DeviceTypeTemplateEndpoints) CreateExtension(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /device-type-templates/{id}/extensions", func(writer http.ResponseWriter, request *http.Request) {
+		extension := model.DeviceTypeExtension{}
+		err := json.NewDecoder(request.Body).Decode(&extension)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if extension.DeviceTypeId != "" {
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "device_type_id", errors.New("extension may not contain a preset device-type id. please use PUT /device-types/{id}/extension to update a device-type")), http.StatusBadRequest)
+			return
+		}
+		if extension.TemplateId == "" {
+			extension.TemplateId = request.PathValue("id")
+		}
+		if extension.TemplateId != request.PathValue("id") {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "template_id", errors.New("template_id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		options, err := getDeviceTypeUpdateOptions(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetDeviceTypeExtension(request.Context(), util.GetAuthToken(request), extension, options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// GetExtension godoc
+// @Summary      get device-type extension
+// @Description  returns the template reference and overrides of a device-type; 404 if the device-type does not extend a template
+// @Tags         device-type-templates, device-types
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceType Id"
+// @Success      200 {object}  model.DeviceTypeExtension
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/extension [GET]
+func (this *DeviceTypeTemplateEndpoints) GetExtension(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-types/{id}/extension", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetDeviceTypeExtension(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// SetExtension godoc
+// @Summary      set device-type extension
+// @Description  sets the template and overrides of a device-type; an existing device-type is converted into an extension.
+// @Description  the effective device-type is materialized, validated, stored and published like with PUT /device-types/{id}
+// @Tags         device-type-templates, device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "DeviceType Id"
+// @Param        distinct_attributes query string false "comma separated list of attribute keys; no other device-type with the same attribute key/value may exist"
+// @Param        lint query bool false "reject the device-type if the linter reports errors; default is configured per installation"
+// @Param        message body model.DeviceTypeExtension true "extension"
+// @Success      200 {object}  models.DeviceType
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-types/{id}/extension [PUT]
+func (this *DeviceTypeTemplateEndpoints) SetExtension(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /device-types/{id}/extension", func(writer http.ResponseWriter, request *http.Request) {
+		id := request.PathValue("id")
+		extension := model.DeviceTypeExtension{}
+		err := json.NewDecoder(request.Body).Decode(&extension)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if extension.DeviceTypeId == "" {
+			extension.DeviceTypeId = id
+		}
+		if extension.DeviceTypeId != id {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "device_type_id", errors.New("device_type_id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		options, err := getDeviceTypeUpdateOptions(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetDeviceTypeExtension(request.Context(), util.GetAuthToken(request), extension, options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// RemoveExtension godoc
+// @Summary      remove device-type extension
+// @Description  detaches the device-type from its template; requires admin rights; the device-type itself is kept unchanged
+// @Tags         device-type-templates, device-types
+// @Security Bearer
+// @Param        id path string true "DeviceType Id"
+// @Success      200
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /device-types/{id}/extension [DELETE]
+func (this *DeviceTypeTemplateEndpoints) RemoveExtension(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("DELETE /device-types/{id}/extension", func(writer http.ResponseWriter, request *http.Request) {
+		err, errCode := control.RemoveDeviceTypeExtension(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		return
+	})
+}
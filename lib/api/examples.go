@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+)
+
+func init() {
+	endpoints = append(endpoints, &ExampleEndpoints{})
+}
+
+type ExampleEndpoints struct{}
+
+// GetServiceExamples godoc
+// @Summary      get service examples
+// @Description  renders an example message for every non-void input and output content of the service in the content serialization; values are (in this order) the fixed content-variable value, the characteristic value, the first allowed characteristic value, the center of the characteristic min/max range or a default of the variable type; variable length lists contain one element and structures with the placeholder '*' use the key "key"; payloads of binary serializations are base64 encoded
+// @Tags         services
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Service Id"
+// @Success      200 {object}  model.ServiceExample
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /services/{id}/examples [GET]
+func (this *ExampleEndpoints) GetServiceExamples(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /services/{id}/examples", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetServiceExamples(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// GetDeviceTypeExamples godoc
+// @Summary      get device-type examples
+// @Description  renders example messages for all services of the device-type (ref GET /services/{id}/examples); use a device-type id with the service_group_selection modifier to get only the services of a service-group
+// @Tags         device-types
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device-Type Id"
+// @Success      200 {array}  model.ServiceExample
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/examples [GET]
+func (this *ExampleEndpoints) GetDeviceTypeExamples(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-types/{id}/examples", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetDeviceTypeExamples(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &FunctionDeprecationEndpoints{})
+}
+
+type FunctionDeprecationEndpoints struct{}
+
+// List godoc
+// @Summary      list function deprecations
+// @Description  list all deprecated functions with their replacements
+// @Tags         functions
+// @Produce      json
+// @Security Bearer
+// @Success      200 {array}  model.FunctionDeprecation
+// @Failure      401
+// @Failure      500
+// @Router       /function-deprecations [GET]
+func (this *FunctionDeprecationEndpoints) List(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /function-deprecations", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.ListFunctionDeprecations(request.Context())
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Get godoc
+// @Summary      get function deprecation
+// @Description  get the deprecation of a function
+// @Tags         functions
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Function Id"
+// @Success      200 {object}  model.FunctionDeprecation
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /functions/{id}/deprecation [GET]
+func (this *FunctionDeprecationEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /functions/{id}/deprecation", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetFunctionDeprecation(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Set godoc
+// @Summary      deprecate function
+// @Description  marks the function as deprecated; requires admin rights.
+// @Description  the replacement must be an existing, not deprecated function of the same type (controlling/measuring).
+// @Description  characteristic_mapping maps characteristics of the deprecated functions concept to characteristics of the replacement functions concept.
+// @Description  usages are not changed until POST /functions/{id}/migrate is called
+// @Tags         functions
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Function Id"
+// @Param        message body model.FunctionDeprecation true "deprecation"
+// @Success      200 {object}  model.FunctionDeprecation
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /functions/{id}/deprecation [PUT]
+func (this *FunctionDeprecationEndpoints) Set(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /functions/{id}/deprecation", func(writer http.ResponseWriter, request *http.Request) {
+		id := request.PathValue("id")
+		deprecation := model.FunctionDeprecation{}
+		err := json.NewDecoder(request.Body).Decode(&deprecation)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if deprecation.FunctionId == "" {
+			deprecation.FunctionId = id
+		}
+		if deprecation.FunctionId != id {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "function_id", errors.New("function_id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetFunctionDeprecation(request.Context(), util.GetAuthToken(request), deprecation)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Remove godoc
+// @Summary      remove function deprecation
+// @Description  removes the deprecation of a function; requires admin rights
+// @Tags         functions
+// @Security Bearer
+// @Param        id path string true "Function Id"
+// @Success      200
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /functions/{id}/deprecation [DELETE]
+func (this *FunctionDeprecationEndpoints) Remove(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("DELETE /functions/{id}/deprecation", func(writer http.ResponseWriter, request *http.Request) {
+		err, errCode := control.RemoveFunctionDeprecation(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		return
+	})
+}
+
+// Usage godoc
+// @Summary      function usage
+// @Description  lists every device-type service content-variable referencing the function
+// @Tags         functions
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Function Id"
+// @Success      200 {array}  model.FunctionUsage
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /functions/{id}/usage [GET]
+func (this *FunctionDeprecationEndpoints) Usage(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /functions/{id}/usage", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetFunctionUsage(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Migrate godoc
+// @Summary      migrate deprecated function
+// @Description  replaces every reference to the deprecated function in device-types, device-type-extensions and device-type-templates
+// @Description  with its replacement, maps characteristics by the deprecations characteristic_mapping and recomputes the criteria of affected device-groups; requires admin rights.
+// @Description  fails without changes, if a used characteristic is not part of the replacement functions concept and has no mapping.
+// @Description  rewritten_device_type_ids and rewritten_device_type_template_ids list the stored changes; an interrupted migration may be retried and only migrates the remaining usages.
+// @Tags         functions
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Id of the deprecated function"
+// @Param        dry-run query bool false "only compute the impact"
+// @Success      200 {object}  model.FunctionMigrationResult
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /functions/{id}/migrate [POST]
+func (this *FunctionDeprecationEndpoints) Migrate(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /functions/{id}/migrate", func(writer http.ResponseWriter, request *http.Request) {
+		dryRun, err := parseOptionalDryRun(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.MigrateDeprecatedFunction(request.Context(), util.GetAuthToken(request), request.PathValue("id"), dryRun)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
@@ -21,6 +21,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -69,7 +71,7 @@ func (this *FunctionsEndpoints) ListFunctions(config configuration.Config, route
 			listoptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -78,7 +80,7 @@ func (this *FunctionsEndpoints) ListFunctions(config configuration.Config, route
 			listoptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -98,9 +100,9 @@ func (this *FunctionsEndpoints) ListFunctions(config configuration.Config, route
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListFunctions(listoptions)
+		result, total, err, errCode := control.ListFunctions(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -135,7 +137,7 @@ func (this *FunctionsEndpoints) QueryFunctions(config configuration.Config, rout
 		listoptions := model.FunctionListOptions{}
 		err := json.NewDecoder(request.Body).Decode(&listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if listoptions.Limit == 0 {
@@ -147,9 +149,9 @@ func (this *FunctionsEndpoints) QueryFunctions(config configuration.Config, rout
 		if listoptions.SortBy == "" {
 			listoptions.SortBy = "name.asc"
 		}
-		result, total, err, errCode := control.ListFunctions(listoptions)
+		result, total, err, errCode := control.ListFunctions(request.Context(), listoptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -178,9 +180,9 @@ func (this *FunctionsEndpoints) QueryFunctions(config configuration.Config, rout
 // @Router       /controlling-functions [GET]
 func (this *FunctionsEndpoints) ListControllingFunctions(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /controlling-functions", func(writer http.ResponseWriter, request *http.Request) {
-		result, err, errCode := control.GetFunctionsByType(model.SES_ONTOLOGY_CONTROLLING_FUNCTION)
+		result, err, errCode := control.GetFunctionsByType(request.Context(), model.SES_ONTOLOGY_CONTROLLING_FUNCTION)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -207,9 +209,9 @@ func (this *FunctionsEndpoints) ListControllingFunctions(config configuration.Co
 // @Router       /measuring-functions [GET]
 func (this *FunctionsEndpoints) ListMeasuringFunctions(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /measuring-functions", func(writer http.ResponseWriter, request *http.Request) {
-		result, err, errCode := control.GetFunctionsByType(model.SES_ONTOLOGY_MEASURING_FUNCTION)
+		result, err, errCode := control.GetFunctionsByType(request.Context(), model.SES_ONTOLOGY_MEASURING_FUNCTION)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -238,9 +240,9 @@ func (this *FunctionsEndpoints) ListMeasuringFunctions(config configuration.Conf
 func (this *FunctionsEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /functions/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetFunction(id)
+		result, err, errCode := control.GetFunction(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -268,23 +270,23 @@ func (this *FunctionsEndpoints) Validate(config configuration.Config, router *ht
 	router.HandleFunc("PUT /functions", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		function := models.Function{}
 		err = json.NewDecoder(request.Body).Decode(&function)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		model.SetFunctionRdfType(&function)
-		err, code := control.ValidateFunction(function)
+		err, code := control.ValidateFunction(request.Context(), function)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -310,23 +312,23 @@ func (this *FunctionsEndpoints) Delete(config configuration.Config, router *http
 			var err error
 			dryRun, err = strconv.ParseBool(request.URL.Query().Get("dry-run"))
 			if err != nil {
-				http.Error(writer, err.Error(), http.StatusBadRequest)
+				util.Error(writer, err, http.StatusBadRequest)
 				return
 			}
 		}
 		if dryRun {
-			err, code := control.ValidateFunctionDelete(id)
+			err, code := control.ValidateFunctionDelete(request.Context(), id)
 			if err != nil {
-				http.Error(writer, err.Error(), code)
+				util.Error(writer, err, code)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
 			return
 		}
 		token := util.GetAuthToken(request)
-		err, code := control.DeleteFunction(token, id)
+		err, code := control.DeleteFunction(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -352,14 +354,14 @@ func (this *FunctionsEndpoints) Create(config configuration.Config, router *http
 		function := models.Function{}
 		err := json.NewDecoder(request.Body).Decode(&function)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetFunction(token, function)
+		result, err, errCode := control.SetFunction(request.Context(), token, function)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -392,20 +394,20 @@ func (this *FunctionsEndpoints) Set(config configuration.Config, router *http.Se
 		function := models.Function{}
 		err := json.NewDecoder(request.Body).Decode(&function)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if function.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetFunction(token, function)
+		result, err, errCode := control.SetFunction(request.Context(), token, function)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
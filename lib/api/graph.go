@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -51,9 +53,9 @@ type GraphEndpoints struct{}
 func (this *GraphEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /graphs/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.ReadGraph(util.GetAuthToken(request), id)
+		result, err, errCode := control.ReadGraph(request.Context(), util.GetAuthToken(request), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -100,7 +102,7 @@ func (this *GraphEndpoints) List(config configuration.Config, router *http.Serve
 			graphListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -109,7 +111,7 @@ func (this *GraphEndpoints) List(config configuration.Config, router *http.Serve
 			graphListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -153,7 +155,7 @@ func (this *GraphEndpoints) List(config configuration.Config, router *http.Serve
 			temp := []models.Attribute{}
 			err = json.Unmarshal([]byte(attributesParam), &temp)
 			if err != nil {
-				http.Error(writer, "unable to parse attributes_json:"+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "attributes_json", fmt.Errorf("unable to parse attributes_json:%w", err)), http.StatusBadRequest)
 				return
 			}
 			graphListOptions.Attributes = append(graphListOptions.Attributes, temp...)
@@ -167,16 +169,16 @@ func (this *GraphEndpoints) List(config configuration.Config, router *http.Serve
 
 		graphListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if graphListOptions.Permission == models.UnsetPermissionFlag {
 			graphListOptions.Permission = model.READ
 		}
 
-		result, total, err, errCode := control.ListGraphs(util.GetAuthToken(request), graphListOptions)
+		result, total, err, errCode := control.ListGraphs(request.Context(), util.GetAuthToken(request), graphListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
@@ -208,18 +210,18 @@ func (this *GraphEndpoints) Create(config configuration.Config, router *http.Ser
 		graph := models.Graph{}
 		err := json.NewDecoder(request.Body).Decode(&graph)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 		if graph.Id != "" {
-			http.Error(writer, "graph may not contain a preset id. please use PUT to update a graph", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("graph may not contain a preset id. please use PUT to update a graph")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetGraph(token, graph)
+		result, err, errCode := control.SetGraph(request.Context(), token, graph)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -252,25 +254,25 @@ func (this *GraphEndpoints) Set(config configuration.Config, router *http.ServeM
 		graph := models.Graph{}
 		err := json.NewDecoder(request.Body).Decode(&graph)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if id == "" {
-			http.Error(writer, "missing id in path", http.StatusBadRequest)
+			util.Error(writer, model.NewError(model.ErrMissingId, errors.New("missing id in path")), http.StatusBadRequest)
 		}
 		if graph.Id == "" {
 			graph.Id = id
 		}
 		if graph.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetGraph(token, graph)
+		result, err, errCode := control.SetGraph(request.Context(), token, graph)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -301,9 +303,9 @@ func (this *GraphEndpoints) Delete(config configuration.Config, router *http.Ser
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.DeleteGraph(token, id)
+		err, errCode := control.DeleteGraph(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
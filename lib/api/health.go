@@ -0,0 +1,70 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &HealthEndpoints{})
+}
+
+type HealthEndpoints struct{}
+
+// Live godoc
+// @Summary      liveness
+// @Description  returns 200 as long as the process is able to handle requests; does not check dependencies
+// @Tags         health
+// @Produce      json
+// @Success      200 {object}  model.HealthReport
+// @Router       /health/live [GET]
+func (this *HealthEndpoints) Live(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /health/live", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err := json.NewEncoder(writer).Encode(model.HealthReport{Status: model.HealthStatusOk})
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// Ready godoc
+// @Summary      readiness
+// @Description  checks mongodb, kafka (if configured), permissions-v2, the mirror source (if AsMgwMirror) and the startup (migrations, sync loop); the response contains a report per dependency; until the startup is finished, endpoints other than /health/* respond with 503
+// @Tags         health
+// @Produce      json
+// @Success      200 {object}  model.HealthReport
+// @Failure      503 {object}  model.HealthReport
+// @Router       /health/ready [GET]
+func (this *HealthEndpoints) Ready(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /health/ready", func(writer http.ResponseWriter, request *http.Request) {
+		result := control.GetReadiness(request.Context())
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if !result.Ok() {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+		err := json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
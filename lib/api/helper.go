@@ -18,6 +18,7 @@ package api
 
 import (
 	"encoding/json"
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
@@ -48,7 +49,7 @@ func (this *HelperEndpoints) Id(config configuration.Config, router *http.ServeM
 		uuidPart, err := models.LongId(shortId)
 		result := prefix + uuidPart
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
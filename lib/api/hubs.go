@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"slices"
 	"strconv"
@@ -56,15 +58,15 @@ func (this *HubEndpoints) Get(config configuration.Config, router *http.ServeMux
 		id := request.PathValue("id")
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
 			permission = model.READ
 		}
-		result, err, errCode := control.ReadHub(id, util.GetAuthToken(request), permission)
+		result, err, errCode := control.ReadHub(request.Context(), id, util.GetAuthToken(request), permission)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -108,7 +110,7 @@ func (this *HubEndpoints) List(config configuration.Config, router *http.ServeMu
 			hubListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -117,7 +119,7 @@ func (this *HubEndpoints) List(config configuration.Config, router *http.ServeMu
 			hubListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -142,7 +144,7 @@ func (this *HubEndpoints) List(config configuration.Config, router *http.ServeMu
 		if request.URL.Query().Has("connection-state") {
 			searchedState := request.URL.Query().Get("connection-state")
 			if !slices.Contains([]models.ConnectionState{models.ConnectionStateOnline, models.ConnectionStateOffline, models.ConnectionStateUnknown}, searchedState) {
-				http.Error(writer, "invalid connection state:"+searchedState, http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "connection-state", errors.New("invalid connection state:"+searchedState)), http.StatusBadRequest)
 				return
 			}
 			hubListOptions.ConnectionState = &searchedState
@@ -150,16 +152,16 @@ func (this *HubEndpoints) List(config configuration.Config, router *http.ServeMu
 
 		hubListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if hubListOptions.Permission == models.UnsetPermissionFlag {
 			hubListOptions.Permission = model.READ
 		}
 
-		result, err, errCode := control.ListHubs(util.GetAuthToken(request), hubListOptions)
+		result, err, errCode := control.ListHubs(request.Context(), util.GetAuthToken(request), hubListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -193,7 +195,7 @@ func (this *HubEndpoints) GetDevices(config configuration.Config, router *http.S
 		id := request.PathValue("id")
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
@@ -212,12 +214,12 @@ func (this *HubEndpoints) GetDevices(config configuration.Config, router *http.S
 		case "local_id":
 			asLocalId = true
 		default:
-			http.Error(writer, "expect 'id', 'localId' or 'local_id' as value for 'as' query-parameter if it is used", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "as", errors.New("expect 'id', 'localId' or 'local_id' as value for 'as' query-parameter if it is used")), http.StatusBadRequest)
 			return
 		}
-		result, err, errCode := control.ListHubDeviceIds(id, util.GetAuthToken(request), permission, asLocalId)
+		result, err, errCode := control.ListHubDeviceIds(request.Context(), id, util.GetAuthToken(request), permission, asLocalId)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -247,14 +249,14 @@ func (this *HubEndpoints) Head(config configuration.Config, router *http.ServeMu
 	router.HandleFunc("HEAD /hubs/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
 			permission = model.READ
 		}
 		id := request.PathValue("id")
-		_, _, errCode := control.ReadHub(id, util.GetAuthToken(request), permission)
+		_, _, errCode := control.ReadHub(request.Context(), id, util.GetAuthToken(request), permission)
 		writer.WriteHeader(errCode)
 		return
 	})
@@ -276,22 +278,22 @@ func (this *HubEndpoints) Validate(config configuration.Config, router *http.Ser
 	router.HandleFunc("PUT /hubs", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		hub := models.Hub{}
 		err = json.NewDecoder(request.Body).Decode(&hub)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateHub(util.GetAuthToken(request), hub)
+		err, code := control.ValidateHub(request.Context(), util.GetAuthToken(request), hub)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -317,19 +319,19 @@ func (this *HubEndpoints) Create(config configuration.Config, router *http.Serve
 		hub := models.Hub{}
 		err := json.NewDecoder(request.Body).Decode(&hub)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
 		if hub.Id != "" {
-			http.Error(writer, "body may not contain a preset id. please use the PUT method for updates", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("body may not contain a preset id. please use the PUT method for updates")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetHub(token, hub, model.HubUpdateOptions{})
+		result, err, errCode := control.SetHub(request.Context(), token, hub, model.HubUpdateOptions{})
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -365,11 +367,11 @@ func (this *HubEndpoints) Set(config configuration.Config, router *http.ServeMux
 		hub := models.Hub{}
 		err := json.NewDecoder(request.Body).Decode(&hub)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if hub.Id != id || hub.Id == "" {
-			http.Error(writer, "hub id in body unequal to hub id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("hub id in body unequal to hub id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
@@ -382,21 +384,21 @@ func (this *HubEndpoints) Set(config configuration.Config, router *http.ServeMux
 		token := util.GetAuthToken(request)
 		jwtToken, err := jwt.Parse(token)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		if userId != "" && !jwtToken.IsAdmin() {
-			http.Error(writer, "only admins may set user_id", http.StatusForbidden)
+			util.Error(writer, errors.New("only admins may set user_id"), http.StatusForbidden)
 			return
 		}
 		if userId != "" {
 			hub.OwnerId = userId
 		}
 
-		result, err, errCode := control.SetHub(token, hub, options)
+		result, err, errCode := control.SetHub(request.Context(), token, hub, options)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -429,20 +431,20 @@ func (this *HubEndpoints) SetName(config configuration.Config, router *http.Serv
 		name := ""
 		err := json.NewDecoder(request.Body).Decode(&name)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
-		hub, err, code := control.ReadHub(id, token, model.WRITE)
+		hub, err, code := control.ReadHub(request.Context(), id, token, model.WRITE)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		hub.Name = name
 
-		result, err, errCode := control.SetHub(token, hub, model.HubUpdateOptions{})
+		result, err, errCode := control.SetHub(request.Context(), token, hub, model.HubUpdateOptions{})
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -472,9 +474,9 @@ func (this *HubEndpoints) Delete(config configuration.Config, router *http.Serve
 	router.HandleFunc("DELETE /hubs/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
-		err, errCode := control.DeleteHub(token, id)
+		err, errCode := control.DeleteHub(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -503,20 +505,20 @@ func (this *HubEndpoints) SetConnectionState(config configuration.Config, router
 	router.HandleFunc("PUT /hubs/{id}/connection-state", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
 		if id == "" {
-			http.Error(writer, "missing id", http.StatusBadRequest)
+			util.Error(writer, model.NewError(model.ErrMissingId, errors.New("missing id")), http.StatusBadRequest)
 			return
 		}
 		connected := false
 		err := json.NewDecoder(request.Body).Decode(&connected)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.SetHubConnectionState(token, id, connected)
+		err, errCode := control.SetHubConnectionState(request.Context(), token, id, connected)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"slices"
 	"strconv"
@@ -55,15 +57,15 @@ func (this *ExtendedHubEndpoints) Get(config configuration.Config, router *http.
 		id := request.PathValue("id")
 		permission, err := model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if permission == models.UnsetPermissionFlag {
 			permission = model.READ
 		}
-		result, err, errCode := control.ReadExtendedHub(id, util.GetAuthToken(request), permission)
+		result, err, errCode := control.ReadExtendedHub(request.Context(), id, util.GetAuthToken(request), permission)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -108,7 +110,7 @@ func (this *ExtendedHubEndpoints) List(config configuration.Config, router *http
 			hubListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -117,7 +119,7 @@ func (this *ExtendedHubEndpoints) List(config configuration.Config, router *http
 			hubListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -142,7 +144,7 @@ func (this *ExtendedHubEndpoints) List(config configuration.Config, router *http
 		if request.URL.Query().Has("connection-state") {
 			searchedState := request.URL.Query().Get("connection-state")
 			if !slices.Contains([]models.ConnectionState{models.ConnectionStateOnline, models.ConnectionStateOffline, models.ConnectionStateUnknown}, searchedState) {
-				http.Error(writer, "invalid connection state:"+searchedState, http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "connection-state", errors.New("invalid connection state:"+searchedState)), http.StatusBadRequest)
 				return
 			}
 			hubListOptions.ConnectionState = &searchedState
@@ -150,16 +152,16 @@ func (this *ExtendedHubEndpoints) List(config configuration.Config, router *http
 
 		hubListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if hubListOptions.Permission == models.UnsetPermissionFlag {
 			hubListOptions.Permission = model.READ
 		}
 
-		result, total, err, errCode := control.ListExtendedHubs(util.GetAuthToken(request), hubListOptions)
+		result, total, err, errCode := control.ListExtendedHubs(request.Context(), util.GetAuthToken(request), hubListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -62,9 +62,9 @@ func (this *ImportExportEndpoints) Export(config configuration.Config, router *h
 			options.FilterIds = strings.Split(request.URL.Query().Get("filter_ids"), ",")
 		}
 
-		result, err, code := control.Export(token, options)
+		result, err, code := control.Export(request.Context(), token, options)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -95,7 +95,7 @@ func (this *ImportExportEndpoints) Import(config configuration.Config, router *h
 		var importModel model.ImportExport
 		err := json.NewDecoder(request.Body).Decode(&importModel)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
@@ -110,9 +110,9 @@ func (this *ImportExportEndpoints) Import(config configuration.Config, router *h
 			options.FilterIds = strings.Split(request.URL.Query().Get("filter_ids"), ",")
 		}
 
-		err, code := control.Import(token, importModel, options)
+		err, code := control.Import(request.Context(), token, importModel, options)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -139,7 +139,7 @@ func (this *ImportExportEndpoints) ImportFrom(config configuration.Config, route
 		options := model.ImportFromOptions{}
 		err := json.NewDecoder(request.Body).Decode(&options)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
@@ -148,9 +148,9 @@ func (this *ImportExportEndpoints) ImportFrom(config configuration.Config, route
 			includeOwnedInformation = true
 		}
 
-		err, code := control.ImportFrom(token, includeOwnedInformation, options)
+		err, code := control.ImportFrom(request.Context(), token, includeOwnedInformation, options)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -17,139 +17,198 @@
 package api
 
 import (
+	"context"
+
+	"github.com/SENERGY-Platform/device-repository/lib/jsonschema"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/rdf"
+	"github.com/SENERGY-Platform/device-repository/lib/wot"
 	"github.com/SENERGY-Platform/models/go/models"
 )
 
 type Controller interface {
-	ListDevices(token string, options model.DeviceListOptions) (result []models.Device, err error, errCode int)
-	ReadDevice(id string, token string, action model.AuthAction) (result models.Device, err error, errCode int)
-	ReadDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int)
-	ValidateDevice(token string, device models.Device) (err error, code int)
-	SetDevice(token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int)
-	CreateDevice(token string, device models.Device) (result models.Device, err error, code int)
-	DeleteDevice(token string, id string) (err error, code int)
-
-	ListExtendedDevices(token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int)
-	ReadExtendedDevice(id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int)
-	ReadExtendedDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int)
-
-	ReadHub(id string, token string, action model.AuthAction) (result models.Hub, err error, errCode int)
-	ListHubs(token string, options model.HubListOptions) (result []models.Hub, err error, errCode int)
-	ListHubDeviceIds(id string, token string, action model.AuthAction, asLocalId bool) (result []string, err error, errCode int)
-	ValidateHub(token string, hub models.Hub) (err error, code int)
-	SetHub(token string, hub models.Hub, options model.HubUpdateOptions) (result models.Hub, err error, errCode int)
-	DeleteHub(token string, id string) (err error, code int)
-
-	ListExtendedHubs(token string, options model.HubListOptions) (result []models.ExtendedHub, total int64, err error, errCode int)
-	ReadExtendedHub(id string, token string, action model.AuthAction) (result models.ExtendedHub, err error, errCode int)
-
-	ReadDeviceType(id string, token string) (result models.DeviceType, err error, errCode int)
-	ListDeviceTypes(token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, interactionsFilter []string, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int)
-	ListDeviceTypesV2(token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int)
-	ListDeviceTypesV3(token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int)
-	ListDeviceTypesUsedByUser(token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int)
-	ValidateDeviceType(deviceType models.DeviceType, options model.ValidationOptions) (err error, code int)
-	SetDeviceType(token string, dt models.DeviceType, options model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, errCode int)
-	DeleteDeviceType(token string, id string) (err error, code int)
-
-	GetDeviceTypeSelectables(query []model.FilterCriteria, pathPrefix string, interactionsFilter []string, includeModified bool) (result []model.DeviceTypeSelectable, err error, code int)
-	GetDeviceTypeSelectablesV2(query []model.FilterCriteria, pathPrefix string, includeModified bool, servicesMustMatchAllCriteria bool) (result []model.DeviceTypeSelectable, err error, code int)
-
-	ReadDeviceGroup(id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int)
-	ListDeviceGroups(token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int)
-	ValidateDeviceGroup(token string, deviceGroup models.DeviceGroup) (err error, code int)
-	ValidateDeviceGroupDelete(token string, id string) (err error, code int)
-	SetDeviceGroup(token string, dg models.DeviceGroup) (result models.DeviceGroup, err error, errCode int)
-	DeleteDeviceGroup(token string, id string) (err error, code int)
-
-	ReadProtocol(id string, token string) (result models.Protocol, err error, errCode int)
-	ListProtocols(token string, limit int64, offset int64, sort string) (result []models.Protocol, err error, errCode int)
-	ValidateProtocol(protocol models.Protocol) (err error, code int)
-	SetProtocol(token string, p models.Protocol) (result models.Protocol, err error, errCode int)
-	DeleteProtocol(token string, id string) (err error, code int)
-
-	GetService(id string) (result models.Service, err error, code int)
-
-	ListAspects(listOptions model.AspectListOptions) (result []models.Aspect, total int64, err error, errCode int)
-	GetAspects() ([]models.Aspect, error, int)
-	GetAspectsWithMeasuringFunction(ancestors bool, descendants bool) ([]models.Aspect, error, int) //returns all aspects used in combination with measuring functions (usage may optionally be by its descendants or ancestors)
-	GetAspect(id string) (models.Aspect, error, int)
-	ValidateAspect(aspect models.Aspect) (err error, code int)
-	ValidateAspectDelete(id string) (err error, code int)
-	SetAspect(token string, aspect models.Aspect) (models.Aspect, error, int)
-	DeleteAspect(token string, id string) (err error, code int)
-
-	ListAspectNodes(listOptions model.AspectListOptions) (result []models.AspectNode, total int64, err error, errCode int)
-	GetAspectNode(id string) (models.AspectNode, error, int)
-	GetAspectNodes() ([]models.AspectNode, error, int)
-	GetAspectNodesMeasuringFunctions(id string, ancestors bool, descendants bool) (result []models.Function, err error, errCode int) //returns all measuring functions used in combination with given aspect (and optional its descendants and ancestors)
-	GetAspectNodesWithMeasuringFunction(ancestors bool, descendants bool) ([]models.AspectNode, error, int)                          //returns all aspect-nodes used in combination with measuring functions (usage may optionally be by its descendants or ancestors)
-	GetAspectNodesByIdList(strings []string) ([]models.AspectNode, error, int)
-
-	ListCharacteristics(listOptions model.CharacteristicListOptions) (result []models.Characteristic, total int64, err error, errCode int)
-	GetCharacteristics(leafsOnly bool) (result []models.Characteristic, err error, errCode int)
-	GetCharacteristic(id string) (result models.Characteristic, err error, errCode int)
-	ValidateCharacteristics(characteristic models.Characteristic) (err error, code int)
-	ValidateCharacteristicDelete(id string) (err error, code int)
-	SetCharacteristic(token string, characteristic models.Characteristic) (result models.Characteristic, err error, errCode int)
-	DeleteCharacteristic(token string, id string) (err error, code int)
-
-	ListConceptsWithCharacteristics(listOptions model.ConceptListOptions) (result []models.ConceptWithCharacteristics, total int64, err error, errCode int)
-	ListConcepts(listOptions model.ConceptListOptions) (result []models.Concept, total int64, err error, errCode int)
-	GetConceptWithCharacteristics(id string) (models.ConceptWithCharacteristics, error, int)
-	GetConceptWithoutCharacteristics(id string) (models.Concept, error, int)
-	ValidateConcept(concept models.Concept) (err error, code int)
-	ValidateConceptDelete(id string) (err error, code int)
-	SetConcept(token string, concept models.Concept) (result models.Concept, err error, errCode int)
-	DeleteConcept(token string, id string) (err error, code int)
-
-	ListDeviceClasses(listOptions model.DeviceClassListOptions) (result []models.DeviceClass, total int64, err error, errCode int)
-	GetDeviceClasses() ([]models.DeviceClass, error, int)
-	GetDeviceClassesWithControllingFunctions() ([]models.DeviceClass, error, int)                      //returns all device-classes used in combination with controlling functions
-	GetDeviceClassesFunctions(id string) (result []models.Function, err error, errCode int)            //returns all functions used in combination with given device-class
-	GetDeviceClassesControllingFunctions(id string) (result []models.Function, err error, errCode int) //returns all controlling functions used in combination with given device-class
-	GetDeviceClass(id string) (result models.DeviceClass, err error, errCode int)
-	ValidateDeviceClass(deviceclass models.DeviceClass) (err error, code int)
-	ValidateDeviceClassDelete(id string) (err error, code int)
-	SetDeviceClass(token string, dc models.DeviceClass) (result models.DeviceClass, err error, errCode int)
-	DeleteDeviceClass(token string, id string) (err error, code int)
-
-	ListFunctions(options model.FunctionListOptions) (result []models.Function, total int64, err error, errCode int)
-	GetFunctionsByType(rdfType string) (result []models.Function, err error, errCode int)
-	GetFunction(id string) (result models.Function, err error, errCode int)
-	ValidateFunction(function models.Function) (err error, code int)
-	ValidateFunctionDelete(id string) (err error, code int)
-	SetFunction(token string, f models.Function) (result models.Function, err error, errCode int)
-	DeleteFunction(token string, id string) (err error, code int)
-
-	GetLocation(id string, token string) (location models.Location, err error, errCode int)
-	ValidateLocation(location models.Location) (err error, code int)
-	ListLocations(token string, options model.LocationListOptions) (result []models.Location, total int64, err error, errCode int)
-	ListExtendedLocations(token string, options model.LocationListOptions) (result []models.ExtendedLocation, total int64, err error, errCode int)
-	GetUsedInDeviceType(query model.UsedInDeviceTypeQuery) (result model.UsedInDeviceTypeResponse, err error, errCode int)
-	SetLocation(token string, location models.Location) (result models.Location, err error, errCode int)
-	DeleteLocation(token string, id string) (err error, code int)
-
-	DeleteUser(adminToken string, userId string) (err error, errCode int)
-
-	SetHubConnectionState(token string, id string, connected bool) (error, int)
-	SetDeviceConnectionState(token string, id string, connected bool) (error, int)
-
-	Export(token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int)
-	Import(token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int)
-
-	ImportFrom(token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int)
-
-	GetDefaultDeviceAttributes(token string) (attributes []models.Attribute, err error, code int)
-	SetDefaultDeviceAttributes(token string, attributes []models.Attribute) (err error, code int)
-
-	ListGraphs(token string, options model.GraphListOptions) (result []models.Graph, total int64, err error, errCode int)
-	ReadGraph(token string, id string) (result models.Graph, err error, errCode int)
-	SetGraph(token string, graph models.Graph) (result models.Graph, err error, code int)
-	DeleteGraph(token string, id string) (error, int)
-
-	GetLastUpdateTimestamps(token string, userId string) (result []model.LastUpdateTimestamp, err error, code int)
+	ListDevices(ctx context.Context, token string, options model.DeviceListOptions) (result []models.Device, err error, errCode int)
+	ReadDevice(ctx context.Context, id string, token string, action model.AuthAction) (result models.Device, err error, errCode int)
+	GetDeviceWotTd(ctx context.Context, token string, id string) (result wot.Thing, err error, code int)
+	ReadDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int)
+	ValidateDevice(ctx context.Context, token string, device models.Device) (err error, code int)
+	SetDevice(ctx context.Context, token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int)
+	CreateDevice(ctx context.Context, token string, device models.Device) (result models.Device, err error, code int)
+	DeleteDevice(ctx context.Context, token string, id string) (err error, code int)
+
+	ListExtendedDevices(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int)
+	ReadExtendedDevice(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int)
+	ReadExtendedDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int)
+	ListExtendedDevicesWithConfiguration(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []model.ExtendedDevice, total int64, err error, errCode int)
+	ReadExtendedDeviceWithConfiguration(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int)
+	ReadExtendedDeviceByLocalIdWithConfiguration(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int)
+	GetDeviceConfiguration(ctx context.Context, token string, id string) (result []model.DeviceConfigurationValue, err error, code int)
+	SetDeviceConfiguration(ctx context.Context, token string, id string, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error, code int)
+
+	ListSerializations(ctx context.Context) (result []model.SerializationInfo, err error, code int)
+
+	ReadHub(ctx context.Context, id string, token string, action model.AuthAction) (result models.Hub, err error, errCode int)
+	ListHubs(ctx context.Context, token string, options model.HubListOptions) (result []models.Hub, err error, errCode int)
+	ListHubDeviceIds(ctx context.Context, id string, token string, action model.AuthAction, asLocalId bool) (result []string, err error, errCode int)
+	ValidateHub(ctx context.Context, token string, hub models.Hub) (err error, code int)
+	SetHub(ctx context.Context, token string, hub models.Hub, options model.HubUpdateOptions) (result models.Hub, err error, errCode int)
+	DeleteHub(ctx context.Context, token string, id string) (err error, code int)
+
+	ListExtendedHubs(ctx context.Context, token string, options model.HubListOptions) (result []models.ExtendedHub, total int64, err error, errCode int)
+	ReadExtendedHub(ctx context.Context, id string, token string, action model.AuthAction) (result models.ExtendedHub, err error, errCode int)
+
+	ReadDeviceType(ctx context.Context, id string, token string) (result models.DeviceType, err error, errCode int)
+	GetDeviceTypeWotTm(ctx context.Context, token string, id string) (result wot.Thing, err error, code int)
+	ImportWotThingModel(ctx context.Context, token string, thing wot.Thing, options model.WotImportOptions) (result model.WotImportResult, err error, code int)
+	GetDeviceTypeJsonSchema(ctx context.Context, token string, id string) (result jsonschema.DeviceTypeSchema, err error, code int)
+	GetDeviceTypeExamples(ctx context.Context, token string, id string) (result []model.ServiceExample, err error, code int)
+	DiffDeviceType(ctx context.Context, token string, id string, other models.DeviceType) (result model.DeviceTypeDiff, err error, code int)
+	DiffStoredDeviceTypes(ctx context.Context, token string, id string, otherId string) (result model.DeviceTypeDiff, err error, code int)
+	CloneDeviceType(ctx context.Context, token string, id string, options model.DeviceTypeCloneOptions, updateOptions model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, code int)
+	ListDeviceTypes(ctx context.Context, token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, interactionsFilter []string, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int)
+	ListDeviceTypesV2(ctx context.Context, token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int)
+	ListDeviceTypesV3(ctx context.Context, token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int)
+	ListDeviceTypesUsedByUser(ctx context.Context, token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int)
+	ValidateDeviceType(ctx context.Context, deviceType models.DeviceType, options model.ValidationOptions) (err error, code int)
+	SetDeviceType(ctx context.Context, token string, dt models.DeviceType, options model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, errCode int)
+	DeleteDeviceType(ctx context.Context, token string, id string) (err error, code int)
+
+	GetDeviceTypeSelectables(ctx context.Context, query []model.FilterCriteria, pathPrefix string, interactionsFilter []string, includeModified bool) (result []model.DeviceTypeSelectable, err error, code int)
+	GetDeviceTypeSelectablesV2(ctx context.Context, query []model.FilterCriteria, pathPrefix string, includeModified bool, servicesMustMatchAllCriteria bool) (result []model.DeviceTypeSelectable, err error, code int)
+
+	ReadDeviceGroup(ctx context.Context, id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int)
+	ListDeviceGroups(ctx context.Context, token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int)
+	ValidateDeviceGroup(ctx context.Context, token string, deviceGroup models.DeviceGroup) (err error, code int)
+	ValidateDeviceGroupDelete(ctx context.Context, token string, id string) (err error, code int)
+	SetDeviceGroup(ctx context.Context, token string, dg models.DeviceGroup) (result models.DeviceGroup, err error, errCode int)
+	DeleteDeviceGroup(ctx context.Context, token string, id string) (err error, code int)
+
+	ReadProtocol(ctx context.Context, id string, token string) (result models.Protocol, err error, errCode int)
+	ListProtocols(ctx context.Context, token string, limit int64, offset int64, sort string) (result []models.Protocol, err error, errCode int)
+	ValidateProtocol(ctx context.Context, protocol models.Protocol) (err error, code int)
+	SetProtocol(ctx context.Context, token string, p models.Protocol) (result models.Protocol, err error, errCode int)
+	DeleteProtocol(ctx context.Context, token string, id string) (err error, code int)
+
+	GetService(ctx context.Context, id string) (result models.Service, err error, code int)
+	GetServiceJsonSchema(ctx context.Context, id string) (result jsonschema.ServiceSchema, err error, code int)
+	ValidateServicePayload(ctx context.Context, serviceId string, options model.PayloadValidationOptions, message []byte) (result model.PayloadValidationResult, err error, code int)
+	GetServiceExamples(ctx context.Context, id string) (result model.ServiceExample, err error, code int)
+
+	ListAspects(ctx context.Context, listOptions model.AspectListOptions) (result []models.Aspect, total int64, err error, errCode int)
+	GetAspects(ctx context.Context) ([]models.Aspect, error, int)
+	GetAspectsWithMeasuringFunction(ctx context.Context, ancestors bool, descendants bool) ([]models.Aspect, error, int) //returns all aspects used in combination with measuring functions (usage may optionally be by its descendants or ancestors)
+	GetAspect(ctx context.Context, id string) (models.Aspect, error, int)
+	ValidateAspect(ctx context.Context, aspect models.Aspect) (err error, code int)
+	ValidateAspectDelete(ctx context.Context, id string) (err error, code int)
+	SetAspect(ctx context.Context, token string, aspect models.Aspect) (models.Aspect, error, int)
+	DeleteAspect(ctx context.Context, token string, id string) (err error, code int)
+	MergeAspects(ctx context.Context, token string, sourceId string, targetId string, dryRun bool) (impact model.AspectChangeImpact, err error, code int)
+	MoveAspect(ctx context.Context, token string, id string, parentId string, dryRun bool) (impact model.AspectChangeImpact, err error, code int)
+
+	ListAspectNodes(ctx context.Context, listOptions model.AspectListOptions) (result []models.AspectNode, total int64, err error, errCode int)
+	GetAspectNode(ctx context.Context, id string) (models.AspectNode, error, int)
+	GetAspectNodes(ctx context.Context) ([]models.AspectNode, error, int)
+	GetAspectNodesMeasuringFunctions(ctx context.Context, id string, ancestors bool, descendants bool) (result []models.Function, err error, errCode int) //returns all measuring functions used in combination with given aspect (and optional its descendants and ancestors)
+	GetAspectNodesWithMeasuringFunction(ctx context.Context, ancestors bool, descendants bool) ([]models.AspectNode, error, int)                          //returns all aspect-nodes used in combination with measuring functions (usage may optionally be by its descendants or ancestors)
+	GetAspectNodesByIdList(ctx context.Context, strings []string) ([]models.AspectNode, error, int)
+
+	ListCharacteristics(ctx context.Context, listOptions model.CharacteristicListOptions) (result []models.Characteristic, total int64, err error, errCode int)
+	GetCharacteristics(ctx context.Context, leafsOnly bool) (result []models.Characteristic, err error, errCode int)
+	GetCharacteristic(ctx context.Context, id string) (result models.Characteristic, err error, errCode int)
+	ValidateCharacteristics(ctx context.Context, characteristic models.Characteristic) (err error, code int)
+	ValidateCharacteristicDelete(ctx context.Context, id string) (err error, code int)
+	SetCharacteristic(ctx context.Context, token string, characteristic models.Characteristic) (result models.Characteristic, err error, errCode int)
+	DeleteCharacteristic(ctx context.Context, token string, id string) (err error, code int)
+	GetCharacteristicPattern(ctx context.Context, characteristicId string) (result model.CharacteristicPattern, err error, code int)
+	SetCharacteristicPattern(ctx context.Context, token string, pattern model.CharacteristicPattern) (result model.CharacteristicPattern, err error, code int)
+	RemoveCharacteristicPattern(ctx context.Context, token string, characteristicId string) (err error, code int)
+
+	ListConceptsWithCharacteristics(ctx context.Context, listOptions model.ConceptListOptions) (result []models.ConceptWithCharacteristics, total int64, err error, errCode int)
+	ListConcepts(ctx context.Context, listOptions model.ConceptListOptions) (result []models.Concept, total int64, err error, errCode int)
+	GetConceptWithCharacteristics(ctx context.Context, id string) (models.ConceptWithCharacteristics, error, int)
+	GetConceptWithoutCharacteristics(ctx context.Context, id string) (models.Concept, error, int)
+	ValidateConcept(ctx context.Context, concept models.Concept) (err error, code int)
+	ValidateConceptDelete(ctx context.Context, id string) (err error, code int)
+	SetConcept(ctx context.Context, token string, concept models.Concept) (result models.Concept, err error, errCode int)
+	DeleteConcept(ctx context.Context, token string, id string) (err error, code int)
+
+	ListDeviceClasses(ctx context.Context, listOptions model.DeviceClassListOptions) (result []models.DeviceClass, total int64, err error, errCode int)
+	GetDeviceClasses(ctx context.Context) ([]models.DeviceClass, error, int)
+	GetDeviceClassesWithControllingFunctions(ctx context.Context) ([]models.DeviceClass, error, int)                        //returns all device-classes used in combination with controlling functions
+	GetDeviceClassesFunctions(ctx context.Context, id string) (result []models.Function, err error, errCode int)            //returns all functions used in combination with given device-class
+	GetDeviceClassesControllingFunctions(ctx context.Context, id string) (result []models.Function, err error, errCode int) //returns all controlling functions used in combination with given device-class
+	GetDeviceClass(ctx context.Context, id string) (result models.DeviceClass, err error, errCode int)
+	ValidateDeviceClass(ctx context.Context, deviceclass models.DeviceClass) (err error, code int)
+	ValidateDeviceClassDelete(ctx context.Context, id string) (err error, code int)
+	SetDeviceClass(ctx context.Context, token string, dc models.DeviceClass) (result models.DeviceClass, err error, errCode int)
+	DeleteDeviceClass(ctx context.Context, token string, id string) (err error, code int)
+
+	ListFunctions(ctx context.Context, options model.FunctionListOptions) (result []models.Function, total int64, err error, errCode int)
+	GetFunctionsByType(ctx context.Context, rdfType string) (result []models.Function, err error, errCode int)
+	GetFunction(ctx context.Context, id string) (result models.Function, err error, errCode int)
+	ValidateFunction(ctx context.Context, function models.Function) (err error, code int)
+	ValidateFunctionDelete(ctx context.Context, id string) (err error, code int)
+	SetFunction(ctx context.Context, token string, f models.Function) (result models.Function, err error, errCode int)
+	DeleteFunction(ctx context.Context, token string, id string) (err error, code int)
+
+	GetFunctionDeprecation(ctx context.Context, functionId string) (result model.FunctionDeprecation, err error, code int)
+	ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error, code int)
+	SetFunctionDeprecation(ctx context.Context, token string, deprecation model.FunctionDeprecation) (result model.FunctionDeprecation, err error, code int)
+	RemoveFunctionDeprecation(ctx context.Context, token string, functionId string) (err error, code int)
+	GetFunctionUsage(ctx context.Context, functionId string) (result []model.FunctionUsage, err error, code int)                                             //returns all device-type content-variables referencing the function
+	MigrateDeprecatedFunction(ctx context.Context, token string, functionId string, dryRun bool) (result model.FunctionMigrationResult, err error, code int) //replaces all references to the deprecated function by its replacement
+
+	GetLocation(ctx context.Context, id string, token string) (location models.Location, err error, errCode int)
+	ValidateLocation(ctx context.Context, location models.Location) (err error, code int)
+	ListLocations(ctx context.Context, token string, options model.LocationListOptions) (result []models.Location, total int64, err error, errCode int)
+	ListExtendedLocations(ctx context.Context, token string, options model.LocationListOptions) (result []models.ExtendedLocation, total int64, err error, errCode int)
+	GetUsedInDeviceType(ctx context.Context, query model.UsedInDeviceTypeQuery) (result model.UsedInDeviceTypeResponse, err error, errCode int)
+	GetImpact(ctx context.Context, token string, query model.ImpactQuery) (result model.ImpactResult, err error, errCode int)           //lists elements affected by a proposed update or delete; requires admin rights
+	QueryDevices(ctx context.Context, token string, query model.DeviceQuery) (result []model.DeviceQueryResult, err error, errCode int) //returns accessible devices matching the semantic criteria with matching service paths
+	SetLocation(ctx context.Context, token string, location models.Location) (result models.Location, err error, errCode int)
+	DeleteLocation(ctx context.Context, token string, id string) (err error, code int)
+
+	DeleteUser(ctx context.Context, adminToken string, userId string) (err error, errCode int)
+
+	SetHubConnectionState(ctx context.Context, token string, id string, connected bool) (error, int)
+	SetDeviceConnectionState(ctx context.Context, token string, id string, connected bool) (error, int)
+
+	Export(ctx context.Context, token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int)
+	Import(ctx context.Context, token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int)
+	ExportRdf(ctx context.Context, token string, options model.ImportExportOptions) (result *rdf.Graph, err error, code int)
+	ImportRdf(ctx context.Context, token string, graph *rdf.Graph, dryRun bool) (result model.ImportResult, err error, code int)
+
+	ImportFrom(ctx context.Context, token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int)
+
+	GetDefaultDeviceAttributes(ctx context.Context, token string) (attributes []models.Attribute, err error, code int)
+	SetDefaultDeviceAttributes(ctx context.Context, token string, attributes []models.Attribute) (err error, code int)
+
+	ListGraphs(ctx context.Context, token string, options model.GraphListOptions) (result []models.Graph, total int64, err error, errCode int)
+	ReadGraph(ctx context.Context, token string, id string) (result models.Graph, err error, errCode int)
+	SetGraph(ctx context.Context, token string, graph models.Graph) (result models.Graph, err error, code int)
+	DeleteGraph(ctx context.Context, token string, id string) (error, int)
+
+	GetLastUpdateTimestamps(ctx context.Context, token string, userId string) (result []model.LastUpdateTimestamp, err error, code int)
+
+	ListWebhooks(ctx context.Context, token string, options model.WebhookListOptions) (result []model.Webhook, total int64, err error, errCode int)
+	GetWebhook(ctx context.Context, token string, id string) (result model.Webhook, err error, errCode int)
+	SetWebhook(ctx context.Context, token string, webhook model.Webhook) (result model.Webhook, err error, errCode int)
+	DeleteWebhook(ctx context.Context, token string, id string) (err error, errCode int)
+	ListWebhookDeliveries(ctx context.Context, token string, id string, options model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error, errCode int)
+
+	LintDeviceType(ctx context.Context, dt models.DeviceType, options model.ValidationOptions) (result model.LintResult, err error, code int)
+	LintDeviceTypes(ctx context.Context, token string, options model.DeviceTypeLintListOptions) (result []model.LintResult, err error, code int)
+	SuggestDeviceTypeModelling(ctx context.Context, dt models.DeviceType, limit int) (result []model.ModellingSuggestion, err error, code int)
+
+	ListDeviceTypeTemplates(ctx context.Context, options model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error, errCode int)
+	GetDeviceTypeTemplate(ctx context.Context, id string) (result model.DeviceTypeTemplate, err error, errCode int)
+	SetDeviceTypeTemplate(ctx context.Context, token string, template model.DeviceTypeTemplate) (result model.DeviceTypeTemplateUpdateResult, err error, errCode int)
+	DeleteDeviceTypeTemplate(ctx context.Context, token string, id string) (err error, errCode int)
+	GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (result model.DeviceTypeExtension, err error, errCode int)
+	SetDeviceTypeExtension(ctx context.Context, token string, extension model.DeviceTypeExtension, options model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, errCode int)
+	RemoveDeviceTypeExtension(ctx context.Context, token string, deviceTypeId string) (err error, errCode int)
 
 	MirrorUpdate() error
+
+	GetReadiness(ctx context.Context) model.HealthReport
 }
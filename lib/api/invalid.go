@@ -18,6 +18,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
@@ -63,7 +64,7 @@ func (this *InvalidElements) DeviceTypes(config configuration.Config, router *ht
 			limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -73,7 +74,7 @@ func (this *InvalidElements) DeviceTypes(config configuration.Config, router *ht
 			offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -84,21 +85,21 @@ func (this *InvalidElements) DeviceTypes(config configuration.Config, router *ht
 
 		options, err := model.LoadDeviceTypeValidationOptions(request.URL.Query())
 		if err != nil {
-			http.Error(writer, "invalid validation options: "+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewError(model.ErrInvalidQueryParameter, fmt.Errorf("invalid validation options: %w", err)), http.StatusBadRequest)
 			return
 		}
 
-		list, err, code := control.ListDeviceTypesV2(util.GetAuthToken(request), limit, offset, sort, nil, false, true)
+		list, err, code := control.ListDeviceTypesV2(request.Context(), util.GetAuthToken(request), limit, offset, sort, nil, false, true)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		result := []ValidationError{}
 		for _, e := range list {
-			err, code = control.ValidateDeviceType(e, options)
+			err, code = control.ValidateDeviceType(request.Context(), e, options)
 			if err != nil {
 				if code != http.StatusBadRequest {
-					http.Error(writer, err.Error(), code)
+					util.Error(writer, err, code)
 					return
 				}
 				result = append(result, ValidationError{
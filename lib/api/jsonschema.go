@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+)
+
+func init() {
+	endpoints = append(endpoints, &JsonSchemaEndpoints{})
+}
+
+type JsonSchemaEndpoints struct{}
+
+// GetServiceSchema godoc
+// @Summary      get service json schema
+// @Description  describes every non-void input and output content of the service as JSON Schema (draft 2020-12); structures become objects (sub content-variables without omit_empty are required, '*' describes additionalProperties), lists become arrays ('*' describes items, otherwise prefixItems of fixed length), fixed values become const, characteristic min/max values become minimum/maximum and allowed values become enum; xml and plain-text contents are described by their json equivalent with contentMediaType
+// @Tags         services
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Service Id"
+// @Success      200 {object}  jsonschema.ServiceSchema
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /services/{id}/schema [GET]
+func (this *JsonSchemaEndpoints) GetServiceSchema(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /services/{id}/schema", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetServiceJsonSchema(request.Context(), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// GetDeviceTypeSchema godoc
+// @Summary      get device-type json schema
+// @Description  describes the contents of all services of the device-type as JSON Schema (ref GET /services/{id}/schema)
+// @Tags         device-types
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Device-Type Id"
+// @Success      200 {object}  jsonschema.DeviceTypeSchema
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/schema [GET]
+func (this *JsonSchemaEndpoints) GetDeviceTypeSchema(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-types/{id}/schema", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetDeviceTypeJsonSchema(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func init() {
+	endpoints = append(endpoints, &LintEndpoints{})
+}
+
+type LintEndpoints struct{}
+
+// LintDraft godoc
+// @Summary      lint device-type draft
+// @Description  checks a device-type draft against the lint rules (validation, measuring_function_without_aspect, characteristic_not_in_function_concept, duplicate_content_variable_path, service_without_function, inconsistent_naming, unused_service_group).
+// @Description  the severity of each rule (error, warning or off) is configured per installation; findings are returned with status 200 regardless of their severity
+// @Tags         device-types, lint
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        allow_none_leaf_aspect_nodes_in_device_types query string false "allow none leaf aspect nodes in device-types"
+// @Param        message body models.DeviceType true "device-type draft"
+// @Success      200 {object}  model.LintResult
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /lint/device-types [POST]
+func (this *LintEndpoints) LintDraft(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /lint/device-types", func(writer http.ResponseWriter, request *http.Request) {
+		options, err := model.LoadDeviceTypeValidationOptions(request.URL.Query())
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidQueryParameter, fmt.Errorf("invalid validation options: %w", err)), http.StatusBadRequest)
+			return
+		}
+		dt := models.DeviceType{}
+		err = json.NewDecoder(request.Body).Decode(&dt)
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.LintDeviceType(request.Context(), dt, options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// LintDeviceTypes godoc
+// @Summary      lint device-types
+// @Description  checks existing device-types against the lint rules; only device-types with findings are returned
+// @Tags         device-types, lint
+// @Produce      json
+// @Security Bearer
+// @Param        limit query integer false "default 100; limit of checked device-types not of returned results"
+// @Param        offset query integer false "default 0"
+// @Param        sort query string false "default name.asc"
+// @Param        allow_none_leaf_aspect_nodes_in_device_types query string false "allow none leaf aspect nodes in device-types"
+// @Success      200 {array}  model.LintResult
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /lint/device-types [GET]
+func (this *LintEndpoints) LintDeviceTypes(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /lint/device-types", func(writer http.ResponseWriter, request *http.Request) {
+		options := model.DeviceTypeLintListOptions{
+			Limit:  100,
+			Offset: 0,
+			SortBy: request.URL.Query().Get("sort"),
+		}
+		var err error
+		limitParam := request.URL.Query().Get("limit")
+		if limitParam != "" {
+			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
+			return
+		}
+		offsetParam := request.URL.Query().Get("offset")
+		if offsetParam != "" {
+			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
+			return
+		}
+		options.Validation, err = model.LoadDeviceTypeValidationOptions(request.URL.Query())
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidQueryParameter, fmt.Errorf("invalid validation options: %w", err)), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.LintDeviceTypes(request.Context(), util.GetAuthToken(request), options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
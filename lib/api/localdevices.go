@@ -18,6 +18,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"slices"
@@ -73,7 +75,7 @@ func (this *LocalDevicesEndpoints) List(config configuration.Config, router *htt
 			deviceListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -82,7 +84,7 @@ func (this *LocalDevicesEndpoints) List(config configuration.Config, router *htt
 			deviceListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -132,7 +134,7 @@ func (this *LocalDevicesEndpoints) List(config configuration.Config, router *htt
 		if request.URL.Query().Has("connection-state") {
 			searchedState := request.URL.Query().Get("connection-state")
 			if !slices.Contains([]models.ConnectionState{models.ConnectionStateOnline, models.ConnectionStateOffline, models.ConnectionStateUnknown}, searchedState) {
-				http.Error(writer, "invalid connection state:"+searchedState, http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "connection-state", errors.New("invalid connection state:"+searchedState)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.ConnectionState = &searchedState
@@ -140,7 +142,7 @@ func (this *LocalDevicesEndpoints) List(config configuration.Config, router *htt
 
 		deviceListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if deviceListOptions.Permission == models.UnsetPermissionFlag {
@@ -151,21 +153,21 @@ func (this *LocalDevicesEndpoints) List(config configuration.Config, router *htt
 		if deviceAttributeBlacklistParam != "" {
 			deviceAttributeBlacklistParam, err = url.QueryUnescape(deviceAttributeBlacklistParam)
 			if err != nil {
-				http.Error(writer, "unable to decode device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to decode device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			var blacklist []models.Attribute
 			err = json.Unmarshal([]byte(deviceAttributeBlacklistParam), &blacklist)
 			if err != nil {
-				http.Error(writer, "unable to parse device-attribute-blacklist: "+err.Error(), http.StatusBadRequest)
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "device-attribute-blacklist", fmt.Errorf("unable to parse device-attribute-blacklist: %w", err)), http.StatusBadRequest)
 				return
 			}
 			deviceListOptions.DeviceAttributeBlacklist = blacklist
 		}
 
-		result, err, errCode := control.ListDevices(util.GetAuthToken(request), deviceListOptions)
+		result, err, errCode := control.ListDevices(request.Context(), util.GetAuthToken(request), deviceListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 
@@ -198,16 +200,16 @@ func (this *LocalDevicesEndpoints) Get(config configuration.Config, router *http
 		id := request.PathValue("id")
 		token, err := jwt.GetParsedToken(request)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		ownerId := request.URL.Query().Get("owner_id")
 		if ownerId == "" {
 			ownerId = token.GetUserId()
 		}
-		result, err, errCode := control.ReadDeviceByLocalId(ownerId, id, token.Jwt(), model.READ)
+		result, err, errCode := control.ReadDeviceByLocalId(request.Context(), ownerId, id, token.Jwt(), model.READ)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -238,20 +240,20 @@ func (this *LocalDevicesEndpoints) Create(config configuration.Config, router *h
 		device := models.Device{}
 		err := json.NewDecoder(request.Body).Decode(&device)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
 		if device.Id != "" {
-			http.Error(writer, "body may not contain a preset id. please use the PUT method for updates", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("body may not contain a preset id. please use the PUT method for updates")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.CreateDevice(token, device)
+		result, err, errCode := control.CreateDevice(request.Context(), token, device)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -284,13 +286,13 @@ func (this *LocalDevicesEndpoints) Set(config configuration.Config, router *http
 		id := request.PathValue("id")
 		token, err := jwt.GetParsedToken(request)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		ownerId := token.GetUserId()
-		old, err, errCode := control.ReadDeviceByLocalId(ownerId, id, token.Jwt(), model.WRITE)
+		old, err, errCode := control.ReadDeviceByLocalId(request.Context(), ownerId, id, token.Jwt(), model.WRITE)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		id = old.Id
@@ -298,12 +300,12 @@ func (this *LocalDevicesEndpoints) Set(config configuration.Config, router *http
 		device := models.Device{}
 		err = json.NewDecoder(request.Body).Decode(&device)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		if device.Id != "" && device.Id != id {
-			http.Error(writer, "device contains a different id then the id from the url", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("device contains a different id then the id from the url")), http.StatusBadRequest)
 			return
 		}
 		device.Id = id
@@ -314,9 +316,9 @@ func (this *LocalDevicesEndpoints) Set(config configuration.Config, router *http
 			options.UpdateOnlySameOriginAttributes = strings.Split(temp, ",")
 		}
 
-		result, err, errCode := control.SetDevice(token.Jwt(), device, options)
+		result, err, errCode := control.SetDevice(request.Context(), token.Jwt(), device, options)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -348,23 +350,23 @@ func (this *LocalDevicesEndpoints) Delete(config configuration.Config, router *h
 		id := request.PathValue("id")
 		token, err := jwt.GetParsedToken(request)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		ownerId := request.URL.Query().Get("owner_id")
 		if ownerId == "" {
 			ownerId = token.GetUserId()
 		}
-		old, err, errCode := control.ReadDeviceByLocalId(ownerId, id, token.Jwt(), model.ADMINISTRATE)
+		old, err, errCode := control.ReadDeviceByLocalId(request.Context(), ownerId, id, token.Jwt(), model.ADMINISTRATE)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		id = old.Id
 
-		err, errCode = control.DeleteDevice(token.Jwt(), id)
+		err, errCode = control.DeleteDevice(request.Context(), token.Jwt(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
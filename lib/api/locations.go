@@ -21,6 +21,8 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -54,9 +56,9 @@ type LocationEndpoints struct{}
 func (this *LocationEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /locations/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetLocation(id, util.GetAuthToken(request))
+		result, err, errCode := control.GetLocation(request.Context(), id, util.GetAuthToken(request))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -84,22 +86,22 @@ func (this *LocationEndpoints) Validate(config configuration.Config, router *htt
 	router.HandleFunc("PUT /locations", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		location := models.Location{}
 		err = json.NewDecoder(request.Body).Decode(&location)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateLocation(location)
+		err, code := control.ValidateLocation(request.Context(), location)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -138,7 +140,7 @@ func (this *LocationEndpoints) List(config configuration.Config, router *http.Se
 			locationListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -147,7 +149,7 @@ func (this *LocationEndpoints) List(config configuration.Config, router *http.Se
 			locationListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -168,16 +170,16 @@ func (this *LocationEndpoints) List(config configuration.Config, router *http.Se
 
 		locationListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if locationListOptions.Permission == models.UnsetPermissionFlag {
 			locationListOptions.Permission = model.READ
 		}
 
-		result, total, err, errCode := control.ListLocations(util.GetAuthToken(request), locationListOptions)
+		result, total, err, errCode := control.ListLocations(request.Context(), util.GetAuthToken(request), locationListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
@@ -209,18 +211,18 @@ func (this *LocationEndpoints) Create(config configuration.Config, router *http.
 		location := models.Location{}
 		err := json.NewDecoder(request.Body).Decode(&location)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 		if location.Id != "" {
-			http.Error(writer, "location may not contain a preset id. please use PUT to update a location", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("location may not contain a preset id. please use PUT to update a location")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetLocation(token, location)
+		result, err, errCode := control.SetLocation(request.Context(), token, location)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -253,20 +255,20 @@ func (this *LocationEndpoints) Set(config configuration.Config, router *http.Ser
 		location := models.Location{}
 		err := json.NewDecoder(request.Body).Decode(&location)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 
 		if location.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetLocation(token, location)
+		result, err, errCode := control.SetLocation(request.Context(), token, location)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -297,9 +299,9 @@ func (this *LocationEndpoints) Delete(config configuration.Config, router *http.
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
 
-		err, errCode := control.DeleteLocation(token, id)
+		err, errCode := control.DeleteLocation(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
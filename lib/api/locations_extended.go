@@ -21,6 +21,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -69,7 +70,7 @@ func (this *ExtendedLocationEndpoints) ListExtended(config configuration.Config,
 			locationListOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -78,7 +79,7 @@ func (this *ExtendedLocationEndpoints) ListExtended(config configuration.Config,
 			locationListOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -99,16 +100,16 @@ func (this *ExtendedLocationEndpoints) ListExtended(config configuration.Config,
 
 		locationListOptions.Permission, err = model.GetPermissionFlagFromQuery(request.URL.Query())
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if locationListOptions.Permission == models.UnsetPermissionFlag {
 			locationListOptions.Permission = model.READ
 		}
 
-		result, total, err, errCode := control.ListExtendedLocations(util.GetAuthToken(request), locationListOptions)
+		result, total, err, errCode := control.ListExtendedLocations(request.Context(), util.GetAuthToken(request), locationListOptions)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
@@ -18,8 +18,11 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
 	"strconv"
@@ -48,9 +51,9 @@ type ProtocolEndpoints struct{}
 func (this *ProtocolEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /protocols/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.ReadProtocol(id, util.GetAuthToken(request))
+		result, err, errCode := control.ReadProtocol(request.Context(), id, util.GetAuthToken(request))
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -87,7 +90,7 @@ func (this *ProtocolEndpoints) List(config configuration.Config, router *http.Se
 			limit, err = strconv.ParseInt(limitParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse limit:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -97,7 +100,7 @@ func (this *ProtocolEndpoints) List(config configuration.Config, router *http.Se
 			offset, err = strconv.ParseInt(offsetParam, 10, 64)
 		}
 		if err != nil {
-			http.Error(writer, "unable to parse offset:"+err.Error(), http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
 			return
 		}
 
@@ -106,9 +109,9 @@ func (this *ProtocolEndpoints) List(config configuration.Config, router *http.Se
 			sort = "name.asc"
 		}
 
-		result, err, errCode := control.ListProtocols(util.GetAuthToken(request), limit, offset, sort)
+		result, err, errCode := control.ListProtocols(request.Context(), util.GetAuthToken(request), limit, offset, sort)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -136,22 +139,22 @@ func (this *ProtocolEndpoints) Validate(config configuration.Config, router *htt
 	router.HandleFunc("PUT /protocols", func(writer http.ResponseWriter, request *http.Request) {
 		dryRun, err := strconv.ParseBool(request.URL.Query().Get("dry-run"))
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		if !dryRun {
-			http.Error(writer, "only with query-parameter 'dry-run=true' allowed", http.StatusNotImplemented)
+			util.Error(writer, model.NewError(model.ErrDryRunRequired, errors.New("only with query-parameter 'dry-run=true' allowed")), http.StatusNotImplemented)
 			return
 		}
 		dt := models.Protocol{}
 		err = json.NewDecoder(request.Body).Decode(&dt)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		err, code := control.ValidateProtocol(dt)
+		err, code := control.ValidateProtocol(request.Context(), dt)
 		if err != nil {
-			http.Error(writer, err.Error(), code)
+			util.Error(writer, err, code)
 			return
 		}
 		writer.WriteHeader(http.StatusOK)
@@ -177,14 +180,14 @@ func (this *ProtocolEndpoints) Create(config configuration.Config, router *http.
 		protocol := models.Protocol{}
 		err := json.NewDecoder(request.Body).Decode(&protocol)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
-		result, err, errCode := control.SetProtocol(token, protocol)
+		result, err, errCode := control.SetProtocol(request.Context(), token, protocol)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -217,19 +220,19 @@ func (this *ProtocolEndpoints) Set(config configuration.Config, router *http.Ser
 		protocol := models.Protocol{}
 		err := json.NewDecoder(request.Body).Decode(&protocol)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
 		token := util.GetAuthToken(request)
 
 		if protocol.Id != id {
-			http.Error(writer, "id in body unequal to id in request endpoint", http.StatusBadRequest)
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
 			return
 		}
 
-		result, err, errCode := control.SetProtocol(token, protocol)
+		result, err, errCode := control.SetProtocol(request.Context(), token, protocol)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -259,9 +262,9 @@ func (this *ProtocolEndpoints) Delete(config configuration.Config, router *http.
 	router.HandleFunc("DELETE /protocols/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
-		err, errCode := control.DeleteProtocol(token, id)
+		err, errCode := control.DeleteProtocol(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
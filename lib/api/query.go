@@ -18,6 +18,7 @@ package api
 
 import (
 	"encoding/json"
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"net/http"
@@ -47,12 +48,88 @@ func (this *QueryEndpoint) Query(config configuration.Config, router *http.Serve
 		query := model.UsedInDeviceTypeQuery{}
 		err := json.NewDecoder(request.Body).Decode(&query)
 		if err != nil {
-			http.Error(writer, err.Error(), http.StatusBadRequest)
+			util.Error(writer, err, http.StatusBadRequest)
 			return
 		}
-		result, err, errCode := control.GetUsedInDeviceType(query)
+		result, err, errCode := control.GetUsedInDeviceType(request.Context(), query)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Impact godoc
+// @Summary      query impact
+// @Description  lists device-types, services, devices, device-groups, hubs, locations and graphs affected by a proposed update or delete
+// @Description  of a concept, characteristic, aspect (including its sub-aspects), function, device-class or device-type, with counts per owner; requires admin rights.
+// @Description  for device-types, an optional proposed device-type limits the affected services to changed or removed services
+// @Tags         device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        message body model.ImpactQuery true "resource and action"
+// @Success      200 {object}  model.ImpactResult
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /query/impact [POST]
+func (this *QueryEndpoint) Impact(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /query/impact", func(writer http.ResponseWriter, request *http.Request) {
+		query := model.ImpactQuery{}
+		err := json.NewDecoder(request.Body).Decode(&query)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.GetImpact(request.Context(), util.GetAuthToken(request), query)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Devices godoc
+// @Summary      query devices
+// @Description  returns the devices the token can access, whose device-types match all criteria (aspects match their descendants), together with the matching service paths.
+// @Description  results may be filtered by location (including devices of its device-groups), hub, device-group and attributes
+// @Tags         devices
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        message body model.DeviceQuery true "criteria and filters"
+// @Success      200 {array}  model.DeviceQueryResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /query/devices [POST]
+func (this *QueryEndpoint) Devices(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /query/devices", func(writer http.ResponseWriter, request *http.Request) {
+		query := model.DeviceQuery{}
+		err := json.NewDecoder(request.Body).Decode(&query)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.QueryDevices(request.Context(), util.GetAuthToken(request), query)
+		if err != nil {
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
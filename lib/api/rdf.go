@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/rdf"
+)
+
+func init() {
+	endpoints = append(endpoints, &RdfEndpoints{})
+}
+
+type RdfEndpoints struct{}
+
+const (
+	rdfFormatTurtle = "turtle"
+	rdfFormatJsonLd = "jsonld"
+)
+
+// ExportRdf godoc
+// @Summary      export rdf
+// @Description  exports device-classes, functions, aspects (with sub-aspects), concepts, characteristics, protocols and device-types (with services, contents and content-variables) as rdf using the SENERGY ontology (https://senergy.infai.org/ontology/)
+// @Tags         import/export
+// @Security Bearer
+// @Produce      text/turtle
+// @Produce      application/ld+json
+// @Param        format query string false "'turtle' or 'jsonld'; defaults to the Accept header or 'turtle'"
+// @Param        filter_resource_types query string false "comma separated list of resource-types; export only given resource-types (device-types,aspects,functions...)"
+// @Param        filter_ids query string false "comma separated list of ids; export only given ids"
+// @Success      200 {string}  string  "turtle or json-ld document"
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /export/rdf [GET]
+func (this *RdfEndpoints) ExportRdf(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /export/rdf", func(writer http.ResponseWriter, request *http.Request) {
+		format, err := getRdfFormat(request)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		options := model.ImportExportOptions{}
+		if request.URL.Query().Get("filter_resource_types") != "" {
+			options.FilterResourceTypes = strings.Split(request.URL.Query().Get("filter_resource_types"), ",")
+		}
+		if request.URL.Query().Get("filter_ids") != "" {
+			options.FilterIds = strings.Split(request.URL.Query().Get("filter_ids"), ",")
+		}
+		graph, err, code := control.ExportRdf(request.Context(), util.GetAuthToken(request), options)
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		if format == rdfFormatJsonLd {
+			writer.Header().Set("Content-Type", rdf.JsonLdContentType+"; charset=utf-8")
+			err = rdf.WriteJsonLd(writer, graph)
+		} else {
+			writer.Header().Set("Content-Type", rdf.TurtleContentType+"; charset=utf-8")
+			err = rdf.WriteTurtle(writer, graph)
+		}
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// getRdfFormat reads the 'format' query parameter, falling back to the Accept header
+func getRdfFormat(request *http.Request) (string, error) {
+	switch format := request.URL.Query().Get("format"); format {
+	case rdfFormatTurtle, rdfFormatJsonLd:
+		return format, nil
+	case "":
+		if strings.Contains(request.Header.Get("Accept"), rdf.JsonLdContentType) {
+			return rdfFormatJsonLd, nil
+		}
+		return rdfFormatTurtle, nil
+	default:
+		return "", model.NewFieldError(model.ErrInvalidQueryParameter, "format", errors.New("unknown rdf format, expected 'turtle' or 'jsonld'"))
+	}
+}
+
+// ImportRdf godoc
+// @Summary      import rdf
+// @Description  imports functions, aspects (with sub-aspects), concepts, characteristics and device-classes from turtle or json-ld using the SENERGY ontology (https://senergy.infai.org/ontology/), as produced by GET /export/rdf; resources are validated like their individual PUT endpoints; existing concept conversions are kept; only admins may import
+// @Tags         import/export
+// @Security Bearer
+// @Accept       text/turtle
+// @Accept       application/ld+json
+// @Produce      json
+// @Param        format query string false "'turtle' or 'jsonld'; defaults to the Content-Type header"
+// @Param        dry-run query bool false "only validate and list the resulting changes"
+// @Param        message body string true "turtle or json-ld document"
+// @Success      200 {object}  model.ImportResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /import/rdf [PUT]
+func (this *RdfEndpoints) ImportRdf(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /import/rdf", func(writer http.ResponseWriter, request *http.Request) {
+		format := request.URL.Query().Get("format")
+		if format == "" && strings.Contains(request.Header.Get("Content-Type"), "json") {
+			format = rdfFormatJsonLd
+		}
+		var graph *rdf.Graph
+		var err error
+		switch format {
+		case "", rdfFormatTurtle:
+			graph, err = rdf.ReadTurtle(request.Body)
+		case rdfFormatJsonLd:
+			graph, err = rdf.ReadJsonLd(request.Body)
+		default:
+			err = model.NewFieldError(model.ErrInvalidQueryParameter, "format", errors.New("unknown rdf format, expected 'turtle' or 'jsonld'"))
+		}
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		dryRun, _ := strconv.ParseBool(request.URL.Query().Get("dry-run"))
+		result, err, code := control.ImportRdf(request.Context(), util.GetAuthToken(request), graph, dryRun)
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
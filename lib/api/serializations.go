@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+)
+
+func init() {
+	endpoints = append(endpoints, &SerializationEndpoints{})
+}
+
+type SerializationEndpoints struct{}
+
+// List godoc
+// @Summary      list serializations
+// @Description  lists the content serializations, that may be used in device-types, with the serialization_options of their content-variables.
+// @Description  binary serializations address the fields of fixed-layout frames with the options offset, length, byte_order and signed (e.g. ["offset=2", "length=2", "byte_order=little"]);
+// @Description  their example payloads are base64 encoded
+// @Tags         device-types
+// @Produce      json
+// @Security Bearer
+// @Success      200 {array}  model.SerializationInfo
+// @Failure      500
+// @Router       /serializations [GET]
+func (this *SerializationEndpoints) List(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /serializations", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.ListSerializations(request.Context())
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
@@ -18,7 +18,10 @@ package api
 
 import (
 	"encoding/json"
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"io"
 	"net/http"
 )
 
@@ -45,9 +48,9 @@ type ServiceEndpoints struct{}
 func (this *ServiceEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
 	router.HandleFunc("GET /services/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
-		result, err, errCode := control.GetService(id)
+		result, err, errCode := control.GetService(request.Context(), id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -58,3 +61,49 @@ func (this *ServiceEndpoints) Get(config configuration.Config, router *http.Serv
 		return
 	})
 }
+
+// ValidatePayload godoc
+// @Summary      validate payload
+// @Description  decodes a raw message with the serialization (ref GET /serializations) of a service content and reports mismatches with the content-variables: type mismatches, missing fields, unexpected fields, values out of the characteristic min/max range and values not in the characteristic allowed values; the content is selected by content_id or, if omitted, is the only non-void content in the given direction; xml messages must use the content-variable name as root element, attributes are matched to content-variables with the prefix '-'
+// @Tags         services
+// @Accept       json
+// @Accept       xml
+// @Accept       plain
+// @Accept       octet-stream
+// @Accept       application/cbor
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Service Id"
+// @Param        direction query string false "'input' or 'output'; defaults to 'output'"
+// @Param        content_id query string false "content id; required if the service has multiple non-void contents in the direction"
+// @Param        message body string true "raw message"
+// @Success      200 {object}  model.PayloadValidationResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /services/{id}/validate-payload [POST]
+func (this *ServiceEndpoints) ValidatePayload(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /services/{id}/validate-payload", func(writer http.ResponseWriter, request *http.Request) {
+		message, err := io.ReadAll(request.Body)
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		options := model.PayloadValidationOptions{
+			Direction: request.URL.Query().Get("direction"),
+			ContentId: request.URL.Query().Get("content_id"),
+		}
+		result, err, errCode := control.ValidateServicePayload(request.Context(), request.PathValue("id"), options, message)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func init() {
+	endpoints = append(endpoints, &ModellingSuggestionEndpoints{})
+}
+
+type ModellingSuggestionEndpoints struct{}
+
+// SuggestDeviceTypeModelling godoc
+// @Summary      suggest device-type modelling
+// @Description  suggests function, aspect and characteristic for every content-variable of the device-type draft without function.
+// @Description  suggestions are derived from similar content-variables (name, path, type and unit) of existing device-types; content-variables without similar annotated content-variables are omitted
+// @Tags         device-types
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        limit query integer false "default 3; max suggestions per function, aspect and characteristic"
+// @Param        message body models.DeviceType true "device-type draft"
+// @Success      200 {array}  model.ModellingSuggestion
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /modelling-suggestions/device-types [POST]
+func (this *ModellingSuggestionEndpoints) SuggestDeviceTypeModelling(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /modelling-suggestions/device-types", func(writer http.ResponseWriter, request *http.Request) {
+		limit := 0
+		var err error
+		if limitParam := request.URL.Query().Get("limit"); limitParam != "" {
+			limit, err = strconv.Atoi(limitParam)
+			if err != nil {
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", err), http.StatusBadRequest)
+				return
+			}
+			if limit < 0 {
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", errors.New("limit must not be negative")), http.StatusBadRequest)
+				return
+			}
+		}
+		dt := models.DeviceType{}
+		err = json.NewDecoder(request.Body).Decode(&dt)
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SuggestDeviceTypeModelling(request.Context(), dt, limit)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
@@ -17,7 +17,9 @@
 package api
 
 import (
+	"errors"
 	_ "github.com/SENERGY-Platform/device-repository/docs"
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/swaggo/swag"
@@ -42,7 +44,7 @@ func (this *Swagger) Swagger(config configuration.Config, router *http.ServeMux,
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
 		doc, err := swag.ReadDoc("devicerepository")
 		if err != nil {
-			http.Error(writer, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			util.Error(writer, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
 			return
 		}
 		//remove empty host to enable developer-swagger-api service to replace it; can not use cleaner delete on json object, because developer-swagger-api is sensible to formatting; better alternative is refactoring of developer-swagger-api/apis/db/db.py
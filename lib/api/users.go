@@ -43,9 +43,9 @@ func (this *AspectEndpoints) UserDelete(config configuration.Config, router *htt
 	router.HandleFunc("DELETE /users/{id}", func(writer http.ResponseWriter, request *http.Request) {
 		id := request.PathValue("id")
 		token := util.GetAuthToken(request)
-		err, errCode := control.DeleteUser(token, id)
+		err, errCode := control.DeleteUser(request.Context(), token, id)
 		if err != nil {
-			http.Error(writer, err.Error(), errCode)
+			util.Error(writer, err, errCode)
 			return
 		}
 		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+// NewFieldsMiddleware reduces json responses of GET requests to the fields selected by the 'fields' query parameter (see model.ParseFields)
+// endpoints may additionally read the parameter to skip loading unselected data
+func NewFieldsMiddleware(handler http.Handler) *FieldsMiddleware {
+	return &FieldsMiddleware{handler: handler}
+}
+
+type FieldsMiddleware struct {
+	handler http.Handler
+}
+
+func (this *FieldsMiddleware) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet || !req.URL.Query().Has("fields") {
+		this.handler.ServeHTTP(res, req)
+		return
+	}
+	fields, err := model.ParseFields(req.URL.Query().Get("fields"))
+	if err != nil {
+		Error(res, err, http.StatusBadRequest)
+		return
+	}
+	if fields == nil {
+		this.handler.ServeHTTP(res, req)
+		return
+	}
+	buffer := &fieldsResponseWriter{header: res.Header(), status: http.StatusOK}
+	this.handler.ServeHTTP(buffer, req)
+	body := buffer.body.Bytes()
+	if buffer.status >= 200 && buffer.status < 300 && isJsonContentType(res.Header().Get("Content-Type")) {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var value interface{}
+		if err = decoder.Decode(&value); err == nil {
+			projected, err := json.Marshal(model.ProjectFields(value, fields))
+			if err == nil {
+				body = append(projected, '\n')
+				res.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			}
+		}
+	}
+	res.WriteHeader(buffer.status)
+	_, _ = res.Write(body)
+}
+
+func isJsonContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+type fieldsResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (this *fieldsResponseWriter) Header() http.Header {
+	return this.header
+}
+
+func (this *fieldsResponseWriter) WriteHeader(status int) {
+	this.status = status
+}
+
+func (this *fieldsResponseWriter) Write(b []byte) (int, error) {
+	return this.body.Write(b)
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFieldsMiddleware(t *testing.T) {
+	handler := NewFieldsMiddleware(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		writer.Header().Set("X-Total-Count", "2")
+		_, _ = writer.Write([]byte(`[{"id":"d1","name":"foo","attributes":[{"key":"k","value":"v"}]},{"id":"d2","name":"bar","attributes":[]}]`))
+	}))
+
+	t.Run("projection", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/devices?fields=id,attributes.key", nil))
+		if recorder.Code != http.StatusOK || recorder.Header().Get("X-Total-Count") != "2" {
+			t.Error(recorder.Code, recorder.Header())
+		}
+		expected := `[{"attributes":[{"key":"k"}],"id":"d1"},{"attributes":[],"id":"d2"}]`
+		if strings.TrimSpace(recorder.Body.String()) != expected {
+			t.Error(recorder.Body.String())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/devices?fields=id,.name", nil))
+		if recorder.Code != http.StatusBadRequest {
+			t.Error(recorder.Code, recorder.Body.String())
+		}
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/devices", nil))
+		if !strings.Contains(recorder.Body.String(), `"name":"foo"`) {
+			t.Error(recorder.Body.String())
+		}
+	})
+}
@@ -44,6 +44,10 @@ type MirrorMiddleware struct {
 }
 
 func (this *MirrorMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/health/") {
+		this.handler.ServeHTTP(w, r)
+		return
+	}
 	if !strings.Contains(r.URL.String(), "query") && (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodDelete) {
 		//forward request to source
 		this.config.GetLogger().Info("forward update request to mirror source", "method", r.Method, "url", r.URL.String())
@@ -53,18 +57,18 @@ func (this *MirrorMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			Error(w, err, http.StatusInternalServerError)
 			return
 		}
 		req, err := http.NewRequest(r.Method, this.config.MgwMirrorSourceUrl+endpoint, strings.NewReader(string(body)))
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			Error(w, err, http.StatusInternalServerError)
 			return
 		}
 		req.Header = r.Header
 		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			Error(w, err, http.StatusInternalServerError)
 			return
 		}
 
@@ -91,7 +95,7 @@ func (this *MirrorMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	} else {
 		token, err := this.GetToken()
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			Error(w, err, http.StatusInternalServerError)
 			return
 		}
 		r.Header.Set("Authorization", token)
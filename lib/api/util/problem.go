@@ -0,0 +1,35 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+// Error replies to the request with err as RFC 7807 problem+json (see model.Problem)
+// err may be annotated with a code and field path by model.NewError() or model.NewFieldError()
+func Error(writer http.ResponseWriter, err error, status int) {
+	problem := model.NewProblem(err, status)
+	writer.Header().Del("Content-Length")
+	writer.Header().Set("Content-Type", model.ProblemContentType)
+	writer.Header().Set("X-Content-Type-Options", "nosniff")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(problem)
+}
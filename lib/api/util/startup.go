@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+type StartupState interface {
+	StartupFinished() bool
+}
+
+// NewStartupMiddleware responds with 503 to all requests except the heart beat and /health/* until state.StartupFinished()
+func NewStartupMiddleware(handler http.Handler, state StartupState) *StartupMiddleware {
+	return &StartupMiddleware{handler: handler, state: state}
+}
+
+type StartupMiddleware struct {
+	handler http.Handler
+	state   StartupState
+}
+
+func (this *StartupMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/" || strings.HasPrefix(r.URL.Path, "/health/") || this.state.StartupFinished() {
+		this.handler.ServeHTTP(w, r)
+		return
+	}
+	w.Header().Set("Retry-After", "10")
+	Error(w, errors.New("device-repository is starting"), http.StatusServiceUnavailable)
+}
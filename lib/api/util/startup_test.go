@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+type testStartupState struct {
+	finished atomic.Bool
+}
+
+func (this *testStartupState) StartupFinished() bool {
+	return this.finished.Load()
+}
+
+func TestStartupMiddleware(t *testing.T) {
+	state := &testStartupState{}
+	handler := NewStartupMiddleware(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}), state)
+
+	request := func(path string) int {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder.Code
+	}
+
+	for path, expected := range map[string]int{"/": http.StatusOK, "/health/ready": http.StatusOK, "/health/live": http.StatusOK, "/device-types": http.StatusServiceUnavailable} {
+		if code := request(path); code != expected {
+			t.Error("during startup", path, code)
+		}
+	}
+	state.finished.Store(true)
+	if code := request("/device-types"); code != http.StatusOK {
+		t.Error("after startup", code)
+	}
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func init() {
+	endpoints = append(endpoints, &WebhookEndpoints{})
+}
+
+type WebhookEndpoints struct{}
+
+// List godoc
+// @Summary      list webhooks
+// @Description  lists the webhooks of the requesting user; admins see all webhooks; secrets are never returned
+// @Tags         webhooks
+// @Produce      json
+// @Security Bearer
+// @Param        limit query integer false "default 100"
+// @Param        offset query integer false "default 0"
+// @Param        resource_type query string false "filter; e.g. devices, device-types, device-groups, hubs, protocols, concepts, characteristics, aspects, functions, device-classes, locations"
+// @Success      200 {array}  model.Webhook
+// @Header       200 {integer}  X-Total-Count  "count of all matching elements; used for pagination"
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /webhooks [GET]
+func (this *WebhookEndpoints) List(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /webhooks", func(writer http.ResponseWriter, request *http.Request) {
+		options := model.WebhookListOptions{
+			Limit:  100,
+			Offset: 0,
+		}
+		var err error
+		limitParam := request.URL.Query().Get("limit")
+		if limitParam != "" {
+			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
+			return
+		}
+		offsetParam := request.URL.Query().Get("offset")
+		if offsetParam != "" {
+			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
+			return
+		}
+		options.ResourceType = request.URL.Query().Get("resource_type")
+
+		result, total, err, errCode := control.ListWebhooks(request.Context(), util.GetAuthToken(request), options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Get godoc
+// @Summary      get webhook
+// @Description  get webhook; the secret is never returned
+// @Tags         webhooks
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Webhook Id"
+// @Success      200 {object}  model.Webhook
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /webhooks/{id} [GET]
+func (this *WebhookEndpoints) Get(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /webhooks/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, errCode := control.GetWebhook(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Create godoc
+// @Summary      create webhook
+// @Description  registers a webhook that receives a signed POST request for every change of a resource of the given resource_types the requesting user may read.
+// @Description  the body is a model.WebhookNotification. if a secret is set, the X-Webhook-Signature header contains "sha256=" followed by the hex encoded HMAC-SHA256 of the X-Webhook-Timestamp header value, a "." and the body.
+// @Description  failed deliveries are retried with exponential backoff; the webhook is disabled after repeated failed deliveries.
+// @Description  urls resolving to addresses, which are not globally reachable (e.g. loopback, link-local, private, shared or multicast), are rejected, unless the address is part of the configured webhook_allowed_networks.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        message body model.Webhook true "webhook"
+// @Success      200 {object}  model.Webhook
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /webhooks [POST]
+func (this *WebhookEndpoints) Create(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /webhooks", func(writer http.ResponseWriter, request *http.Request) {
+		webhook := model.Webhook{}
+		err := json.NewDecoder(request.Body).Decode(&webhook)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if webhook.Id != "" {
+			util.Error(writer, model.NewFieldError(model.ErrPresetId, "id", errors.New("webhook may not contain a preset id. please use PUT to update a webhook")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetWebhook(request.Context(), util.GetAuthToken(request), webhook)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Set godoc
+// @Summary      set webhook
+// @Description  updates a webhook; an empty secret keeps the stored secret; setting disabled=false re-enables the webhook and resets its failure count
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Webhook Id"
+// @Param        message body model.Webhook true "webhook"
+// @Success      200 {object}  model.Webhook
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /webhooks/{id} [PUT]
+func (this *WebhookEndpoints) Set(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("PUT /webhooks/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		id := request.PathValue("id")
+		webhook := model.Webhook{}
+		err := json.NewDecoder(request.Body).Decode(&webhook)
+		if err != nil {
+			util.Error(writer, err, http.StatusBadRequest)
+			return
+		}
+		if webhook.Id == "" {
+			webhook.Id = id
+		}
+		if webhook.Id != id {
+			util.Error(writer, model.NewFieldError(model.ErrIdMismatch, "id", errors.New("id in body unequal to id in request endpoint")), http.StatusBadRequest)
+			return
+		}
+		result, err, errCode := control.SetWebhook(request.Context(), util.GetAuthToken(request), webhook)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
+
+// Delete godoc
+// @Summary      delete webhook
+// @Description  deletes the webhook and its delivery logs
+// @Tags         webhooks
+// @Security Bearer
+// @Param        id path string true "Webhook Id"
+// @Success      200
+// @Failure      400
+// @Failure      401
+// @Failure      500
+// @Router       /webhooks/{id} [DELETE]
+func (this *WebhookEndpoints) Delete(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("DELETE /webhooks/{id}", func(writer http.ResponseWriter, request *http.Request) {
+		err, errCode := control.DeleteWebhook(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		return
+	})
+}
+
+// ListDeliveries godoc
+// @Summary      list webhook deliveries
+// @Description  lists the delivery logs of the webhook, newest first; logs are removed after the configured retention
+// @Tags         webhooks
+// @Produce      json
+// @Security Bearer
+// @Param        id path string true "Webhook Id"
+// @Param        limit query integer false "default 100"
+// @Param        offset query integer false "default 0"
+// @Success      200 {array}  model.WebhookDelivery
+// @Header       200 {integer}  X-Total-Count  "count of all matching elements; used for pagination"
+// @Failure      400
+// @Failure      401
+// @Failure      404
+// @Failure      500
+// @Router       /webhooks/{id}/deliveries [GET]
+func (this *WebhookEndpoints) ListDeliveries(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /webhooks/{id}/deliveries", func(writer http.ResponseWriter, request *http.Request) {
+		options := model.WebhookDeliveryListOptions{
+			Limit:  100,
+			Offset: 0,
+		}
+		var err error
+		limitParam := request.URL.Query().Get("limit")
+		if limitParam != "" {
+			options.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "limit", fmt.Errorf("unable to parse limit:%w", err)), http.StatusBadRequest)
+			return
+		}
+		offsetParam := request.URL.Query().Get("offset")
+		if offsetParam != "" {
+			options.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+		}
+		if err != nil {
+			util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "offset", fmt.Errorf("unable to parse offset:%w", err)), http.StatusBadRequest)
+			return
+		}
+
+		result, total, err, errCode := control.ListWebhookDeliveries(request.Context(), util.GetAuthToken(request), request.PathValue("id"), options)
+		if err != nil {
+			util.Error(writer, err, errCode)
+			return
+		}
+		writer.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+		return
+	})
+}
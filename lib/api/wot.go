@@ -0,0 +1,139 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/api/util"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/wot"
+)
+
+func init() {
+	endpoints = append(endpoints, &WotEndpoints{})
+}
+
+type WotEndpoints struct{}
+
+// GetDeviceTypeThingModel godoc
+// @Summary      get device-type as wot thing model
+// @Description  renders the device-type as W3C Web of Things Thing Model; services with inputs or controlling functions become actions, other services become properties (request), events (event) or observable properties (event+request); content-variables become data schemas; form hrefs use the protocol handler as scheme and {{DEVICE_LOCAL_ID}} as placeholder
+// @Tags         device-types
+// @Produce      application/tm+json
+// @Security Bearer
+// @Param        id path string true "Device-Type Id"
+// @Success      200 {object}  wot.Thing
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /device-types/{id}/wot-tm [GET]
+func (this *WotEndpoints) GetDeviceTypeThingModel(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /device-types/{id}/wot-tm", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetDeviceTypeWotTm(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", wot.TmContentType+"; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// GetDeviceThingDescription godoc
+// @Summary      get device as wot thing description
+// @Description  renders the device as W3C Web of Things Thing Description of its device-type (ref GET /device-types/{id}/wot-tm); requires read permission on the device
+// @Tags         devices
+// @Produce      application/td+json
+// @Security Bearer
+// @Param        id path string true "Device Id"
+// @Success      200 {object}  wot.Thing
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /devices/{id}/wot-td [GET]
+func (this *WotEndpoints) GetDeviceThingDescription(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("GET /devices/{id}/wot-td", func(writer http.ResponseWriter, request *http.Request) {
+		result, err, code := control.GetDeviceWotTd(request.Context(), util.GetAuthToken(request), request.PathValue("id"))
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", wot.TdContentType+"; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
+
+// ImportThingModel godoc
+// @Summary      import device-type from wot thing model
+// @Description  creates a device-type draft from a W3C Web of Things Thing Model; properties become request services (observable: event+request) and writable properties additional 'set_<name>' services; actions become request services; events become event services; data schemas become content-variables; object schemas with one property per protocol segment name are split into one content per segment; SENERGY terms like 'ses:function' are kept; content-variables without function get functions and aspects suggested by name matching; without save=true the draft is only validated and returned for review
+// @Tags         device-types
+// @Accept       application/tm+json
+// @Produce      json
+// @Security Bearer
+// @Param        protocol_id query string true "protocol used by all services"
+// @Param        save query bool false "save the device-type like POST /device-types"
+// @Param        message body wot.Thing true "Thing Model"
+// @Success      200 {object}  model.WotImportResult
+// @Failure      400
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /import/wot-tm [POST]
+func (this *WotEndpoints) ImportThingModel(config configuration.Config, router *http.ServeMux, control Controller) {
+	router.HandleFunc("POST /import/wot-tm", func(writer http.ResponseWriter, request *http.Request) {
+		options := model.WotImportOptions{ProtocolId: request.URL.Query().Get("protocol_id")}
+		if request.URL.Query().Has("save") {
+			var err error
+			options.Save, err = strconv.ParseBool(request.URL.Query().Get("save"))
+			if err != nil {
+				util.Error(writer, model.NewFieldError(model.ErrInvalidQueryParameter, "save", errors.New("expect boolean")), http.StatusBadRequest)
+				return
+			}
+		}
+		thing := wot.Thing{}
+		err := json.NewDecoder(request.Body).Decode(&thing)
+		if err != nil {
+			util.Error(writer, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest)
+			return
+		}
+		result, err, code := control.ImportWotThingModel(request.Context(), util.GetAuthToken(request), thing, options)
+		if err != nil {
+			util.Error(writer, err, code)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = json.NewEncoder(writer).Encode(result)
+		if err != nil {
+			config.GetLogger().Info("unable to encode response", "error", err.Error())
+		}
+	})
+}
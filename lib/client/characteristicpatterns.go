@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+type CharacteristicPattern = model.CharacteristicPattern
+type ValueConstraints = model.ValueConstraints
+
+func (c *Client) GetCharacteristicPattern(ctx context.Context, characteristicId string) (result model.CharacteristicPattern, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/characteristics/"+url.PathEscape(characteristicId)+"/pattern", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.CharacteristicPattern](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetCharacteristicPattern(ctx context.Context, token string, pattern model.CharacteristicPattern) (result model.CharacteristicPattern, err error, code int) {
+	b, err := json.Marshal(pattern)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/characteristics/"+url.PathEscape(pattern.CharacteristicId)+"/pattern", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.CharacteristicPattern](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) RemoveCharacteristicPattern(ctx context.Context, token string, characteristicId string) (err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/characteristics/"+url.PathEscape(characteristicId)+"/pattern", nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
+}
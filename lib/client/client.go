@@ -18,14 +18,17 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/SENERGY-Platform/device-repository/lib/api"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
 	permissions "github.com/SENERGY-Platform/permissions-v2/pkg/client"
 )
 
@@ -63,14 +66,14 @@ func do[T any](req *http.Request, optionalAuthTokenForApiGatewayRequest func() (
 		}
 		req.Header.Set("Authorization", token)
 	}
+	setFieldsFromContext(req)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode > 299 {
-		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
-		return result, fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp)), resp.StatusCode
+		return result, newResponseError(resp), resp.StatusCode
 	}
 	err = json.NewDecoder(resp.Body).Decode(&result)
 	if err != nil {
@@ -94,8 +97,7 @@ func doVoid(req *http.Request, optionalAuthTokenForApiGatewayRequest func() (tok
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode > 299 {
-		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
-		return fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp)), resp.StatusCode
+		return newResponseError(resp), resp.StatusCode
 	}
 	return
 }
@@ -108,14 +110,14 @@ func doWithTotalInResult[T any](req *http.Request, optionalAuthTokenForApiGatewa
 		}
 		req.Header.Set("Authorization", token)
 	}
+	setFieldsFromContext(req)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode > 299 {
-		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
-		return result, total, fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp)), resp.StatusCode
+		return result, total, newResponseError(resp), resp.StatusCode
 	}
 	total, err = strconv.ParseInt(resp.Header.Get("X-Total-Count"), 10, 64)
 	if err != nil {
@@ -129,15 +131,33 @@ func doWithTotalInResult[T any](req *http.Request, optionalAuthTokenForApiGatewa
 	return
 }
 
+// newResponseError reads the RFC 7807 problem of an error response and returns it as *model.Problem
+// responses without problem body (e.g. from an api-gateway) are returned as generic problem of the status code
+func newResponseError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), model.ProblemContentType) {
+		problem := model.Problem{}
+		err := json.Unmarshal(body, &problem)
+		if err == nil {
+			if problem.Status == 0 {
+				problem.Status = resp.StatusCode
+			}
+			return &problem
+		}
+	}
+	problem := model.NewProblem(fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(body)), resp.StatusCode)
+	return &problem
+}
+
 func (c *Client) GetPermissionsClient() permissions.Client {
 	return permissions.New(c.baseUrl + "/permissions")
 }
 
-func (c *Client) validateWithToken(token string, path string, e interface{}) (err error, code int) {
-	return c.validateWithTokenAndOptions(token, path, e, nil)
+func (c *Client) validateWithToken(ctx context.Context, token string, path string, e interface{}) (err error, code int) {
+	return c.validateWithTokenAndOptions(ctx, token, path, e, nil)
 }
 
-func (c *Client) validateWithTokenAndOptions(token string, path string, e interface{}, options url.Values) (err error, code int) {
+func (c *Client) validateWithTokenAndOptions(ctx context.Context, token string, path string, e interface{}, options url.Values) (err error, code int) {
 	b, err := json.Marshal(e)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -146,7 +166,7 @@ func (c *Client) validateWithTokenAndOptions(token string, path string, e interf
 	if optQuery != "" {
 		optQuery = "&" + optQuery
 	}
-	req, err := http.NewRequest(http.MethodPut, c.baseUrl+path+"?dry-run=true", bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+path+"?dry-run=true", bytes.NewBuffer(b))
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -156,30 +176,29 @@ func (c *Client) validateWithTokenAndOptions(token string, path string, e interf
 		return err, http.StatusInternalServerError
 	}
 	if resp.StatusCode > 299 {
-		temp, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("validation error: %v", string(temp)), resp.StatusCode
+		return newResponseError(resp), resp.StatusCode
 	}
 	return nil, resp.StatusCode
 }
 
-func (c *Client) validate(path string, e interface{}) (err error, code int) {
-	return c.validateWithOptions(path, e, nil)
+func (c *Client) validate(ctx context.Context, path string, e interface{}) (err error, code int) {
+	return c.validateWithOptions(ctx, path, e, nil)
 }
 
-func (c *Client) validateWithOptions(path string, e interface{}, options url.Values) (err error, code int) {
+func (c *Client) validateWithOptions(ctx context.Context, path string, e interface{}, options url.Values) (err error, code int) {
 	if c.optionalAuthTokenForApiGatewayRequest != nil {
 		token, err := c.optionalAuthTokenForApiGatewayRequest()
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
-		return c.validateWithTokenAndOptions(token, path, e, options)
+		return c.validateWithTokenAndOptions(ctx, token, path, e, options)
 	}
 	b, err := json.Marshal(e)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
 	options.Set("dry-run", "true")
-	req, err := http.NewRequest(http.MethodPut, c.baseUrl+path+"?"+options.Encode(), bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+path+"?"+options.Encode(), bytes.NewBuffer(b))
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -188,22 +207,20 @@ func (c *Client) validateWithOptions(path string, e interface{}, options url.Val
 		return err, http.StatusInternalServerError
 	}
 	if resp.StatusCode >= 300 {
-		temp, _ := io.ReadAll(resp.Body)
-		err = fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp))
-		return err, resp.StatusCode
+		return newResponseError(resp), resp.StatusCode
 	}
 	return nil, resp.StatusCode
 }
 
-func (c *Client) validateDelete(path string) (err error, code int) {
+func (c *Client) validateDelete(ctx context.Context, path string) (err error, code int) {
 	if c.optionalAuthTokenForApiGatewayRequest != nil {
 		token, err := c.optionalAuthTokenForApiGatewayRequest()
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
-		return c.validateDeleteWithToken(token, path)
+		return c.validateDeleteWithToken(ctx, token, path)
 	}
-	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+path+"?dry-run=true", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+path+"?dry-run=true", nil)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -212,15 +229,13 @@ func (c *Client) validateDelete(path string) (err error, code int) {
 		return err, http.StatusInternalServerError
 	}
 	if resp.StatusCode >= 300 {
-		temp, _ := io.ReadAll(resp.Body)
-		err = fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp))
-		return err, resp.StatusCode
+		return newResponseError(resp), resp.StatusCode
 	}
 	return nil, resp.StatusCode
 }
 
-func (c *Client) validateDeleteWithToken(token string, path string) (err error, code int) {
-	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+path+"?dry-run=true", nil)
+func (c *Client) validateDeleteWithToken(ctx context.Context, token string, path string) (err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+path+"?dry-run=true", nil)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -230,9 +245,7 @@ func (c *Client) validateDeleteWithToken(token string, path string) (err error,
 		return err, http.StatusInternalServerError
 	}
 	if resp.StatusCode >= 300 {
-		temp, _ := io.ReadAll(resp.Body)
-		err = fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp))
-		return err, resp.StatusCode
+		return newResponseError(resp), resp.StatusCode
 	}
 	return nil, resp.StatusCode
 }
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func (c *Client) GetDeviceConfiguration(ctx context.Context, token string, id string) (result []model.DeviceConfigurationValue, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(id)+"/configuration", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[[]model.DeviceConfigurationValue](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetDeviceConfiguration(ctx context.Context, token string, id string, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error, code int) {
+	b, err := json.Marshal(configuration)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/devices/"+url.PathEscape(id)+"/configuration", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[[]model.DeviceConfigurationValue](req, c.optionalAuthTokenForApiGatewayRequest)
+}
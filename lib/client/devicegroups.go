@@ -18,6 +18,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -27,16 +28,16 @@ import (
 	"strings"
 )
 
-func (c *Client) SetDeviceGroup(token string, deviceGroup models.DeviceGroup) (result models.DeviceGroup, err error, code int) {
+func (c *Client) SetDeviceGroup(ctx context.Context, token string, deviceGroup models.DeviceGroup) (result models.DeviceGroup, err error, code int) {
 	var req *http.Request
 	b, err := json.Marshal(deviceGroup)
 	if err != nil {
 		return result, err, http.StatusBadRequest
 	}
 	if deviceGroup.Id == "" {
-		req, err = http.NewRequest(http.MethodPost, c.baseUrl+"/device-groups", bytes.NewBuffer(b))
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/device-groups", bytes.NewBuffer(b))
 	} else {
-		req, err = http.NewRequest(http.MethodPut, c.baseUrl+"/device-groups/"+url.PathEscape(deviceGroup.Id), bytes.NewBuffer(b))
+		req, err = http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/device-groups/"+url.PathEscape(deviceGroup.Id), bytes.NewBuffer(b))
 	}
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -45,8 +46,8 @@ func (c *Client) SetDeviceGroup(token string, deviceGroup models.DeviceGroup) (r
 	return do[models.DeviceGroup](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) DeleteDeviceGroup(token string, id string) (err error, code int) {
-	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+"/device-groups/"+url.PathEscape(id), nil)
+func (c *Client) DeleteDeviceGroup(ctx context.Context, token string, id string) (err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/device-groups/"+url.PathEscape(id), nil)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -54,7 +55,7 @@ func (c *Client) DeleteDeviceGroup(token string, id string) (err error, code int
 	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ListDeviceGroups(token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int) {
+func (c *Client) ListDeviceGroups(ctx context.Context, token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int) {
 	queryString := ""
 	query := url.Values{}
 	if options.Permission != models.UnsetPermissionFlag {
@@ -100,7 +101,7 @@ func (c *Client) ListDeviceGroups(token string, options model.DeviceGroupListOpt
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/device-groups"+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-groups"+queryString, nil)
 	if err != nil {
 		return result, 0, err, http.StatusInternalServerError
 	}
@@ -108,12 +109,12 @@ func (c *Client) ListDeviceGroups(token string, options model.DeviceGroupListOpt
 	return doWithTotalInResult[[]models.DeviceGroup](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ReadDeviceGroup(id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int) {
+func (c *Client) ReadDeviceGroup(ctx context.Context, id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int) {
 	query := ""
 	if filterGenericDuplicateCriteria {
 		query = "?filter_generic_duplicate_criteria=true"
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/device-groups/"+id+query, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-groups/"+id+query, nil)
 	req.Header.Set("Authorization", token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -121,10 +122,10 @@ func (c *Client) ReadDeviceGroup(id string, token string, filterGenericDuplicate
 	return do[models.DeviceGroup](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ValidateDeviceGroup(token string, deviceGroup models.DeviceGroup) (err error, code int) {
-	return c.validateWithToken(token, "/device-groups", deviceGroup)
+func (c *Client) ValidateDeviceGroup(ctx context.Context, token string, deviceGroup models.DeviceGroup) (err error, code int) {
+	return c.validateWithToken(ctx, token, "/device-groups", deviceGroup)
 }
 
-func (c *Client) ValidateDeviceGroupDelete(token string, id string) (err error, code int) {
-	return c.validateDeleteWithToken(token, "/device-groups/"+id)
+func (c *Client) ValidateDeviceGroupDelete(ctx context.Context, token string, id string) (err error, code int) {
+	return c.validateDeleteWithToken(ctx, token, "/device-groups/"+id)
 }
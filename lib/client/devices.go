@@ -18,6 +18,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -30,12 +31,12 @@ import (
 
 type DeviceUpdateOptions = model.DeviceUpdateOptions
 
-func (c *Client) SetDeviceConnectionState(token string, id string, connected bool) (error, int) {
+func (c *Client) SetDeviceConnectionState(ctx context.Context, token string, id string, connected bool) (error, int) {
 	b, err := json.Marshal(connected)
 	if err != nil {
 		return err, http.StatusBadRequest
 	}
-	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/devices/"+url.PathEscape(id)+"/connection-state", bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/devices/"+url.PathEscape(id)+"/connection-state", bytes.NewBuffer(b))
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -43,7 +44,7 @@ func (c *Client) SetDeviceConnectionState(token string, id string, connected boo
 	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) SetDevice(token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int) {
+func (c *Client) SetDevice(ctx context.Context, token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int) {
 	b, err := json.Marshal(device)
 	if err != nil {
 		return result, err, http.StatusBadRequest
@@ -52,7 +53,7 @@ func (c *Client) SetDevice(token string, device models.Device, options model.Dev
 	if options.UpdateOnlySameOriginAttributes != nil {
 		query.Set("update-only-same-origin-attributes", strings.Join(options.UpdateOnlySameOriginAttributes, ","))
 	}
-	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/devices/"+url.PathEscape(device.Id)+"?"+query.Encode(), bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/devices/"+url.PathEscape(device.Id)+"?"+query.Encode(), bytes.NewBuffer(b))
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -60,12 +61,12 @@ func (c *Client) SetDevice(token string, device models.Device, options model.Dev
 	return do[models.Device](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) CreateDevice(token string, device models.Device) (result models.Device, err error, code int) {
+func (c *Client) CreateDevice(ctx context.Context, token string, device models.Device) (result models.Device, err error, code int) {
 	b, err := json.Marshal(device)
 	if err != nil {
 		return result, err, http.StatusBadRequest
 	}
-	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/devices", bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/devices", bytes.NewBuffer(b))
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -73,8 +74,8 @@ func (c *Client) CreateDevice(token string, device models.Device) (result models
 	return do[models.Device](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) DeleteDevice(token string, id string) (err error, code int) {
-	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+"/devices/"+url.PathEscape(id), nil)
+func (c *Client) DeleteDevice(ctx context.Context, token string, id string) (err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/devices/"+url.PathEscape(id), nil)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -82,7 +83,7 @@ func (c *Client) DeleteDevice(token string, id string) (err error, code int) {
 	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ListDevices(token string, options DeviceListOptions) (result []models.Device, err error, errCode int) {
+func (c *Client) ListDevices(ctx context.Context, token string, options DeviceListOptions) (result []models.Device, err error, errCode int) {
 	queryString := ""
 	query := url.Values{}
 	if options.Permission != models.UnsetPermissionFlag {
@@ -128,10 +129,13 @@ func (c *Client) ListDevices(token string, options DeviceListOptions) (result []
 		}
 		query.Set("device-attribute-blacklist", url.QueryEscape(string(b)))
 	}
+	if options.Fields != nil {
+		query.Set("fields", strings.Join(options.Fields, ","))
+	}
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/devices"+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/devices"+queryString, nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -139,7 +143,7 @@ func (c *Client) ListDevices(token string, options DeviceListOptions) (result []
 	return do[[]models.Device](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ReadDevice(id string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
+func (c *Client) ReadDevice(ctx context.Context, id string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
 	query := url.Values{}
 	if action != models.UnsetPermissionFlag {
 		query.Set("p", string(action))
@@ -148,7 +152,7 @@ func (c *Client) ReadDevice(id string, token string, action model.AuthAction) (r
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(id)+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(id)+queryString, nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -156,14 +160,14 @@ func (c *Client) ReadDevice(id string, token string, action model.AuthAction) (r
 	return do[models.Device](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ReadDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
+func (c *Client) ReadDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
 	query := url.Values{}
 	if action != models.UnsetPermissionFlag {
 		query.Set("p", string(action))
 	}
 	query.Set("as", "local_id")
 	query.Set("owner_id", ownerId)
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(localId)+"?"+query.Encode(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(localId)+"?"+query.Encode(), nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -171,6 +175,6 @@ func (c *Client) ReadDeviceByLocalId(ownerId string, localId string, token strin
 	return do[models.Device](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ValidateDevice(token string, device models.Device) (err error, code int) {
-	return c.validateWithToken(token, "/devices", device)
+func (c *Client) ValidateDevice(ctx context.Context, token string, device models.Device) (err error, code int) {
+	return c.validateWithToken(ctx, token, "/devices", device)
 }
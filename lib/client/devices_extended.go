@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -29,7 +30,16 @@ import (
 
 const extendedDevicePath = "extended-devices"
 
-func (c *Client) ListExtendedDevices(token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int) {
+func (c *Client) ListExtendedDevices(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int) {
+	list, total, err, errCode := c.ListExtendedDevicesWithConfiguration(ctx, token, options)
+	result = make([]models.ExtendedDevice, 0, len(list))
+	for _, element := range list {
+		result = append(result, element.ExtendedDevice)
+	}
+	return result, total, err, errCode
+}
+
+func (c *Client) ListExtendedDevicesWithConfiguration(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []model.ExtendedDevice, total int64, err error, errCode int) {
 	query := url.Values{}
 	if options.Permission != models.UnsetPermissionFlag {
 		query.Set("p", string(options.Permission))
@@ -78,18 +88,26 @@ func (c *Client) ListExtendedDevices(token string, options model.ExtendedDeviceL
 		query.Set("device-attribute-blacklist", url.QueryEscape(string(b)))
 	}
 	queryString := ""
+	if options.Fields != nil {
+		query.Set("fields", strings.Join(options.Fields, ","))
+	}
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/"+extendedDevicePath+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/"+extendedDevicePath+queryString, nil)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token)
-	return doWithTotalInResult[[]models.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
+	return doWithTotalInResult[[]model.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ReadExtendedDevice(id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+func (c *Client) ReadExtendedDevice(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+	temp, err, errCode := c.ReadExtendedDeviceWithConfiguration(ctx, id, token, action, fullDt)
+	return temp.ExtendedDevice, err, errCode
+}
+
+func (c *Client) ReadExtendedDeviceWithConfiguration(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int) {
 	query := url.Values{}
 	if action != models.UnsetPermissionFlag {
 		query.Set("p", string(action))
@@ -101,15 +119,20 @@ func (c *Client) ReadExtendedDevice(id string, token string, action model.AuthAc
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/"+extendedDevicePath+"/"+id+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/"+extendedDevicePath+"/"+id+queryString, nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token)
-	return do[models.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
+	return do[model.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) ReadExtendedDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+	temp, err, errCode := c.ReadExtendedDeviceByLocalIdWithConfiguration(ctx, ownerId, localId, token, action, fullDt)
+	return temp.ExtendedDevice, err, errCode
 }
 
-func (c *Client) ReadExtendedDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+func (c *Client) ReadExtendedDeviceByLocalIdWithConfiguration(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int) {
 	query := url.Values{}
 	if action != models.UnsetPermissionFlag {
 		query.Set("p", string(action))
@@ -119,10 +142,10 @@ func (c *Client) ReadExtendedDeviceByLocalId(ownerId string, localId string, tok
 	if fullDt {
 		query.Set("fulldt", "true")
 	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/"+extendedDevicePath+"/"+url.PathEscape(localId)+"?"+query.Encode(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/"+extendedDevicePath+"/"+url.PathEscape(localId)+"?"+query.Encode(), nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token)
-	return do[models.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
+	return do[model.ExtendedDevice](req, c.optionalAuthTokenForApiGatewayRequest)
 }
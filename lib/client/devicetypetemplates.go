@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+type DeviceTypeTemplate = model.DeviceTypeTemplate
+type DeviceTypeExtension = model.DeviceTypeExtension
+type DeviceTypeTemplateListOptions = model.DeviceTypeTemplateListOptions
+
+func (c *Client) ListDeviceTypeTemplates(ctx context.Context, options model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error, errCode int) {
+	queryString := ""
+	query := url.Values{}
+	if options.Search != "" {
+		query.Set("search", options.Search)
+	}
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	if len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-type-templates"+queryString, nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	return doWithTotalInResult[[]model.DeviceTypeTemplate](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetDeviceTypeTemplate(ctx context.Context, id string) (result model.DeviceTypeTemplate, err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-type-templates/"+url.PathEscape(id), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.DeviceTypeTemplate](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetDeviceTypeTemplate(ctx context.Context, token string, template model.DeviceTypeTemplate) (result model.DeviceTypeTemplateUpdateResult, err error, errCode int) {
+	method := http.MethodPost
+	endpoint := c.baseUrl + "/device-type-templates"
+	if template.Id != "" {
+		method = http.MethodPut
+		endpoint = c.baseUrl + "/device-type-templates/" + url.PathEscape(template.Id)
+	}
+	b, err := json.Marshal(template)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.DeviceTypeTemplateUpdateResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) DeleteDeviceTypeTemplate(ctx context.Context, token string, id string) (err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/device-type-templates/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (result model.DeviceTypeExtension, err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-types/"+url.PathEscape(deviceTypeId)+"/extension", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.DeviceTypeExtension](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetDeviceTypeExtension(ctx context.Context, token string, extension model.DeviceTypeExtension, options model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, errCode int) {
+	b, err := json.Marshal(extension)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	query := url.Values{}
+	if options.DistinctAttributes != nil {
+		query.Set("distinct_attributes", strings.Join(options.DistinctAttributes, ","))
+	}
+	if options.Lint != nil {
+		query.Set("lint", strconv.FormatBool(*options.Lint))
+	}
+	var req *http.Request
+	if extension.DeviceTypeId == "" {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/device-type-templates/"+url.PathEscape(extension.TemplateId)+"/extensions?"+query.Encode(), bytes.NewBuffer(b))
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/device-types/"+url.PathEscape(extension.DeviceTypeId)+"/extension?"+query.Encode(), bytes.NewBuffer(b))
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[models.DeviceType](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) RemoveDeviceTypeExtension(ctx context.Context, token string, deviceTypeId string) (err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/device-types/"+url.PathEscape(deviceTypeId)+"/extension", nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
+}
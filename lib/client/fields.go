@@ -0,0 +1,47 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type fieldsContextKey struct{}
+
+// WithFields returns a context that reduces the responses of GET requests made with it to the given json paths (e.g. "id", "name", "services.id")
+// fields set explicitly by list options take precedence; the in-memory test client ignores this option
+func WithFields(ctx context.Context, fields ...string) context.Context {
+	return context.WithValue(ctx, fieldsContextKey{}, fields)
+}
+
+func setFieldsFromContext(req *http.Request) {
+	if req.Method != http.MethodGet {
+		return
+	}
+	fields, ok := req.Context().Value(fieldsContextKey{}).([]string)
+	if !ok || len(fields) == 0 {
+		return
+	}
+	query := req.URL.Query()
+	if query.Has("fields") {
+		return
+	}
+	query.Set("fields", strings.Join(fields, ","))
+	req.URL.RawQuery = query.Encode()
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+type FunctionDeprecation = model.FunctionDeprecation
+type FunctionUsage = model.FunctionUsage
+type FunctionMigrationResult = model.FunctionMigrationResult
+
+func (c *Client) GetFunctionDeprecation(ctx context.Context, functionId string) (result model.FunctionDeprecation, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/functions/"+url.PathEscape(functionId)+"/deprecation", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.FunctionDeprecation](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/function-deprecations", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[[]model.FunctionDeprecation](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetFunctionDeprecation(ctx context.Context, token string, deprecation model.FunctionDeprecation) (result model.FunctionDeprecation, err error, code int) {
+	b, err := json.Marshal(deprecation)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/functions/"+url.PathEscape(deprecation.FunctionId)+"/deprecation", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.FunctionDeprecation](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) RemoveFunctionDeprecation(ctx context.Context, token string, functionId string) (err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/functions/"+url.PathEscape(functionId)+"/deprecation", nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetFunctionUsage(ctx context.Context, functionId string) (result []model.FunctionUsage, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/functions/"+url.PathEscape(functionId)+"/usage", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[[]model.FunctionUsage](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) MigrateDeprecatedFunction(ctx context.Context, token string, functionId string, dryRun bool) (result model.FunctionMigrationResult, err error, code int) {
+	query := url.Values{}
+	if dryRun {
+		query.Set("dry-run", "true")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/functions/"+url.PathEscape(functionId)+"/migrate?"+query.Encode(), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.FunctionMigrationResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
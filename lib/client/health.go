@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+// GetReadiness returns the report of GET /health/ready
+// if the device-repository itself is not reachable, the report contains a single failed "device-repository" check
+func (c *Client) GetReadiness(ctx context.Context) (result model.HealthReport) {
+	result, err := c.getReadiness(ctx)
+	if err != nil {
+		return model.HealthReport{
+			Status: model.HealthStatusError,
+			Checks: map[string]model.HealthCheckResult{
+				"device-repository": {Status: model.HealthStatusError, Error: err.Error()},
+			},
+		}
+	}
+	return result
+}
+
+func (c *Client) getReadiness(ctx context.Context) (result model.HealthReport, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/health/ready", nil)
+	if err != nil {
+		return result, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
+		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
+		return result, fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp))
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		_, _ = io.ReadAll(resp.Body) //ensure resp.Body is read to EOF
+		return result, err
+	}
+	return result, nil
+}
@@ -18,6 +18,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"github.com/SENERGY-Platform/device-repository/lib/controller"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
@@ -32,9 +33,11 @@ type Resource = permissions.Resource
 type ResourcePermissions = permissions.ResourcePermissions
 type PermissionsMap = permissions.PermissionsMap
 type ImportFromOptions = model.ImportFromOptions
+type ImportResult = model.ImportResult
+type ImportChange = model.ImportChange
 
-func (c *Client) Export(token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int) {
-	req, err := controller.GetExportHttpRequest(c.baseUrl, token, options)
+func (c *Client) Export(ctx context.Context, token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int) {
+	req, err := controller.GetExportHttpRequest(ctx, c.baseUrl, token, options)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -42,7 +45,7 @@ func (c *Client) Export(token string, options model.ImportExportOptions) (result
 	return do[model.ImportExport](req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) Import(token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int) {
+func (c *Client) Import(ctx context.Context, token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int) {
 	queryString := ""
 	query := url.Values{}
 	if options.IncludeOwnedInformation {
@@ -55,7 +58,7 @@ func (c *Client) Import(token string, importModel model.ImportExport, options mo
 	if err != nil {
 		return err, http.StatusBadRequest
 	}
-	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/import"+queryString, bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/import"+queryString, bytes.NewBuffer(b))
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -63,7 +66,7 @@ func (c *Client) Import(token string, importModel model.ImportExport, options mo
 	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
 }
 
-func (c *Client) ImportFrom(token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int) {
+func (c *Client) ImportFrom(ctx context.Context, token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int) {
 	b, err := json.Marshal(options)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -76,7 +79,7 @@ func (c *Client) ImportFrom(token string, includeOwnedInformation bool, options
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-from"+queryString, bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/import-from"+queryString, bytes.NewBuffer(b))
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/device-repository/lib/jsonschema"
+)
+
+type JsonSchema = jsonschema.Schema
+type ServiceJsonSchema = jsonschema.ServiceSchema
+type DeviceTypeJsonSchema = jsonschema.DeviceTypeSchema
+
+// NewServiceJsonSchema is the generator used by GET /services/{id}/schema; use it for services that are not (yet) stored in the device-repository
+var NewServiceJsonSchema = jsonschema.NewServiceSchema
+
+// NewDeviceTypeJsonSchema is the generator used by GET /device-types/{id}/schema
+var NewDeviceTypeJsonSchema = jsonschema.NewDeviceTypeSchema
+
+func (c *Client) GetServiceJsonSchema(ctx context.Context, id string) (result jsonschema.ServiceSchema, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/services/"+url.PathEscape(id)+"/schema", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[jsonschema.ServiceSchema](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetDeviceTypeJsonSchema(ctx context.Context, token string, id string) (result jsonschema.DeviceTypeSchema, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-types/"+url.PathEscape(id)+"/schema", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[jsonschema.DeviceTypeSchema](req, c.optionalAuthTokenForApiGatewayRequest)
+}
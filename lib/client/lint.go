@@ -0,0 +1,87 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+type LintResult = model.LintResult
+type LintFinding = model.LintFinding
+type DeviceTypeLintListOptions = model.DeviceTypeLintListOptions
+
+func (c *Client) LintDeviceType(ctx context.Context, dt models.DeviceType, options model.ValidationOptions) (result model.LintResult, err error, code int) {
+	b, err := json.Marshal(dt)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	queryString := ""
+	if query := options.AsUrlValues(); len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/lint/device-types"+queryString, bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.LintResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) LintDeviceTypes(ctx context.Context, token string, options model.DeviceTypeLintListOptions) (result []model.LintResult, err error, code int) {
+	query := options.Validation.AsUrlValues()
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	if options.SortBy != "" {
+		query.Set("sort", options.SortBy)
+	}
+	queryString := ""
+	if len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/lint/device-types"+queryString, nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[[]model.LintResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SuggestDeviceTypeModelling(ctx context.Context, dt models.DeviceType, limit int) (result []model.ModellingSuggestion, err error, code int) {
+	b, err := json.Marshal(dt)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	queryString := ""
+	if limit != 0 {
+		queryString = "?limit=" + strconv.Itoa(limit)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/modelling-suggestions/device-types"+queryString, bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[[]model.ModellingSuggestion](req, c.optionalAuthTokenForApiGatewayRequest)
+}
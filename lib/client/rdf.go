@@ -0,0 +1,66 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/rdf"
+)
+
+func (c *Client) ExportRdf(ctx context.Context, token string, options model.ImportExportOptions) (result *rdf.Graph, err error, code int) {
+	query := url.Values{}
+	query.Set("format", "jsonld")
+	if options.FilterIds != nil {
+		query.Set("filter_ids", strings.Join(options.FilterIds, ","))
+	}
+	if options.FilterResourceTypes != nil {
+		query.Set("filter_resource_types", strings.Join(options.FilterResourceTypes, ","))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/export/rdf?"+query.Encode(), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	req.Header.Set("Accept", rdf.JsonLdContentType)
+	return do[*rdf.Graph](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) ImportRdf(ctx context.Context, token string, graph *rdf.Graph, dryRun bool) (result model.ImportResult, err error, code int) {
+	b, err := json.Marshal(graph)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	query := url.Values{}
+	query.Set("format", "jsonld")
+	if dryRun {
+		query.Set("dry-run", "true")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseUrl+"/import/rdf?"+query.Encode(), bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	req.Header.Set("Content-Type", rdf.JsonLdContentType)
+	return do[model.ImportResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func (c *Client) ListSerializations(ctx context.Context) (result []model.SerializationInfo, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/serializations", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[[]model.SerializationInfo](req, c.optionalAuthTokenForApiGatewayRequest)
+}
@@ -17,14 +17,41 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
+	"net/url"
 )
 
-func (c *Client) GetService(id string) (result models.Service, err error, code int) {
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/services/"+id, nil)
+func (c *Client) GetService(ctx context.Context, id string) (result models.Service, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/services/"+id, nil)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	return do[models.Service](req, c.optionalAuthTokenForApiGatewayRequest)
 }
+
+func (c *Client) ValidateServicePayload(ctx context.Context, serviceId string, options model.PayloadValidationOptions, message []byte) (result model.PayloadValidationResult, err error, code int) {
+	query := url.Values{}
+	if options.Direction != "" {
+		query.Set("direction", options.Direction)
+	}
+	if options.ContentId != "" {
+		query.Set("content_id", options.ContentId)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/services/"+url.PathEscape(serviceId)+"/validate-payload?"+query.Encode(), bytes.NewReader(message))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.PayloadValidationResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetServiceExamples(ctx context.Context, id string) (result model.ServiceExample, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/services/"+url.PathEscape(id)+"/examples", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return do[model.ServiceExample](req, c.optionalAuthTokenForApiGatewayRequest)
+}
@@ -54,9 +54,10 @@ func NewTestClient() (ctrl Interface, db database.Database, err error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	ctrl, err = controller.New(conf, db, publisher.Void{}, permclient)
+	c, err := controller.New(conf, db, publisher.Void{}, permclient)
 	if err != nil {
 		return nil, nil, err
 	}
-	return ctrl, db, nil
+	c.SetStartupFinished()
+	return c, db, nil
 }
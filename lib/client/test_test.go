@@ -16,7 +16,12 @@
 
 package client
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
 
 func TestNewTestClient(t *testing.T) {
 	_, _, err := NewTestClient()
@@ -24,3 +29,20 @@ func TestNewTestClient(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTestClientReadiness(t *testing.T) {
+	c, _, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := c.GetReadiness(context.Background())
+	if !report.Ok() {
+		t.Errorf("%#v", report)
+	}
+	if report.Checks["mongo"].Status != model.HealthStatusOk {
+		t.Errorf("%#v", report.Checks["mongo"])
+	}
+	if report.Checks["kafka"].Status != model.HealthStatusSkipped {
+		t.Errorf("%#v", report.Checks["kafka"])
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+type Webhook = model.Webhook
+type WebhookFilter = model.WebhookFilter
+type WebhookNotification = model.WebhookNotification
+type WebhookDelivery = model.WebhookDelivery
+type WebhookListOptions = model.WebhookListOptions
+type WebhookDeliveryListOptions = model.WebhookDeliveryListOptions
+
+func (c *Client) ListWebhooks(ctx context.Context, token string, options model.WebhookListOptions) (result []model.Webhook, total int64, err error, errCode int) {
+	queryString := ""
+	query := url.Values{}
+	if options.ResourceType != "" {
+		query.Set("resource_type", options.ResourceType)
+	}
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	if len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/webhooks"+queryString, nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doWithTotalInResult[[]model.Webhook](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetWebhook(ctx context.Context, token string, id string) (result model.Webhook, err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/webhooks/"+url.PathEscape(id), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.Webhook](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) SetWebhook(ctx context.Context, token string, webhook model.Webhook) (result model.Webhook, err error, errCode int) {
+	method := http.MethodPost
+	endpoint := c.baseUrl + "/webhooks"
+	if webhook.Id != "" {
+		method = http.MethodPut
+		endpoint = c.baseUrl + "/webhooks/" + url.PathEscape(webhook.Id)
+	}
+	b, err := json.Marshal(webhook)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[model.Webhook](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, token string, id string) (err error, errCode int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseUrl+"/webhooks/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doVoid(req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, token string, id string, options model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error, errCode int) {
+	queryString := ""
+	query := url.Values{}
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	if len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/webhooks/"+url.PathEscape(id)+"/deliveries"+queryString, nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return doWithTotalInResult[[]model.WebhookDelivery](req, c.optionalAuthTokenForApiGatewayRequest)
+}
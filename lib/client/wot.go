@@ -0,0 +1,66 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/wot"
+)
+
+type WotThing = wot.Thing
+
+func (c *Client) GetDeviceTypeWotTm(ctx context.Context, token string, id string) (result wot.Thing, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/device-types/"+url.PathEscape(id)+"/wot-tm", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[wot.Thing](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) GetDeviceWotTd(ctx context.Context, token string, id string) (result wot.Thing, err error, code int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/devices/"+url.PathEscape(id)+"/wot-td", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	return do[wot.Thing](req, c.optionalAuthTokenForApiGatewayRequest)
+}
+
+func (c *Client) ImportWotThingModel(ctx context.Context, token string, thing wot.Thing, options model.WotImportOptions) (result model.WotImportResult, err error, code int) {
+	b, err := json.Marshal(thing)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	query := url.Values{}
+	query.Set("protocol_id", options.ProtocolId)
+	query.Set("save", strconv.FormatBool(options.Save))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/import/wot-tm?"+query.Encode(), bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token)
+	req.Header.Set("Content-Type", wot.TmContentType)
+	return do[model.WotImportResult](req, c.optionalAuthTokenForApiGatewayRequest)
+}
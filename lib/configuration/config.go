@@ -69,6 +69,12 @@ type Config struct {
 	MongoDefaultDeviceAttributesCollection string `json:"mongo_default_device_attributes_collection"`
 	MongoLastUpdateTimestampsCollection    string `json:"mongo_last_update_timestamps_collection"`
 	MongoGraphCollection                   string `json:"mongo_graph_collection"`
+	MongoWebhookCollection                 string `json:"mongo_webhook_collection"`
+	MongoWebhookDeliveryCollection         string `json:"mongo_webhook_delivery_collection"`
+	MongoDeviceTypeTemplateCollection      string `json:"mongo_device_type_template_collection"`
+	MongoDeviceTypeExtensionCollection     string `json:"mongo_device_type_extension_collection"`
+	MongoFunctionDeprecationCollection     string `json:"mongo_function_deprecation_collection"`
+	MongoCharacteristicPatternCollection   string `json:"mongo_characteristic_pattern_collection"`
 	Debug                                  bool   `json:"debug"`
 	HttpClientTimeout                      string `json:"http_client_timeout"`
 
@@ -87,6 +93,11 @@ type Config struct {
 	SyncInterval     string `json:"sync_interval"`
 	SyncLockDuration string `json:"sync_lock_duration"`
 
+	Timeouts map[string]string `json:"timeouts"` //per operation deadlines as duration strings (e.g. "10s"); keys are the Timeout* constants; missing keys use DefaultTimeouts
+
+	LintSeverities              map[string]string `json:"lint_severities"`                  //per rule severity ("error", "warning" or "off"); keys are the LintRule* constants; missing keys use DefaultLintSeverities
+	LintDeviceTypesOnSetDefault bool              `json:"lint_device_types_on_set_default"` //reject device-types with lint errors on set, if not overwritten by the lint query parameter
+
 	DisableStrictValidationForTesting bool `json:"disable_strict_validation_for_testing"` //only for tests; disables validations and id generations
 
 	StructLoggerLogLevel   string `json:"struct_logger_log_level"`
@@ -106,6 +117,18 @@ type Config struct {
 	MgwCertManagerUrl       string `json:"mgw_cert_manager_url"` //used to get MgwMirrorUserId if not set
 	MgwMirrorSourceUrl      string `json:"mgw_mirror_source_url"`
 	MgwMirrorUpdateInterval string `json:"mgw_mirror_update_interval"`
+
+	WebhookWorkerCount          int64    `json:"webhook_worker_count"`           //parallel webhook deliveries; default 10
+	WebhookQueueSize            int64    `json:"webhook_queue_size"`             //pending notifications; further notifications are dropped; default 1000
+	WebhookMaxAttempts          int64    `json:"webhook_max_attempts"`           //attempts per notification; default 5
+	WebhookInitialBackoff       string   `json:"webhook_initial_backoff"`        //wait time before the first retry; doubled for every further retry; default 1s
+	WebhookDisableAfterFailures int64    `json:"webhook_disable_after_failures"` //consecutive failed notifications before a webhook is disabled; default 10
+	WebhookDeliveryLogRetention string   `json:"webhook_delivery_log_retention"` //duration after which delivery logs are removed; default 168h; "-" keeps logs
+	WebhookAllowedNetworks      []string `json:"webhook_allowed_networks"`       //cidr networks (e.g. "10.1.0.0/16"), which webhooks may address, although they are not globally routable; default none
+	WebhookAuthEndpoint         string   `json:"webhook_auth_endpoint"`          //keycloak url used to resolve the current roles and groups of webhook owners on delivery; "" or "-" checks only the user permissions of owners
+	WebhookAuthRealm            string   `json:"webhook_auth_realm"`             //default "master"
+	WebhookAuthClientId         string   `json:"webhook_auth_client_id"`         //client with the view-users role of the realm-management client
+	WebhookAuthClientSecret     string   `json:"webhook_auth_client_secret"`
 }
 
 // loads config from json in location and used environment variables (e.g ZookeeperUrl --> ZOOKEEPER_URL)
@@ -241,6 +264,103 @@ func (this *Config) GetLogger() *slog.Logger {
 	return this.logger
 }
 
+const (
+	TimeoutDefault            = "default"              //used by controller operations without a dedicated timeout
+	TimeoutValidation         = "validation"           //validation of device-types, services and content variables
+	TimeoutImport             = "import"               //PUT /import
+	TimeoutExport             = "export"               //GET /export
+	TimeoutImportFrom         = "import_from"          //import from a remote device-repository
+	TimeoutSync               = "sync"                 //sync handlers, which are detached from request contexts and retried by the sync loop
+	TimeoutHealth             = "health"               //dependency checks of GET /health/ready
+	TimeoutWebhook            = "webhook"              //single delivery attempt of a webhook notification
+	TimeoutAspectChange       = "aspect_change"        //POST /aspects/{id}/merge and /aspects/{id}/move, rewrites device-types and device-type-templates
+	TimeoutFunctionMigration  = "function_migration"   //POST /functions/{id}/migrate, rewrites device-types and device-type-templates
+	TimeoutDeviceTypeTemplate = "device_type_template" //POST/PUT /device-type-templates, re-materializes all device-types extending the template
+	TimeoutDeviceConfigPrune  = "device_config_prune"  //removal of device configuration values invalidated by a device-type update, iterates all devices of the device-type
+)
+
+var DefaultTimeouts = map[string]time.Duration{
+	TimeoutDefault:            10 * time.Second,
+	TimeoutValidation:         10 * time.Second,
+	TimeoutImport:             10 * time.Minute,
+	TimeoutExport:             5 * time.Minute,
+	TimeoutImportFrom:         10 * time.Minute,
+	TimeoutSync:               10 * time.Second,
+	TimeoutHealth:             5 * time.Second,
+	TimeoutWebhook:            10 * time.Second,
+	TimeoutAspectChange:       5 * time.Minute,
+	TimeoutFunctionMigration:  5 * time.Minute,
+	TimeoutDeviceTypeTemplate: 5 * time.Minute,
+	TimeoutDeviceConfigPrune:  5 * time.Minute,
+}
+
+// GetTimeout returns the configured deadline for the operation
+// falls back to DefaultTimeouts and to the TimeoutDefault entries if the operation is unknown
+// a value of "-" or "0" disables the deadline; the operation is then only limited by the request context
+func (this *Config) GetTimeout(operation string) time.Duration {
+	if value, ok := this.Timeouts[operation]; ok && value != "" {
+		if value == "-" {
+			return 0
+		}
+		timeout, err := time.ParseDuration(value)
+		if err == nil {
+			return timeout
+		}
+		this.GetLogger().Warn("invalid timeout config", "operation", operation, "value", value, "error", err)
+	}
+	if timeout, ok := DefaultTimeouts[operation]; ok {
+		return timeout
+	}
+	if operation != TimeoutDefault {
+		return this.GetTimeout(TimeoutDefault)
+	}
+	return DefaultTimeouts[TimeoutDefault]
+}
+
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+	LintSeverityOff     = "off"
+)
+
+const (
+	LintRuleValidation                     = "validation"                             //device-type fails ValidateDeviceType
+	LintRuleMeasuringFunctionWithoutAspect = "measuring_function_without_aspect"      //content-variable with measuring function but without aspect
+	LintRuleCharacteristicNotInConcept     = "characteristic_not_in_function_concept" //content-variable characteristic is not part of the concept of its function
+	LintRuleDuplicateContentVariablePath   = "duplicate_content_variable_path"        //multiple content-variables of a service share the same path
+	LintRuleServiceWithoutFunction         = "service_without_function"               //no content-variable of the service references a function
+	LintRuleInconsistentNaming             = "inconsistent_naming"                    //service local ids mix naming styles (e.g. camelCase and snake_case)
+	LintRuleUnusedServiceGroup             = "unused_service_group"                   //service-group is not referenced by any service
+	LintRuleDeprecatedFunction             = "deprecated_function"                    //content-variable references a deprecated function (ref FunctionDeprecation)
+)
+
+var DefaultLintSeverities = map[string]string{
+	LintRuleValidation:                     LintSeverityError,
+	LintRuleMeasuringFunctionWithoutAspect: LintSeverityWarning,
+	LintRuleCharacteristicNotInConcept:     LintSeverityError,
+	LintRuleDuplicateContentVariablePath:   LintSeverityError,
+	LintRuleServiceWithoutFunction:         LintSeverityWarning,
+	LintRuleInconsistentNaming:             LintSeverityWarning,
+	LintRuleUnusedServiceGroup:             LintSeverityWarning,
+	LintRuleDeprecatedFunction:             LintSeverityWarning,
+}
+
+// GetLintSeverity returns the configured severity of the lint rule
+// falls back to DefaultLintSeverities; unknown or invalid values are handled as LintSeverityWarning
+func (this *Config) GetLintSeverity(rule string) string {
+	if value, ok := this.LintSeverities[rule]; ok && value != "" {
+		switch value {
+		case LintSeverityError, LintSeverityWarning, LintSeverityOff:
+			return value
+		}
+		this.GetLogger().Warn("invalid lint severity config", "rule", rule, "value", value)
+	}
+	if severity, ok := DefaultLintSeverities[rule]; ok {
+		return severity
+	}
+	return LintSeverityWarning
+}
+
 func (this *Config) GetMgwMirrorUserId() (string, error) {
 	if this.MgwMirrorUserId != "" && this.MgwMirrorUserId != "-" {
 		return this.MgwMirrorUserId, nil
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// MergeAspects merges the source aspect into the target aspect:
+// sub-aspects of the source are moved under the target, references to the source in device-types and device-type-templates
+// are replaced by the target, device-group criteria and aspect nodes are rebuilt and the source is removed last
+func (this *Controller) MergeAspects(ctx context.Context, token string, sourceId string, targetId string, dryRun bool) (impact model.AspectChangeImpact, err error, code int) {
+	err, code = this.checkAspectChangeRights(token)
+	if err != nil {
+		return impact, err, code
+	}
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutAspectChange)
+	defer cancel()
+	source, err, code := this.getAspectNodeForChange(ctx, sourceId)
+	if err != nil {
+		return impact, err, code
+	}
+	target, err, code := this.getAspectNodeForChange(ctx, targetId)
+	if err != nil {
+		return impact, err, code
+	}
+	if source.Id == target.Id {
+		return impact, model.NewError(model.ErrAspectInvalidMove, errors.New("aspect can not be merged into itself")), http.StatusBadRequest
+	}
+	if slices.Contains(source.DescendentIds, target.Id) {
+		return impact, model.NewError(model.ErrAspectInvalidMove, errors.New("aspect can not be merged into one of its sub-aspects")), http.StatusBadRequest
+	}
+
+	sourceRoot, err, code := this.GetAspect(ctx, source.RootId)
+	if err != nil {
+		return impact, err, code
+	}
+	sourceAspect, _ := findSubAspect(sourceRoot, source.Id)
+	targetRoot, err, code := this.GetAspect(ctx, target.RootId)
+	if err != nil {
+		return impact, err, code
+	}
+	targetRoot = updateSubAspect(targetRoot, target.Id, func(aspect models.Aspect) models.Aspect {
+		aspect.SubAspects = append(slices.Clone(aspect.SubAspects), sourceAspect.SubAspects...)
+		return aspect
+	})
+
+	aspectUpdates := []models.Aspect{}
+	if sourceRoot.Id == targetRoot.Id {
+		aspectUpdates = append(aspectUpdates, filterSubAspects(targetRoot, []string{source.Id}))
+	} else {
+		aspectUpdates = append(aspectUpdates, targetRoot)
+		if source.Id == sourceRoot.Id {
+			impact.DeletedAspectIds = []string{source.Id}
+		} else {
+			aspectUpdates = append(aspectUpdates, filterSubAspects(sourceRoot, append([]string{source.Id}, source.DescendentIds...)))
+		}
+	}
+	for _, aspect := range aspectUpdates {
+		impact.ChangedAspectIds = append(impact.ChangedAspectIds, aspect.Id)
+	}
+
+	deviceTypeIds, err := this.getDeviceTypeIdsUsingAspects(ctx, []string{source.Id})
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	rewrite, err := this.planReferenceRewrite(ctx, deviceTypeIds, func(variable models.ContentVariable) bool {
+		return variable.AspectId == source.Id
+	}, func(variable models.ContentVariable) models.ContentVariable {
+		variable.AspectId = target.Id
+		return variable
+	})
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	rewritten := rewrite.DeviceTypeIds()
+	impact.DeviceTypeIds = rewritten
+	impact.DeviceTypeTemplateIds = rewrite.TemplateIds()
+
+	//descendants of the source get new ancestors; device-groups using them need new criteria
+	regroupDeviceTypeIds, err := this.getDeviceTypeIdsUsingAspects(ctx, source.DescendentIds)
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	regroupDeviceTypeIds = slices.DeleteFunc(regroupDeviceTypeIds, func(id string) bool {
+		return slices.Contains(rewritten, id)
+	})
+	impact.DeviceGroupIds, err = this.getDeviceGroupIdsOfDeviceTypes(ctx, append(slices.Clone(rewritten), regroupDeviceTypeIds...))
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+
+	if dryRun {
+		return impact, nil, http.StatusOK
+	}
+
+	for _, aspect := range aspectUpdates {
+		err = this.setAspect(ctx, aspect)
+		if err != nil {
+			return impact, err, http.StatusInternalServerError
+		}
+	}
+	_, err = this.applyReferenceRewrite(ctx, rewrite)
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	err = this.updateDeviceGroupsOfDeviceTypes(ctx, regroupDeviceTypeIds)
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	//the source is removed last, so that a failed rewrite leaves no reference to a missing aspect
+	for _, id := range impact.DeletedAspectIds {
+		err = this.deleteAspect(ctx, id)
+		if err != nil {
+			return impact, err, http.StatusInternalServerError
+		}
+	}
+	return impact, nil, http.StatusOK
+}
+
+// MoveAspect moves the aspect and its sub-aspects under the parent aspect, which may belong to another root aspect
+// an empty parentId makes the aspect a root aspect; aspect ids and references stay unchanged, aspect nodes and device-group criteria are rebuilt
+func (this *Controller) MoveAspect(ctx context.Context, token string, id string, parentId string, dryRun bool) (impact model.AspectChangeImpact, err error, code int) {
+	err, code = this.checkAspectChangeRights(token)
+	if err != nil {
+		return impact, err, code
+	}
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutAspectChange)
+	defer cancel()
+	node, err, code := this.getAspectNodeForChange(ctx, id)
+	if err != nil {
+		return impact, err, code
+	}
+	sourceRoot, err, code := this.GetAspect(ctx, node.RootId)
+	if err != nil {
+		return impact, err, code
+	}
+	subtree, _ := findSubAspect(sourceRoot, node.Id)
+
+	aspectUpdates := []models.Aspect{}
+	if parentId == "" {
+		if node.Id == node.RootId {
+			return impact, model.NewError(model.ErrAspectInvalidMove, errors.New("aspect is already a root aspect")), http.StatusBadRequest
+		}
+		aspectUpdates = append(aspectUpdates, subtree, filterSubAspects(sourceRoot, []string{node.Id}))
+	} else {
+		parent, err, code := this.getAspectNodeForChange(ctx, parentId)
+		if err != nil {
+			return impact, err, code
+		}
+		if parent.Id == node.Id || slices.Contains(node.DescendentIds, parent.Id) {
+			return impact, model.NewError(model.ErrAspectInvalidMove, errors.New("aspect can not be moved under itself or one of its sub-aspects")), http.StatusBadRequest
+		}
+		if parent.Id == node.ParentId {
+			return impact, model.NewError(model.ErrAspectInvalidMove, errors.New("aspect is already a sub-aspect of the parent")), http.StatusBadRequest
+		}
+		addToParent := func(aspect models.Aspect) models.Aspect {
+			aspect.SubAspects = append(slices.Clone(aspect.SubAspects), subtree)
+			return aspect
+		}
+		if parent.RootId == sourceRoot.Id {
+			aspectUpdates = append(aspectUpdates, updateSubAspect(filterSubAspects(sourceRoot, []string{node.Id}), parent.Id, addToParent))
+		} else {
+			parentRoot, err, code := this.GetAspect(ctx, parent.RootId)
+			if err != nil {
+				return impact, err, code
+			}
+			aspectUpdates = append(aspectUpdates, updateSubAspect(parentRoot, parent.Id, addToParent))
+			if node.Id == sourceRoot.Id {
+				impact.DeletedAspectIds = []string{node.Id}
+			} else {
+				aspectUpdates = append(aspectUpdates, filterSubAspects(sourceRoot, []string{node.Id}))
+			}
+		}
+	}
+	for _, aspect := range aspectUpdates {
+		impact.ChangedAspectIds = append(impact.ChangedAspectIds, aspect.Id)
+	}
+	impact.DeviceTypeIds = []string{}
+	impact.DeviceTypeTemplateIds = []string{}
+
+	regroupDeviceTypeIds, err := this.getDeviceTypeIdsUsingAspects(ctx, append([]string{node.Id}, node.DescendentIds...))
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	impact.DeviceGroupIds, err = this.getDeviceGroupIdsOfDeviceTypes(ctx, regroupDeviceTypeIds)
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+
+	if dryRun {
+		return impact, nil, http.StatusOK
+	}
+
+	for _, aspect := range aspectUpdates {
+		err = this.setAspect(ctx, aspect)
+		if err != nil {
+			return impact, err, http.StatusInternalServerError
+		}
+	}
+	err = this.updateDeviceGroupsOfDeviceTypes(ctx, regroupDeviceTypeIds)
+	if err != nil {
+		return impact, err, http.StatusInternalServerError
+	}
+	//the previous root is removed last, after the moved aspect nodes and device-groups have been updated
+	for _, id := range impact.DeletedAspectIds {
+		err = this.deleteAspect(ctx, id)
+		if err != nil {
+			return impact, err, http.StatusInternalServerError
+		}
+	}
+	return impact, nil, http.StatusOK
+}
+
+func (this *Controller) checkAspectChangeRights(token string) (err error, code int) {
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !jwtToken.IsAdmin() {
+		return errors.New("token is not an admin"), http.StatusUnauthorized
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) getAspectNodeForChange(ctx context.Context, id string) (result models.AspectNode, err error, code int) {
+	result, exists, err := this.db.GetAspectNode(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("aspect %v %w", id, model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) getDeviceTypeIdsUsingAspects(ctx context.Context, aspectIds []string) (result []string, err error) {
+	result = []string{}
+	if len(aspectIds) == 0 {
+		return result, nil
+	}
+	criteria, err := this.db.GetDeviceTypeCriteriaByAspectIds(ctx, aspectIds, false)
+	if err != nil {
+		return result, err
+	}
+	for _, c := range criteria {
+		if !slices.Contains(result, c.DeviceTypeId) {
+			result = append(result, c.DeviceTypeId)
+		}
+	}
+	return result, nil
+}
+
+func (this *Controller) getDeviceGroupIdsOfDeviceTypes(ctx context.Context, deviceTypeIds []string) (result []string, err error) {
+	result = []string{}
+	for _, id := range deviceTypeIds {
+		groups, err := this.listDeviceGroupsOfDeviceType(ctx, id)
+		if err != nil {
+			return result, err
+		}
+		for _, dg := range groups {
+			if !slices.Contains(result, dg.Id) {
+				result = append(result, dg.Id)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (this *Controller) updateDeviceGroupsOfDeviceTypes(ctx context.Context, deviceTypeIds []string) error {
+	for _, id := range deviceTypeIds {
+		groups, err := this.listDeviceGroupsOfDeviceType(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, dg := range groups {
+			err = this.UpdateDeviceGroupCriteria(ctx, dg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func findSubAspect(aspect models.Aspect, id string) (models.Aspect, bool) {
+	if aspect.Id == id {
+		return aspect, true
+	}
+	for _, sub := range aspect.SubAspects {
+		if result, ok := findSubAspect(sub, id); ok {
+			return result, true
+		}
+	}
+	return models.Aspect{}, false
+}
+
+// updateSubAspect returns a copy of aspect, where the (sub-)aspect with the given id is replaced by the result of update
+func updateSubAspect(aspect models.Aspect, id string, update func(models.Aspect) models.Aspect) models.Aspect {
+	if aspect.Id == id {
+		return update(aspect)
+	}
+	aspect.SubAspects = slices.Clone(aspect.SubAspects)
+	for i, sub := range aspect.SubAspects {
+		aspect.SubAspects[i] = updateSubAspect(sub, id, update)
+	}
+	return aspect
+}
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -26,12 +27,14 @@ import (
 )
 
 func (this *Controller) setAspectSyncHandler(aspect models.Aspect) (err error) {
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
 	descendentNodeIds := getDescendentNodeIds(aspect)
-	err = this.handleMovedSubAspects(aspect, descendentNodeIds)
+	err = this.handleMovedSubAspects(ctx, aspect, descendentNodeIds)
 	if err != nil {
 		return err
 	}
-	err = this.setAspectNodes(aspect)
+	err = this.setAspectNodes(ctx, aspect)
 	if err != nil {
 		return err
 	}
@@ -42,8 +45,9 @@ func (this *Controller) setAspectSyncHandler(aspect models.Aspect) (err error) {
 	return nil
 }
 
-func (this *Controller) setAspect(aspect models.Aspect) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setAspect(ctx context.Context, aspect models.Aspect) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err := this.db.SetAspect(ctx, aspect, this.setAspectSyncHandler)
 	if err != nil {
 		return err
@@ -59,8 +63,9 @@ func getDescendentNodeIds(aspect models.Aspect) (result []string) {
 	return result
 }
 
-func (this *Controller) handleMovedSubAspects(aspect models.Aspect, descendentNodesIds []string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) handleMovedSubAspects(ctx context.Context, aspect models.Aspect, descendentNodesIds []string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	nodes, err := this.db.ListAspectNodesByIdList(ctx, descendentNodesIds)
 	if err != nil {
 		return err
@@ -75,7 +80,7 @@ func (this *Controller) handleMovedSubAspects(aspect models.Aspect, descendentNo
 		//sub aspect is moved root aspect
 		if node.Id == node.RootId && node.Id != aspect.Id {
 			deletedAspect[node.Id] = true
-			err = this.deleteAspect(node.Id)
+			err = this.deleteAspect(ctx, node.Id)
 			if err != nil {
 				return err
 			}
@@ -89,7 +94,7 @@ func (this *Controller) handleMovedSubAspects(aspect models.Aspect, descendentNo
 			}
 			if exists {
 				changedAspect := filterSubAspects(sourceAspect, movedIds)
-				err = this.setAspect(changedAspect)
+				err = this.setAspect(ctx, changedAspect)
 				if err != nil {
 					return err
 				}
@@ -114,7 +119,7 @@ func filterSubAspects(aspect models.Aspect, ids []string) models.Aspect {
 	return aspect
 }
 
-func (this *Controller) SetAspect(token string, aspect models.Aspect) (result models.Aspect, err error, code int) {
+func (this *Controller) SetAspect(ctx context.Context, token string, aspect models.Aspect) (result models.Aspect, err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -126,20 +131,20 @@ func (this *Controller) SetAspect(token string, aspect models.Aspect) (result mo
 	//ensure ids
 	aspect.GenerateId()
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateAspect(aspect)
+		err, code = this.ValidateAspect(ctx, aspect)
 		if err != nil {
 			return aspect, err, code
 		}
 	}
 
-	err = this.setAspect(aspect)
+	err = this.setAspect(ctx, aspect)
 	if err != nil {
 		return aspect, err, http.StatusInternalServerError
 	}
 	return aspect, nil, http.StatusOK
 }
 
-func (this *Controller) DeleteAspect(token string, id string) (err error, code int) {
+func (this *Controller) DeleteAspect(ctx context.Context, token string, id string) (err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -147,11 +152,11 @@ func (this *Controller) DeleteAspect(token string, id string) (err error, code i
 	if !jwtToken.IsAdmin() {
 		return errors.New("token is not an admin"), http.StatusUnauthorized
 	}
-	err, code = this.ValidateAspectDelete(id)
+	err, code = this.ValidateAspectDelete(ctx, id)
 	if err != nil {
 		return err, code
 	}
-	err = this.deleteAspect(id)
+	err = this.deleteAspect(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -159,7 +164,8 @@ func (this *Controller) DeleteAspect(token string, id string) (err error, code i
 }
 
 func (this *Controller) deleteAspectSyncHandler(aspect models.Aspect) (err error) {
-	ctx, _ := getTimeoutContext()
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
 	err = this.db.RemoveAspectNodesByRootId(ctx, aspect.Id)
 	if err != nil {
 		return err
@@ -171,8 +177,9 @@ func (this *Controller) deleteAspectSyncHandler(aspect models.Aspect) (err error
 	return nil
 }
 
-func (this *Controller) deleteAspect(id string) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteAspect(ctx context.Context, id string) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = this.db.RemoveAspect(ctx, id, this.deleteAspectSyncHandler)
 	if err != nil {
 		return err
@@ -180,9 +187,10 @@ func (this *Controller) deleteAspect(id string) (err error) {
 	return nil
 }
 
-func (this *Controller) GetAspects() (result []models.Aspect, err error, code int) {
+func (this *Controller) GetAspects(ctx context.Context) (result []models.Aspect, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllAspects(ctx)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -190,8 +198,9 @@ func (this *Controller) GetAspects() (result []models.Aspect, err error, code in
 	return
 }
 
-func (this *Controller) ListAspects(listOptions model.AspectListOptions) (result []models.Aspect, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListAspects(ctx context.Context, listOptions model.AspectListOptions) (result []models.Aspect, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListAspects(ctx, listOptions)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -199,8 +208,9 @@ func (this *Controller) ListAspects(listOptions model.AspectListOptions) (result
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) GetAspect(id string) (result models.Aspect, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetAspect(ctx context.Context, id string) (result models.Aspect, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetAspect(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -211,9 +221,10 @@ func (this *Controller) GetAspect(id string) (result models.Aspect, err error, c
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) GetAspectsWithMeasuringFunction(ancestors bool, descendants bool) (result []models.Aspect, err error, code int) {
+func (this *Controller) GetAspectsWithMeasuringFunction(ctx context.Context, ancestors bool, descendants bool) (result []models.Aspect, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAspectsWithMeasuringFunction(ctx, ancestors, descendants)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -221,22 +232,24 @@ func (this *Controller) GetAspectsWithMeasuringFunction(ancestors bool, descenda
 	return
 }
 
-func (this *Controller) ValidateAspect(aspect models.Aspect) (err error, code int) {
-	return this.validateAspect(aspect, true)
+func (this *Controller) ValidateAspect(ctx context.Context, aspect models.Aspect) (err error, code int) {
+	return this.validateAspect(ctx, aspect, true)
 }
 
-func (this *Controller) validateAspect(aspect models.Aspect, checkDelete bool) (err error, code int) {
+func (this *Controller) validateAspect(ctx context.Context, aspect models.Aspect, checkDelete bool) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if aspect.Id == "" {
-		return errors.New("missing aspect id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing aspect id")), http.StatusBadRequest
 	}
 	if !strings.HasPrefix(aspect.Id, model.URN_PREFIX) {
 		return errors.New("invalid aspect id"), http.StatusBadRequest
 	}
 	if aspect.Name == "" {
-		return errors.New("missing aspect name"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "name", errors.New("missing aspect name")), http.StatusBadRequest
 	}
 	for _, sub := range aspect.SubAspects {
-		err, code = this.validateAspect(sub, false)
+		err, code = this.validateAspect(ctx, sub, false)
 		if err != nil {
 			return err, code
 		}
@@ -244,7 +257,6 @@ func (this *Controller) validateAspect(aspect models.Aspect, checkDelete bool) (
 
 	//check for deleted sub aspects; but only for the root aspect, to prevent errors when moving sub aspect
 	if checkDelete {
-		ctx, _ := getTimeoutContext()
 		old, exists, err := this.db.GetAspectNode(ctx, aspect.Id)
 		if err != nil {
 			return err, http.StatusInternalServerError
@@ -264,7 +276,7 @@ func (this *Controller) validateAspect(aspect models.Aspect, checkDelete bool) (
 					return err, http.StatusInternalServerError
 				}
 				if isUsed {
-					return errors.New("sub aspect " + id + " is still in use: " + strings.Join(where, ",")), http.StatusBadRequest
+					return model.NewError(model.ErrAspectInUse, errors.New("sub aspect "+id+" is still in use: "+strings.Join(where, ","))), http.StatusBadRequest
 				}
 			}
 		}
@@ -272,8 +284,9 @@ func (this *Controller) validateAspect(aspect models.Aspect, checkDelete bool) (
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateAspectDelete(id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateAspectDelete(ctx context.Context, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	aspect, exists, err := this.db.GetAspectNode(ctx, id)
 	if !exists {
 		//deleting nothing is ok
@@ -284,12 +297,12 @@ func (this *Controller) ValidateAspectDelete(id string) (err error, code int) {
 	}
 	isUsed, where, err := this.db.AspectIsUsed(ctx, id)
 	if isUsed {
-		return errors.New("still in use: " + strings.Join(where, ",")), http.StatusBadRequest
+		return model.NewError(model.ErrAspectInUse, errors.New("still in use: "+strings.Join(where, ","))), http.StatusBadRequest
 	}
 	for _, sub := range aspect.DescendentIds {
 		isUsed, where, err = this.db.AspectIsUsed(ctx, sub)
 		if isUsed {
-			return errors.New("sub aspect " + sub + " is still in use: " + strings.Join(where, ",")), http.StatusBadRequest
+			return model.NewError(model.ErrAspectInUse, errors.New("sub aspect "+sub+" is still in use: "+strings.Join(where, ","))), http.StatusBadRequest
 		}
 	}
 	return nil, http.StatusOK
@@ -0,0 +1,285 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/payload"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (this *Controller) GetCharacteristicPattern(ctx context.Context, characteristicId string) (result model.CharacteristicPattern, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, exists, err := this.db.GetCharacteristicPattern(ctx, characteristicId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("pattern of characteristic %v %w", characteristicId, model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetCharacteristicPattern restricts the string values of a characteristic; existing device-types are checked on their next update
+func (this *Controller) SetCharacteristicPattern(ctx context.Context, token string, pattern model.CharacteristicPattern) (result model.CharacteristicPattern, err error, code int) {
+	err, code = this.checkCharacteristicPatternRights(token)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	err, code = this.validateCharacteristicPattern(ctx, pattern)
+	if err != nil {
+		return result, err, code
+	}
+	err = this.db.SetCharacteristicPattern(ctx, pattern)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return pattern, nil, http.StatusOK
+}
+
+func (this *Controller) RemoveCharacteristicPattern(ctx context.Context, token string, characteristicId string) (err error, code int) {
+	err, code = this.checkCharacteristicPatternRights(token)
+	if err != nil {
+		return err, code
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	_, exists, err := this.db.GetCharacteristicPattern(ctx, characteristicId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return fmt.Errorf("pattern of characteristic %v %w", characteristicId, model.ErrNotFound), http.StatusNotFound
+	}
+	err = this.db.RemoveCharacteristicPattern(ctx, characteristicId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) checkCharacteristicPatternRights(token string) (err error, code int) {
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !jwtToken.IsAdmin() {
+		return errors.New("token is not an admin"), http.StatusUnauthorized
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) validateCharacteristicPattern(ctx context.Context, pattern model.CharacteristicPattern) (err error, code int) {
+	if pattern.CharacteristicId == "" {
+		return model.NewFieldError(model.ErrMissingField, "characteristic_id", errors.New("missing characteristic id")), http.StatusBadRequest
+	}
+	if pattern.Pattern == "" {
+		return model.NewFieldError(model.ErrMissingField, "pattern", errors.New("missing pattern")), http.StatusBadRequest
+	}
+	_, err = pattern.Compile()
+	if err != nil {
+		return model.NewFieldError(model.ErrInvalidField, "pattern", err), http.StatusBadRequest
+	}
+	characteristic, exists, err := this.db.GetCharacteristic(ctx, pattern.CharacteristicId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return fmt.Errorf("characteristic %v %w", pattern.CharacteristicId, model.ErrNotFound), http.StatusNotFound
+	}
+	return validateCharacteristicValues(characteristic, &pattern)
+}
+
+// validateCharacteristicValues checks min_value, max_value, allowed_values and value of the characteristic against its type and each other
+// if pattern is set, string values have to match it
+func validateCharacteristicValues(characteristic models.Characteristic, pattern *model.CharacteristicPattern) (err error, code int) {
+	isNumber := characteristic.Type == models.Integer || characteristic.Type == models.Float
+	isPrimitive := isNumber || characteristic.Type == models.String || characteristic.Type == models.Boolean
+	if pattern != nil && characteristic.Type != models.String {
+		return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, "pattern", fmt.Errorf("patterns are only allowed for %v characteristics", models.String)), http.StatusBadRequest
+	}
+	for _, field := range []string{"min_value", "max_value"} {
+		value := characteristic.MinValue
+		if field == "max_value" {
+			value = characteristic.MaxValue
+		}
+		if value == nil {
+			continue
+		}
+		if !isNumber {
+			return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, field, fmt.Errorf("%v is only allowed for numeric characteristics", field)), http.StatusBadRequest
+		}
+		problems := checkCharacteristicValue(models.Characteristic{Id: characteristic.Id, Type: characteristic.Type}, value, nil)
+		if len(problems) > 0 {
+			return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, field, errors.New(problems[0].Detail)), http.StatusBadRequest
+		}
+	}
+	if characteristic.MinValue != nil && characteristic.MaxValue != nil {
+		min, _ := normalizeCharacteristicValue(characteristic.MinValue)
+		max, _ := normalizeCharacteristicValue(characteristic.MaxValue)
+		if min.(float64) > max.(float64) {
+			return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, "min_value", errors.New("min_value is greater than max_value")), http.StatusBadRequest
+		}
+	}
+	if len(characteristic.AllowedValues) > 0 && !isPrimitive {
+		return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, "allowed_values", errors.New("allowed_values are only allowed for primitive characteristics")), http.StatusBadRequest
+	}
+	withoutEnumeration := characteristic
+	withoutEnumeration.AllowedValues = nil
+	for i, value := range characteristic.AllowedValues {
+		problems := checkCharacteristicValue(withoutEnumeration, value, pattern)
+		if len(problems) > 0 {
+			return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, fmt.Sprintf("allowed_values[%v]", i), errors.New(problems[0].Detail)), http.StatusBadRequest
+		}
+	}
+	if characteristic.Value != nil && isPrimitive {
+		problems := checkCharacteristicValue(characteristic, characteristic.Value, pattern)
+		if len(problems) > 0 {
+			return model.NewFieldError(model.ErrCharacteristicInvalidConstraint, "value", errors.New(problems[0].Detail)), http.StatusBadRequest
+		}
+	}
+	return nil, http.StatusOK
+}
+
+// checkCharacteristicValue checks a primitive value against the type and constraints of the characteristic (ref payload.Validate)
+func checkCharacteristicValue(characteristic models.Characteristic, value interface{}, pattern *model.CharacteristicPattern) []model.ProblemField {
+	value, err := normalizeCharacteristicValue(value)
+	if err != nil {
+		return []model.ProblemField{{Code: model.ErrPayloadTypeMismatch, Detail: err.Error()}}
+	}
+	patterns := map[string]model.CharacteristicPattern{}
+	if pattern != nil {
+		patterns[characteristic.Id] = *pattern
+	}
+	variable := models.ContentVariable{Name: "value", Type: characteristic.Type, CharacteristicId: characteristic.Id}
+	return payload.Validate(variable, value, false, map[string]models.Characteristic{characteristic.Id: characteristic}, patterns)
+}
+
+// normalizeCharacteristicValue returns value as it would be decoded from json (e.g. int as float64)
+func normalizeCharacteristicValue(value interface{}) (result interface{}, err error) {
+	temp, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(temp, &result)
+	return result, err
+}
+
+// getCharacteristicPatterns returns the patterns of the given characteristics by characteristic id
+func (this *Controller) getCharacteristicPatterns(ctx context.Context, characteristics map[string]models.Characteristic) (result map[string]model.CharacteristicPattern, err error) {
+	result = map[string]model.CharacteristicPattern{}
+	for id := range characteristics {
+		pattern, exists, err := this.db.GetCharacteristicPattern(ctx, id)
+		if err != nil {
+			return result, err
+		}
+		if exists {
+			result[id] = pattern
+		}
+	}
+	return result, nil
+}
+
+// validateVariableCharacteristic checks the type and the fixed value of the variable against the referenced characteristic
+// unknown characteristics are ignored
+func (this *Controller) validateVariableCharacteristic(ctx context.Context, variable models.ContentVariable, serializationKey models.Serialization) (err error, code int) {
+	characteristic, exists, err := this.db.GetCharacteristic(ctx, variable.CharacteristicId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return nil, http.StatusOK
+	}
+	if !characteristicTypeMatches(characteristic.Type, variable.Type) {
+		return model.NewFieldError(model.ErrDeviceTypeCharacteristicTypeMismatch, "type", fmt.Errorf("type %v of %v does not match type %v of characteristic %v", variable.Type, variable.Name, characteristic.Type, characteristic.Id)), http.StatusBadRequest
+	}
+	if variable.Value == nil || variable.Value == "" || variable.Type == models.Structure || variable.Type == models.List {
+		return nil, http.StatusOK
+	}
+	patterns := map[string]model.CharacteristicPattern{}
+	pattern, exists, err := this.db.GetCharacteristicPattern(ctx, characteristic.Id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if exists {
+		patterns[characteristic.Id] = pattern
+	}
+	value, err := normalizeCharacteristicValue(variable.Value)
+	if err != nil {
+		return model.NewFieldError(model.ErrDeviceTypeInvalidValue, "value", err), http.StatusBadRequest
+	}
+	_, isString := value.(string)
+	leaf := models.ContentVariable{Name: variable.Name, Type: variable.Type, CharacteristicId: variable.CharacteristicId}
+	problems := payload.Validate(leaf, value, serialization.StringValues(serializationKey) && isString, map[string]models.Characteristic{characteristic.Id: characteristic}, patterns)
+	if len(problems) > 0 {
+		details := []string{}
+		for _, problem := range problems {
+			details = append(details, problem.Detail)
+		}
+		return model.NewFieldError(model.ErrDeviceTypeInvalidValue, "value", fmt.Errorf("invalid value of %v: %v", variable.Name, strings.Join(details, "; "))), http.StatusBadRequest
+	}
+	return nil, http.StatusOK
+}
+
+func characteristicTypeMatches(characteristicType models.Type, variableType models.Type) bool {
+	isNumber := func(t models.Type) bool {
+		return t == models.Integer || t == models.Float
+	}
+	return characteristicType == variableType || (isNumber(characteristicType) && isNumber(variableType))
+}
+
+// getValueConstraints returns the constraints of the characteristic or nil if the characteristic is unknown or unconstrained
+func (this *Controller) getValueConstraints(ctx context.Context, cache map[string]*model.ValueConstraints, characteristicId string) (result *model.ValueConstraints, err error) {
+	if characteristicId == "" {
+		return nil, nil
+	}
+	if result, ok := cache[characteristicId]; ok {
+		return result, nil
+	}
+	characteristic, exists, err := this.db.GetCharacteristic(ctx, characteristicId)
+	if err != nil {
+		return nil, err
+	}
+	pattern, hasPattern, err := this.db.GetCharacteristicPattern(ctx, characteristicId)
+	if err != nil {
+		return nil, err
+	}
+	if exists && (characteristic.MinValue != nil || characteristic.MaxValue != nil || len(characteristic.AllowedValues) > 0 || hasPattern) {
+		result = &model.ValueConstraints{
+			Type:               characteristic.Type,
+			MinValue:           characteristic.MinValue,
+			MaxValue:           characteristic.MaxValue,
+			AllowedValues:      characteristic.AllowedValues,
+			Pattern:            pattern.Pattern,
+			PatternDescription: pattern.Description,
+		}
+	}
+	cache[characteristicId] = result
+	return result, nil
+}
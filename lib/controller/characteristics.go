@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -25,8 +26,9 @@ import (
 	"strings"
 )
 
-func (this *Controller) ListCharacteristics(listOptions model.CharacteristicListOptions) (result []models.Characteristic, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListCharacteristics(ctx context.Context, listOptions model.CharacteristicListOptions) (result []models.Characteristic, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListCharacteristics(ctx, listOptions)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -38,7 +40,7 @@ func (this *Controller) setCharacteristicSyncHandler(c models.Characteristic) (e
 	return this.publisher.PublishCharacteristic(c)
 }
 
-func (this *Controller) SetCharacteristic(token string, characteristic models.Characteristic) (result models.Characteristic, err error, code int) {
+func (this *Controller) SetCharacteristic(ctx context.Context, token string, characteristic models.Characteristic) (result models.Characteristic, err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -49,21 +51,22 @@ func (this *Controller) SetCharacteristic(token string, characteristic models.Ch
 	//ensure ids
 	characteristic.GenerateId()
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateCharacteristics(characteristic)
+		err, code = this.ValidateCharacteristics(ctx, characteristic)
 		if err != nil {
 			return result, err, code
 		}
 	}
 
-	err = this.setCharacteristic(characteristic)
+	err = this.setCharacteristic(ctx, characteristic)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	return characteristic, nil, http.StatusOK
 }
 
-func (this *Controller) setCharacteristic(characteristic models.Characteristic) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setCharacteristic(ctx context.Context, characteristic models.Characteristic) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = this.db.SetCharacteristic(ctx, characteristic, this.setCharacteristicSyncHandler)
 	return err
 }
@@ -72,7 +75,9 @@ func (this *Controller) deleteCharacteristicSyncHandler(c models.Characteristic)
 	return this.publisher.PublishCharacteristicDelete(c.Id)
 }
 
-func (this *Controller) DeleteCharacteristic(token string, id string) (error, int) {
+func (this *Controller) DeleteCharacteristic(ctx context.Context, token string, id string) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -80,20 +85,24 @@ func (this *Controller) DeleteCharacteristic(token string, id string) (error, in
 	if !jwtToken.IsAdmin() {
 		return errors.New("token is not an admin"), http.StatusUnauthorized
 	}
-	err, code := this.ValidateCharacteristicDelete(id)
+	err, code := this.ValidateCharacteristicDelete(ctx, id)
 	if err != nil {
 		return err, code
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.RemoveCharacteristic(ctx, id, this.deleteCharacteristicSyncHandler)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
+	err = this.db.RemoveCharacteristicPattern(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
 	return nil, http.StatusOK
 }
 
-func (this *Controller) GetCharacteristics(leafsOnly bool) (result []models.Characteristic, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetCharacteristics(ctx context.Context, leafsOnly bool) (result []models.Characteristic, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, err = this.db.ListAllCharacteristics(ctx)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -115,8 +124,9 @@ func getLeafCharacteristics(list []models.Characteristic) (result []models.Chara
 	return result
 }
 
-func (this *Controller) GetCharacteristic(id string) (result models.Characteristic, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetCharacteristic(ctx context.Context, id string) (result models.Characteristic, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetCharacteristic(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -137,8 +147,9 @@ func characteristicIdToBaseCharacteristic(characteristic models.Characteristic)
 	return result
 }
 
-func (this *Controller) ValidateCharacteristics(characteristic models.Characteristic) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateCharacteristics(ctx context.Context, characteristic models.Characteristic) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	knownCharacteristics, err := this.db.ListAllCharacteristics(ctx)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -147,7 +158,18 @@ func (this *Controller) ValidateCharacteristics(characteristic models.Characteri
 	if err != nil {
 		return err, code
 	}
-	return ValidateCharacteristicsWithoutDbAccess(characteristic)
+	err, code = ValidateCharacteristicsWithoutDbAccess(characteristic)
+	if err != nil {
+		return err, code
+	}
+	pattern, exists, err := this.db.GetCharacteristicPattern(ctx, characteristic.Id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if exists {
+		return validateCharacteristicValues(characteristic, &pattern)
+	}
+	return nil, http.StatusOK
 }
 
 func validateCharacteristicIdReuse(characteristic models.Characteristic, knownCharacteristics []models.Characteristic) (err error, code int) {
@@ -180,6 +202,11 @@ func ValidateCharacteristicsWithoutDbAccess(characteristic models.Characteristic
 		return errors.New("wrong characteristic type"), http.StatusBadRequest
 	}
 
+	err, code = validateCharacteristicValues(characteristic, nil)
+	if err != nil {
+		return err, code
+	}
+
 	err, code = validateSubCharacteristics(characteristic.SubCharacteristics)
 	if err != nil {
 		return err, code
@@ -203,8 +230,9 @@ func validateSubCharacteristics(characteristics []models.Characteristic) (error,
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateCharacteristicDelete(id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateCharacteristicDelete(ctx context.Context, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	isUsed, where, err := this.db.CharacteristicIsUsed(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
@@ -30,7 +31,7 @@ func (this *Controller) setConceptSyncHandler(c models.Concept) error {
 	return this.publisher.PublishConcept(c)
 }
 
-func (this *Controller) SetConcept(token string, concept models.Concept) (result models.Concept, err error, code int) {
+func (this *Controller) SetConcept(ctx context.Context, token string, concept models.Concept) (result models.Concept, err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -42,20 +43,21 @@ func (this *Controller) SetConcept(token string, concept models.Concept) (result
 	//ensure ids
 	concept.GenerateId()
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateConcept(concept)
+		err, code = this.ValidateConcept(ctx, concept)
 		if err != nil {
 			return result, err, code
 		}
 	}
-	err = this.setConcept(concept)
+	err = this.setConcept(ctx, concept)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	return concept, nil, http.StatusOK
 }
 
-func (this *Controller) setConcept(concept models.Concept) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setConcept(ctx context.Context, concept models.Concept) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = this.db.SetConcept(ctx, concept, this.setConceptSyncHandler)
 	return err
 }
@@ -64,7 +66,9 @@ func (this *Controller) deleteConceptSyncHandler(c models.Concept) error {
 	return this.publisher.PublishConceptDelete(c.Id)
 }
 
-func (this *Controller) DeleteConcept(token string, id string) (error, int) {
+func (this *Controller) DeleteConcept(ctx context.Context, token string, id string) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -72,11 +76,10 @@ func (this *Controller) DeleteConcept(token string, id string) (error, int) {
 	if !jwtToken.IsAdmin() {
 		return errors.New("token is not an admin"), http.StatusUnauthorized
 	}
-	err, code := this.ValidateConceptDelete(id)
+	err, code := this.ValidateConceptDelete(ctx, id)
 	if err != nil {
 		return err, code
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.RemoveConcept(ctx, id, this.deleteConceptSyncHandler)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -84,7 +87,14 @@ func (this *Controller) DeleteConcept(token string, id string) (error, int) {
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateConcept(concept models.Concept) (err error, code int) {
+func (this *Controller) ValidateConcept(ctx context.Context, concept models.Concept) (err error, code int) {
+	return this.validateConcept(ctx, concept, nil)
+}
+
+// validateConcept handles pendingCharacteristicIds as existing characteristics (e.g. characteristics that are part of the same import)
+func (this *Controller) validateConcept(ctx context.Context, concept models.Concept, pendingCharacteristicIds map[string]bool) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if concept.Id == "" {
 		return errors.New("missing concept id"), http.StatusBadRequest
 	}
@@ -98,11 +108,14 @@ func (this *Controller) ValidateConcept(concept models.Concept) (err error, code
 		return errors.New("concept base characteristic not in characteristic ids list"), http.StatusBadRequest
 	}
 	newCharacteristicIds := map[string]bool{}
-	ctx, _ := getTimeoutContext()
 	for _, charId := range concept.CharacteristicIds {
 		if charId == "" {
 			return errors.New("missing char id"), http.StatusBadRequest
 		}
+		if pendingCharacteristicIds[charId] {
+			newCharacteristicIds[charId] = true
+			continue
+		}
 		_, exists, err := this.db.GetCharacteristic(ctx, charId)
 		if err != nil {
 			return err, http.StatusInternalServerError
@@ -133,8 +146,9 @@ func (this *Controller) ValidateConcept(concept models.Concept) (err error, code
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ListConceptsWithCharacteristics(listOptions model.ConceptListOptions) (result []models.ConceptWithCharacteristics, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListConceptsWithCharacteristics(ctx context.Context, listOptions model.ConceptListOptions) (result []models.ConceptWithCharacteristics, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListConceptsWithCharacteristics(ctx, listOptions)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -142,8 +156,9 @@ func (this *Controller) ListConceptsWithCharacteristics(listOptions model.Concep
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ListConcepts(listOptions model.ConceptListOptions) (result []models.Concept, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListConcepts(ctx context.Context, listOptions model.ConceptListOptions) (result []models.Concept, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListConcepts(ctx, listOptions)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -151,8 +166,9 @@ func (this *Controller) ListConcepts(listOptions model.ConceptListOptions) (resu
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) GetConceptWithCharacteristics(id string) (result models.ConceptWithCharacteristics, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetConceptWithCharacteristics(ctx context.Context, id string) (result models.ConceptWithCharacteristics, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetConceptWithCharacteristics(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -163,8 +179,9 @@ func (this *Controller) GetConceptWithCharacteristics(id string) (result models.
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) GetConceptWithoutCharacteristics(id string) (result models.Concept, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetConceptWithoutCharacteristics(ctx context.Context, id string) (result models.Concept, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetConceptWithoutCharacteristics(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -175,8 +192,9 @@ func (this *Controller) GetConceptWithoutCharacteristics(id string) (result mode
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ValidateConceptDelete(id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateConceptDelete(ctx context.Context, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	isUsed, where, err := this.db.ConceptIsUsed(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -17,31 +17,35 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
 )
 
-func (this *Controller) ValidateContent(content models.Content, protocol models.Protocol, options model.ValidationOptions) (err error, code int) {
+func (this *Controller) ValidateContent(ctx context.Context, content models.Content, protocol models.Protocol, options model.ValidationOptions) (err error, code int) {
 	if content.Id == "" {
-		return errors.New("missing content id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing content id")), http.StatusBadRequest
 	}
-	if !content.Serialization.Valid() {
-		return errors.New("unknown serialization " + string(content.Serialization)), http.StatusBadRequest
-	}
-	if content.Serialization == models.PlainText && content.ContentVariable.Type != models.String {
-		return errors.New("plain-text serialization only for string content"), http.StatusBadRequest
+	s, ok := serialization.Get(content.Serialization)
+	if !ok {
+		return model.NewFieldError(model.ErrDeviceTypeUnknownSerialization, "serialization", errors.New("unknown serialization "+string(content.Serialization))), http.StatusBadRequest
 	}
 	if content.ProtocolSegmentId == "" {
-		return errors.New("missing protocol_segment_id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "protocol_segment_id", errors.New("missing protocol_segment_id")), http.StatusBadRequest
 	}
 	if !protocolContainsSegment(protocol, content.ProtocolSegmentId) {
-		return errors.New("protocol_segment_id does not match to protocol"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceTypeUnknownProtocolSegment, "protocol_segment_id", errors.New("protocol_segment_id does not match to protocol")), http.StatusBadRequest
+	}
+	err, code = this.ValidateVariable(ctx, content.ContentVariable, content.Serialization, options)
+	if err != nil {
+		return model.PrefixErrorField(err, "content_variable"), code
 	}
-	err, code = this.ValidateVariable(content.ContentVariable, content.Serialization, options)
+	err = s.ValidateVariable(content.ContentVariable)
 	if err != nil {
-		return err, code
+		return err, http.StatusBadRequest
 	}
 	return nil, http.StatusOK
 }
@@ -20,7 +20,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
-	"time"
+	"sync/atomic"
 
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/database"
@@ -97,9 +97,30 @@ type Controller struct {
 	config              configuration.Config
 	permissionsV2Client client.Client
 	logger              *slog.Logger
-	mirrorPullCallback  func(config configuration.Config, db database.Database, checkLastUpdate bool)
+	mirrorPullCallback  func(ctx context.Context, config configuration.Config, db database.Database, checkLastUpdate bool)
+
+	startupFinished atomic.Bool
+}
+
+// getTimeoutContext limits ctx by the configured default deadline
+func (this *Controller) getTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return this.getOperationTimeoutContext(ctx, configuration.TimeoutDefault)
+}
+
+// getOperationTimeoutContext limits ctx by the deadline configured for the operation (see configuration.Config.Timeouts)
+func (this *Controller) getOperationTimeoutContext(ctx context.Context, operation string) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	timeout := this.config.GetTimeout(operation)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
 }
 
-func getTimeoutContext() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), 10*time.Second)
+// getSyncContext is used by sync handlers
+// sync handlers run after the database write and are retried by the sync loop, so they must not be canceled by the request that triggered them
+func (this *Controller) getSyncContext() (context.Context, context.CancelFunc) {
+	return this.getOperationTimeoutContext(context.Background(), configuration.TimeoutSync)
 }
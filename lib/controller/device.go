@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,7 +35,19 @@ import (
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 )
 
-func (this *Controller) ListExtendedDevices(token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int) {
+func (this *Controller) ListExtendedDevices(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []models.ExtendedDevice, total int64, err error, errCode int) {
+	list, total, err, errCode := this.ListExtendedDevicesWithConfiguration(ctx, token, options)
+	result = make([]models.ExtendedDevice, 0, len(list))
+	for _, element := range list {
+		result = append(result, element.ExtendedDevice)
+	}
+	return result, total, err, errCode
+}
+
+// ListExtendedDevicesWithConfiguration is ListExtendedDevices with the device configuration (ref SetDeviceConfiguration)
+func (this *Controller) ListExtendedDevicesWithConfiguration(ctx context.Context, token string, options model.ExtendedDeviceListOptions) (result []model.ExtendedDevice, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -49,7 +62,6 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 		if options.Owner == "" {
 			options.Owner = jwtToken.GetUserId()
 		}
-		ctx, _ := getTimeoutContext()
 		options.Ids, err = this.db.DeviceLocalIdsToIds(ctx, options.Owner, options.LocalIds)
 		if err != nil {
 			return result, total, err, http.StatusInternalServerError
@@ -96,12 +108,14 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 
 	options.Ids = pureIds
 
-	ctx, _ := getTimeoutContext()
+	//skip full device-types if the projection does not need them
+	fullDt := options.FullDt && model.FieldSelected(options.Fields, "device_type")
+
 	devices, total, err := this.db.ListDevices(ctx, options.ToDeviceListOptions(), true)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
 	}
-	devices, err = this.applyDefaultDeviceAttributesToList(devices)
+	devices, err = this.applyDefaultDeviceAttributesToList(ctx, devices)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
 	}
@@ -138,7 +152,7 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 			resultSize = resultSize + 1
 		}
 	}
-	result = make([]models.ExtendedDevice, resultSize)
+	result = make([]model.ExtendedDevice, resultSize)
 
 	//transform db devices to extended devices; use go-routines; applies modified ids
 	wg := sync.WaitGroup{}
@@ -157,7 +171,7 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 				defer wg.Done()
 				d.Id = rawId
 				_, modifier := idmodifier.SplitModifier(rawId)
-				modDevice, modErr, modErrCode := this.modifyDevice(d.Device, modifier)
+				modDevice, modErr, modErrCode := this.modifyDevice(ctx, d.Device, modifier)
 				if modErr != nil {
 					mux.Lock()
 					defer mux.Unlock()
@@ -168,7 +182,7 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 					return
 				}
 				d.Device = modDevice
-				extendedDevice, extendErr := this.extendDevice(token, d, deviceTypes, options.FullDt)
+				extendedDevice, extendErr := this.extendDevice(token, d, deviceTypes, fullDt)
 				if extendErr != nil {
 					mux.Lock()
 					defer mux.Unlock()
@@ -190,7 +204,9 @@ func (this *Controller) ListExtendedDevices(token string, options model.Extended
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ListDevices(token string, options model.DeviceListOptions) (result []models.Device, err error, errCode int) {
+func (this *Controller) ListDevices(ctx context.Context, token string, options model.DeviceListOptions) (result []models.Device, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -246,12 +262,11 @@ func (this *Controller) ListDevices(token string, options model.DeviceListOption
 
 	options.Ids = pureIds
 
-	ctx, _ := getTimeoutContext()
 	devices, _, err := this.db.ListDevices(ctx, options, false)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
-	devices, err = this.applyDefaultDeviceAttributesToList(devices)
+	devices, err = this.applyDefaultDeviceAttributesToList(ctx, devices)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -265,7 +280,7 @@ func (this *Controller) ListDevices(token string, options model.DeviceListOption
 		for _, id := range pureIdToRawIds[device.Id] {
 			device.Id = id
 			_, modifier := idmodifier.SplitModifier(id)
-			device.Device, err, errCode = this.modifyDevice(device.Device, modifier)
+			device.Device, err, errCode = this.modifyDevice(ctx, device.Device, modifier)
 			if err != nil {
 				return result, err, errCode
 			}
@@ -275,8 +290,8 @@ func (this *Controller) ListDevices(token string, options model.DeviceListOption
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ReadDevice(id string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
-	temp, err, errCode := this.readDevice(id, true)
+func (this *Controller) ReadDevice(ctx context.Context, id string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
+	temp, err, errCode := this.readDevice(ctx, id, true)
 	if err != nil {
 		return result, err, errCode
 	}
@@ -291,8 +306,16 @@ func (this *Controller) ReadDevice(id string, token string, action model.AuthAct
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ReadExtendedDevice(id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
-	temp, err, errCode := this.readDevice(id, true)
+func (this *Controller) ReadExtendedDevice(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+	temp, err, errCode := this.ReadExtendedDeviceWithConfiguration(ctx, id, token, action, fullDt)
+	return temp.ExtendedDevice, err, errCode
+}
+
+// ReadExtendedDeviceWithConfiguration is ReadExtendedDevice with the device configuration (ref SetDeviceConfiguration)
+func (this *Controller) ReadExtendedDeviceWithConfiguration(ctx context.Context, id string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	temp, err, errCode := this.readDevice(ctx, id, true)
 	if err != nil {
 		return result, err, errCode
 	}
@@ -303,7 +326,6 @@ func (this *Controller) ReadExtendedDevice(id string, token string, action model
 	if !ok {
 		return result, errors.New("access denied"), http.StatusForbidden
 	}
-	ctx, _ := getTimeoutContext()
 	pureDtId, _ := idmodifier.SplitModifier(temp.DeviceTypeId)
 	dt, _, _ := this.db.GetDeviceType(ctx, pureDtId)
 	result, err = this.extendDevice(token, temp, []models.DeviceType{dt}, fullDt)
@@ -313,8 +335,9 @@ func (this *Controller) ReadExtendedDevice(id string, token string, action model
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) readDevice(id string, addDefaultAttributes bool) (result model.DeviceWithConnectionState, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) readDevice(ctx context.Context, id string, addDefaultAttributes bool) (result model.DeviceWithConnectionState, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	pureId, modifier := idmodifier.SplitModifier(id)
 	device, exists, err := this.db.GetDevice(ctx, pureId)
 	if err != nil {
@@ -325,13 +348,13 @@ func (this *Controller) readDevice(id string, addDefaultAttributes bool) (result
 	}
 	device.Id = id
 	if addDefaultAttributes {
-		device, err = this.applyDefaultDeviceAttributes(device)
+		device, err = this.applyDefaultDeviceAttributes(ctx, device)
 		if err != nil {
 			return result, err, http.StatusInternalServerError
 		}
 	}
 	if modifier != nil && len(modifier) > 0 {
-		device.Device, err, errCode = this.modifyDevice(device.Device, modifier)
+		device.Device, err, errCode = this.modifyDevice(ctx, device.Device, modifier)
 		if err != nil {
 			return result, err, errCode
 		}
@@ -339,7 +362,7 @@ func (this *Controller) readDevice(id string, addDefaultAttributes bool) (result
 	return device, nil, http.StatusOK
 }
 
-func (this *Controller) extendDevice(token string, device model.DeviceWithConnectionState, deviceTypes []models.DeviceType, fullDt bool) (result models.ExtendedDevice, err error) {
+func (this *Controller) extendDevice(token string, device model.DeviceWithConnectionState, deviceTypes []models.DeviceType, fullDt bool) (result model.ExtendedDevice, err error) {
 	var dtp *models.DeviceType
 	deviceTypeName := ""
 	for _, dt := range deviceTypes {
@@ -350,7 +373,7 @@ func (this *Controller) extendDevice(token string, device model.DeviceWithConnec
 				selectedDt.Id = device.DeviceTypeId //modifyDeviceType() does not modify id
 				selectedDt, err, _ = this.modifyDeviceType(selectedDt, modifier)
 				if err != nil {
-					return models.ExtendedDevice{}, err
+					return model.ExtendedDevice{}, err
 				}
 			}
 			if fullDt {
@@ -370,10 +393,10 @@ func (this *Controller) extendDevice(token string, device model.DeviceWithConnec
 	pureDeviceId, _ := idmodifier.SplitModifier(device.Id)
 	computedPermissionList, err, _ := this.permissionsV2Client.ListComputedPermissions(token, this.config.DeviceTopic, []string{pureDeviceId})
 	if err != nil {
-		return models.ExtendedDevice{}, err
+		return model.ExtendedDevice{}, err
 	}
 	if len(computedPermissionList) != 1 {
-		return models.ExtendedDevice{}, errors.New("unexpected response from permissions-v2 ListComputedPermissions()")
+		return model.ExtendedDevice{}, errors.New("unexpected response from permissions-v2 ListComputedPermissions()")
 	}
 	permissions := models.Permissions{
 		Read:         computedPermissionList[0].Read,
@@ -382,19 +405,23 @@ func (this *Controller) extendDevice(token string, device model.DeviceWithConnec
 		Administrate: computedPermissionList[0].Administrate,
 	}
 
-	return models.ExtendedDevice{
-		Device:          device.Device,
-		ConnectionState: device.ConnectionState,
-		DisplayName:     getDeviceDisplayName(device.Device),
-		DeviceTypeName:  deviceTypeName,
-		Shared:          requestingUser != device.OwnerId,
-		Permissions:     permissions,
-		DeviceType:      dtp,
+	return model.ExtendedDevice{
+		ExtendedDevice: models.ExtendedDevice{
+			Device:          device.Device,
+			ConnectionState: device.ConnectionState,
+			DisplayName:     getDeviceDisplayName(device.Device),
+			DeviceTypeName:  deviceTypeName,
+			Shared:          requestingUser != device.OwnerId,
+			Permissions:     permissions,
+			DeviceType:      dtp,
+		},
+		Configuration: device.Configuration,
 	}, err
 }
 
-func (this *Controller) ReadDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ReadDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction) (result models.Device, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	device, exists, err := this.db.GetDeviceByLocalId(ctx, ownerId, localId)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -402,7 +429,7 @@ func (this *Controller) ReadDeviceByLocalId(ownerId string, localId string, toke
 	if !exists {
 		return result, errors.New("not found"), http.StatusNotFound
 	}
-	device, err = this.applyDefaultDeviceAttributes(device)
+	device, err = this.applyDefaultDeviceAttributes(ctx, device)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -416,8 +443,15 @@ func (this *Controller) ReadDeviceByLocalId(ownerId string, localId string, toke
 	return device.Device, nil, http.StatusOK
 }
 
-func (this *Controller) ReadExtendedDeviceByLocalId(ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ReadExtendedDeviceByLocalId(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result models.ExtendedDevice, err error, errCode int) {
+	temp, err, errCode := this.ReadExtendedDeviceByLocalIdWithConfiguration(ctx, ownerId, localId, token, action, fullDt)
+	return temp.ExtendedDevice, err, errCode
+}
+
+// ReadExtendedDeviceByLocalIdWithConfiguration is ReadExtendedDeviceByLocalId with the device configuration (ref SetDeviceConfiguration)
+func (this *Controller) ReadExtendedDeviceByLocalIdWithConfiguration(ctx context.Context, ownerId string, localId string, token string, action model.AuthAction, fullDt bool) (result model.ExtendedDevice, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	device, exists, err := this.db.GetDeviceByLocalId(ctx, ownerId, localId)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -425,7 +459,7 @@ func (this *Controller) ReadExtendedDeviceByLocalId(ownerId string, localId stri
 	if !exists {
 		return result, errors.New("not found"), http.StatusNotFound
 	}
-	device, err = this.applyDefaultDeviceAttributes(device)
+	device, err = this.applyDefaultDeviceAttributes(ctx, device)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
@@ -445,7 +479,7 @@ func (this *Controller) ReadExtendedDeviceByLocalId(ownerId string, localId stri
 	return result, nil, http.StatusOK
 }
 
-const DisplayNameAttributeName = "shared/nickname"
+const DisplayNameAttributeName = idmodifier.DisplayNameAttributeName
 
 func ValidateDeviceName(device models.Device) (err error) {
 	if device.Name == "" {
@@ -463,7 +497,9 @@ func ValidateDeviceName(device models.Device) (err error) {
 	return nil
 }
 
-func (this *Controller) ValidateDevice(token string, device models.Device) (err error, code int) {
+func (this *Controller) ValidateDevice(ctx context.Context, token string, device models.Device) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if device.Id == "" {
 		return errors.New("missing device id"), http.StatusBadRequest
 	}
@@ -478,8 +514,6 @@ func (this *Controller) ValidateDevice(token string, device models.Device) (err
 		return errors.New("missing device type id"), http.StatusBadRequest
 	}
 
-	ctx, _ := getTimeoutContext()
-
 	original, exists, err := this.db.GetDevice(ctx, device.Id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -539,27 +573,26 @@ func (this *Controller) ValidateDevice(token string, device models.Device) (err
 
 	if contains(constraints, model.SenergyConnectorLocalIdConstraint) {
 		if strings.ContainsAny(device.LocalId, "+#/") {
-			return errors.New("device local id may not contain any +#/"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceInvalidLocalId, "local_id", errors.New("device local id may not contain any +#/")), http.StatusBadRequest
 		}
 	}
 
 	//local ids are globally unique
-	ctx, _ = getTimeoutContext()
 	d, ok, err := this.db.GetDeviceByLocalId(ctx, device.OwnerId, device.LocalId)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
 	if ok && d.Id != device.Id {
 		if !this.config.LocalIdUniqueForOwner {
-			return errors.New("local id should be empty or globally unique"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceLocalIdConflict, "local_id", errors.New("local id should be empty or globally unique")), http.StatusBadRequest
 		}
-		return errors.New("local id should be empty or for the owner unique"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceLocalIdConflict, "local_id", errors.New("local id should be empty or for the owner unique")), http.StatusBadRequest
 	}
 
 	return nil, http.StatusOK
 }
 
-func (this *Controller) CreateDevice(token string, device models.Device) (result models.Device, err error, code int) {
+func (this *Controller) CreateDevice(ctx context.Context, token string, device models.Device) (result models.Device, err error, code int) {
 	if device.Id != "" {
 		return result, errors.New("device id already set"), http.StatusBadRequest
 	}
@@ -579,17 +612,17 @@ func (this *Controller) CreateDevice(token string, device models.Device) (result
 		device.OwnerId = jwtToken.GetUserId()
 	}
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateDevice(token, device)
+		err, code = this.ValidateDevice(ctx, token, device)
 		if err != nil {
 			this.logger.Warn("device validation failed with error: " + err.Error())
 			return device, err, code
 		}
 	}
 
-	return this.setDevice(device)
+	return this.setDevice(ctx, device)
 }
 
-func (this *Controller) SetDevice(token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int) {
+func (this *Controller) SetDevice(ctx context.Context, token string, device models.Device, options model.DeviceUpdateOptions) (result models.Device, err error, code int) {
 	if device.Id == "" {
 		return result, errors.New("missing device id"), http.StatusBadRequest
 	}
@@ -609,7 +642,7 @@ func (this *Controller) SetDevice(token string, device models.Device, options mo
 
 	var original model.DeviceWithConnectionState
 	var exists bool
-	original, err, code = this.readDevice(device.Id, false)
+	original, err, code = this.readDevice(ctx, device.Id, false)
 	if err != nil && code != http.StatusNotFound {
 		return device, err, code
 	}
@@ -659,14 +692,14 @@ func (this *Controller) SetDevice(token string, device models.Device, options mo
 	}
 
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateDevice(token, device)
+		err, code = this.ValidateDevice(ctx, token, device)
 		if err != nil {
 			this.logger.Warn("device validation failed with error: " + err.Error())
 			return device, err, code
 		}
 	}
 
-	device, err, code = this.setDevice(device)
+	device, err, code = this.setDevice(ctx, device)
 	if err != nil {
 		return device, err, code
 	}
@@ -674,25 +707,34 @@ func (this *Controller) SetDevice(token string, device models.Device, options mo
 	return device, nil, http.StatusOK
 }
 
-func (this *Controller) setDevice(device models.Device) (result models.Device, err error, code int) {
+func (this *Controller) setDevice(ctx context.Context, device models.Device) (result models.Device, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	//update hub about changed device.local_id
 	this.config.GetLogger().Debug("create/update device", "id", device.Id, "name", device.Name)
-	ctx, _ := getTimeoutContext()
 	old, exists, err := this.db.GetDevice(ctx, device.Id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 
 	connectionState := models.ConnectionStateUnknown
+	var configuration []model.DeviceConfigurationValue
 	if exists {
 		connectionState = old.ConnectionState
+		//configuration values reference services of the device-type
+		if old.DeviceTypeId == device.DeviceTypeId {
+			configuration, err = this.pruneDeviceConfiguration(ctx, device.DeviceTypeId, old.Configuration)
+			if err != nil {
+				return result, err, http.StatusInternalServerError
+			}
+		}
 	}
 
 	//save device
-	ctx, _ = getTimeoutContext()
 	err = this.db.SetDevice(ctx, model.DeviceWithConnectionState{
 		Device:          device,
 		ConnectionState: connectionState,
+		Configuration:   configuration,
 	}, this.setDeviceSyncHandler)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -702,18 +744,19 @@ func (this *Controller) setDevice(device models.Device) (result models.Device, e
 }
 
 func (this *Controller) setDeviceSyncHandler(oldDevice model.DeviceWithConnectionState, device model.DeviceWithConnectionState) (err error) {
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
 	err = this.EnsureInitialRights(this.config.DeviceTopic, device.Id, device.OwnerId)
 	if err != nil {
 		return fmt.Errorf("unable to ensure initial device permissions: %w", err)
 	}
 
-	err = this.EnsureGeneratedDeviceGroup(oldDevice.Device, device.Device)
+	err = this.EnsureGeneratedDeviceGroup(ctx, oldDevice.Device, device.Device)
 	if err != nil {
 		return fmt.Errorf("unable to generate device group: %w", err)
 	}
 
 	//ensure that changed device-local-ids are mirrored in hubs
-	ctx, _ := getTimeoutContext()
 	hubs, err := this.db.GetHubsByDeviceId(ctx, device.Id)
 	if err != nil {
 		return fmt.Errorf("unable to get hubs by device id to ensure changed device-local-ids are mirrored in hubs: %w", err)
@@ -729,21 +772,22 @@ func (this *Controller) setDeviceSyncHandler(oldDevice model.DeviceWithConnectio
 				hub.DeviceLocalIds = append(hub.DeviceLocalIds, d.LocalId)
 			}
 			hub.Hash = ""
-			err = this.setHub(hub)
+			err = this.setHub(ctx, hub)
 			if err != nil {
 				return fmt.Errorf("unable to update hub to ensure that changed device-local-ids are mirrored in hubs: %w", err)
 			}
 		}
 	}
-	err = this.publisher.PublishDevice(device.Device)
+	err = this.publisher.PublishDevice(device.Device, device.Configuration)
 	if err != nil {
 		return fmt.Errorf("unable to send device update to kafka: %w", err)
 	}
 	return nil
 }
 
-func (this *Controller) DeleteDevice(token string, id string) (error, int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) DeleteDevice(ctx context.Context, token string, id string) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	_, exists, err := this.db.GetDevice(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -758,7 +802,7 @@ func (this *Controller) DeleteDevice(token string, id string) (error, int) {
 	if !ok {
 		return errors.New("access denied"), http.StatusForbidden
 	}
-	err = this.deleteDevice(id)
+	err = this.deleteDevice(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -766,15 +810,17 @@ func (this *Controller) DeleteDevice(token string, id string) (error, int) {
 }
 
 func (this *Controller) deleteDeviceSyncHandler(old model.DeviceWithConnectionState) (err error) {
-	err = this.removeDeviceFromGraphs(old.Id)
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
+	err = this.removeDeviceFromGraphs(ctx, old.Id)
 	if err != nil {
 		return err
 	}
-	err = this.resetHubsForDeviceUpdate(old.Device)
+	err = this.resetHubsForDeviceUpdate(ctx, old.Device)
 	if err != nil {
 		return err
 	}
-	err = this.RemoveGeneratedDeviceGroup(old.Id, old.OwnerId)
+	err = this.RemoveGeneratedDeviceGroup(ctx, old.Id, old.OwnerId)
 	if err != nil {
 		return err
 	}
@@ -789,8 +835,9 @@ func (this *Controller) deleteDeviceSyncHandler(old model.DeviceWithConnectionSt
 	return nil
 }
 
-func (this *Controller) deleteDevice(id string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteDevice(ctx context.Context, id string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err := this.db.RemoveDevice(ctx, id, this.deleteDeviceSyncHandler)
 	if err != nil {
 		return err
@@ -798,8 +845,9 @@ func (this *Controller) deleteDevice(id string) error {
 	return nil
 }
 
-func (this *Controller) resetHubsForDeviceUpdate(old models.Device) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) resetHubsForDeviceUpdate(ctx context.Context, old models.Device) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	hubs, err := this.db.GetHubsByDeviceId(ctx, old.Id)
 	if err != nil {
 		return err
@@ -827,7 +875,7 @@ func (this *Controller) resetHubsForDeviceUpdate(old models.Device) error {
 			hub.DeviceLocalIds = append(hub.DeviceLocalIds, d.LocalId)
 		}
 		hub.Hash = ""
-		err = this.setHub(hub)
+		err = this.setHub(ctx, hub)
 		if err != nil {
 			return err
 		}
@@ -861,7 +909,9 @@ func updateSameOriginAttributes(attributes []models.Attribute, update []models.A
 	return result
 }
 
-func (this *Controller) SetDeviceConnectionState(token string, id string, connected bool) (error, int) {
+func (this *Controller) SetDeviceConnectionState(ctx context.Context, token string, id string, connected bool) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -879,7 +929,6 @@ func (this *Controller) SetDeviceConnectionState(token string, id string, connec
 	if connected {
 		state = models.ConnectionStateOnline
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.SetDeviceConnectionState(ctx, id, state)
 	if err != nil {
 		return err, http.StatusInternalServerError
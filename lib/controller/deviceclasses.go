@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -25,8 +26,9 @@ import (
 	"strings"
 )
 
-func (this *Controller) ListDeviceClasses(listOptions model.DeviceClassListOptions) (result []models.DeviceClass, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListDeviceClasses(ctx context.Context, listOptions model.DeviceClassListOptions) (result []models.DeviceClass, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListDeviceClasses(ctx, listOptions)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -38,7 +40,7 @@ func (this *Controller) setDeviceClassSyncHandler(c models.DeviceClass) error {
 	return this.publisher.PublishDeviceClass(c)
 }
 
-func (this *Controller) SetDeviceClass(token string, class models.DeviceClass) (result models.DeviceClass, err error, code int) {
+func (this *Controller) SetDeviceClass(ctx context.Context, token string, class models.DeviceClass) (result models.DeviceClass, err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -50,20 +52,21 @@ func (this *Controller) SetDeviceClass(token string, class models.DeviceClass) (
 	//ensure ids
 	class.GenerateId()
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateDeviceClass(class)
+		err, code = this.ValidateDeviceClass(ctx, class)
 		if err != nil {
 			return result, err, code
 		}
 	}
-	err = this.setDeviceClass(class)
+	err = this.setDeviceClass(ctx, class)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	return class, nil, http.StatusOK
 }
 
-func (this *Controller) setDeviceClass(class models.DeviceClass) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setDeviceClass(ctx context.Context, class models.DeviceClass) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = this.db.SetDeviceClass(ctx, class, this.setDeviceClassSyncHandler)
 	return err
 }
@@ -72,7 +75,9 @@ func (this *Controller) deleteDeviceClassSyncHandler(c models.DeviceClass) error
 	return this.publisher.PublishDeviceClassDelete(c.Id)
 }
 
-func (this *Controller) DeleteDeviceClass(token string, id string) (error, int) {
+func (this *Controller) DeleteDeviceClass(ctx context.Context, token string, id string) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -80,11 +85,10 @@ func (this *Controller) DeleteDeviceClass(token string, id string) (error, int)
 	if !jwtToken.IsAdmin() {
 		return errors.New("token is not an admin"), http.StatusUnauthorized
 	}
-	err, code := this.ValidateDeviceClassDelete(id)
+	err, code := this.ValidateDeviceClassDelete(ctx, id)
 	if err != nil {
 		return err, code
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.RemoveDeviceClass(ctx, id, this.deleteDeviceClassSyncHandler)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -92,9 +96,10 @@ func (this *Controller) DeleteDeviceClass(token string, id string) (error, int)
 	return nil, http.StatusOK
 }
 
-func (this *Controller) GetDeviceClasses() (result []models.DeviceClass, err error, code int) {
+func (this *Controller) GetDeviceClasses(ctx context.Context) (result []models.DeviceClass, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllDeviceClasses(ctx)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -102,8 +107,9 @@ func (this *Controller) GetDeviceClasses() (result []models.DeviceClass, err err
 	return
 }
 
-func (this *Controller) GetDeviceClass(id string) (result models.DeviceClass, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetDeviceClass(ctx context.Context, id string) (result models.DeviceClass, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetDeviceClass(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -114,7 +120,7 @@ func (this *Controller) GetDeviceClass(id string) (result models.DeviceClass, er
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ValidateDeviceClass(deviceClass models.DeviceClass) (err error, code int) {
+func (this *Controller) ValidateDeviceClass(ctx context.Context, deviceClass models.DeviceClass) (err error, code int) {
 	if deviceClass.Id == "" {
 		return errors.New("missing device class id"), http.StatusBadRequest
 	}
@@ -127,9 +133,10 @@ func (this *Controller) ValidateDeviceClass(deviceClass models.DeviceClass) (err
 	return nil, http.StatusOK
 }
 
-func (this *Controller) GetDeviceClassesWithControllingFunctions() (result []models.DeviceClass, err error, code int) {
+func (this *Controller) GetDeviceClassesWithControllingFunctions(ctx context.Context) (result []models.DeviceClass, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllDeviceClassesUsedWithControllingFunctions(ctx)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -137,8 +144,9 @@ func (this *Controller) GetDeviceClassesWithControllingFunctions() (result []mod
 	return
 }
 
-func (this *Controller) ValidateDeviceClassDelete(id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateDeviceClassDelete(ctx context.Context, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	isUsed, where, err := this.db.DeviceClassIsUsed(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -0,0 +1,253 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/payload"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/SENERGY-Platform/service-commons/pkg/util"
+)
+
+// GetDeviceConfiguration returns the configuration values of the device
+func (this *Controller) GetDeviceConfiguration(ctx context.Context, token string, id string) (result []model.DeviceConfigurationValue, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	//permission is checked first, so that the response does not reveal the existence of foreign devices
+	pureId, _ := idmodifier.SplitModifier(id)
+	ok, err, _ := this.permissionsV2Client.CheckPermission(token, this.config.DeviceTopic, pureId, client.Read)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !ok {
+		return result, errors.New("access denied"), http.StatusForbidden
+	}
+	device, err, code := this.readDevice(ctx, id, false)
+	if err != nil {
+		return result, err, code
+	}
+	result = device.Configuration
+	if result == nil {
+		result = []model.DeviceConfigurationValue{}
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetDeviceConfiguration replaces the configuration values of the device
+// each value must reference a configurable content-variable (leaf, input, not void) of the device-type and match its type and characteristic constraints
+func (this *Controller) SetDeviceConfiguration(ctx context.Context, token string, id string, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	err = preventIdModifier(id)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusUnauthorized
+	}
+	if !jwtToken.IsAdmin() {
+		ok, err, _ := this.permissionsV2Client.CheckPermission(token, this.config.DeviceTopic, id, client.Write)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		if !ok {
+			return result, errors.New("access denied"), http.StatusForbidden
+		}
+	}
+	return this.setDeviceConfiguration(ctx, id, configuration)
+}
+
+// setDeviceConfiguration validates and stores the configuration values without permission check (ref SetDeviceConfiguration and Import)
+func (this *Controller) setDeviceConfiguration(ctx context.Context, id string, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error, code int) {
+	device, exists, err := this.db.GetDevice(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("device %w", model.ErrNotFound), http.StatusNotFound
+	}
+	pureDtId, _ := idmodifier.SplitModifier(device.DeviceTypeId)
+	dt, exists, err := this.db.GetDeviceType(ctx, pureDtId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("device-type %v %w", device.DeviceTypeId, model.ErrNotFound), http.StatusNotFound
+	}
+	if configuration == nil {
+		configuration = []model.DeviceConfigurationValue{}
+	}
+	err, code = this.validateDeviceConfiguration(ctx, dt, configuration)
+	if err != nil {
+		return result, err, code
+	}
+	device.Configuration = configuration
+	err = this.db.SetDevice(ctx, device, this.setDeviceSyncHandler)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return configuration, nil, http.StatusOK
+}
+
+func (this *Controller) validateDeviceConfiguration(ctx context.Context, dt models.DeviceType, configuration []model.DeviceConfigurationValue) (err error, code int) {
+	configurables := map[string]map[string]models.ContentVariable{}
+	for _, service := range dt.Services {
+		configurables[service.Id] = map[string]models.ContentVariable{}
+		for _, content := range service.Inputs {
+			addConfigurableVariables(configurables[service.Id], content.ContentVariable, "")
+		}
+	}
+	characteristics := map[string]models.Characteristic{}
+	patterns := map[string]model.CharacteristicPattern{}
+	known := map[string]bool{}
+	for i, value := range configuration {
+		field := fmt.Sprintf("[%v]", i)
+		variables, ok := configurables[value.ServiceId]
+		if !ok {
+			return model.NewFieldError(model.ErrDeviceConfigurationUnknownService, field+".service_id", fmt.Errorf("unknown service %v in device-type %v", value.ServiceId, dt.Id)), http.StatusBadRequest
+		}
+		variable, ok := variables[value.Path]
+		if !ok {
+			return model.NewFieldError(model.ErrDeviceConfigurationUnknownPath, field+".path", fmt.Errorf("%v is no configurable content-variable of service %v", value.Path, value.ServiceId)), http.StatusBadRequest
+		}
+		key := value.ServiceId + "\n" + value.Path
+		if known[key] {
+			return model.NewFieldError(model.ErrDeviceConfigurationDuplicate, field, fmt.Errorf("duplicate value for %v in service %v", value.Path, value.ServiceId)), http.StatusBadRequest
+		}
+		known[key] = true
+
+		if _, ok := characteristics[variable.CharacteristicId]; variable.CharacteristicId != "" && !ok {
+			characteristic, exists, err := this.db.GetCharacteristic(ctx, variable.CharacteristicId)
+			if err != nil {
+				return err, http.StatusInternalServerError
+			}
+			if exists {
+				characteristics[characteristic.Id] = characteristic
+			}
+			pattern, exists, err := this.db.GetCharacteristicPattern(ctx, variable.CharacteristicId)
+			if err != nil {
+				return err, http.StatusInternalServerError
+			}
+			if exists {
+				patterns[pattern.CharacteristicId] = pattern
+			}
+		}
+		normalized, err := normalizeCharacteristicValue(value.Value)
+		if err != nil {
+			return model.NewFieldError(model.ErrDeviceConfigurationInvalidValue, field+".value", err), http.StatusBadRequest
+		}
+		problems := payload.Validate(variable, normalized, false, characteristics, patterns)
+		if len(problems) > 0 {
+			details := []string{}
+			for _, problem := range problems {
+				details = append(details, problem.Detail)
+			}
+			return model.NewFieldError(model.ErrDeviceConfigurationInvalidValue, field+".value", fmt.Errorf("invalid value for %v: %v", value.Path, strings.Join(details, "; "))), http.StatusBadRequest
+		}
+	}
+	return nil, http.StatusOK
+}
+
+// pruneDeviceConfiguration removes configuration values, that are no longer valid for the (changed) device-type
+func (this *Controller) pruneDeviceConfiguration(ctx context.Context, deviceTypeId string, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error) {
+	if len(configuration) == 0 {
+		return configuration, nil
+	}
+	pureDtId, _ := idmodifier.SplitModifier(deviceTypeId)
+	dt, exists, err := this.db.GetDeviceType(ctx, pureDtId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return this.pruneDeviceConfigurationByDeviceType(ctx, dt, configuration)
+}
+
+func (this *Controller) pruneDeviceConfigurationByDeviceType(ctx context.Context, dt models.DeviceType, configuration []model.DeviceConfigurationValue) (result []model.DeviceConfigurationValue, err error) {
+	result = []model.DeviceConfigurationValue{}
+	for _, value := range configuration {
+		err, code := this.validateDeviceConfiguration(ctx, dt, []model.DeviceConfigurationValue{value})
+		if err != nil && code != http.StatusBadRequest {
+			return nil, err
+		}
+		if err == nil {
+			result = append(result, value)
+		}
+	}
+	return result, nil
+}
+
+// pruneDeviceConfigurationsOfDeviceType updates devices of the device-type, whose configuration values are no longer valid
+// is called by the device-type sync handler; the devices are paged and limited by their own deadline instead of configuration.TimeoutSync
+func (this *Controller) pruneDeviceConfigurationsOfDeviceType(dt models.DeviceType) error {
+	ctx, cancel := this.getOperationTimeoutContext(context.Background(), configuration.TimeoutDeviceConfigPrune)
+	defer cancel()
+	for device, err := range util.IterBatch(500, func(limit int64, offset int64) ([]model.DeviceWithConnectionState, error) {
+		devices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{DeviceTypeIds: []string{dt.Id}, Limit: limit, Offset: offset, SortBy: "id.asc"}, false)
+		return devices, err
+	}) {
+		if err != nil {
+			return err
+		}
+		if len(device.Configuration) == 0 {
+			continue
+		}
+		pruned, err := this.pruneDeviceConfigurationByDeviceType(ctx, dt, device.Configuration)
+		if err != nil {
+			return err
+		}
+		if len(pruned) == len(device.Configuration) {
+			continue
+		}
+		this.config.GetLogger().Info("remove invalid device configuration values after device-type update", "device", device.Id, "removed", len(device.Configuration)-len(pruned))
+		device.Configuration = pruned
+		err = this.db.SetDevice(ctx, device, this.setDeviceSyncHandler)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addConfigurableVariables adds the configurable leaf variables by path (ref model.Configurable.Path)
+func addConfigurableVariables(result map[string]models.ContentVariable, variable models.ContentVariable, parentPath string) {
+	path := variable.Name
+	if parentPath != "" {
+		path = parentPath + "." + variable.Name
+	}
+	if len(variable.SubContentVariables) == 0 {
+		if !variable.IsVoid {
+			result[path] = variable
+		}
+		return
+	}
+	for _, sub := range variable.SubContentVariables {
+		addConfigurableVariables(result, sub, path)
+	}
+}
@@ -33,7 +33,9 @@ import (
 
 const FilterDevicesOfGroupByAccess = true
 
-func (this *Controller) ListDeviceGroups(token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int) {
+func (this *Controller) ListDeviceGroups(ctx context.Context, token string, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -72,7 +74,6 @@ func (this *Controller) ListDeviceGroups(token string, options model.DeviceGroup
 	}
 	options.Ids = ids
 
-	ctx, _ := getTimeoutContext()
 	result, total, err = this.db.ListDeviceGroups(ctx, options)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -80,7 +81,7 @@ func (this *Controller) ListDeviceGroups(token string, options model.DeviceGroup
 
 	if options.FilterGenericDuplicateCriteria {
 		for i, group := range result {
-			group, err = DeviceGroupFilterGenericDuplicateCriteria(group, this.db)
+			group, err = DeviceGroupFilterGenericDuplicateCriteria(ctx, group, this.db)
 			if err != nil {
 				return result, total, err, http.StatusInternalServerError
 			}
@@ -91,8 +92,9 @@ func (this *Controller) ListDeviceGroups(token string, options model.DeviceGroup
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ReadDeviceGroup(id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ReadDeviceGroup(ctx context.Context, id string, token string, filterGenericDuplicateCriteria bool) (result models.DeviceGroup, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetDeviceGroup(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -112,7 +114,7 @@ func (this *Controller) ReadDeviceGroup(id string, token string, filterGenericDu
 
 	//ref https://bitnify.atlassian.net/browse/SNRGY-3027
 	if filterGenericDuplicateCriteria {
-		result, err = DeviceGroupFilterGenericDuplicateCriteria(result, this.db)
+		result, err = DeviceGroupFilterGenericDuplicateCriteria(ctx, result, this.db)
 		if err != nil {
 			result = models.DeviceGroup{}
 			return result, err, http.StatusInternalServerError
@@ -164,14 +166,15 @@ func (this *Controller) checkAccessToDevicesOfGroup(token string, group models.D
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateDeviceGroup(token string, group models.DeviceGroup) (err error, code int) {
+func (this *Controller) ValidateDeviceGroup(ctx context.Context, token string, group models.DeviceGroup) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if group.Id == "" {
 		return errors.New("missing device-group id"), http.StatusBadRequest
 	}
 	if group.Name == "" {
 		return errors.New("missing device-group name"), http.StatusBadRequest
 	}
-	ctx, _ := getTimeoutContext()
 	old, exists, err := this.db.GetDeviceGroup(ctx, group.Id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -186,10 +189,10 @@ func (this *Controller) ValidateDeviceGroup(token string, group models.DeviceGro
 	if err != nil {
 		return err, code
 	}
-	return this.ValidateDeviceGroupSelection(group.Criteria, group.DeviceIds)
+	return this.ValidateDeviceGroupSelection(ctx, group.Criteria, group.DeviceIds)
 }
 
-func (this *Controller) ValidateDeviceGroupSelection(criteria []models.DeviceGroupFilterCriteria, devices []string) (error, int) {
+func (this *Controller) ValidateDeviceGroupSelection(ctx context.Context, criteria []models.DeviceGroupFilterCriteria, devices []string) (error, int) {
 	deviceCache := map[string]models.Device{}
 	deviceTypeCache := map[string]models.DeviceType{}
 	deviceUsageCount := map[string]int{}
@@ -201,7 +204,7 @@ func (this *Controller) ValidateDeviceGroupSelection(criteria []models.DeviceGro
 			}
 			deviceUsedInMapping[deviceId] = true
 			deviceUsageCount[deviceId] = deviceUsageCount[deviceId] + 1
-			err, code := this.selectionMatchesCriteria(&deviceCache, &deviceTypeCache, c, deviceId)
+			err, code := this.selectionMatchesCriteria(ctx, &deviceCache, &deviceTypeCache, c, deviceId)
 			if err != nil {
 				return err, code
 			}
@@ -216,7 +219,7 @@ type AspectNodeProvider interface {
 
 // DeviceGroupFilterGenericDuplicateCriteria removes criteria without aspect, that are already present with an aspect
 // ref: https://bitnify.atlassian.net/browse/SNRGY-3027
-func DeviceGroupFilterGenericDuplicateCriteria(dg models.DeviceGroup, aspectNodeProvider AspectNodeProvider) (result models.DeviceGroup, err error) {
+func DeviceGroupFilterGenericDuplicateCriteria(ctx context.Context, dg models.DeviceGroup, aspectNodeProvider AspectNodeProvider) (result models.DeviceGroup, err error) {
 	result = dg
 
 	//get used aspect ids
@@ -228,7 +231,7 @@ func DeviceGroupFilterGenericDuplicateCriteria(dg models.DeviceGroup, aspectNode
 	}
 
 	//get used aspect nodes
-	aspectNodes, err := aspectNodeProvider.ListAspectNodesByIdList(context.Background(), aspectIds)
+	aspectNodes, err := aspectNodeProvider.ListAspectNodesByIdList(ctx, aspectIds)
 	if err != nil {
 		return result, err
 	}
@@ -274,13 +277,14 @@ func DeviceGroupFilterGenericDuplicateCriteria(dg models.DeviceGroup, aspectNode
 	return result, nil
 }
 
-func (this *Controller) selectionMatchesCriteria(
+func (this *Controller) selectionMatchesCriteria(ctx context.Context,
 	dcache *map[string]models.Device,
 	dtcache *map[string]models.DeviceType,
 	criteria models.DeviceGroupFilterCriteria,
 	deviceId string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 
-	ctx, _ := getTimeoutContext()
 	var exists bool
 
 	var aspectNode models.AspectNode
@@ -290,13 +294,13 @@ func (this *Controller) selectionMatchesCriteria(
 			return err, http.StatusInternalServerError
 		}
 		if !exists {
-			return errors.New("unknown aspect-node-id: " + criteria.AspectId), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceGroupUnknownAspect, "aspect_id", errors.New("unknown aspect-node-id: "+criteria.AspectId)), http.StatusBadRequest
 		}
 	}
 
 	device, ok := (*dcache)[deviceId]
 	if !ok {
-		temp, err, code := this.readDevice(deviceId, true)
+		temp, err, code := this.readDevice(ctx, deviceId, true)
 		if err != nil {
 			return fmt.Errorf("unable to read device %v: %w", deviceId, err), code
 		}
@@ -306,7 +310,7 @@ func (this *Controller) selectionMatchesCriteria(
 
 	deviceType, ok := (*dtcache)[device.DeviceTypeId]
 	if !ok {
-		deviceType, err, code = this.readDeviceType(device.DeviceTypeId)
+		deviceType, err, code = this.readDeviceType(ctx, device.DeviceTypeId)
 		if err != nil {
 			return fmt.Errorf("unable to read device-type %v: %w", device.DeviceTypeId, err), code
 		}
@@ -372,8 +376,9 @@ func listContains(list []string, search string) bool {
 	return false
 }
 
-func (this *Controller) ValidateDeviceGroupDelete(token string, id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateDeviceGroupDelete(ctx context.Context, token string, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	dg, exists, err := this.db.GetDeviceGroup(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -393,7 +398,9 @@ func (this *Controller) ValidateDeviceGroupDelete(token string, id string) (err
 	return nil, http.StatusOK
 }
 
-func (this *Controller) SetDeviceGroup(token string, dg models.DeviceGroup) (result models.DeviceGroup, err error, errCode int) {
+func (this *Controller) SetDeviceGroup(ctx context.Context, token string, dg models.DeviceGroup) (result models.DeviceGroup, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -408,7 +415,6 @@ func (this *Controller) SetDeviceGroup(token string, dg models.DeviceGroup) (res
 		}
 	}
 
-	ctx, _ := getTimeoutContext()
 	old, exists, err := this.db.GetDeviceGroup(ctx, dg.Id)
 	if err != nil {
 		return dg, err, http.StatusInternalServerError
@@ -430,14 +436,14 @@ func (this *Controller) SetDeviceGroup(token string, dg models.DeviceGroup) (res
 	}
 
 	if !this.config.DisableStrictValidationForTesting {
-		err, code := this.ValidateDeviceGroup(token, dg)
+		err, code := this.ValidateDeviceGroup(ctx, token, dg)
 		if err != nil {
 			debug.PrintStack()
 			return dg, err, code
 		}
 	}
 
-	err = this.setDeviceGroup(dg, jwtToken.GetUserId())
+	err = this.setDeviceGroup(ctx, dg, jwtToken.GetUserId())
 	if err != nil {
 		debug.PrintStack()
 		return dg, err, http.StatusInternalServerError
@@ -494,17 +500,19 @@ func (this *Controller) setDeviceGroupSyncHandler(dg models.DeviceGroup, user st
 	return this.publisher.PublishDeviceGroup(dg)
 }
 
-func (this *Controller) setDeviceGroup(deviceGroup models.DeviceGroup, owner string) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setDeviceGroup(ctx context.Context, deviceGroup models.DeviceGroup, owner string) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.SetDeviceGroup(ctx, deviceGroup, this.setDeviceGroupSyncHandler, owner)
 }
 
-func (this *Controller) DeleteDeviceGroup(token string, id string) (err error, code int) {
+func (this *Controller) DeleteDeviceGroup(ctx context.Context, token string, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = preventIdModifier(id)
 	if err != nil {
 		return err, http.StatusBadRequest
 	}
-	ctx, _ := getTimeoutContext()
 	_, exists, err := this.db.GetDeviceGroup(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -520,12 +528,12 @@ func (this *Controller) DeleteDeviceGroup(token string, id string) (err error, c
 		return errors.New("access denied"), http.StatusForbidden
 	}
 
-	err, code = this.ValidateDeviceGroupDelete(token, id)
+	err, code = this.ValidateDeviceGroupDelete(ctx, token, id)
 	if err != nil {
 		return err, code
 	}
 
-	err = this.deleteDeviceGroup(id)
+	err = this.deleteDeviceGroup(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -540,8 +548,9 @@ func (this *Controller) deleteDeviceGroupSyncHandler(dg models.DeviceGroup) (err
 	return this.publisher.PublishDeviceGroupDelete(dg.Id)
 }
 
-func (this *Controller) deleteDeviceGroup(id string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteDeviceGroup(ctx context.Context, id string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err := this.db.RemoveDeviceGroup(ctx, id, this.deleteDeviceGroupSyncHandler)
 	if err != nil {
 		return err
@@ -549,8 +558,8 @@ func (this *Controller) deleteDeviceGroup(id string) error {
 	return nil
 }
 
-func (this *Controller) UpdateDeviceGroupCriteria(dg models.DeviceGroup) (err error) {
-	user, exists, err := this.db.GetDeviceGroupSyncUser(context.Background(), dg.Id)
+func (this *Controller) UpdateDeviceGroupCriteria(ctx context.Context, dg models.DeviceGroup) (err error) {
+	user, exists, err := this.db.GetDeviceGroupSyncUser(ctx, dg.Id)
 	if err != nil {
 		return err
 	}
@@ -558,7 +567,7 @@ func (this *Controller) UpdateDeviceGroupCriteria(dg models.DeviceGroup) (err er
 		this.config.GetLogger().Warn("tried to update unknown device-group criteria")
 		return nil
 	}
-	dg.Criteria, err, _ = this.GetDeviceGroupCriteria(dg.DeviceIds)
+	dg.Criteria, err, _ = this.GetDeviceGroupCriteria(ctx, dg.DeviceIds)
 	if err != nil {
 		return err
 	}
@@ -566,5 +575,5 @@ func (this *Controller) UpdateDeviceGroupCriteria(dg models.DeviceGroup) (err er
 		return strings.Compare(a.Short(), b.Short())
 	})
 	dg.SetShortCriteria()
-	return this.setDeviceGroup(dg, user)
+	return this.setDeviceGroup(ctx, dg, user)
 }
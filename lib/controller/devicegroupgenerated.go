@@ -25,12 +25,13 @@ import (
 	"strings"
 )
 
-func (this *Controller) EnsureGeneratedDeviceGroup(oldDevice models.Device, device models.Device) (err error) {
+func (this *Controller) EnsureGeneratedDeviceGroup(ctx context.Context, oldDevice models.Device, device models.Device) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if this.config.SkipDeviceGroupGenerationFromDevice {
 		return nil
 	}
 	virtualDgId := this.DeviceIdToGeneratedDeviceGroupId(device.Id)
-	ctx, _ := getTimeoutContext()
 	old, exists, err := this.db.GetDeviceGroup(ctx, virtualDgId)
 	if err != nil {
 		return err
@@ -65,7 +66,7 @@ func (this *Controller) EnsureGeneratedDeviceGroup(oldDevice models.Device, devi
 			Origin: "device-repository",
 		}},
 	}
-	dg.Criteria, err, _ = this.getDeviceGroupCriteriaOfDevice(device)
+	dg.Criteria, err, _ = this.getDeviceGroupCriteriaOfDevice(ctx, device)
 	if err != nil {
 		return err
 	}
@@ -73,7 +74,7 @@ func (this *Controller) EnsureGeneratedDeviceGroup(oldDevice models.Device, devi
 		return strings.Compare(a.Short(), b.Short())
 	})
 	dg.SetShortCriteria()
-	return this.setDeviceGroup(dg, device.OwnerId)
+	return this.setDeviceGroup(ctx, dg, device.OwnerId)
 }
 
 func getDeviceDisplayName(device models.Device) string {
@@ -86,9 +87,10 @@ func getDeviceDisplayName(device models.Device) string {
 	return displayName
 }
 
-func (this *Controller) RemoveGeneratedDeviceGroup(deviceid string, owner string) error {
+func (this *Controller) RemoveGeneratedDeviceGroup(ctx context.Context, deviceid string, owner string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	virtualDgId := this.DeviceIdToGeneratedDeviceGroupId(deviceid)
-	ctx, _ := getTimeoutContext()
 	dg, exists, err := this.db.GetDeviceGroup(ctx, virtualDgId)
 	if err != nil {
 		return err
@@ -101,9 +103,9 @@ func (this *Controller) RemoveGeneratedDeviceGroup(deviceid string, owner string
 	})
 	if len(dg.DeviceIds) > 0 {
 		dg.AutoGeneratedByDevice = ""
-		return this.setDeviceGroup(dg, owner)
+		return this.setDeviceGroup(ctx, dg, owner)
 	} else {
-		return this.deleteDeviceGroup(virtualDgId)
+		return this.deleteDeviceGroup(ctx, virtualDgId)
 	}
 }
 
@@ -111,11 +113,11 @@ func (this *Controller) DeviceIdToGeneratedDeviceGroupId(deviceId string) string
 	return model.DeviceIdToGeneratedDeviceGroupId(deviceId)
 }
 
-func (this *Controller) GetDeviceGroupCriteria(deviceIds []string) (result []models.DeviceGroupFilterCriteria, err error, code int) {
-	devices, _, err := this.db.ListDevices(context.Background(), model.DeviceListOptions{Ids: deviceIds}, false)
+func (this *Controller) GetDeviceGroupCriteria(ctx context.Context, deviceIds []string) (result []models.DeviceGroupFilterCriteria, err error, code int) {
+	devices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{Ids: deviceIds}, false)
 	currentSet := map[string]models.DeviceGroupFilterCriteria{}
 	for i, device := range devices {
-		deviceCriterias, err, code := this.getDeviceGroupCriteriaOfDevice(device.Device)
+		deviceCriterias, err, code := this.getDeviceGroupCriteriaOfDevice(ctx, device.Device)
 		if err != nil {
 			return result, err, code
 		}
@@ -136,12 +138,17 @@ func (this *Controller) GetDeviceGroupCriteria(deviceIds []string) (result []mod
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) getDeviceGroupCriteriaOfDevice(device models.Device) (result []models.DeviceGroupFilterCriteria, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) getDeviceGroupCriteriaOfDevice(ctx context.Context, device models.Device) (result []models.DeviceGroupFilterCriteria, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	deviceType, _, err := this.db.GetDeviceType(ctx, device.DeviceTypeId)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
+	return this.getDeviceGroupCriteriaOfDeviceType(ctx, deviceType)
+}
+
+func (this *Controller) getDeviceGroupCriteriaOfDeviceType(ctx context.Context, deviceType models.DeviceType) (result []models.DeviceGroupFilterCriteria, err error, code int) {
 	resultSet := map[string]models.DeviceGroupFilterCriteria{}
 	for _, service := range deviceType.Services {
 		interactions := []models.Interaction{service.Interaction}
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/util"
+)
+
+const deviceQueryBatchSize int64 = 500
+
+// QueryDevices returns the devices accessible by the token, whose device-types match the query criteria,
+// together with the matching service paths (ref GetDeviceTypeSelectablesV2)
+func (this *Controller) QueryDevices(ctx context.Context, token string, query model.DeviceQuery) (result []model.DeviceQueryResult, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result = []model.DeviceQueryResult{}
+
+	selectables, err := this.getDeviceTypeSelectablesV2(ctx, query.Criteria, "", true, query.ServicesMustMatchAllCriteria)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	pathOptions := map[string]map[string][]model.ServicePathOption{}
+	pureDeviceTypeIds := []string{}
+	for _, selectable := range selectables {
+		if len(selectable.ServicePathOptions) == 0 {
+			continue
+		}
+		pathOptions[selectable.DeviceTypeId] = selectable.ServicePathOptions
+		pureId, _ := idmodifier.SplitModifier(selectable.DeviceTypeId)
+		if !slices.Contains(pureDeviceTypeIds, pureId) {
+			pureDeviceTypeIds = append(pureDeviceTypeIds, pureId)
+		}
+	}
+	if len(pureDeviceTypeIds) == 0 {
+		return result, nil, http.StatusOK
+	}
+
+	deviceIds, err, code := this.getDeviceQueryIdFilter(ctx, token, query)
+	if err != nil {
+		return result, err, code
+	}
+	if deviceIds != nil && len(deviceIds) == 0 {
+		return result, nil, http.StatusOK
+	}
+	options := model.DeviceListOptions{
+		DeviceTypeIds: pureDeviceTypeIds,
+		Permission:    query.Permission,
+	}
+	for _, attr := range query.Attributes {
+		options.AttributeKeys = append(options.AttributeKeys, attr.Key)
+	}
+
+	//attribute values and modified device-types are checked locally -> the db is read in batches until the requested page is filled
+	var devices iter.Seq2[models.Device, error]
+	if deviceIds != nil {
+		//ListDevices ignores limit/offset if Ids are set; the location/hub/group already bounds the result
+		options.Ids = deviceIds
+		devices = func(yield func(models.Device, error) bool) {
+			var list []models.Device
+			list, err, code = this.ListDevices(ctx, token, options)
+			if err != nil {
+				yield(models.Device{}, err)
+				return
+			}
+			for _, device := range list {
+				if !yield(device, nil) {
+					return
+				}
+			}
+		}
+	} else {
+		devices = util.IterBatch(deviceQueryBatchSize, func(limit int64, offset int64) (list []models.Device, err error) {
+			options.Limit = limit
+			options.Offset = offset
+			list, err, code = this.ListDevices(ctx, token, options)
+			return list, err
+		})
+	}
+
+	skipped := int64(0)
+	for device, err := range devices {
+		if err != nil {
+			return result, err, code
+		}
+		paths, ok := pathOptions[device.DeviceTypeId]
+		if !ok || !deviceHasAttributes(device, query.Attributes) {
+			continue
+		}
+		if skipped < query.Offset {
+			skipped++
+			continue
+		}
+		result = append(result, model.DeviceQueryResult{Device: device, ServicePathOptions: paths})
+		if query.Limit > 0 && int64(len(result)) >= query.Limit {
+			break
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+// getDeviceQueryIdFilter returns the device ids of the queried location, hub and device-group; nil if none is queried
+func (this *Controller) getDeviceQueryIdFilter(ctx context.Context, token string, query model.DeviceQuery) (result []string, err error, code int) {
+	intersect := func(ids []string) {
+		if result == nil {
+			result = slices.Clone(ids)
+		} else {
+			result = slices.DeleteFunc(result, func(id string) bool { return !slices.Contains(ids, id) })
+		}
+		if result == nil {
+			result = []string{}
+		}
+	}
+	if query.LocationId != "" {
+		location, err, code := this.GetLocation(ctx, query.LocationId, token)
+		if err != nil {
+			return result, err, code
+		}
+		ids := slices.Clone(location.DeviceIds)
+		for _, groupId := range location.DeviceGroupIds {
+			group, exists, err := this.db.GetDeviceGroup(ctx, groupId)
+			if err != nil {
+				return result, err, http.StatusInternalServerError
+			}
+			if exists {
+				ids = append(ids, group.DeviceIds...)
+			}
+		}
+		intersect(ids)
+	}
+	if query.HubId != "" {
+		hub, err, code := this.ReadHub(ctx, query.HubId, token, model.READ)
+		if err != nil {
+			return result, err, code
+		}
+		intersect(hub.DeviceIds)
+	}
+	if query.DeviceGroupId != "" {
+		group, err, code := this.ReadDeviceGroup(ctx, query.DeviceGroupId, token, false)
+		if err != nil {
+			return result, err, code
+		}
+		intersect(group.DeviceIds)
+	}
+	return result, nil, http.StatusOK
+}
+
+func deviceHasAttributes(device models.Device, attributes []models.Attribute) bool {
+	for _, expected := range attributes {
+		if !slices.ContainsFunc(device.Attributes, func(attr models.Attribute) bool {
+			return attr.Key == expected.Key && (expected.Value == "" || attr.Value == expected.Value) && (expected.Origin == "" || attr.Origin == expected.Origin)
+		}) {
+			return false
+		}
+	}
+	return true
+}
@@ -20,12 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"net/http"
 	"runtime/debug"
 	"slices"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
@@ -34,12 +34,13 @@ import (
 	"github.com/SENERGY-Platform/service-commons/pkg/util"
 )
 
-func (this *Controller) ReadDeviceType(id string, token string) (result models.DeviceType, err error, errCode int) {
-	return this.readDeviceType(id)
+func (this *Controller) ReadDeviceType(ctx context.Context, id string, token string) (result models.DeviceType, err error, errCode int) {
+	return this.readDeviceType(ctx, id)
 }
 
-func (this *Controller) readDeviceType(id string) (result models.DeviceType, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) readDeviceType(ctx context.Context, id string) (result models.DeviceType, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	pureId, modifier := idmodifier.SplitModifier(id)
 	deviceType, exists, err := this.db.GetDeviceType(ctx, pureId)
 	if err != nil {
@@ -58,8 +59,9 @@ func (this *Controller) readDeviceType(id string) (result models.DeviceType, err
 	return deviceType, nil, http.StatusOK
 }
 
-func (this *Controller) ListDeviceTypes(token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, interactionsFilter []string, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListDeviceTypes(ctx context.Context, token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, interactionsFilter []string, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	temp, err := this.db.ListDeviceTypes(ctx, limit, offset, sort, filter, interactionsFilter, includeModified)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -67,8 +69,9 @@ func (this *Controller) ListDeviceTypes(token string, limit int64, offset int64,
 	return this.modifyDeviceTypeList(temp, sort, includeModified, includeUnmodified)
 }
 
-func (this *Controller) ListDeviceTypesV2(token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListDeviceTypesV2(ctx context.Context, token string, limit int64, offset int64, sort string, filter []model.FilterCriteria, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	temp, err := this.db.ListDeviceTypesV2(ctx, limit, offset, sort, filter, includeModified)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -76,8 +79,9 @@ func (this *Controller) ListDeviceTypesV2(token string, limit int64, offset int6
 	return this.modifyDeviceTypeList(temp, sort, includeModified, includeUnmodified)
 }
 
-func (this *Controller) ListDeviceTypesV3(token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListDeviceTypesV3(ctx context.Context, token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	temp, total, err := this.db.ListDeviceTypesV3(ctx, listOptions)
 	if err != nil {
 		return result, 0, err, http.StatusInternalServerError
@@ -86,11 +90,12 @@ func (this *Controller) ListDeviceTypesV3(token string, listOptions model.Device
 	return result, total, err, errCode
 }
 
-func (this *Controller) ListDeviceTypesUsedByUser(token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListDeviceTypesUsedByUser(ctx context.Context, token string, listOptions model.DeviceTypeListOptions) (result []models.DeviceType, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	dtIds := []string{}
 	for device, err := range util.IterBatch(200, func(limit int64, offset int64) ([]models.Device, error) {
-		devices, err, _ := this.ListDevices(token, model.DeviceListOptions{Limit: limit, Offset: offset, DeviceTypeIds: listOptions.Ids})
+		devices, err, _ := this.ListDevices(ctx, token, model.DeviceListOptions{Limit: limit, Offset: offset, DeviceTypeIds: listOptions.Ids})
 		return devices, err
 	}) {
 		if err != nil {
@@ -109,37 +114,39 @@ func (this *Controller) ListDeviceTypesUsedByUser(token string, listOptions mode
 	return result, total, err, errCode
 }
 
-func (this *Controller) ValidateDeviceType(dt models.DeviceType, options model.ValidationOptions) (err error, code int) {
+func (this *Controller) ValidateDeviceType(ctx context.Context, dt models.DeviceType, options model.ValidationOptions) (err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutValidation)
+	defer cancel()
 	if dt.Id == "" {
-		return errors.New("missing device-type id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing device-type id")), http.StatusBadRequest
 	}
 	if dt.Name == "" {
-		return errors.New("missing device-type name"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "name", errors.New("missing device-type name")), http.StatusBadRequest
 	}
 	if len(dt.Services) == 0 {
-		return errors.New("expect at least one service"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "services", errors.New("expect at least one service")), http.StatusBadRequest
 	}
 	protocolCache := &map[string]models.Protocol{}
-	for _, service := range dt.Services {
-		ctx, _ := context.WithTimeout(context.Background(), 2*time.Second)
+	for i, service := range dt.Services {
+		field := fmt.Sprintf("services[%v]", i)
 		deviceTypes, err := this.db.GetDeviceTypesByServiceId(ctx, service.Id)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
 		if len(deviceTypes) > 1 {
-			return errors.New("reused service id"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeReusedServiceId, field+".id", errors.New("reused service id")), http.StatusBadRequest
 		}
 		if len(deviceTypes) == 1 && deviceTypes[0].Id != dt.Id {
-			return errors.New("reused service id"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeReusedServiceId, field+".id", errors.New("reused service id")), http.StatusBadRequest
 		}
-		err, code = this.ValidateService(service, protocolCache, options)
+		err, code = this.ValidateService(ctx, service, protocolCache, options)
 		if err != nil {
-			return err, code
+			return model.PrefixErrorField(err, field), code
 		}
 	}
 	err = ValidateServiceGroups(dt.ServiceGroups, dt.Services)
 	if err != nil {
-		return err, http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceTypeInvalidServiceGroup, "service_groups", err), http.StatusBadRequest
 	}
 	return nil, http.StatusOK
 }
@@ -163,9 +170,10 @@ func ValidateServiceGroups(groups []models.ServiceGroup, services []models.Servi
 	return nil
 }
 
-func (this *Controller) GetDeviceTypeSelectables(query []model.FilterCriteria, pathPrefix string, interactionsFilter []string, includeModified bool) (result []model.DeviceTypeSelectable, err error, code int) {
+func (this *Controller) GetDeviceTypeSelectables(ctx context.Context, query []model.FilterCriteria, pathPrefix string, interactionsFilter []string, includeModified bool) (result []model.DeviceTypeSelectable, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.getDeviceTypeSelectables(ctx, query, pathPrefix, interactionsFilter, includeModified)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -173,9 +181,10 @@ func (this *Controller) GetDeviceTypeSelectables(query []model.FilterCriteria, p
 	return
 }
 
-func (this *Controller) GetDeviceTypeSelectablesV2(query []model.FilterCriteria, pathPrefix string, includeModified bool, servicesMustMatchAllCriteria bool) (result []model.DeviceTypeSelectable, err error, code int) {
+func (this *Controller) GetDeviceTypeSelectablesV2(ctx context.Context, query []model.FilterCriteria, pathPrefix string, includeModified bool, servicesMustMatchAllCriteria bool) (result []model.DeviceTypeSelectable, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.getDeviceTypeSelectablesV2(ctx, query, pathPrefix, includeModified, servicesMustMatchAllCriteria)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -210,8 +219,9 @@ func (this *Controller) getDeviceTypeSelectables(ctx context.Context, query []mo
 		}
 	}
 	aspectCache := &map[string]models.AspectNode{}
+	constraintsCache := map[string]*model.ValueConstraints{}
 	for dtId, dtCriteria := range groupByDeviceType {
-		dt, err, _ := this.readDeviceType(dtId)
+		dt, err, _ := this.readDeviceType(ctx, dtId)
 		if err != nil {
 			return result, err
 		}
@@ -221,7 +231,11 @@ func (this *Controller) getDeviceTypeSelectables(ctx context.Context, query []mo
 			ServicePathOptions: map[string][]model.ServicePathOption{},
 		}
 		for _, criteria := range dtCriteria {
-			aspectNode, err := this.getAspectNodeForDeviceTypeSelectables(aspectCache, criteria.AspectId)
+			aspectNode, err := this.getAspectNodeForDeviceTypeSelectables(ctx, aspectCache, criteria.AspectId)
+			if err != nil {
+				return result, err
+			}
+			constraints, err := this.getValueConstraints(ctx, constraintsCache, criteria.CharacteristicId)
 			if err != nil {
 				return result, err
 			}
@@ -235,6 +249,7 @@ func (this *Controller) getDeviceTypeSelectables(ctx context.Context, query []mo
 				Value:                 criteria.Value,
 				Type:                  criteria.Type,
 				IsControllingFunction: criteria.IsControllingFunction,
+				Constraints:           constraints,
 			})
 		}
 		for sid, options := range element.ServicePathOptions {
@@ -243,7 +258,7 @@ func (this *Controller) getDeviceTypeSelectables(ctx context.Context, query []mo
 				return result, err
 			}
 			for i, option := range options {
-				options[i].Configurables, err = this.getConfigurables(configurablesCandidates, option)
+				options[i].Configurables, err = this.getConfigurables(ctx, configurablesCandidates, option, constraintsCache)
 				if err != nil {
 					return result, err
 				}
@@ -301,8 +316,9 @@ func (this *Controller) getDeviceTypeSelectablesV2(ctx context.Context, query []
 	}
 
 	aspectCache := &map[string]models.AspectNode{}
+	constraintsCache := map[string]*model.ValueConstraints{}
 	for dtId, dtCriteria := range groupByDeviceType {
-		dt, err, _ := this.readDeviceType(dtId)
+		dt, err, _ := this.readDeviceType(ctx, dtId)
 		if err != nil {
 			return result, err
 		}
@@ -314,7 +330,11 @@ func (this *Controller) getDeviceTypeSelectablesV2(ctx context.Context, query []
 		usedPaths := map[string]map[string]bool{}
 		for _, criteria := range dtCriteria {
 			if !servicesMustMatchAllCriteria || validService[criteria.ServiceId] {
-				aspectNode, err := this.getAspectNodeForDeviceTypeSelectables(aspectCache, criteria.AspectId)
+				aspectNode, err := this.getAspectNodeForDeviceTypeSelectables(ctx, aspectCache, criteria.AspectId)
+				if err != nil {
+					return result, err
+				}
+				constraints, err := this.getValueConstraints(ctx, constraintsCache, criteria.CharacteristicId)
 				if err != nil {
 					return result, err
 				}
@@ -334,6 +354,7 @@ func (this *Controller) getDeviceTypeSelectablesV2(ctx context.Context, query []
 						Type:                  criteria.Type,
 						IsControllingFunction: criteria.IsControllingFunction,
 						Interaction:           models.Interaction(criteria.Interaction),
+						Constraints:           constraints,
 					})
 				}
 			}
@@ -344,7 +365,7 @@ func (this *Controller) getDeviceTypeSelectablesV2(ctx context.Context, query []
 				return result, err
 			}
 			for i, option := range options {
-				options[i].Configurables, err = this.getConfigurables(configurablesCandidates, option)
+				options[i].Configurables, err = this.getConfigurables(ctx, configurablesCandidates, option, constraintsCache)
 				if err != nil {
 					return result, err
 				}
@@ -371,14 +392,15 @@ func contains(s []string, e string) bool {
 	return slices.Contains(s, e)
 }
 
-func (this *Controller) getAspectNodeForDeviceTypeSelectables(aspectCache *map[string]models.AspectNode, aspectId string) (aspectNode models.AspectNode, err error) {
+func (this *Controller) getAspectNodeForDeviceTypeSelectables(ctx context.Context, aspectCache *map[string]models.AspectNode, aspectId string) (aspectNode models.AspectNode, err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if aspectId == "" {
 		return aspectNode, nil
 	}
 	var ok bool
 	aspectNode, ok = (*aspectCache)[aspectId]
 	if !ok {
-		ctx, _ := getTimeoutContext()
 		aspectNode, ok, err = this.db.GetAspectNode(ctx, aspectId)
 		if err != nil {
 			this.config.GetLogger().Warn("unable to load aspect node", "aspectId", aspectId, "error", err)
@@ -393,16 +415,20 @@ func (this *Controller) getAspectNodeForDeviceTypeSelectables(aspectCache *map[s
 	return aspectNode, nil
 }
 
-func (this *Controller) getConfigurables(candidates []model.DeviceTypeCriteria, pathOption model.ServicePathOption) (result []model.Configurable, err error) {
+func (this *Controller) getConfigurables(ctx context.Context, candidates []model.DeviceTypeCriteria, pathOption model.ServicePathOption, constraintsCache map[string]*model.ValueConstraints) (result []model.Configurable, err error) {
 	for _, candidate := range candidates {
 		aspectNode := models.AspectNode{}
 		if candidate.AspectId != "" {
-			aspectNode, err, _ = this.GetAspectNode(candidate.AspectId)
+			aspectNode, err, _ = this.GetAspectNode(ctx, candidate.AspectId)
 			if err != nil {
 				return result, err
 			}
 		}
 		if !pathOption.IsControllingFunction || !pathOptionIsAncestorOfConfigurableCandidate(pathOption, candidate) {
+			constraints, err := this.getValueConstraints(ctx, constraintsCache, candidate.CharacteristicId)
+			if err != nil {
+				return result, err
+			}
 			result = append(result, model.Configurable{
 				Path:             candidate.ContentVariablePath,
 				CharacteristicId: candidate.CharacteristicId,
@@ -410,6 +436,7 @@ func (this *Controller) getConfigurables(candidates []model.DeviceTypeCriteria,
 				FunctionId:       candidate.FunctionId,
 				Type:             candidate.Type,
 				Value:            candidate.Value,
+				Constraints:      constraints,
 			})
 		}
 	}
@@ -426,7 +453,9 @@ func pathOptionIsAncestorOfConfigurableCandidate(option model.ServicePathOption,
 	return false
 }
 
-func (this *Controller) GetUsedInDeviceType(query model.UsedInDeviceTypeQuery) (result model.UsedInDeviceTypeResponse, err error, errCode int) {
+func (this *Controller) GetUsedInDeviceType(ctx context.Context, query model.UsedInDeviceTypeQuery) (result model.UsedInDeviceTypeResponse, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result = model.UsedInDeviceTypeResponse{}
 	if query.Resource == "" {
 		return result, errors.New("unknown resource"), http.StatusBadRequest
@@ -438,8 +467,6 @@ func (this *Controller) GetUsedInDeviceType(query model.UsedInDeviceTypeQuery) (
 		query.CountBy = "device-type"
 	}
 
-	ctx, _ := getTimeoutContext()
-
 	criteria := []model.DeviceTypeCriteria{}
 
 	switch query.Resource {
@@ -593,7 +620,20 @@ func addDeviceTypeCriteriaToVariableRefs(list []model.VariableReference, criteri
 	return list
 }
 
-func (this *Controller) SetDeviceType(token string, dt models.DeviceType, options model.DeviceTypeUpdateOptions) (models.DeviceType, error, int) {
+func (this *Controller) SetDeviceType(ctx context.Context, token string, dt models.DeviceType, options model.DeviceTypeUpdateOptions) (models.DeviceType, error, int) {
+	_, extended, err := this.db.GetDeviceTypeExtension(ctx, dt.Id)
+	if err != nil {
+		return dt, err, http.StatusInternalServerError
+	}
+	if extended {
+		return dt, model.NewError(model.ErrDeviceTypeExtendsTemplate, errors.New("device-type extends a template; update it with PUT /device-types/{id}/extension or remove the extension")), http.StatusBadRequest
+	}
+	return this.setDeviceTypeWithValidation(ctx, token, dt, options)
+}
+
+func (this *Controller) setDeviceTypeWithValidation(ctx context.Context, token string, dt models.DeviceType, options model.DeviceTypeUpdateOptions) (models.DeviceType, error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if !this.config.DisableStrictValidationForTesting {
 		dt.GenerateId() //ensure ids
 	}
@@ -602,7 +642,6 @@ func (this *Controller) SetDeviceType(token string, dt models.DeviceType, option
 	if err != nil {
 		return dt, err, http.StatusInternalServerError
 	}
-	ctx, _ := getTimeoutContext()
 	_, exists, err := this.db.GetDeviceType(ctx, dt.Id)
 	if err != nil {
 		return dt, err, http.StatusInternalServerError
@@ -612,21 +651,28 @@ func (this *Controller) SetDeviceType(token string, dt models.DeviceType, option
 	}
 
 	if options.DistinctAttributes != nil {
-		err = this.ValidateDistinctDeviceTypeAttributes(dt, options.DistinctAttributes)
+		err = this.ValidateDistinctDeviceTypeAttributes(ctx, dt, options.DistinctAttributes)
 		if err != nil {
 			return dt, err, http.StatusBadRequest
 		}
 	}
 
 	if !this.config.DisableStrictValidationForTesting {
-		err, code := this.ValidateDeviceType(dt, model.ValidationOptions{})
+		err, code := this.ValidateDeviceType(ctx, dt, model.ValidationOptions{})
 		if err != nil {
 			debug.PrintStack()
 			return dt, err, code
 		}
 	}
 
-	err = this.setDeviceType(dt)
+	if options.CheckLint(this.config) {
+		err, code := this.lintGate(ctx, dt)
+		if err != nil {
+			return dt, err, code
+		}
+	}
+
+	err = this.setDeviceType(ctx, dt)
 	if err != nil {
 		debug.PrintStack()
 		return dt, err, http.StatusInternalServerError
@@ -636,34 +682,47 @@ func (this *Controller) SetDeviceType(token string, dt models.DeviceType, option
 }
 
 func (this *Controller) setDeviceTypeSyncHandler(dt models.DeviceType) (err error) {
-	devices, _, err := this.db.ListDevices(context.Background(), model.DeviceListOptions{DeviceTypeIds: []string{dt.Id}}, false)
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
+	dgList, err := this.listDeviceGroupsOfDeviceType(ctx, dt.Id)
 	if err != nil {
 		return err
 	}
-	deviceIds := []string{}
-	for _, device := range devices {
-		deviceIds = append(deviceIds, device.Id)
-	}
-	if len(deviceIds) > 0 {
-		dgList, _, err := this.db.ListDeviceGroups(context.Background(), model.DeviceGroupListOptions{
-			DeviceIds: deviceIds,
-		})
+	for _, dg := range dgList {
+		err = this.UpdateDeviceGroupCriteria(ctx, dg)
 		if err != nil {
 			return err
 		}
-		for _, dg := range dgList {
-			err = this.UpdateDeviceGroupCriteria(dg)
-			if err != nil {
-				return err
-			}
-		}
 	}
-
+	err = this.pruneDeviceConfigurationsOfDeviceType(dt)
+	if err != nil {
+		return err
+	}
 	return this.publisher.PublishDeviceType(dt)
 }
 
-func (this *Controller) setDeviceType(deviceType models.DeviceType) (err error) {
-	ctx, _ := getTimeoutContext()
+// listDeviceGroupsOfDeviceType returns the device-groups containing devices of the device-type
+func (this *Controller) listDeviceGroupsOfDeviceType(ctx context.Context, deviceTypeId string) (result []models.DeviceGroup, err error) {
+	devices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{DeviceTypeIds: []string{deviceTypeId}}, false)
+	if err != nil {
+		return nil, err
+	}
+	deviceIds := []string{}
+	for _, device := range devices {
+		deviceIds = append(deviceIds, device.Id)
+	}
+	if len(deviceIds) == 0 {
+		return []models.DeviceGroup{}, nil
+	}
+	result, _, err = this.db.ListDeviceGroups(ctx, model.DeviceGroupListOptions{
+		DeviceIds: deviceIds,
+	})
+	return result, err
+}
+
+func (this *Controller) setDeviceType(ctx context.Context, deviceType models.DeviceType) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.SetDeviceType(ctx, deviceType, this.setDeviceTypeSyncHandler)
 }
 
@@ -671,7 +730,9 @@ func (this *Controller) deleteDeviceTypeSyncHandler(dt models.DeviceType) (err e
 	return this.publisher.PublishDeviceTypeDelete(dt.Id)
 }
 
-func (this *Controller) DeleteDeviceType(token string, id string) (err error, code int) {
+func (this *Controller) DeleteDeviceType(ctx context.Context, token string, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -679,22 +740,27 @@ func (this *Controller) DeleteDeviceType(token string, id string) (err error, co
 	if !jwtToken.IsAdmin() {
 		return errors.New("only admins may delete device-types"), http.StatusForbidden
 	}
-	ctx, _ := getTimeoutContext()
 	_, total, err := this.db.ListDevices(ctx, model.DeviceListOptions{DeviceTypeIds: []string{id}, Limit: 1}, true)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
 	if total > 0 {
-		return errors.New("device-type is still in use"), http.StatusBadRequest
+		return model.NewError(model.ErrDeviceTypeInUse, errors.New("device-type is still in use")), http.StatusBadRequest
 	}
 	err = this.db.RemoveDeviceType(ctx, id, this.deleteDeviceTypeSyncHandler)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
+	err = this.db.RemoveDeviceTypeExtension(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateDistinctDeviceTypeAttributes(devicetype models.DeviceType, attributeKeys []string) error {
+func (this *Controller) ValidateDistinctDeviceTypeAttributes(ctx context.Context, devicetype models.DeviceType, attributeKeys []string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	dtAttr := map[string]string{}
 	for _, attr := range devicetype.Attributes {
 		dtAttr[strings.TrimSpace(attr.Key)] = strings.TrimSpace(attr.Value)
@@ -715,7 +781,6 @@ func (this *Controller) ValidateDistinctDeviceTypeAttributes(devicetype models.D
 			return errors.New("distinct attribute not in device-type attributes found")
 		}
 	}
-	ctx, _ := getTimeoutContext()
 	list, _, err := this.db.ListDeviceTypesV3(ctx, options)
 	if err != nil {
 		return err
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// CloneDeviceType stores a copy of the device-type id with newly generated ids
+// a clone of an extended device-type is a plain device-type without template reference
+func (this *Controller) CloneDeviceType(ctx context.Context, token string, id string, options model.DeviceTypeCloneOptions, updateOptions model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, code int) {
+	source, err, code := this.ReadDeviceType(ctx, id, token)
+	if code == http.StatusNotFound {
+		return result, fmt.Errorf("device-type %w", model.ErrNotFound), code
+	}
+	if err != nil {
+		return result, err, code
+	}
+	clone := models.DeviceType{}
+	clone.GenerateId()
+	return this.setDeviceTypeWithValidation(ctx, token, options.Clone(clone.Id, source), updateOptions)
+}
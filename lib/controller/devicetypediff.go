@@ -0,0 +1,400 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// DiffDeviceType compares the stored device-type id with the submitted device-type other (e.g. the body of a planned PUT /device-types/{id})
+func (this *Controller) DiffDeviceType(ctx context.Context, token string, id string, other models.DeviceType) (result model.DeviceTypeDiff, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	current, err, code := this.ReadDeviceType(ctx, id, token)
+	if err != nil {
+		return result, err, code
+	}
+	result, err = diffDeviceTypes(current, other)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if other.Id != "" && other.Id != current.Id {
+		result.OtherDeviceTypeId = other.Id
+	}
+	result.Impact, err = this.getDeviceTypeDiffImpact(ctx, token, current, other)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// DiffStoredDeviceTypes compares two stored device-types
+func (this *Controller) DiffStoredDeviceTypes(ctx context.Context, token string, id string, otherId string) (result model.DeviceTypeDiff, err error, code int) {
+	other, err, code := this.ReadDeviceType(ctx, otherId, token)
+	if err != nil {
+		return result, err, code
+	}
+	return this.DiffDeviceType(ctx, token, id, other)
+}
+
+// getDeviceTypeDiffImpact only counts devices and lists device-groups the token may read
+func (this *Controller) getDeviceTypeDiffImpact(ctx context.Context, token string, current models.DeviceType, other models.DeviceType) (result model.DeviceTypeDiffImpact, err error) {
+	result = model.DeviceTypeDiffImpact{
+		AddedCriteria:   []models.DeviceGroupFilterCriteria{},
+		RemovedCriteria: []models.DeviceGroupFilterCriteria{},
+		DeviceGroupIds:  []string{},
+	}
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err
+	}
+	var accessibleDeviceIds []string //nil for admins -> no id filter
+	var accessibleGroupIds map[string]bool
+	if !jwtToken.IsAdmin() {
+		accessibleDeviceIds, err, _ = this.permissionsV2Client.ListAccessibleResourceIds(token, this.config.DeviceTopic, client.ListOptions{}, client.Read)
+		if err != nil {
+			return result, err
+		}
+		if accessibleDeviceIds == nil {
+			accessibleDeviceIds = []string{}
+		}
+		groupIds, err, _ := this.permissionsV2Client.ListAccessibleResourceIds(token, this.config.DeviceGroupTopic, client.ListOptions{}, client.Read)
+		if err != nil {
+			return result, err
+		}
+		accessibleGroupIds = map[string]bool{}
+		for _, id := range groupIds {
+			accessibleGroupIds[id] = true
+		}
+	}
+
+	pureId, _ := idmodifier.SplitModifier(current.Id)
+	_, total, err := this.db.ListDevices(ctx, model.DeviceListOptions{
+		Ids:           accessibleDeviceIds,
+		DeviceTypeIds: []string{pureId},
+		Limit:         1,
+		Fields:        []string{"id"},
+	}, true)
+	if err != nil {
+		return result, err
+	}
+	result.DeviceCount = int(total)
+
+	currentCriteria, err, _ := this.getDeviceGroupCriteriaOfDeviceType(ctx, current)
+	if err != nil {
+		return result, err
+	}
+	otherCriteria, err, _ := this.getDeviceGroupCriteriaOfDeviceType(ctx, other)
+	if err != nil {
+		return result, err
+	}
+	result.RemovedCriteria = criteriaDifference(currentCriteria, otherCriteria)
+	result.AddedCriteria = criteriaDifference(otherCriteria, currentCriteria)
+	if len(result.AddedCriteria) == 0 && len(result.RemovedCriteria) == 0 {
+		return result, nil
+	}
+
+	groups, err := this.listDeviceGroupsOfDeviceType(ctx, pureId)
+	if err != nil {
+		return result, err
+	}
+	criteriaByDeviceType := map[string][]models.DeviceGroupFilterCriteria{pureId: otherCriteria}
+	for _, group := range groups {
+		if accessibleGroupIds != nil && !accessibleGroupIds[group.Id] {
+			continue
+		}
+		groupDevices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{Ids: group.DeviceIds}, false)
+		if err != nil {
+			return result, err
+		}
+		var expected []models.DeviceGroupFilterCriteria
+		for i, device := range groupDevices {
+			deviceCriteria, ok := criteriaByDeviceType[device.DeviceTypeId]
+			if !ok {
+				deviceCriteria, err, _ = this.getDeviceGroupCriteriaOfDevice(ctx, device.Device)
+				if err != nil {
+					return result, err
+				}
+				criteriaByDeviceType[device.DeviceTypeId] = deviceCriteria
+			}
+			if i == 0 {
+				expected = deviceCriteria
+			} else {
+				expected = criteriaIntersection(expected, deviceCriteria)
+			}
+		}
+		if len(criteriaDifference(expected, group.Criteria)) > 0 || len(criteriaDifference(group.Criteria, expected)) > 0 {
+			result.DeviceGroupIds = append(result.DeviceGroupIds, group.Id)
+		}
+	}
+	slices.Sort(result.DeviceGroupIds)
+	return result, nil
+}
+
+// criteriaDifference returns the criteria of a, that are not in b
+func criteriaDifference(a []models.DeviceGroupFilterCriteria, b []models.DeviceGroupFilterCriteria) (result []models.DeviceGroupFilterCriteria) {
+	result = []models.DeviceGroupFilterCriteria{}
+	known := map[string]bool{}
+	for _, criteria := range b {
+		known[criteriaHash(criteria)] = true
+	}
+	for _, criteria := range a {
+		if !known[criteriaHash(criteria)] {
+			result = append(result, criteria)
+		}
+	}
+	slices.SortFunc(result, func(a, b models.DeviceGroupFilterCriteria) int {
+		return strings.Compare(a.Short(), b.Short())
+	})
+	return result
+}
+
+func criteriaIntersection(a []models.DeviceGroupFilterCriteria, b []models.DeviceGroupFilterCriteria) (result []models.DeviceGroupFilterCriteria) {
+	known := map[string]bool{}
+	for _, criteria := range b {
+		known[criteriaHash(criteria)] = true
+	}
+	for _, criteria := range a {
+		if known[criteriaHash(criteria)] {
+			result = append(result, criteria)
+		}
+	}
+	return result
+}
+
+func diffDeviceTypes(current models.DeviceType, other models.DeviceType) (result model.DeviceTypeDiff, err error) {
+	result = model.DeviceTypeDiff{
+		DeviceTypeId:    current.Id,
+		AddedServices:   []model.DiffService{},
+		RemovedServices: []model.DiffService{},
+		ChangedServices: []model.ServiceDiff{},
+	}
+	currentWithoutServices, otherWithoutServices := current, other
+	currentWithoutServices.Id, otherWithoutServices.Id = "", ""
+	currentWithoutServices.Services, otherWithoutServices.Services = nil, nil
+	result.Fields, err = model.DiffFields(currentWithoutServices, otherWithoutServices)
+	if err != nil {
+		return result, err
+	}
+
+	matches := matchDiffElements(current.Services, other.Services, func(s models.Service) string { return s.Id }, func(s models.Service) string { return s.LocalId })
+	for _, match := range matches {
+		switch {
+		case match.current == nil:
+			result.AddedServices = append(result.AddedServices, model.DiffService{Id: match.other.Id, LocalId: match.other.LocalId, Name: match.other.Name})
+		case match.other == nil:
+			result.RemovedServices = append(result.RemovedServices, model.DiffService{Id: match.current.Id, LocalId: match.current.LocalId, Name: match.current.Name})
+		default:
+			serviceDiff, err := diffServices(*match.current, *match.other)
+			if err != nil {
+				return result, err
+			}
+			if len(serviceDiff.Fields) > 0 || len(serviceDiff.AddedContents) > 0 || len(serviceDiff.RemovedContents) > 0 || len(serviceDiff.ChangedContents) > 0 {
+				result.ChangedServices = append(result.ChangedServices, serviceDiff)
+			}
+		}
+	}
+	return result, nil
+}
+
+func diffServices(current models.Service, other models.Service) (result model.ServiceDiff, err error) {
+	result = model.ServiceDiff{
+		Id:              current.Id,
+		LocalId:         current.LocalId,
+		AddedContents:   []model.DiffContent{},
+		RemovedContents: []model.DiffContent{},
+		ChangedContents: []model.ContentDiff{},
+	}
+	if other.Id != current.Id {
+		result.OtherId = other.Id
+	}
+	currentWithoutContents, otherWithoutContents := current, other
+	currentWithoutContents.Id, otherWithoutContents.Id = "", ""
+	currentWithoutContents.Inputs, otherWithoutContents.Inputs = nil, nil
+	currentWithoutContents.Outputs, otherWithoutContents.Outputs = nil, nil
+	result.Fields, err = model.DiffFields(currentWithoutContents, otherWithoutContents)
+	if err != nil {
+		return result, err
+	}
+	for _, direction := range []struct {
+		name    string
+		current []models.Content
+		other   []models.Content
+	}{
+		{name: model.PayloadDirectionInput, current: current.Inputs, other: other.Inputs},
+		{name: model.PayloadDirectionOutput, current: current.Outputs, other: other.Outputs},
+	} {
+		matches := matchDiffElements(direction.current, direction.other, func(c models.Content) string { return c.Id }, func(c models.Content) string { return c.ContentVariable.Name })
+		for _, match := range matches {
+			switch {
+			case match.current == nil:
+				result.AddedContents = append(result.AddedContents, model.DiffContent{Id: match.other.Id, Direction: direction.name, Name: match.other.ContentVariable.Name})
+			case match.other == nil:
+				result.RemovedContents = append(result.RemovedContents, model.DiffContent{Id: match.current.Id, Direction: direction.name, Name: match.current.ContentVariable.Name})
+			default:
+				contentDiff, err := diffContents(*match.current, *match.other, direction.name)
+				if err != nil {
+					return result, err
+				}
+				if len(contentDiff.Fields) > 0 || len(contentDiff.AddedVariables) > 0 || len(contentDiff.RemovedVariables) > 0 || len(contentDiff.ChangedVariables) > 0 {
+					result.ChangedContents = append(result.ChangedContents, contentDiff)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+type diffVariable struct {
+	path     string
+	variable models.ContentVariable
+}
+
+func diffContents(current models.Content, other models.Content, direction string) (result model.ContentDiff, err error) {
+	result = model.ContentDiff{
+		Id:               current.Id,
+		Direction:        direction,
+		AddedVariables:   []model.DiffVariable{},
+		RemovedVariables: []model.DiffVariable{},
+		ChangedVariables: []model.ContentVariableDiff{},
+	}
+	if other.Id != current.Id {
+		result.OtherId = other.Id
+	}
+	currentWithoutVariable, otherWithoutVariable := current, other
+	currentWithoutVariable.Id, otherWithoutVariable.Id = "", ""
+	currentWithoutVariable.ContentVariable, otherWithoutVariable.ContentVariable = models.ContentVariable{}, models.ContentVariable{}
+	result.Fields, err = model.DiffFields(currentWithoutVariable, otherWithoutVariable)
+	if err != nil {
+		return result, err
+	}
+
+	listVariables := func(content models.Content) (list []diffVariable) {
+		_ = walkLintVariables(content.ContentVariable, "", "", func(variable models.ContentVariable, _ string, path string) error {
+			list = append(list, diffVariable{path: path, variable: variable})
+			return nil
+		})
+		return list
+	}
+	matches := matchDiffElements(listVariables(current), listVariables(other), func(v diffVariable) string { return v.variable.Id }, func(v diffVariable) string { return v.path })
+	for _, match := range matches {
+		switch {
+		case match.current == nil:
+			result.AddedVariables = append(result.AddedVariables, model.DiffVariable{Id: match.other.variable.Id, Path: match.other.path})
+		case match.other == nil:
+			result.RemovedVariables = append(result.RemovedVariables, model.DiffVariable{Id: match.current.variable.Id, Path: match.current.path})
+		default:
+			variableDiff, err := diffContentVariables(*match.current, *match.other)
+			if err != nil {
+				return result, err
+			}
+			if variableDiff.OtherPath != "" || len(variableDiff.Fields) > 0 || len(variableDiff.Annotations) > 0 {
+				result.ChangedVariables = append(result.ChangedVariables, variableDiff)
+			}
+		}
+	}
+	return result, nil
+}
+
+func diffContentVariables(current diffVariable, other diffVariable) (result model.ContentVariableDiff, err error) {
+	result = model.ContentVariableDiff{
+		Id:          current.variable.Id,
+		Path:        current.path,
+		Annotations: []model.AnnotationChange{},
+	}
+	if other.path != current.path {
+		result.OtherPath = other.path
+	}
+	for _, annotation := range []struct {
+		field   string
+		current string
+		other   string
+	}{
+		{field: "function_id", current: current.variable.FunctionId, other: other.variable.FunctionId},
+		{field: "aspect_id", current: current.variable.AspectId, other: other.variable.AspectId},
+		{field: "characteristic_id", current: current.variable.CharacteristicId, other: other.variable.CharacteristicId},
+	} {
+		if annotation.current != annotation.other {
+			result.Annotations = append(result.Annotations, model.AnnotationChange{Field: annotation.field, Old: annotation.current, New: annotation.other})
+		}
+	}
+	reduce := func(variable models.ContentVariable) models.ContentVariable {
+		variable.Id = ""
+		variable.Name = ""
+		variable.FunctionId = ""
+		variable.AspectId = ""
+		variable.CharacteristicId = ""
+		variable.SubContentVariables = nil
+		return variable
+	}
+	result.Fields, err = model.DiffFields(reduce(current.variable), reduce(other.variable))
+	return result, err
+}
+
+type diffMatch[T any] struct {
+	current *T
+	other   *T
+}
+
+// matchDiffElements pairs elements of current and other by id or else by key
+// the result keeps the order of current; unmatched elements of other are appended
+func matchDiffElements[T any](current []T, other []T, id func(T) string, key func(T) string) (result []diffMatch[T]) {
+	matches := make([]int, len(current))
+	used := make([]bool, len(other))
+	for i := range current {
+		matches[i] = slices.IndexFunc(other, func(candidate T) bool {
+			return id(current[i]) != "" && id(candidate) == id(current[i])
+		})
+		if matches[i] >= 0 {
+			used[matches[i]] = true
+		}
+	}
+	for i := range current {
+		if matches[i] >= 0 {
+			continue
+		}
+		for j, candidate := range other {
+			if !used[j] && key(candidate) == key(current[i]) {
+				matches[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	for i := range current {
+		match := diffMatch[T]{current: &current[i]}
+		if matches[i] >= 0 {
+			match.other = &other[matches[i]]
+		}
+		result = append(result, match)
+	}
+	for j := range other {
+		if !used[j] {
+			result = append(result, diffMatch[T]{other: &other[j]})
+		}
+	}
+	return result
+}
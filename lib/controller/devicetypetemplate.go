@@ -0,0 +1,268 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (this *Controller) ListDeviceTypeTemplates(ctx context.Context, options model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, total, err = this.db.ListDeviceTypeTemplates(ctx, options)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	return result, total, nil, http.StatusOK
+}
+
+func (this *Controller) GetDeviceTypeTemplate(ctx context.Context, id string) (result model.DeviceTypeTemplate, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, exists, err := this.db.GetDeviceTypeTemplate(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("device-type-template %w", model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetDeviceTypeTemplate creates or updates a template
+// all device-types extending the template are re-validated before the update and re-materialized (and published) before the template is stored;
+// if a device-type or the template can not be stored, the already updated device-types are restored
+func (this *Controller) SetDeviceTypeTemplate(ctx context.Context, token string, template model.DeviceTypeTemplate) (result model.DeviceTypeTemplateUpdateResult, err error, errCode int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutDeviceTypeTemplate)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusUnauthorized
+	}
+	if !jwtToken.IsAdmin() {
+		return result, errors.New("only admins may set device-type-templates"), http.StatusForbidden
+	}
+	template.GenerateId()
+	err, errCode = this.ValidateDeviceTypeTemplate(ctx, template)
+	if err != nil {
+		return result, err, errCode
+	}
+	extensions, err := this.db.ListDeviceTypeExtensions(ctx, template.Id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	dependents := []models.DeviceType{}
+	for _, extension := range extensions {
+		dt := extension.Materialize(template)
+		if !this.config.DisableStrictValidationForTesting {
+			err, errCode = this.ValidateDeviceType(ctx, dt, model.ValidationOptions{})
+			if err != nil {
+				return result, fmt.Errorf("dependent device-type %v: %w", dt.Id, err), errCode
+			}
+		}
+		dependents = append(dependents, dt)
+	}
+
+	//dependents are stored first, so that a failed update leaves no device-type materialized from an unknown template version
+	updated := []models.DeviceType{}
+	created := []string{}
+	for _, dt := range dependents {
+		previous, exists, err := this.db.GetDeviceType(ctx, dt.Id)
+		if err != nil {
+			this.restoreDeviceTypes(updated, created)
+			return result, err, http.StatusInternalServerError
+		}
+		err = this.setDeviceType(ctx, dt)
+		if err != nil {
+			this.restoreDeviceTypes(updated, created)
+			return result, fmt.Errorf("dependent device-type %v: %w", dt.Id, err), http.StatusInternalServerError
+		}
+		if exists {
+			updated = append(updated, previous)
+		} else {
+			created = append(created, dt.Id)
+		}
+	}
+	err = this.db.SetDeviceTypeTemplate(ctx, template)
+	if err != nil {
+		this.restoreDeviceTypes(updated, created)
+		return result, err, http.StatusInternalServerError
+	}
+	result = model.DeviceTypeTemplateUpdateResult{
+		DeviceTypeTemplate:   template,
+		UpdatedDeviceTypeIds: []string{},
+	}
+	for _, dt := range dependents {
+		result.UpdatedDeviceTypeIds = append(result.UpdatedDeviceTypeIds, dt.Id)
+	}
+	return result, nil, http.StatusOK
+}
+
+// restoreDeviceTypes stores the previous versions of device-types and removes newly created device-types
+// after a failed SetDeviceTypeTemplate or SetDeviceTypeExtension
+// the request context may already be done, so the restore uses its own deadline
+func (this *Controller) restoreDeviceTypes(previous []models.DeviceType, created []string) {
+	ctx, cancel := this.getOperationTimeoutContext(context.Background(), configuration.TimeoutDeviceTypeTemplate)
+	defer cancel()
+	for _, dt := range previous {
+		err := this.setDeviceType(ctx, dt)
+		if err != nil {
+			this.config.GetLogger().Error("unable to restore device-type after failed device-type-template or extension update", "id", dt.Id, "error", err)
+		}
+	}
+	for _, id := range created {
+		err := this.db.RemoveDeviceType(ctx, id, this.deleteDeviceTypeSyncHandler)
+		if err != nil {
+			this.config.GetLogger().Error("unable to remove device-type after failed device-type-template or extension update", "id", id, "error", err)
+		}
+	}
+}
+
+func (this *Controller) ValidateDeviceTypeTemplate(ctx context.Context, template model.DeviceTypeTemplate) (err error, code int) {
+	if template.Id == "" {
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing device-type-template id")), http.StatusBadRequest
+	}
+	if template.Name == "" {
+		return model.NewFieldError(model.ErrMissingField, "name", errors.New("missing device-type-template name")), http.StatusBadRequest
+	}
+	protocolCache := &map[string]models.Protocol{}
+	localIds := map[string]bool{}
+	for i, service := range template.Services {
+		field := fmt.Sprintf("services[%v]", i)
+		if localIds[service.LocalId] {
+			return model.NewFieldError(model.ErrInvalidField, field+".local_id", errors.New("reused service local id: "+service.LocalId)), http.StatusBadRequest
+		}
+		localIds[service.LocalId] = true
+		err, code = this.ValidateService(ctx, service, protocolCache, model.ValidationOptions{})
+		if err != nil {
+			return model.PrefixErrorField(err, field), code
+		}
+	}
+	err = ValidateServiceGroups(template.ServiceGroups, template.Services)
+	if err != nil {
+		return model.NewFieldError(model.ErrDeviceTypeInvalidServiceGroup, "service_groups", err), http.StatusBadRequest
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) DeleteDeviceTypeTemplate(ctx context.Context, token string, id string) (err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return err, http.StatusUnauthorized
+	}
+	if !jwtToken.IsAdmin() {
+		return errors.New("only admins may delete device-type-templates"), http.StatusForbidden
+	}
+	extensions, err := this.db.ListDeviceTypeExtensions(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if len(extensions) > 0 {
+		return model.NewError(model.ErrDeviceTypeTemplateInUse, fmt.Errorf("device-type-template is still extended by %v device-types", len(extensions))), http.StatusBadRequest
+	}
+	err = this.db.RemoveDeviceTypeTemplate(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (result model.DeviceTypeExtension, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, exists, err := this.db.GetDeviceTypeExtension(ctx, deviceTypeId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("device-type-extension %w", model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetDeviceTypeExtension stores the extension and the materialized device-type
+// an empty extension.DeviceTypeId creates a new device-type; an existing device-type is converted into an extension
+// if the extension can not be stored, the previous device-type is restored (or the new one removed)
+func (this *Controller) SetDeviceTypeExtension(ctx context.Context, token string, extension model.DeviceTypeExtension, options model.DeviceTypeUpdateOptions) (result models.DeviceType, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	if extension.TemplateId == "" {
+		return result, model.NewFieldError(model.ErrMissingField, "template_id", errors.New("missing template id")), http.StatusBadRequest
+	}
+	template, exists, err := this.db.GetDeviceTypeTemplate(ctx, extension.TemplateId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, model.NewFieldError(model.ErrInvalidField, "template_id", errors.New("unknown device-type-template")), http.StatusBadRequest
+	}
+	if extension.DeviceTypeId == "" {
+		dt := models.DeviceType{}
+		dt.GenerateId()
+		extension.DeviceTypeId = dt.Id
+	}
+	extension.Overrides.Id = extension.DeviceTypeId
+	extension.Overrides.GenerateId()
+	previous, exists, err := this.db.GetDeviceType(ctx, extension.DeviceTypeId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	result, err, errCode = this.setDeviceTypeWithValidation(ctx, token, extension.Materialize(template), options)
+	if err != nil {
+		return result, err, errCode
+	}
+	err = this.db.SetDeviceTypeExtension(ctx, extension)
+	if err != nil {
+		//without the extension record the materialized device-type would not follow template updates
+		if exists {
+			this.restoreDeviceTypes([]models.DeviceType{previous}, nil)
+		} else {
+			this.restoreDeviceTypes(nil, []string{extension.DeviceTypeId})
+		}
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// RemoveDeviceTypeExtension detaches the device-type from its template
+// the last materialized device-type is kept and may be updated with SetDeviceType afterward
+func (this *Controller) RemoveDeviceTypeExtension(ctx context.Context, token string, deviceTypeId string) (err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return err, http.StatusUnauthorized
+	}
+	if !jwtToken.IsAdmin() {
+		return errors.New("only admins may remove device-type-extensions"), http.StatusForbidden
+	}
+	err = this.db.RemoveDeviceTypeExtension(ctx, deviceTypeId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
@@ -0,0 +1,304 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (this *Controller) GetFunctionDeprecation(ctx context.Context, functionId string) (result model.FunctionDeprecation, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, exists, err := this.db.GetFunctionDeprecation(ctx, functionId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("deprecation of function %v %w", functionId, model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result, err = this.db.ListFunctionDeprecations(ctx)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetFunctionDeprecation marks deprecation.FunctionId as deprecated; existing usages stay untouched until MigrateDeprecatedFunction is called
+func (this *Controller) SetFunctionDeprecation(ctx context.Context, token string, deprecation model.FunctionDeprecation) (result model.FunctionDeprecation, err error, code int) {
+	err, code = this.checkFunctionDeprecationRights(token)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	err, code = this.validateFunctionDeprecation(ctx, deprecation)
+	if err != nil {
+		return result, err, code
+	}
+	err = this.db.SetFunctionDeprecation(ctx, deprecation)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return deprecation, nil, http.StatusOK
+}
+
+func (this *Controller) RemoveFunctionDeprecation(ctx context.Context, token string, functionId string) (err error, code int) {
+	err, code = this.checkFunctionDeprecationRights(token)
+	if err != nil {
+		return err, code
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	_, exists, err := this.db.GetFunctionDeprecation(ctx, functionId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return fmt.Errorf("deprecation of function %v %w", functionId, model.ErrNotFound), http.StatusNotFound
+	}
+	err = this.db.RemoveFunctionDeprecation(ctx, functionId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
+
+// GetFunctionUsage lists all content-variables of device-types referencing the function (ref device-type criteria)
+func (this *Controller) GetFunctionUsage(ctx context.Context, functionId string) (result []model.FunctionUsage, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	_, exists, err := this.db.GetFunction(ctx, functionId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, fmt.Errorf("function %v %w", functionId, model.ErrNotFound), http.StatusNotFound
+	}
+	result, err = this.getFunctionUsage(ctx, functionId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// MigrateDeprecatedFunction replaces all references to the deprecated function in device-types, device-type-extensions and device-type-templates
+// with the replacement function, maps characteristics by the deprecations characteristic mapping and recomputes the criteria of affected device-groups
+func (this *Controller) MigrateDeprecatedFunction(ctx context.Context, token string, functionId string, dryRun bool) (result model.FunctionMigrationResult, err error, code int) {
+	err, code = this.checkFunctionDeprecationRights(token)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutFunctionMigration)
+	defer cancel()
+	deprecation, exists, err := this.db.GetFunctionDeprecation(ctx, functionId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, model.NewError(model.ErrFunctionNotDeprecated, fmt.Errorf("function %v is not deprecated", functionId)), http.StatusBadRequest
+	}
+	result.FunctionId = deprecation.FunctionId
+	result.ReplacementFunctionId = deprecation.ReplacementFunctionId
+	result.RewrittenDeviceTypeIds = []string{}
+	result.RewrittenDeviceTypeTemplateIds = []string{}
+
+	result.Usages, err = this.getFunctionUsage(ctx, functionId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	replacementCharacteristics, err, code := this.getFunctionCharacteristicIds(ctx, deprecation.ReplacementFunctionId)
+	if err != nil {
+		return result, err, code
+	}
+	mapCharacteristic := func(characteristicId string) (string, bool) {
+		if characteristicId == "" {
+			return "", true
+		}
+		if mapped, ok := deprecation.CharacteristicMapping[characteristicId]; ok {
+			return mapped, true
+		}
+		return characteristicId, replacementCharacteristics == nil || slices.Contains(replacementCharacteristics, characteristicId)
+	}
+	deviceTypeIds := []string{}
+	for _, usage := range result.Usages {
+		if _, ok := mapCharacteristic(usage.CharacteristicId); !ok {
+			return result, model.NewFieldError(model.ErrFunctionMissingCharacteristicMapping, "characteristic_mapping", fmt.Errorf("missing mapping for characteristic %v used by %v %v %v", usage.CharacteristicId, usage.DeviceTypeId, usage.ServiceId, usage.ContentVariablePath)), http.StatusBadRequest
+		}
+		if !slices.Contains(deviceTypeIds, usage.DeviceTypeId) {
+			deviceTypeIds = append(deviceTypeIds, usage.DeviceTypeId)
+		}
+	}
+
+	rewrite, err := this.planReferenceRewrite(ctx, deviceTypeIds, func(variable models.ContentVariable) bool {
+		return variable.FunctionId == functionId
+	}, func(variable models.ContentVariable) models.ContentVariable {
+		variable.FunctionId = deprecation.ReplacementFunctionId
+		variable.CharacteristicId, _ = mapCharacteristic(variable.CharacteristicId)
+		return variable
+	})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	result.DeviceTypeIds = rewrite.DeviceTypeIds()
+	result.DeviceTypeTemplateIds = rewrite.TemplateIds()
+	result.DeviceGroupIds, err = this.getDeviceGroupIdsOfDeviceTypes(ctx, result.DeviceTypeIds)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+
+	if dryRun {
+		return result, nil, http.StatusOK
+	}
+
+	//device-group criteria are recomputed by the device-type sync handler
+	applied, err := this.applyReferenceRewrite(ctx, rewrite)
+	result.RewrittenDeviceTypeIds = applied.DeviceTypeIds()
+	result.RewrittenDeviceTypeTemplateIds = applied.TemplateIds()
+	if err != nil {
+		//rewritten references no longer use the deprecated function, a retry only migrates the remaining usages
+		return result, fmt.Errorf("function migration incomplete (rewritten device-types: %v, rewritten device-type-templates: %v), retry to migrate the remaining usages: %w", result.RewrittenDeviceTypeIds, result.RewrittenDeviceTypeTemplateIds, err), http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) checkFunctionDeprecationRights(token string) (err error, code int) {
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !jwtToken.IsAdmin() {
+		return errors.New("token is not an admin"), http.StatusUnauthorized
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) validateFunctionDeprecation(ctx context.Context, deprecation model.FunctionDeprecation) (err error, code int) {
+	if deprecation.FunctionId == "" {
+		return model.NewFieldError(model.ErrMissingField, "function_id", errors.New("missing function id")), http.StatusBadRequest
+	}
+	if deprecation.ReplacementFunctionId == "" {
+		return model.NewFieldError(model.ErrMissingField, "replacement_function_id", errors.New("missing replacement function id")), http.StatusBadRequest
+	}
+	if deprecation.ReplacementFunctionId == deprecation.FunctionId {
+		return model.NewFieldError(model.ErrFunctionInvalidReplacement, "replacement_function_id", errors.New("function can not replace itself")), http.StatusBadRequest
+	}
+	function, exists, err := this.db.GetFunction(ctx, deprecation.FunctionId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return fmt.Errorf("function %v %w", deprecation.FunctionId, model.ErrNotFound), http.StatusNotFound
+	}
+	replacement, exists, err := this.db.GetFunction(ctx, deprecation.ReplacementFunctionId)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return model.NewFieldError(model.ErrFunctionInvalidReplacement, "replacement_function_id", fmt.Errorf("unknown replacement function %v", deprecation.ReplacementFunctionId)), http.StatusBadRequest
+	}
+	if function.RdfType != replacement.RdfType {
+		return model.NewFieldError(model.ErrFunctionInvalidReplacement, "replacement_function_id", errors.New("replacement function must be of the same type (controlling/measuring) as the deprecated function")), http.StatusBadRequest
+	}
+	_, replacementIsDeprecated, err := this.db.GetFunctionDeprecation(ctx, replacement.Id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if replacementIsDeprecated {
+		return model.NewFieldError(model.ErrFunctionInvalidReplacement, "replacement_function_id", errors.New("replacement function is deprecated")), http.StatusBadRequest
+	}
+	if len(deprecation.CharacteristicMapping) == 0 {
+		return nil, http.StatusOK
+	}
+	characteristics, err, code := this.getFunctionCharacteristicIds(ctx, function.Id)
+	if err != nil {
+		return err, code
+	}
+	replacementCharacteristics, err, code := this.getFunctionCharacteristicIds(ctx, replacement.Id)
+	if err != nil {
+		return err, code
+	}
+	for from, to := range deprecation.CharacteristicMapping {
+		if characteristics != nil && !slices.Contains(characteristics, from) {
+			return model.NewFieldError(model.ErrInvalidField, "characteristic_mapping", fmt.Errorf("characteristic %v is not part of the concept of function %v", from, function.Id)), http.StatusBadRequest
+		}
+		if replacementCharacteristics != nil && !slices.Contains(replacementCharacteristics, to) {
+			return model.NewFieldError(model.ErrInvalidField, "characteristic_mapping", fmt.Errorf("characteristic %v is not part of the concept of function %v", to, replacement.Id)), http.StatusBadRequest
+		}
+	}
+	return nil, http.StatusOK
+}
+
+// getFunctionCharacteristicIds returns the characteristic ids of the functions concept; nil if the function has no concept
+func (this *Controller) getFunctionCharacteristicIds(ctx context.Context, functionId string) (result []string, err error, code int) {
+	function, exists, err := this.db.GetFunction(ctx, functionId)
+	if err != nil {
+		return nil, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return nil, fmt.Errorf("function %v %w", functionId, model.ErrNotFound), http.StatusNotFound
+	}
+	if function.ConceptId == "" {
+		return nil, nil, http.StatusOK
+	}
+	concept, exists, err := this.db.GetConceptWithoutCharacteristics(ctx, function.ConceptId)
+	if err != nil {
+		return nil, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return nil, nil, http.StatusOK
+	}
+	return concept.CharacteristicIds, nil, http.StatusOK
+}
+
+func (this *Controller) getFunctionUsage(ctx context.Context, functionId string) (result []model.FunctionUsage, err error) {
+	result = []model.FunctionUsage{}
+	criteria, err := this.db.GetDeviceTypeCriteriaByFunctionIds(ctx, []string{functionId}, false)
+	if err != nil {
+		return result, err
+	}
+	for _, c := range criteria {
+		//criteria may repeat a content-variable (e.g. per device-class)
+		if slices.ContainsFunc(result, func(usage model.FunctionUsage) bool {
+			return usage.DeviceTypeId == c.DeviceTypeId && usage.ServiceId == c.ServiceId && usage.ContentVariableId == c.ContentVariableId
+		}) {
+			continue
+		}
+		result = append(result, model.FunctionUsage{
+			DeviceTypeId:        c.DeviceTypeId,
+			ServiceId:           c.ServiceId,
+			ContentVariableId:   c.ContentVariableId,
+			ContentVariablePath: c.ContentVariablePath,
+			CharacteristicId:    c.CharacteristicId,
+		})
+	}
+	return result, nil
+}
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -25,7 +26,7 @@ import (
 	"strings"
 )
 
-func (this *Controller) SetFunction(token string, function models.Function) (result models.Function, err error, code int) {
+func (this *Controller) SetFunction(ctx context.Context, token string, function models.Function) (result models.Function, err error, code int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -38,20 +39,20 @@ func (this *Controller) SetFunction(token string, function models.Function) (res
 	//ensure ids
 	function.GenerateId()
 	if !this.config.DisableStrictValidationForTesting {
-		err, code = this.ValidateFunction(function)
+		err, code = this.ValidateFunction(ctx, function)
 		if err != nil {
 			return result, err, code
 		}
 	}
 
-	err = this.setFunction(function)
+	err = this.setFunction(ctx, function)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
 	return function, nil, http.StatusOK
 }
 
-func (this *Controller) DeleteFunction(token string, id string) (error, int) {
+func (this *Controller) DeleteFunction(ctx context.Context, token string, id string) (error, int) {
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -59,11 +60,15 @@ func (this *Controller) DeleteFunction(token string, id string) (error, int) {
 	if !jwtToken.IsAdmin() {
 		return errors.New("token is not an admin"), http.StatusUnauthorized
 	}
-	err, code := this.ValidateFunctionDelete(id)
+	err, code := this.ValidateFunctionDelete(ctx, id)
 	if err != nil {
 		return err, code
 	}
-	err = this.deleteFunction(id)
+	err = this.deleteFunction(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	err = this.db.RemoveFunctionDeprecation(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -74,8 +79,9 @@ func (this *Controller) setFunctionSyncHandler(f models.Function) (err error) {
 	return this.publisher.PublishFunction(f)
 }
 
-func (this *Controller) setFunction(function models.Function) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setFunction(ctx context.Context, function models.Function) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.SetFunction(ctx, function, this.setFunctionSyncHandler)
 }
 
@@ -83,13 +89,15 @@ func (this *Controller) deleteFunctionSyncHandler(f models.Function) (err error)
 	return this.publisher.PublishFunctionDelete(f.Id)
 }
 
-func (this *Controller) deleteFunction(id string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteFunction(ctx context.Context, id string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.RemoveFunction(ctx, id, this.deleteFunctionSyncHandler)
 }
 
-func (this *Controller) ListFunctions(options model.FunctionListOptions) (result []models.Function, total int64, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListFunctions(ctx context.Context, options model.FunctionListOptions) (result []models.Function, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, total, err = this.db.ListFunctions(ctx, options)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -97,9 +105,10 @@ func (this *Controller) ListFunctions(options model.FunctionListOptions) (result
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) GetFunctionsByType(rdfType string) (result []models.Function, err error, code int) {
+func (this *Controller) GetFunctionsByType(ctx context.Context, rdfType string) (result []models.Function, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllFunctionsByType(ctx, rdfType)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -108,9 +117,10 @@ func (this *Controller) GetFunctionsByType(rdfType string) (result []models.Func
 }
 
 // returns all measuring functions used in combination with given aspect (and optional its descendants and ancestors)
-func (this *Controller) GetAspectNodesMeasuringFunctions(aspect string, ancestors bool, descendants bool) (result []models.Function, err error, code int) {
+func (this *Controller) GetAspectNodesMeasuringFunctions(ctx context.Context, aspect string, ancestors bool, descendants bool) (result []models.Function, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllMeasuringFunctionsByAspect(ctx, aspect, ancestors, descendants)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -118,9 +128,10 @@ func (this *Controller) GetAspectNodesMeasuringFunctions(aspect string, ancestor
 	return
 }
 
-func (this *Controller) GetDeviceClassesFunctions(deviceClass string) (result []models.Function, err error, code int) {
+func (this *Controller) GetDeviceClassesFunctions(ctx context.Context, deviceClass string) (result []models.Function, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllFunctionsByDeviceClass(ctx, deviceClass)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -128,9 +139,10 @@ func (this *Controller) GetDeviceClassesFunctions(deviceClass string) (result []
 	return
 }
 
-func (this *Controller) GetDeviceClassesControllingFunctions(deviceClass string) (result []models.Function, err error, code int) {
+func (this *Controller) GetDeviceClassesControllingFunctions(ctx context.Context, deviceClass string) (result []models.Function, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	code = http.StatusOK
-	ctx, _ := getTimeoutContext()
 	result, err = this.db.ListAllControllingFunctionsByDeviceClass(ctx, deviceClass)
 	if err != nil {
 		code = http.StatusInternalServerError
@@ -138,8 +150,9 @@ func (this *Controller) GetDeviceClassesControllingFunctions(deviceClass string)
 	return
 }
 
-func (this *Controller) GetFunction(id string) (result models.Function, err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) GetFunction(ctx context.Context, id string) (result models.Function, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	result, exists, err := this.db.GetFunction(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -150,7 +163,7 @@ func (this *Controller) GetFunction(id string) (result models.Function, err erro
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ValidateFunction(function models.Function) (err error, code int) {
+func (this *Controller) ValidateFunction(ctx context.Context, function models.Function) (err error, code int) {
 	if function.Id == "" {
 		return errors.New("missing function id"), http.StatusBadRequest
 	}
@@ -166,8 +179,9 @@ func (this *Controller) ValidateFunction(function models.Function) (err error, c
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateFunctionDelete(id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ValidateFunctionDelete(ctx context.Context, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	isUsed, where, err := this.db.FunctionIsUsed(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -28,7 +29,9 @@ import (
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 )
 
-func (this *Controller) ListGraphs(token string, options model.GraphListOptions) (result []models.Graph, total int64, err error, errCode int) {
+func (this *Controller) ListGraphs(ctx context.Context, token string, options model.GraphListOptions) (result []models.Graph, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -64,7 +67,6 @@ func (this *Controller) ListGraphs(token string, options model.GraphListOptions)
 
 	options.Ids = ids
 
-	ctx, _ := getTimeoutContext()
 	result, total, err = this.db.ListGraphs(ctx, options)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -72,7 +74,9 @@ func (this *Controller) ListGraphs(token string, options model.GraphListOptions)
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ReadGraph(token string, id string) (result models.Graph, err error, errCode int) {
+func (this *Controller) ReadGraph(ctx context.Context, token string, id string) (result models.Graph, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ok, err, _ := this.permissionsV2Client.CheckPermission(token, this.config.GraphTopic, id, client.Read)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -80,7 +84,6 @@ func (this *Controller) ReadGraph(token string, id string) (result models.Graph,
 	if !ok {
 		return result, errors.New("access denied"), http.StatusForbidden
 	}
-	ctx, _ := getTimeoutContext()
 	result, exists, err := this.db.GetGraph(ctx, id)
 	if err != nil {
 		return models.Graph{}, err, errCode
@@ -91,8 +94,9 @@ func (this *Controller) ReadGraph(token string, id string) (result models.Graph,
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) removeDeviceFromGraphs(deviceId string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) removeDeviceFromGraphs(ctx context.Context, deviceId string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	graphs, _, err := this.db.ListGraphs(ctx, model.GraphListOptions{DeviceIds: []string{deviceId}})
 	if err != nil {
 		return err
@@ -108,7 +112,7 @@ func (this *Controller) removeDeviceFromGraphs(deviceId string) error {
 			this.config.GetLogger().Error("graph.DeleteNode created invalid graph", "graphId", graph.Id, "deviceId", deviceId, "error", err)
 			return err
 		}
-		err = this.setGraph(graph)
+		err = this.setGraph(ctx, graph)
 		if err != nil {
 			this.config.GetLogger().Error("unable to update graph to remove device", "graphId", graph.Id, "deviceId", deviceId, "error", err)
 			return err
@@ -117,7 +121,9 @@ func (this *Controller) removeDeviceFromGraphs(deviceId string) error {
 	return nil
 }
 
-func (this *Controller) SetGraph(token string, graph models.Graph) (result models.Graph, err error, code int) {
+func (this *Controller) SetGraph(ctx context.Context, token string, graph models.Graph) (result models.Graph, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -136,7 +142,6 @@ func (this *Controller) SetGraph(token string, graph models.Graph) (result model
 		}
 	}
 
-	ctx, _ := getTimeoutContext()
 	original, exists, err := this.db.GetGraph(ctx, graph.Id)
 	if err != nil {
 		return graph, err, code
@@ -181,7 +186,7 @@ func (this *Controller) SetGraph(token string, graph models.Graph) (result model
 		return graph, fmt.Errorf("invalid graph: %w", err), http.StatusBadRequest
 	}
 
-	err = this.setGraph(graph)
+	err = this.setGraph(ctx, graph)
 	if err != nil {
 		return graph, err, http.StatusInternalServerError
 	}
@@ -189,9 +194,10 @@ func (this *Controller) SetGraph(token string, graph models.Graph) (result model
 	return graph, nil, http.StatusOK
 }
 
-func (this *Controller) setGraph(graph models.Graph) (err error) {
+func (this *Controller) setGraph(ctx context.Context, graph models.Graph) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	this.config.GetLogger().Debug("create/update graph", "id", graph.Id)
-	ctx, _ := getTimeoutContext()
 	err = this.db.SetGraph(ctx, graph, this.setGraphSyncHandler)
 	if err != nil {
 		return err
@@ -207,8 +213,9 @@ func (this *Controller) setGraphSyncHandler(graph models.Graph) (err error) {
 	return nil
 }
 
-func (this *Controller) DeleteGraph(token string, id string) (error, int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) DeleteGraph(ctx context.Context, token string, id string) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	_, exists, err := this.db.GetGraph(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -223,7 +230,7 @@ func (this *Controller) DeleteGraph(token string, id string) (error, int) {
 	if !ok {
 		return errors.New("access denied"), http.StatusForbidden
 	}
-	err = this.deleteGraph(id)
+	err = this.deleteGraph(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -238,8 +245,9 @@ func (this *Controller) deleteGraphSyncHandler(old models.Graph) (err error) {
 	return nil
 }
 
-func (this *Controller) deleteGraph(id string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteGraph(ctx context.Context, id string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err := this.db.RemoveGraph(ctx, id, this.deleteGraphSyncHandler)
 	if err != nil {
 		return err
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+// SetStartupFinished marks the controller as ready in regard to startup migrations, the sync loop and the mirror pull callback
+// is called at the end of lib.Start, even if migrations are disabled
+func (this *Controller) SetStartupFinished() {
+	this.startupFinished.Store(true)
+}
+
+// StartupFinished is used by the api to answer requests other than /health/* with 503 until SetStartupFinished()
+func (this *Controller) StartupFinished() bool {
+	return this.startupFinished.Load()
+}
+
+func (this *Controller) GetReadiness(ctx context.Context) (result model.HealthReport) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutHealth)
+	defer cancel()
+
+	checks := map[string]func(ctx context.Context) (skipped bool, err error){
+		"mongo": func(ctx context.Context) (bool, error) {
+			return false, this.db.Ping(ctx)
+		},
+		"kafka": func(ctx context.Context) (bool, error) {
+			if this.config.KafkaUrl == "" || this.config.KafkaUrl == "-" {
+				return true, nil
+			}
+			return false, this.publisher.Ping(ctx)
+		},
+		"permissions": func(ctx context.Context) (bool, error) {
+			if this.config.AsMgwMirror {
+				return true, nil //permissions are handled locally by mgwmirror.MgwMirrorPerm
+			}
+			if this.config.PermissionsV2Url == "" || this.config.PermissionsV2Url == "-" {
+				return true, nil //permissions client is injected (e.g. in tests)
+			}
+			return false, pingHttpService(ctx, this.config.PermissionsV2Url)
+		},
+		"mirror_source": func(ctx context.Context) (bool, error) {
+			if !this.config.AsMgwMirror {
+				return true, nil
+			}
+			return false, pingHttpService(ctx, this.config.MgwMirrorSourceUrl)
+		},
+		"startup": func(ctx context.Context) (bool, error) {
+			if !this.startupFinished.Load() {
+				return false, errors.New("startup not finished")
+			}
+			return false, nil
+		},
+	}
+
+	result = model.HealthReport{Status: model.HealthStatusOk, Checks: map[string]model.HealthCheckResult{}}
+	mux := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			skipped, err := check(ctx)
+			checkResult := model.HealthCheckResult{Status: model.HealthStatusOk, Duration: time.Since(start).String()}
+			switch {
+			case err != nil:
+				checkResult.Status = model.HealthStatusError
+				checkResult.Error = err.Error()
+			case skipped:
+				checkResult = model.HealthCheckResult{Status: model.HealthStatusSkipped}
+			}
+			mux.Lock()
+			defer mux.Unlock()
+			result.Checks[name] = checkResult
+			if err != nil {
+				result.Status = model.HealthStatusError
+				this.logger.Warn("readiness check failed", "check", name, "error", err)
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+// pingHttpService checks if the service behind url responds; every response below 500 counts as reachable
+func pingHttpService(ctx context.Context, url string) error {
+	if url == "" || url == "-" {
+		return errors.New("missing url")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("unexpected statuscode %v", resp.StatusCode)
+	}
+	return nil
+}
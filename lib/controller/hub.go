@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -31,7 +32,9 @@ import (
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 )
 
-func (this *Controller) ListHubs(token string, options model.HubListOptions) (result []models.Hub, err error, errCode int) {
+func (this *Controller) ListHubs(ctx context.Context, token string, options model.HubListOptions) (result []models.Hub, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -69,7 +72,6 @@ func (this *Controller) ListHubs(token string, options model.HubListOptions) (re
 		options.OwnerId = jwtToken.GetUserId()
 	}
 
-	ctx, _ := getTimeoutContext()
 	hubs, _, err := this.db.ListHubs(ctx, options, false)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -81,7 +83,9 @@ func (this *Controller) ListHubs(token string, options model.HubListOptions) (re
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ListExtendedHubs(token string, options model.HubListOptions) (result []models.ExtendedHub, total int64, err error, errCode int) {
+func (this *Controller) ListExtendedHubs(ctx context.Context, token string, options model.HubListOptions) (result []models.ExtendedHub, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -119,7 +123,6 @@ func (this *Controller) ListExtendedHubs(token string, options model.HubListOpti
 		options.OwnerId = jwtToken.GetUserId()
 	}
 
-	ctx, _ := getTimeoutContext()
 	hubs, total, err := this.db.ListHubs(ctx, options, true)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -145,8 +148,9 @@ func (this *Controller) ListExtendedHubs(token string, options model.HubListOpti
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ReadExtendedHub(id string, token string, action model.AuthAction) (result models.ExtendedHub, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ReadExtendedHub(ctx context.Context, id string, token string, action model.AuthAction) (result models.ExtendedHub, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	hub, exists, err := this.db.GetHub(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -195,8 +199,9 @@ func (this *Controller) extendHub(token string, hub model.HubWithConnectionState
 	}, nil
 }
 
-func (this *Controller) ReadHub(id string, token string, action model.AuthAction) (result models.Hub, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ReadHub(ctx context.Context, id string, token string, action model.AuthAction) (result models.Hub, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	hub, exists, err := this.db.GetHub(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -214,8 +219,9 @@ func (this *Controller) ReadHub(id string, token string, action model.AuthAction
 	return hub.Hub, nil, http.StatusOK
 }
 
-func (this *Controller) ListHubDeviceIds(id string, token string, action model.AuthAction, asLocalId bool) (result []string, err error, errCode int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) ListHubDeviceIds(ctx context.Context, id string, token string, action model.AuthAction, asLocalId bool) (result []string, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	hub, exists, err := this.db.GetHub(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -237,7 +243,9 @@ func (this *Controller) ListHubDeviceIds(id string, token string, action model.A
 	}
 }
 
-func (this *Controller) ValidateHub(token string, hub models.Hub) (err error, code int) {
+func (this *Controller) ValidateHub(ctx context.Context, token string, hub models.Hub) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if hub.Id == "" {
 		return errors.New("missing hub id"), http.StatusBadRequest
 	}
@@ -248,7 +256,6 @@ func (this *Controller) ValidateHub(token string, hub models.Hub) (err error, co
 		return errors.New("missing hub owner_id"), http.StatusBadRequest
 	}
 	for _, deviceId := range hub.DeviceIds {
-		ctx, _ := getTimeoutContext()
 		device, exists, err := this.db.GetDevice(ctx, deviceId)
 		if err != nil {
 			return err, http.StatusInternalServerError
@@ -260,7 +267,6 @@ func (this *Controller) ValidateHub(token string, hub models.Hub) (err error, co
 			return errors.New("all devices in the hub must have the same owner_id as the hub"), http.StatusBadRequest
 		}
 	}
-	ctx, _ := getTimeoutContext()
 	original, exists, err := this.db.GetHub(ctx, hub.Id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -287,13 +293,14 @@ func (this *Controller) ValidateHub(token string, hub models.Hub) (err error, co
 			}
 		}
 	}
-	return this.ValidateHubDevices(hub)
+	return this.ValidateHubDevices(ctx, hub)
 }
 
-func (this *Controller) ValidateHubDevices(hub models.Hub) (err error, code int) {
+func (this *Controller) ValidateHubDevices(ctx context.Context, hub models.Hub) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	for _, localId := range hub.DeviceLocalIds {
 		//device exists?
-		ctx, _ := getTimeoutContext()
 		device, exists, err := this.db.GetDeviceByLocalId(ctx, hub.OwnerId, localId)
 		if err != nil {
 			return err, http.StatusInternalServerError
@@ -307,7 +314,6 @@ func (this *Controller) ValidateHubDevices(hub models.Hub) (err error, code int)
 	}
 	for _, id := range hub.DeviceIds {
 		//device exists?
-		ctx, _ := getTimeoutContext()
 		device, exists, err := this.db.GetDevice(ctx, id)
 		if err != nil {
 			return err, http.StatusInternalServerError
@@ -325,15 +331,16 @@ func (this *Controller) ValidateHubDevices(hub models.Hub) (err error, code int)
 	return nil, http.StatusOK
 }
 
-func (this *Controller) SetHub(token string, hub models.Hub, options model.HubUpdateOptions) (result models.Hub, err error, code int) {
-	hub, err, code = this.completeHub(token, hub)
+func (this *Controller) SetHub(ctx context.Context, token string, hub models.Hub, options model.HubUpdateOptions) (result models.Hub, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	hub, err, code = this.completeHub(ctx, token, hub)
 	if err != nil {
 		return hub, err, code
 	}
 	if hub.Id == "" {
 		hub.GenerateId()
 	}
-	ctx, _ := getTimeoutContext()
 	old, exists, err := this.db.GetHub(ctx, hub.Id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -392,11 +399,11 @@ func (this *Controller) SetHub(token string, hub models.Hub, options model.HubUp
 		hub.Attributes = updateSameOriginAttributes(old.Attributes, hub.Attributes, options.UpdateOnlySameOriginAttributes)
 	}
 
-	err, code = this.ValidateHub(token, hub)
+	err, code = this.ValidateHub(ctx, token, hub)
 	if err != nil {
 		return hub, err, code
 	}
-	err = this.setHub(model.HubWithConnectionState{
+	err = this.setHub(ctx, model.HubWithConnectionState{
 		Hub:             hub,
 		ConnectionState: old.ConnectionState,
 	})
@@ -406,7 +413,7 @@ func (this *Controller) SetHub(token string, hub models.Hub, options model.HubUp
 	return hub, nil, http.StatusOK
 }
 
-func (this *Controller) completeHub(token string, edit models.Hub) (result models.Hub, err error, code int) {
+func (this *Controller) completeHub(ctx context.Context, token string, edit models.Hub) (result models.Hub, err error, code int) {
 	result = edit
 	if result.DeviceLocalIds == nil {
 		result.DeviceLocalIds = []string{}
@@ -416,7 +423,7 @@ func (this *Controller) completeHub(token string, edit models.Hub) (result model
 	}
 
 	if len(edit.DeviceLocalIds) > 0 {
-		devices, err, code := this.ListDevices(token, model.DeviceListOptions{LocalIds: edit.DeviceLocalIds, Owner: edit.OwnerId})
+		devices, err, code := this.ListDevices(ctx, token, model.DeviceListOptions{LocalIds: edit.DeviceLocalIds, Owner: edit.OwnerId})
 		if err != nil {
 			return result, err, code
 		}
@@ -444,7 +451,7 @@ func (this *Controller) completeHub(token string, edit models.Hub) (result model
 	}
 
 	if len(edit.DeviceIds) > 0 {
-		devices, err, code := this.ListDevices(token, model.DeviceListOptions{Ids: edit.DeviceIds})
+		devices, err, code := this.ListDevices(ctx, token, model.DeviceListOptions{Ids: edit.DeviceIds})
 		if err != nil {
 			return result, err, code
 		}
@@ -468,6 +475,8 @@ func (this *Controller) completeHub(token string, edit models.Hub) (result model
 }
 
 func (this *Controller) setHubSyncHandler(hub model.HubWithConnectionState) (err error) {
+	ctx, cancel := this.getSyncContext()
+	defer cancel()
 	err = this.EnsureInitialRights(this.config.HubTopic, hub.Id, hub.OwnerId)
 	if err != nil {
 		return err
@@ -476,7 +485,6 @@ func (this *Controller) setHubSyncHandler(hub model.HubWithConnectionState) (err
 	//remove devices fom other hubs
 	hubIndex := map[string]model.HubWithConnectionState{}
 	for _, id := range hub.DeviceIds {
-		ctx, _ := getTimeoutContext()
 		hubs, err := this.db.GetHubsByDeviceId(ctx, id)
 		if err != nil {
 			return err
@@ -500,7 +508,7 @@ func (this *Controller) setHubSyncHandler(hub model.HubWithConnectionState) (err
 		}
 	}
 	for _, otherHub := range hubIndex {
-		err := this.setHub(otherHub)
+		err := this.setHub(ctx, otherHub)
 		if err != nil {
 			return err
 		}
@@ -508,12 +516,13 @@ func (this *Controller) setHubSyncHandler(hub model.HubWithConnectionState) (err
 	return this.publisher.PublishHub(hub.Hub)
 }
 
-func (this *Controller) setHub(hub model.HubWithConnectionState) (err error) {
+func (this *Controller) setHub(ctx context.Context, hub model.HubWithConnectionState) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if hub.Id == "" {
 		this.config.GetLogger().Error("received hub without id")
 		return nil
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.SetHub(ctx, hub, this.setHubSyncHandler)
 	if err != nil {
 		return err
@@ -521,8 +530,9 @@ func (this *Controller) setHub(hub model.HubWithConnectionState) (err error) {
 	return nil
 }
 
-func (this *Controller) DeleteHub(token string, id string) (err error, code int) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) DeleteHub(ctx context.Context, token string, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	_, exists, err := this.db.GetHub(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -537,7 +547,7 @@ func (this *Controller) DeleteHub(token string, id string) (err error, code int)
 	if !ok {
 		return errors.New("access denied"), http.StatusForbidden
 	}
-	err = this.deleteHub(id)
+	err = this.deleteHub(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -552,8 +562,9 @@ func (this *Controller) deleteHubSyncHandler(hub model.HubWithConnectionState) (
 	return this.publisher.PublishHubDelete(hub.Hub)
 }
 
-func (this *Controller) deleteHub(id string) (err error) {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteHub(ctx context.Context, id string) (err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	err = this.db.RemoveHub(ctx, id, this.deleteHubSyncHandler)
 	if err != nil {
 		return err
@@ -561,7 +572,9 @@ func (this *Controller) deleteHub(id string) (err error) {
 	return nil
 }
 
-func (this *Controller) SetHubConnectionState(token string, id string, connected bool) (error, int) {
+func (this *Controller) SetHubConnectionState(ctx context.Context, token string, id string, connected bool) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -579,7 +592,6 @@ func (this *Controller) SetHubConnectionState(token string, id string, connected
 	if connected {
 		state = models.ConnectionStateOnline
 	}
-	ctx, _ := getTimeoutContext()
 	err = this.db.SetHubConnectionState(ctx, id, state)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -25,34 +26,44 @@ import (
 	"strings"
 )
 
-func (this *Controller) modifyDevice(device models.Device, modifier map[string][]string) (result models.Device, err error, code int) {
-	code = http.StatusOK
-	result = device
-	for key, params := range modifier {
-		switch key {
-		case ServiceGroupSelectionIdModifier:
-			result, err, code = this.modifyDeviceServiceGroupSelection(result, params)
-			if err != nil {
-				return result, err, code
-			}
-		}
+// modifyDevice applies the registered id-modifiers (ref idmodifier.Register) to the device
+func (this *Controller) modifyDevice(ctx context.Context, device models.Device, modifier map[string][]string) (result models.Device, err error, code int) {
+	if !idmodifier.IsKnown(modifier) {
+		return device, nil, http.StatusOK
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	pureDtId, _ := idmodifier.SplitModifier(device.DeviceTypeId)
+	dt, exists, err := this.db.GetDeviceType(ctx, pureDtId)
+	if err != nil {
+		return device, err, http.StatusInternalServerError
 	}
-	return result, err, code
+	if !exists {
+		return device, errors.New("unable to use id-modifier: device-type not found"), http.StatusInternalServerError
+	}
+	result, err = idmodifier.ModifyDevice(device, dt, modifier, idmodifier.Options{
+		AllowUnknownServiceGroup: this.config.DeviceServiceGroupSelectionAllowNotFound,
+	})
+	if err != nil {
+		return result, err, getIdModifierErrorCode(err)
+	}
+	return result, nil, http.StatusOK
 }
 
+// modifyDeviceType applies the registered id-modifiers (ref idmodifier.Register) to the device-type
 func (this *Controller) modifyDeviceType(dt models.DeviceType, modifier map[string][]string) (result models.DeviceType, err error, code int) {
-	code = http.StatusOK
-	result = dt
-	for key, params := range modifier {
-		switch key {
-		case ServiceGroupSelectionIdModifier:
-			result, err, code = this.modifyDeviceTypeServiceGroupSelection(result, params)
-			if err != nil {
-				return result, err, code
-			}
-		}
+	result, err = idmodifier.ModifyDeviceType(dt, modifier)
+	if err != nil {
+		return result, err, getIdModifierErrorCode(err)
+	}
+	return result, nil, http.StatusOK
+}
+
+func getIdModifierErrorCode(err error) int {
+	if errors.Is(err, idmodifier.ErrInvalidParameter) {
+		return http.StatusBadRequest
 	}
-	return result, err, code
+	return http.StatusInternalServerError
 }
 
 func (this *Controller) modifyDeviceTypeList(list []models.DeviceType, sort string, includeModified bool, includeUnmodified bool) (result []models.DeviceType, err error, code int) {
@@ -90,6 +101,10 @@ func sortDeviceTypes(list []models.DeviceType, sort string) []models.DeviceType
 		})
 	case "name":
 		slices.SortFunc(list, func(a, b models.DeviceType) int {
+			//variants may keep the name of their device-type, the id keeps the order deterministic
+			if a.Name == b.Name {
+				return strings.Compare(a.Id, b.Id) * direction
+			}
 			return strings.Compare(a.Name, b.Name) * direction
 		})
 	default:
@@ -98,80 +113,7 @@ func sortDeviceTypes(list []models.DeviceType, sort string) []models.DeviceType
 	return list
 }
 
-const ServiceGroupSelectionIdModifier = "service_group_selection"
-
-func (this *Controller) modifyDeviceServiceGroupSelection(device models.Device, params []string) (result models.Device, err error, ode int) {
-	if len(params) == 0 {
-		return result, errors.New("missing service-group-key in " + ServiceGroupSelectionIdModifier + " id parameter"), http.StatusBadRequest
-	}
-	result = device
-	sgKey := params[0]
-	ctx, _ := getTimeoutContext()
-	dt, exists, err := this.db.GetDeviceType(ctx, device.DeviceTypeId)
-	if err != nil {
-		return result, err, http.StatusInternalServerError
-	}
-	if !exists {
-		return result, errors.New("unable to use " + ServiceGroupSelectionIdModifier + " modifier: device-type not found"), http.StatusInternalServerError
-	}
-	if !strings.Contains(result.DeviceTypeId, idmodifier.Seperator) {
-		result.DeviceTypeId = result.DeviceTypeId + idmodifier.Seperator
-	} else {
-		result.DeviceTypeId = result.DeviceTypeId + "&"
-	}
-	result.DeviceTypeId = result.DeviceTypeId + idmodifier.EncodeModifierParameter(map[string][]string{ServiceGroupSelectionIdModifier: params})
-	serviceGroupList := []models.ServiceGroup{}
-	if this.config.DeviceServiceGroupSelectionAllowNotFound {
-		serviceGroupList = append(dt.ServiceGroups, models.ServiceGroup{
-			Key:  sgKey,
-			Name: sgKey,
-		})
-	} else {
-		serviceGroupList = dt.ServiceGroups
-	}
-	for _, sg := range serviceGroupList {
-		if sg.Key == sgKey {
-			if result.Name != "" {
-				result.Name = result.Name + " " + sg.Name
-			}
-			for i, attr := range result.Attributes {
-				if attr.Key == DisplayNameAttributeName {
-					attr.Value = attr.Value + " " + sg.Name
-				}
-				result.Attributes[i] = attr
-			}
-			return result, nil, http.StatusOK
-		}
-	}
-
-	return result, errors.New("no matching service-group-key found for " + ServiceGroupSelectionIdModifier + " id parameter"), http.StatusOK
-}
-
-func (this *Controller) modifyDeviceTypeServiceGroupSelection(dt models.DeviceType, params []string) (result models.DeviceType, err error, ode int) {
-	if len(params) == 0 {
-		return result, errors.New("missing service-group-key in " + ServiceGroupSelectionIdModifier + " id parameter"), http.StatusBadRequest
-	}
-	result = dt
-	sgKey := params[0]
-
-	newServiceList := []models.Service{}
-
-	for _, service := range dt.Services {
-		if service.ServiceGroupKey == sgKey || service.ServiceGroupKey == "" {
-			newServiceList = append(newServiceList, service)
-		}
-	}
-
-	for _, sg := range dt.ServiceGroups {
-		if sg.Key == sgKey {
-			result.Name = result.Name + " " + sg.Name
-			break
-		}
-	}
-
-	result.Services = newServiceList
-	return result, nil, http.StatusOK
-}
+const ServiceGroupSelectionIdModifier = idmodifier.ServiceGroupSelection
 
 func removeIdModifier(id string) string {
 	return strings.SplitN(id, idmodifier.Seperator, 2)[0]
@@ -0,0 +1,280 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// GetImpact lists device-types, services, devices, device-groups, hubs, locations and graphs
+// affected by a proposed update or delete of the queried resource; requires admin rights
+func (this *Controller) GetImpact(ctx context.Context, token string, query model.ImpactQuery) (result model.ImpactResult, err error, code int) {
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !jwtToken.IsAdmin() {
+		return result, errors.New("token is not an admin"), http.StatusUnauthorized
+	}
+	if query.Action == "" {
+		query.Action = model.ImpactActionUpdate
+	}
+	if query.Action != model.ImpactActionUpdate && query.Action != model.ImpactActionDelete {
+		return result, model.NewFieldError(model.ErrInvalidField, "action", fmt.Errorf("unknown action=\"%v\"", query.Action)), http.StatusBadRequest
+	}
+	if query.Id == "" {
+		return result, model.NewFieldError(model.ErrMissingField, "id", errors.New("missing id")), http.StatusBadRequest
+	}
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+
+	result = model.ImpactResult{
+		Resource:     query.Resource,
+		Id:           query.Id,
+		Action:       query.Action,
+		DeviceTypes:  []model.ImpactDeviceType{},
+		Devices:      []model.ImpactElement{},
+		DeviceGroups: []model.ImpactElement{},
+		Hubs:         []model.ImpactElement{},
+		Locations:    []model.ImpactElement{},
+		Graphs:       []model.ImpactElement{},
+		Owners:       map[string]model.ImpactCount{},
+	}
+
+	services, err, code := this.getImpactedServices(ctx, query)
+	if err != nil {
+		return result, err, code
+	}
+	deviceTypeIds := []string{}
+	for _, dtId := range slices.Sorted(maps.Keys(services)) {
+		dt, exists, err := this.db.GetDeviceType(ctx, dtId)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		if !exists {
+			continue
+		}
+		deviceTypeIds = append(deviceTypeIds, dtId)
+		result.DeviceTypes = append(result.DeviceTypes, model.ImpactDeviceType{Id: dt.Id, Name: dt.Name, ServiceIds: services[dtId]})
+	}
+
+	count := func(owners []string, f func(count *model.ImpactCount)) {
+		for _, owner := range owners {
+			c := result.Owners[owner]
+			f(&c)
+			result.Owners[owner] = c
+		}
+	}
+
+	deviceIds := []string{}
+	if len(deviceTypeIds) > 0 {
+		devices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{DeviceTypeIds: deviceTypeIds}, false)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		for _, device := range devices {
+			if !slices.Contains(deviceTypeIds, device.DeviceTypeId) {
+				continue
+			}
+			deviceIds = append(deviceIds, device.Id)
+			result.Devices = append(result.Devices, model.ImpactElement{Id: device.Id, Name: device.Name, OwnerIds: []string{device.OwnerId}})
+			count([]string{device.OwnerId}, func(c *model.ImpactCount) { c.Devices++ })
+		}
+	}
+	if len(deviceIds) == 0 {
+		return result, nil, http.StatusOK
+	}
+
+	groupIds := []string{}
+	groups, _, err := this.db.ListDeviceGroups(ctx, model.DeviceGroupListOptions{DeviceIds: deviceIds})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	for _, group := range groups {
+		if !slices.ContainsFunc(group.DeviceIds, func(id string) bool { return slices.Contains(deviceIds, id) }) {
+			continue
+		}
+		owners, err, code := this.getImpactAdministrators(this.config.DeviceGroupTopic, group.Id)
+		if err != nil {
+			return result, err, code
+		}
+		groupIds = append(groupIds, group.Id)
+		result.DeviceGroups = append(result.DeviceGroups, model.ImpactElement{Id: group.Id, Name: group.Name, OwnerIds: owners})
+		count(owners, func(c *model.ImpactCount) { c.DeviceGroups++ })
+	}
+
+	hubIds := []string{}
+	for _, deviceId := range deviceIds {
+		hubs, err := this.db.GetHubsByDeviceId(ctx, deviceId)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		for _, hub := range hubs {
+			if slices.Contains(hubIds, hub.Id) {
+				continue
+			}
+			hubIds = append(hubIds, hub.Id)
+			result.Hubs = append(result.Hubs, model.ImpactElement{Id: hub.Id, Name: hub.Name, OwnerIds: []string{hub.OwnerId}})
+			count([]string{hub.OwnerId}, func(c *model.ImpactCount) { c.Hubs++ })
+		}
+	}
+
+	locations, _, err := this.db.ListLocations(ctx, model.LocationListOptions{DeviceIds: deviceIds, DeviceGroupIds: groupIds})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	for _, location := range locations {
+		if !slices.ContainsFunc(location.DeviceIds, func(id string) bool { return slices.Contains(deviceIds, id) }) &&
+			!slices.ContainsFunc(location.DeviceGroupIds, func(id string) bool { return slices.Contains(groupIds, id) }) {
+			continue
+		}
+		owners, err, code := this.getImpactAdministrators(this.config.LocationTopic, location.Id)
+		if err != nil {
+			return result, err, code
+		}
+		result.Locations = append(result.Locations, model.ImpactElement{Id: location.Id, Name: location.Name, OwnerIds: owners})
+		count(owners, func(c *model.ImpactCount) { c.Locations++ })
+	}
+
+	graphs, _, err := this.db.ListGraphs(ctx, model.GraphListOptions{DeviceIds: deviceIds})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	for _, graph := range graphs {
+		if !slices.ContainsFunc(graph.Nodes, func(node models.Node) bool {
+			return node.ResourceType == models.GraphResourceTypeDevice && slices.Contains(deviceIds, node.ResourceId)
+		}) {
+			continue
+		}
+		result.Graphs = append(result.Graphs, model.ImpactElement{Id: graph.Id, OwnerIds: []string{graph.Owner}})
+		count([]string{graph.Owner}, func(c *model.ImpactCount) { c.Graphs++ })
+	}
+	return result, nil, http.StatusOK
+}
+
+// getImpactedServices returns the affected service ids per device-type id
+func (this *Controller) getImpactedServices(ctx context.Context, query model.ImpactQuery) (result map[string][]string, err error, code int) {
+	result = map[string][]string{}
+	notFound := func(exists bool, err error) (error, int) {
+		if err != nil {
+			return err, http.StatusInternalServerError
+		}
+		if !exists {
+			return fmt.Errorf("%v %v %w", strings.TrimSuffix(query.Resource, "s"), query.Id, model.ErrNotFound), http.StatusNotFound
+		}
+		return nil, http.StatusOK
+	}
+	var criteria []model.DeviceTypeCriteria
+	var exists bool
+	switch query.Resource {
+	case "device-types":
+		dt, exists, err := this.db.GetDeviceType(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		serviceIds := []string{}
+		for _, service := range dt.Services {
+			if query.Action == model.ImpactActionUpdate && query.DeviceType != nil {
+				index := slices.IndexFunc(query.DeviceType.Services, func(proposed models.Service) bool { return proposed.Id == service.Id })
+				if index >= 0 && reflect.DeepEqual(service, query.DeviceType.Services[index]) {
+					continue
+				}
+			}
+			serviceIds = append(serviceIds, service.Id)
+		}
+		if query.Action == model.ImpactActionDelete || query.DeviceType == nil || len(serviceIds) > 0 {
+			result[dt.Id] = serviceIds
+		}
+		return result, nil, http.StatusOK
+	case "aspects":
+		var node models.AspectNode
+		node, exists, err = this.db.GetAspectNode(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		criteria, err = this.db.GetDeviceTypeCriteriaByAspectIds(ctx, append([]string{node.Id}, node.DescendentIds...), false)
+	case "functions":
+		_, exists, err = this.db.GetFunction(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		criteria, err = this.db.GetDeviceTypeCriteriaByFunctionIds(ctx, []string{query.Id}, false)
+	case "device-classes":
+		_, exists, err = this.db.GetDeviceClass(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		criteria, err = this.db.GetDeviceTypeCriteriaByDeviceClassIds(ctx, []string{query.Id}, false)
+	case "characteristics":
+		_, exists, err = this.db.GetCharacteristic(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		criteria, err = this.db.GetDeviceTypeCriteriaByCharacteristicIds(ctx, []string{query.Id}, false)
+	case "concepts":
+		var concept models.Concept
+		concept, exists, err = this.db.GetConceptWithoutCharacteristics(ctx, query.Id)
+		if err, code := notFound(exists, err); err != nil {
+			return result, err, code
+		}
+		if len(concept.CharacteristicIds) > 0 {
+			criteria, err = this.db.GetDeviceTypeCriteriaByCharacteristicIds(ctx, concept.CharacteristicIds, false)
+		}
+	default:
+		return result, model.NewFieldError(model.ErrInvalidField, "resource", fmt.Errorf("unknown resource=\"%v\"", query.Resource)), http.StatusBadRequest
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	for _, c := range criteria {
+		if !slices.Contains(result[c.DeviceTypeId], c.ServiceId) {
+			result[c.DeviceTypeId] = append(result[c.DeviceTypeId], c.ServiceId)
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) getImpactAdministrators(topic string, id string) (result []string, err error, code int) {
+	result = []string{}
+	resource, err, code := this.permissionsV2Client.GetResource(client.InternalAdminToken, topic, id)
+	if code == http.StatusNotFound {
+		//permissions may not be synced yet
+		return result, nil, http.StatusOK
+	}
+	if err != nil {
+		return result, err, code
+	}
+	for user, permissions := range resource.UserPermissions {
+		if permissions.Administrate {
+			result = append(result, user)
+		}
+	}
+	slices.Sort(result)
+	return result, nil, http.StatusOK
+}
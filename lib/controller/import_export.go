@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
@@ -32,40 +33,47 @@ import (
 	"strings"
 )
 
-func (this *Controller) Export(token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int) {
+func (this *Controller) Export(ctx context.Context, token string, options model.ImportExportOptions) (result model.ImportExport, err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutExport)
+	defer cancel()
 	result = model.ImportExport{}
 
-	result.Protocols, err, code = this.ExportProtocols(options)
+	result.Protocols, err, code = this.ExportProtocols(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.Functions, err, code = this.ExportFunctions(options)
+	result.Functions, err, code = this.ExportFunctions(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.Aspects, err, code = this.ExportAspects(options)
+	result.Aspects, err, code = this.ExportAspects(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.Concepts, err, code = this.ExportConcepts(options)
+	result.Concepts, err, code = this.ExportConcepts(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.Characteristics, err, code = this.ExportCharacteristics(options)
+	result.Characteristics, err, code = this.ExportCharacteristics(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.DeviceClasses, err, code = this.ExportDeviceClasses(options)
+	result.DeviceClasses, err, code = this.ExportDeviceClasses(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
 
-	result.DeviceTypes, err, code = this.ExportDeviceTypes(options)
+	result.DeviceTypes, err, code = this.ExportDeviceTypes(ctx, options)
+	if err != nil {
+		return result, err, code
+	}
+
+	result.DeviceTypeTemplates, result.DeviceTypeExtensions, err, code = this.ExportDeviceTypeTemplates(ctx, options)
 	if err != nil {
 		return result, err, code
 	}
@@ -81,25 +89,25 @@ func (this *Controller) Export(token string, options model.ImportExportOptions)
 
 		var tempPerm []client.Resource
 
-		result.Devices, tempPerm, err, code = this.ExportDevices(token, options)
+		result.Devices, result.DeviceConfigurations, tempPerm, err, code = this.ExportDevices(ctx, token, options)
 		if err != nil {
 			return result, err, code
 		}
 		result.Permissions = append(result.Permissions, tempPerm...)
 
-		result.DeviceGroups, tempPerm, err, code = this.ExportDeviceGroups(token, options)
+		result.DeviceGroups, tempPerm, err, code = this.ExportDeviceGroups(ctx, token, options)
 		if err != nil {
 			return result, err, code
 		}
 		result.Permissions = append(result.Permissions, tempPerm...)
 
-		result.Hubs, tempPerm, err, code = this.ExportHubs(token, options)
+		result.Hubs, tempPerm, err, code = this.ExportHubs(ctx, token, options)
 		if err != nil {
 			return result, err, code
 		}
 		result.Permissions = append(result.Permissions, tempPerm...)
 
-		result.Locations, tempPerm, err, code = this.ExportLocations(token, options)
+		result.Locations, tempPerm, err, code = this.ExportLocations(ctx, token, options)
 		if err != nil {
 			return result, err, code
 		}
@@ -109,9 +117,9 @@ func (this *Controller) Export(token string, options model.ImportExportOptions)
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ExportProtocols(options model.ImportExportOptions) (result []models.Protocol, err error, code int) {
+func (this *Controller) ExportProtocols(ctx context.Context, options model.ImportExportOptions) (result []models.Protocol, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "protocols") {
-		result, err = this.db.ListProtocols(context.Background(), 0, 0, "name.asc")
+		result, err = this.db.ListProtocols(ctx, 0, 0, "name.asc")
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -128,9 +136,9 @@ func (this *Controller) ExportProtocols(options model.ImportExportOptions) (resu
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportConcepts(options model.ImportExportOptions) (result []models.Concept, err error, code int) {
+func (this *Controller) ExportConcepts(ctx context.Context, options model.ImportExportOptions) (result []models.Concept, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "concepts") {
-		result, _, err = this.db.ListConcepts(context.Background(), model.ConceptListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListConcepts(ctx, model.ConceptListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -138,9 +146,9 @@ func (this *Controller) ExportConcepts(options model.ImportExportOptions) (resul
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportFunctions(options model.ImportExportOptions) (result []models.Function, err error, code int) {
+func (this *Controller) ExportFunctions(ctx context.Context, options model.ImportExportOptions) (result []models.Function, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "functions") {
-		result, _, err = this.db.ListFunctions(context.Background(), model.FunctionListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListFunctions(ctx, model.FunctionListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -148,9 +156,9 @@ func (this *Controller) ExportFunctions(options model.ImportExportOptions) (resu
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportAspects(options model.ImportExportOptions) (result []models.Aspect, err error, code int) {
+func (this *Controller) ExportAspects(ctx context.Context, options model.ImportExportOptions) (result []models.Aspect, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "aspects") {
-		result, _, err = this.db.ListAspects(context.Background(), model.AspectListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListAspects(ctx, model.AspectListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -158,9 +166,9 @@ func (this *Controller) ExportAspects(options model.ImportExportOptions) (result
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportCharacteristics(options model.ImportExportOptions) (result []models.Characteristic, err error, code int) {
+func (this *Controller) ExportCharacteristics(ctx context.Context, options model.ImportExportOptions) (result []models.Characteristic, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "characteristics") {
-		result, _, err = this.db.ListCharacteristics(context.Background(), model.CharacteristicListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListCharacteristics(ctx, model.CharacteristicListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -168,9 +176,9 @@ func (this *Controller) ExportCharacteristics(options model.ImportExportOptions)
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportDeviceClasses(options model.ImportExportOptions) (result []models.DeviceClass, err error, code int) {
+func (this *Controller) ExportDeviceClasses(ctx context.Context, options model.ImportExportOptions) (result []models.DeviceClass, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-classes") {
-		result, _, err = this.db.ListDeviceClasses(context.Background(), model.DeviceClassListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListDeviceClasses(ctx, model.DeviceClassListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -178,9 +186,9 @@ func (this *Controller) ExportDeviceClasses(options model.ImportExportOptions) (
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportDeviceTypes(options model.ImportExportOptions) (result []models.DeviceType, err error, code int) {
+func (this *Controller) ExportDeviceTypes(ctx context.Context, options model.ImportExportOptions) (result []models.DeviceType, err error, code int) {
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-types") {
-		result, _, err = this.db.ListDeviceTypesV3(context.Background(), model.DeviceTypeListOptions{Ids: options.FilterIds})
+		result, _, err = this.db.ListDeviceTypesV3(ctx, model.DeviceTypeListOptions{Ids: options.FilterIds})
 	}
 	if err != nil {
 		return nil, err, http.StatusInternalServerError
@@ -188,29 +196,60 @@ func (this *Controller) ExportDeviceTypes(options model.ImportExportOptions) (re
 	return result, err, http.StatusOK
 }
 
-func (this *Controller) ExportDevices(token string, options model.ImportExportOptions) (result []models.Device, perm []client.Resource, err error, code int) {
+// ExportDeviceTypeTemplates returns the templates ('device-type-templates') and the extensions of the exported device-types ('device-types')
+func (this *Controller) ExportDeviceTypeTemplates(ctx context.Context, options model.ImportExportOptions) (templates []model.DeviceTypeTemplate, extensions []model.DeviceTypeExtension, err error, code int) {
+	allTemplates, _, err := this.db.ListDeviceTypeTemplates(ctx, model.DeviceTypeTemplateListOptions{Limit: 0})
+	if err != nil {
+		return nil, nil, err, http.StatusInternalServerError
+	}
+	for _, template := range allTemplates {
+		if (options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-type-templates")) && (options.FilterIds == nil || slices.Contains(options.FilterIds, template.Id)) {
+			templates = append(templates, template)
+		}
+		if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-types") {
+			temp, err := this.db.ListDeviceTypeExtensions(ctx, template.Id)
+			if err != nil {
+				return nil, nil, err, http.StatusInternalServerError
+			}
+			for _, extension := range temp {
+				if options.FilterIds == nil || slices.Contains(options.FilterIds, extension.DeviceTypeId) {
+					extensions = append(extensions, extension)
+				}
+			}
+		}
+	}
+	return templates, extensions, nil, http.StatusOK
+}
+
+func (this *Controller) ExportDevices(ctx context.Context, token string, options model.ImportExportOptions) (result []models.Device, configurations map[string][]model.DeviceConfigurationValue, perm []client.Resource, err error, code int) {
 	if options.FilterResourceTypes != nil && !slices.Contains(options.FilterResourceTypes, "devices") {
-		return nil, nil, nil, http.StatusOK
+		return nil, nil, nil, nil, http.StatusOK
 	}
-	tempDevices, _, err := this.db.ListDevices(context.Background(), model.DeviceListOptions{Ids: options.FilterIds}, false)
+	tempDevices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{Ids: options.FilterIds}, false)
 	if err != nil {
-		return result, perm, err, http.StatusInternalServerError
+		return result, configurations, perm, err, http.StatusInternalServerError
 	}
 	for _, d := range tempDevices {
 		result = append(result, d.Device)
+		if len(d.Configuration) > 0 {
+			if configurations == nil {
+				configurations = map[string][]model.DeviceConfigurationValue{}
+			}
+			configurations[d.Id] = d.Configuration
+		}
 	}
 	perm, err, code = this.permissionsV2Client.ListResourcesWithAdminPermission(token, this.config.DeviceTopic, client.ListOptions{Ids: options.FilterIds})
 	if err != nil {
-		return result, perm, err, code
+		return result, configurations, perm, err, code
 	}
-	return result, perm, nil, http.StatusOK
+	return result, configurations, perm, nil, http.StatusOK
 }
 
-func (this *Controller) ExportDeviceGroups(token string, options model.ImportExportOptions) (result []models.DeviceGroup, perm []client.Resource, err error, code int) {
+func (this *Controller) ExportDeviceGroups(ctx context.Context, token string, options model.ImportExportOptions) (result []models.DeviceGroup, perm []client.Resource, err error, code int) {
 	if options.FilterResourceTypes != nil && !slices.Contains(options.FilterResourceTypes, "device-groups") {
 		return nil, nil, nil, http.StatusOK
 	}
-	result, _, err = this.db.ListDeviceGroups(context.Background(), model.DeviceGroupListOptions{Ids: options.FilterIds})
+	result, _, err = this.db.ListDeviceGroups(ctx, model.DeviceGroupListOptions{Ids: options.FilterIds})
 	if err != nil {
 		return result, perm, err, http.StatusInternalServerError
 	}
@@ -221,11 +260,11 @@ func (this *Controller) ExportDeviceGroups(token string, options model.ImportExp
 	return result, perm, nil, http.StatusOK
 }
 
-func (this *Controller) ExportHubs(token string, options model.ImportExportOptions) (result []models.Hub, perm []client.Resource, err error, code int) {
+func (this *Controller) ExportHubs(ctx context.Context, token string, options model.ImportExportOptions) (result []models.Hub, perm []client.Resource, err error, code int) {
 	if options.FilterResourceTypes != nil && !slices.Contains(options.FilterResourceTypes, "hubs") {
 		return nil, nil, nil, http.StatusOK
 	}
-	tempHubs, _, err := this.db.ListHubs(context.Background(), model.HubListOptions{Ids: options.FilterIds}, false)
+	tempHubs, _, err := this.db.ListHubs(ctx, model.HubListOptions{Ids: options.FilterIds}, false)
 	if err != nil {
 		return result, perm, err, http.StatusInternalServerError
 	}
@@ -239,11 +278,11 @@ func (this *Controller) ExportHubs(token string, options model.ImportExportOptio
 	return result, perm, nil, http.StatusOK
 }
 
-func (this *Controller) ExportLocations(token string, options model.ImportExportOptions) (result []models.Location, perm []client.Resource, err error, code int) {
+func (this *Controller) ExportLocations(ctx context.Context, token string, options model.ImportExportOptions) (result []models.Location, perm []client.Resource, err error, code int) {
 	if options.FilterResourceTypes != nil && !slices.Contains(options.FilterResourceTypes, "locations") {
 		return nil, nil, nil, http.StatusOK
 	}
-	result, _, err = this.db.ListLocations(context.Background(), model.LocationListOptions{Ids: options.FilterIds})
+	result, _, err = this.db.ListLocations(ctx, model.LocationListOptions{Ids: options.FilterIds})
 	if err != nil {
 		return result, perm, err, http.StatusInternalServerError
 	}
@@ -254,7 +293,9 @@ func (this *Controller) ExportLocations(token string, options model.ImportExport
 	return result, perm, nil, http.StatusOK
 }
 
-func (this *Controller) Import(token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int) {
+func (this *Controller) Import(ctx context.Context, token string, importModel model.ImportExport, options model.ImportExportOptions) (err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutImport)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -266,11 +307,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "protocols") {
 		for _, p := range importModel.Protocols {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, p.Id) {
-				err, code = this.ValidateProtocol(p)
+				err, code = this.ValidateProtocol(ctx, p)
 				if err != nil {
 					return err, code
 				}
-				err = this.setProtocol(p)
+				err = this.setProtocol(ctx, p)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -281,11 +322,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "characteristics") {
 		for _, characteristic := range importModel.Characteristics {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, characteristic.Id) {
-				err, code = this.ValidateCharacteristics(characteristic)
+				err, code = this.ValidateCharacteristics(ctx, characteristic)
 				if err != nil {
 					return err, code
 				}
-				err = this.setCharacteristic(characteristic)
+				err = this.setCharacteristic(ctx, characteristic)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -296,11 +337,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "concepts") {
 		for _, concept := range importModel.Concepts {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, concept.Id) {
-				err, code = this.ValidateConcept(concept)
+				err, code = this.ValidateConcept(ctx, concept)
 				if err != nil {
 					return err, code
 				}
-				err = this.setConcept(concept)
+				err = this.setConcept(ctx, concept)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -311,11 +352,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "functions") {
 		for _, f := range importModel.Functions {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, f.Id) {
-				err, code = this.ValidateFunction(f)
+				err, code = this.ValidateFunction(ctx, f)
 				if err != nil {
 					return err, code
 				}
-				err = this.setFunction(f)
+				err = this.setFunction(ctx, f)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -326,11 +367,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "aspects") {
 		for _, a := range importModel.Aspects {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, a.Id) {
-				err, code = this.ValidateAspect(a)
+				err, code = this.ValidateAspect(ctx, a)
 				if err != nil {
 					return err, code
 				}
-				err = this.setAspect(a)
+				err = this.setAspect(ctx, a)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -341,11 +382,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-classes") {
 		for _, dc := range importModel.DeviceClasses {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, dc.Id) {
-				err, code = this.ValidateDeviceClass(dc)
+				err, code = this.ValidateDeviceClass(ctx, dc)
 				if err != nil {
 					return err, code
 				}
-				err = this.setDeviceClass(dc)
+				err = this.setDeviceClass(ctx, dc)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -353,14 +394,64 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 		}
 	}
 
+	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-type-templates") {
+		for _, template := range importModel.DeviceTypeTemplates {
+			if options.FilterIds == nil || slices.Contains(options.FilterIds, template.Id) {
+				//re-materializes the local device-types extending the template
+				_, err, code = this.SetDeviceTypeTemplate(ctx, token, template)
+				if err != nil {
+					return err, code
+				}
+			}
+		}
+	}
+
 	if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-types") {
+		importedExtensions := map[string]bool{}
+		for _, extension := range importModel.DeviceTypeExtensions {
+			importedExtensions[extension.DeviceTypeId] = true
+		}
 		for _, dt := range importModel.DeviceTypes {
 			if options.FilterIds == nil || slices.Contains(options.FilterIds, dt.Id) {
-				err, code = this.ValidateDeviceType(dt, model.ValidationOptions{})
+				if importedExtensions[dt.Id] {
+					continue //materialized from the imported extension
+				}
+				_, extended, err := this.db.GetDeviceTypeExtension(ctx, dt.Id)
+				if err != nil {
+					return err, http.StatusInternalServerError
+				}
+				if extended {
+					return model.NewError(model.ErrDeviceTypeExtendsTemplate, fmt.Errorf("device-type %v extends a template; import it with its extension or remove the extension", dt.Id)), http.StatusBadRequest
+				}
+				err, code = this.ValidateDeviceType(ctx, dt, model.ValidationOptions{})
+				if err != nil {
+					return err, code
+				}
+				err = this.setDeviceType(ctx, dt)
+				if err != nil {
+					return err, http.StatusInternalServerError
+				}
+			}
+		}
+		for _, extension := range importModel.DeviceTypeExtensions {
+			if options.FilterIds == nil || slices.Contains(options.FilterIds, extension.DeviceTypeId) {
+				template, exists, err := this.db.GetDeviceTypeTemplate(ctx, extension.TemplateId)
+				if err != nil {
+					return err, http.StatusInternalServerError
+				}
+				if !exists {
+					return model.NewFieldError(model.ErrInvalidField, "template_id", fmt.Errorf("unknown device-type-template %v of device-type %v", extension.TemplateId, extension.DeviceTypeId)), http.StatusBadRequest
+				}
+				dt := extension.Materialize(template)
+				err, code = this.ValidateDeviceType(ctx, dt, model.ValidationOptions{})
 				if err != nil {
 					return err, code
 				}
-				err = this.setDeviceType(dt)
+				err = this.setDeviceType(ctx, dt)
+				if err != nil {
+					return err, http.StatusInternalServerError
+				}
+				err = this.db.SetDeviceTypeExtension(ctx, extension)
 				if err != nil {
 					return err, http.StatusInternalServerError
 				}
@@ -382,15 +473,21 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 		if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "devices") {
 			for _, d := range importModel.Devices {
 				if options.FilterIds == nil || slices.Contains(options.FilterIds, d.Id) {
-					err, code = this.ValidateDevice(token, d)
+					err, code = this.ValidateDevice(ctx, token, d)
 					if err != nil {
 						this.logger.Warn("device validation failed with error: " + err.Error())
 						return err, code
 					}
-					_, err, _ = this.setDevice(d)
+					_, err, _ = this.setDevice(ctx, d)
 					if err != nil {
 						return err, http.StatusInternalServerError
 					}
+					if configuration, ok := importModel.DeviceConfigurations[d.Id]; ok {
+						_, err, code = this.setDeviceConfiguration(ctx, d.Id, configuration)
+						if err != nil {
+							return err, code
+						}
+					}
 				}
 			}
 		}
@@ -398,11 +495,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 		if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "hubs") {
 			for _, h := range importModel.Hubs {
 				if options.FilterIds == nil || slices.Contains(options.FilterIds, h.Id) {
-					err, code = this.ValidateHub(token, h)
+					err, code = this.ValidateHub(ctx, token, h)
 					if err != nil {
 						return err, code
 					}
-					err = this.setHub(model.HubWithConnectionState{
+					err = this.setHub(ctx, model.HubWithConnectionState{
 						Hub:             h,
 						ConnectionState: models.ConnectionStateUnknown,
 					})
@@ -416,11 +513,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 		if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "device-groups") {
 			for _, dg := range importModel.DeviceGroups {
 				if options.FilterIds == nil || slices.Contains(options.FilterIds, dg.Id) {
-					err, code = this.ValidateDeviceGroup(token, dg)
+					err, code = this.ValidateDeviceGroup(ctx, token, dg)
 					if err != nil {
 						return err, code
 					}
-					err = this.setDeviceGroup(dg, jwtToken.GetUserId())
+					err = this.setDeviceGroup(ctx, dg, jwtToken.GetUserId())
 					if err != nil {
 						return err, http.StatusInternalServerError
 					}
@@ -431,11 +528,11 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 		if options.FilterResourceTypes == nil || slices.Contains(options.FilterResourceTypes, "locations") {
 			for _, l := range importModel.Locations {
 				if options.FilterIds == nil || slices.Contains(options.FilterIds, l.Id) {
-					err, code = this.ValidateLocation(l)
+					err, code = this.ValidateLocation(ctx, l)
 					if err != nil {
 						return err, code
 					}
-					err = this.setLocation(l, jwtToken.GetUserId())
+					err = this.setLocation(ctx, l, jwtToken.GetUserId())
 					if err != nil {
 						return err, http.StatusInternalServerError
 					}
@@ -456,7 +553,9 @@ func (this *Controller) Import(token string, importModel model.ImportExport, opt
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ImportFrom(token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int) {
+func (this *Controller) ImportFrom(ctx context.Context, token string, includeOwnedInformation bool, options model.ImportFromOptions) (err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutImportFrom)
+	defer cancel()
 	jwtToken, err := jwt.Parse(token)
 	if err != nil {
 		return err, http.StatusBadRequest
@@ -475,7 +574,7 @@ func (this *Controller) ImportFrom(token string, includeOwnedInformation bool, o
 		FilterIds:               options.FilterIds,
 	}
 
-	req, err := GetExportHttpRequest(options.RemoteDeviceRepository, options.RemoteAuthToken, ieoptions)
+	req, err := GetExportHttpRequest(ctx, options.RemoteDeviceRepository, options.RemoteAuthToken, ieoptions)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -496,14 +595,14 @@ func (this *Controller) ImportFrom(token string, includeOwnedInformation bool, o
 		return err, http.StatusInternalServerError
 	}
 
-	return this.Import(token, export, ieoptions)
+	return this.Import(ctx, token, export, ieoptions)
 }
 
 func hasBearerPrefix(token string) bool {
 	return len(token) > 7 && strings.ToLower(token[:7]) == "bearer "
 }
 
-func GetExportHttpRequest(deviceRepoUrl string, token string, options model.ImportExportOptions) (*http.Request, error) {
+func GetExportHttpRequest(ctx context.Context, deviceRepoUrl string, token string, options model.ImportExportOptions) (*http.Request, error) {
 	queryString := ""
 	query := url.Values{}
 	if options.IncludeOwnedInformation {
@@ -518,7 +617,7 @@ func GetExportHttpRequest(deviceRepoUrl string, token string, options model.Impo
 	if len(query) > 0 {
 		queryString = "?" + query.Encode()
 	}
-	req, err := http.NewRequest(http.MethodGet, deviceRepoUrl+"/export"+queryString, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, deviceRepoUrl+"/export"+queryString, nil)
 	if err != nil {
 		return req, err
 	}
@@ -16,10 +16,17 @@
 
 package controller
 
-import "github.com/SENERGY-Platform/models/go/models"
+import (
+	"context"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
 
 type Publisher interface {
-	PublishDevice(device models.Device) (err error)
+	Ping(ctx context.Context) error
+
+	PublishDevice(device models.Device, configuration []model.DeviceConfigurationValue) (err error)
 	PublishDeviceDelete(device models.Device) error
 
 	PublishDeviceType(device models.DeviceType) (err error)
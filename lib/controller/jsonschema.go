@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/jsonschema"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func (this *Controller) GetServiceJsonSchema(ctx context.Context, id string) (result jsonschema.ServiceSchema, err error, code int) {
+	service, err, code := this.GetService(ctx, id)
+	if err != nil {
+		return result, err, code
+	}
+	protocols, err, code := this.getServiceProtocols(ctx, models.DeviceType{Services: []models.Service{service}})
+	if err != nil {
+		return result, err, code
+	}
+	characteristics, err, code := this.getServiceCharacteristics(ctx, service)
+	if err != nil {
+		return result, err, code
+	}
+	return jsonschema.NewServiceSchema(service, protocols[service.ProtocolId], characteristics), nil, http.StatusOK
+}
+
+func (this *Controller) GetDeviceTypeJsonSchema(ctx context.Context, token string, id string) (result jsonschema.DeviceTypeSchema, err error, code int) {
+	deviceType, err, code := this.ReadDeviceType(ctx, id, token)
+	if err != nil {
+		return result, err, code
+	}
+	protocols, err, code := this.getServiceProtocols(ctx, deviceType)
+	if err != nil {
+		return result, err, code
+	}
+	characteristics, err, code := this.getServiceCharacteristics(ctx, deviceType.Services...)
+	if err != nil {
+		return result, err, code
+	}
+	return jsonschema.NewDeviceTypeSchema(deviceType, protocols, characteristics), nil, http.StatusOK
+}
+
+// getServiceCharacteristics returns the characteristics referenced by the content variables of services, indexed by id
+func (this *Controller) getServiceCharacteristics(ctx context.Context, services ...models.Service) (result map[string]models.Characteristic, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	ids := jsonschema.CharacteristicIds(services...)
+	if len(ids) == 0 {
+		return map[string]models.Characteristic{}, nil, http.StatusOK
+	}
+	characteristics, _, err := this.db.ListCharacteristics(ctx, model.CharacteristicListOptions{Ids: ids})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return jsonschema.FlattenCharacteristics(characteristics...), nil, http.StatusOK
+}
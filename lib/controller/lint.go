@@ -0,0 +1,278 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// LintDeviceType checks a device-type draft against all lint rules; missing ids are generated before the check
+func (this *Controller) LintDeviceType(ctx context.Context, dt models.DeviceType, options model.ValidationOptions) (result model.LintResult, err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutValidation)
+	defer cancel()
+	dt.GenerateId()
+	return this.lintDeviceType(ctx, dt, options, true)
+}
+
+// LintDeviceTypes checks stored device-types and returns the results of device-types with findings
+func (this *Controller) LintDeviceTypes(ctx context.Context, token string, options model.DeviceTypeLintListOptions) (result []model.LintResult, err error, code int) {
+	if options.SortBy == "" {
+		options.SortBy = "name.asc"
+	}
+	list, err, code := this.ListDeviceTypesV2(ctx, token, options.Limit, options.Offset, options.SortBy, nil, false, true)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutValidation)
+	defer cancel()
+	result = []model.LintResult{}
+	for _, dt := range list {
+		lintResult, err, code := this.lintDeviceType(ctx, dt, options.Validation, true)
+		if err != nil {
+			return result, err, code
+		}
+		if len(lintResult.Findings) > 0 {
+			result = append(result, lintResult)
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+// lintGate returns an ErrDeviceTypeLint error if the device-type has findings with error severity
+// validation is skipped, because the caller validates the device-type itself
+func (this *Controller) lintGate(ctx context.Context, dt models.DeviceType) (err error, code int) {
+	result, err, code := this.lintDeviceType(ctx, dt, model.ValidationOptions{}, false)
+	if err != nil {
+		return err, code
+	}
+	if result.Errors == 0 {
+		return nil, http.StatusOK
+	}
+	messages := []string{}
+	field := ""
+	for _, finding := range result.Findings {
+		if finding.Severity != configuration.LintSeverityError {
+			continue
+		}
+		if field == "" {
+			field = finding.Field
+		}
+		messages = append(messages, fmt.Sprintf("%v: %v", finding.Rule, finding.Message))
+	}
+	return model.NewFieldError(model.ErrDeviceTypeLint, field, fmt.Errorf("device-type has %v lint errors: %v", result.Errors, strings.Join(messages, "; "))), http.StatusBadRequest
+}
+
+func (this *Controller) lintDeviceType(ctx context.Context, dt models.DeviceType, options model.ValidationOptions, includeValidation bool) (result model.LintResult, err error, code int) {
+	result = model.LintResult{
+		DeviceTypeId:   dt.Id,
+		DeviceTypeName: dt.Name,
+		Findings:       []model.LintFinding{},
+	}
+	add := func(rule string, field string, message string) {
+		severity := this.config.GetLintSeverity(rule)
+		switch severity {
+		case configuration.LintSeverityOff:
+			return
+		case configuration.LintSeverityError:
+			result.Errors++
+		default:
+			result.Warnings++
+		}
+		result.Findings = append(result.Findings, model.LintFinding{Rule: rule, Severity: severity, Field: field, Message: message})
+	}
+
+	if includeValidation && this.config.GetLintSeverity(configuration.LintRuleValidation) != configuration.LintSeverityOff {
+		err, code = this.ValidateDeviceType(ctx, dt, options)
+		if err != nil {
+			//some validation errors (e.g. ErrDeviceTypeCharacteristicMismatch) are reported with status 500 but are coded
+			var coded *model.CodedError
+			isCoded := errors.As(err, &coded) && coded.Code != ""
+			if code != http.StatusBadRequest && !isCoded {
+				return result, err, code
+			}
+			field := ""
+			if isCoded {
+				field = coded.Field
+			}
+			add(configuration.LintRuleValidation, field, err.Error())
+		}
+	}
+
+	functions := map[string]*models.Function{}
+	concepts := map[string]*models.Concept{}
+	getFunction := func(id string) (*models.Function, error) {
+		if f, ok := functions[id]; ok {
+			return f, nil
+		}
+		f, exists, err := this.db.GetFunction(ctx, id)
+		if err != nil || !exists {
+			functions[id] = nil
+			return nil, err
+		}
+		functions[id] = &f
+		return &f, nil
+	}
+	getConcept := func(id string) (*models.Concept, error) {
+		if c, ok := concepts[id]; ok {
+			return c, nil
+		}
+		c, exists, err := this.db.GetConceptWithoutCharacteristics(ctx, id)
+		if err != nil || !exists {
+			concepts[id] = nil
+			return nil, err
+		}
+		concepts[id] = &c
+		return &c, nil
+	}
+	deprecations, err := this.db.ListFunctionDeprecations(ctx)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	replacements := map[string]string{}
+	for _, deprecation := range deprecations {
+		replacements[deprecation.FunctionId] = deprecation.ReplacementFunctionId
+	}
+
+	for i, service := range dt.Services {
+		serviceField := fmt.Sprintf("services[%v]", i)
+		hasFunction := false
+		for _, direction := range []string{"inputs", "outputs"} {
+			contents := service.Inputs
+			if direction == "outputs" {
+				contents = service.Outputs
+			}
+			paths := map[string]bool{}
+			for j, content := range contents {
+				contentField := fmt.Sprintf("%v.%v[%v].content_variable", serviceField, direction, j)
+				err = walkLintVariables(content.ContentVariable, contentField, "", func(variable models.ContentVariable, field string, path string) error {
+					if paths[path] {
+						add(configuration.LintRuleDuplicateContentVariablePath, field, fmt.Sprintf("%v path %v is used by multiple content-variables of service %v", direction, path, service.LocalId))
+					}
+					paths[path] = true
+					if variable.FunctionId == "" {
+						return nil
+					}
+					hasFunction = true
+					if replacement, ok := replacements[variable.FunctionId]; ok {
+						add(configuration.LintRuleDeprecatedFunction, field+".function_id", fmt.Sprintf("content-variable %v uses deprecated function %v, replaced by %v", path, variable.FunctionId, replacement))
+					}
+					if strings.HasPrefix(variable.FunctionId, model.MEASURING_FUNCTION_PREFIX) && variable.AspectId == "" {
+						add(configuration.LintRuleMeasuringFunctionWithoutAspect, field+".aspect_id", fmt.Sprintf("content-variable %v has measuring function %v but no aspect", path, variable.FunctionId))
+					}
+					if variable.CharacteristicId == "" {
+						return nil
+					}
+					function, err := getFunction(variable.FunctionId)
+					if err != nil || function == nil || function.ConceptId == "" {
+						return err
+					}
+					concept, err := getConcept(function.ConceptId)
+					if err != nil || concept == nil {
+						return err
+					}
+					if !slices.Contains(concept.CharacteristicIds, variable.CharacteristicId) {
+						add(configuration.LintRuleCharacteristicNotInConcept, field+".characteristic_id", fmt.Sprintf("characteristic %v of content-variable %v is not part of concept %v of function %v", variable.CharacteristicId, path, concept.Id, function.Id))
+					}
+					return nil
+				})
+				if err != nil {
+					return result, err, http.StatusInternalServerError
+				}
+			}
+		}
+		if !hasFunction {
+			add(configuration.LintRuleServiceWithoutFunction, serviceField, fmt.Sprintf("no content-variable of service %v references a function", service.LocalId))
+		}
+	}
+
+	styles := map[string]int{}
+	styleOrder := []string{}
+	for _, service := range dt.Services {
+		style := namingStyle(service.LocalId)
+		if style == "" {
+			continue
+		}
+		if styles[style] == 0 {
+			styleOrder = append(styleOrder, style)
+		}
+		styles[style]++
+	}
+	if len(styleOrder) > 1 {
+		majority := styleOrder[0]
+		for _, style := range styleOrder {
+			if styles[style] > styles[majority] {
+				majority = style
+			}
+		}
+		for i, service := range dt.Services {
+			if style := namingStyle(service.LocalId); style != "" && style != majority {
+				add(configuration.LintRuleInconsistentNaming, fmt.Sprintf("services[%v].local_id", i), fmt.Sprintf("service local id %v uses %v, most service local ids of the device-type use %v", service.LocalId, style, majority))
+			}
+		}
+	}
+
+	for i, group := range dt.ServiceGroups {
+		if !slices.ContainsFunc(dt.Services, func(service models.Service) bool { return service.ServiceGroupKey == group.Key }) {
+			add(configuration.LintRuleUnusedServiceGroup, fmt.Sprintf("service_groups[%v]", i), fmt.Sprintf("service-group %v is not used by any service", group.Key))
+		}
+	}
+
+	return result, nil, http.StatusOK
+}
+
+func walkLintVariables(variable models.ContentVariable, field string, parentPath string, f func(variable models.ContentVariable, field string, path string) error) error {
+	path := variable.Name
+	if parentPath != "" {
+		path = parentPath + "." + variable.Name
+	}
+	err := f(variable, field, path)
+	if err != nil {
+		return err
+	}
+	for i, sub := range variable.SubContentVariables {
+		err = walkLintVariables(sub, fmt.Sprintf("%v.sub_content_variables[%v]", field, i), path, f)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// namingStyle returns the naming convention of a local id or "" if the id fits every convention (e.g. "state")
+func namingStyle(id string) string {
+	switch {
+	case strings.Contains(id, "_"):
+		return "snake_case"
+	case strings.Contains(id, "-"):
+		return "kebab-case"
+	case id != "" && unicode.IsUpper([]rune(id)[0]):
+		return "PascalCase"
+	case strings.ContainsFunc(id, unicode.IsUpper):
+		return "camelCase"
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/database/testdb"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestLintSeverities(t *testing.T) {
+	config := configuration.Config{LintSeverities: map[string]string{
+		configuration.LintRuleUnusedServiceGroup: configuration.LintSeverityError,
+		configuration.LintRuleInconsistentNaming: configuration.LintSeverityOff,
+	}}
+	ctrl := &Controller{config: config, db: testdb.NewTestDB(config)}
+	dt := models.DeviceType{
+		Id:            "dt",
+		Name:          "dt",
+		ServiceGroups: []models.ServiceGroup{{Key: "unused"}},
+		Services: []models.Service{
+			{Id: "s1", LocalId: "getState", Outputs: []models.Content{{ContentVariable: models.ContentVariable{Name: "state", FunctionId: model.MEASURING_FUNCTION_PREFIX + "state"}}}},
+			{Id: "s2", LocalId: "set_state", Inputs: []models.Content{{ContentVariable: models.ContentVariable{Name: "state", FunctionId: model.CONTROLLING_FUNCTION_PREFIX + "state"}}}},
+		},
+	}
+	result, err, _ := ctrl.lintDeviceType(context.Background(), dt, model.ValidationOptions{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []model.LintFinding{
+		{Rule: configuration.LintRuleMeasuringFunctionWithoutAspect, Severity: configuration.LintSeverityWarning, Field: "services[0].outputs[0].content_variable.aspect_id", Message: "content-variable state has measuring function urn:infai:ses:measuring-function:state but no aspect"},
+		{Rule: configuration.LintRuleUnusedServiceGroup, Severity: configuration.LintSeverityError, Field: "service_groups[0]", Message: "service-group unused is not used by any service"},
+	}
+	if len(result.Findings) != len(expected) || result.Errors != 1 || result.Warnings != 1 {
+		t.Fatalf("%#v", result)
+	}
+	for i := range expected {
+		if result.Findings[i] != expected[i] {
+			t.Errorf("\n%#v\n%#v", result.Findings[i], expected[i])
+		}
+	}
+
+	err, _ = ctrl.lintGate(context.Background(), dt)
+	if !errors.Is(err, model.ErrDeviceTypeLint) {
+		t.Error(err)
+	}
+	dt.ServiceGroups = nil
+	err, _ = ctrl.lintGate(context.Background(), dt)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,9 +31,10 @@ import (
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 )
 
-func (this *Controller) SetLocation(token string, location models.Location) (result models.Location, err error, errCode int) {
+func (this *Controller) SetLocation(ctx context.Context, token string, location models.Location) (result models.Location, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if location.Id != "" {
-		ctx, _ := getTimeoutContext()
 		_, exists, err := this.db.GetLocation(ctx, location.Id)
 		if err != nil {
 			return result, err, http.StatusInternalServerError
@@ -54,7 +56,7 @@ func (this *Controller) SetLocation(token string, location models.Location) (res
 		if err != nil {
 			return location, err, http.StatusInternalServerError
 		}
-		err, code := this.ValidateLocation(location)
+		err, code := this.ValidateLocation(ctx, location)
 		if err != nil {
 			return location, err, code
 		}
@@ -65,7 +67,7 @@ func (this *Controller) SetLocation(token string, location models.Location) (res
 		return location, err, http.StatusBadRequest
 	}
 
-	err = this.setLocation(location, jwtToken.GetUserId())
+	err = this.setLocation(ctx, location, jwtToken.GetUserId())
 	if err != nil {
 		return location, err, http.StatusInternalServerError
 	}
@@ -73,11 +75,12 @@ func (this *Controller) SetLocation(token string, location models.Location) (res
 	return location, nil, http.StatusOK
 }
 
-func (this *Controller) DeleteLocation(token string, id string) (err error, code int) {
+func (this *Controller) DeleteLocation(ctx context.Context, token string, id string) (err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if err := preventIdModifier(id); err != nil {
 		return err, http.StatusBadRequest
 	}
-	ctx, _ := getTimeoutContext()
 	_, exists, err := this.db.GetLocation(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -92,7 +95,7 @@ func (this *Controller) DeleteLocation(token string, id string) (err error, code
 	if !ok {
 		return errors.New("access denied"), http.StatusForbidden
 	}
-	err = this.deleteLocation(id)
+	err = this.deleteLocation(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
@@ -107,8 +110,9 @@ func (this *Controller) setLocationSyncHandler(location models.Location, user st
 	return this.publisher.PublishLocation(location)
 }
 
-func (this *Controller) setLocation(location models.Location, owner string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) setLocation(ctx context.Context, location models.Location, owner string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.SetLocation(ctx, location, this.setLocationSyncHandler, owner)
 }
 
@@ -120,12 +124,15 @@ func (this *Controller) deleteLocationSyncHandler(location models.Location) erro
 	return this.publisher.PublishLocationDelete(location.Id)
 }
 
-func (this *Controller) deleteLocation(id string) error {
-	ctx, _ := getTimeoutContext()
+func (this *Controller) deleteLocation(ctx context.Context, id string) error {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	return this.db.RemoveLocation(ctx, id, this.deleteLocationSyncHandler)
 }
 
-func (this *Controller) GetLocation(id string, token string) (result models.Location, err error, code int) {
+func (this *Controller) GetLocation(ctx context.Context, id string, token string) (result models.Location, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ok, err, _ := this.permissionsV2Client.CheckPermission(token, this.config.LocationTopic, id, client.Read)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -133,7 +140,6 @@ func (this *Controller) GetLocation(id string, token string) (result models.Loca
 	if !ok {
 		return result, errors.New("access denied"), http.StatusForbidden
 	}
-	ctx, _ := getTimeoutContext()
 	result, exists, err := this.db.GetLocation(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -144,7 +150,7 @@ func (this *Controller) GetLocation(id string, token string) (result models.Loca
 	return result, nil, http.StatusOK
 }
 
-func (this *Controller) ValidateLocation(location models.Location) (err error, code int) {
+func (this *Controller) ValidateLocation(ctx context.Context, location models.Location) (err error, code int) {
 	if location.Id == "" {
 		return errors.New("missing device class id"), http.StatusBadRequest
 	}
@@ -172,7 +178,9 @@ func (this *Controller) ValidateLocation(location models.Location) (err error, c
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ListLocations(token string, options model.LocationListOptions) (result []models.Location, total int64, err error, errCode int) {
+func (this *Controller) ListLocations(ctx context.Context, token string, options model.LocationListOptions) (result []models.Location, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	ids := []string{}
 	permissionFlag := options.Permission
 	if permissionFlag == models.UnsetPermissionFlag {
@@ -210,7 +218,6 @@ func (this *Controller) ListLocations(token string, options model.LocationListOp
 	}
 	options.Ids = ids
 
-	ctx, _ := getTimeoutContext()
 	result, total, err = this.db.ListLocations(ctx, options)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
@@ -219,8 +226,8 @@ func (this *Controller) ListLocations(token string, options model.LocationListOp
 	return result, total, nil, http.StatusOK
 }
 
-func (this *Controller) ListExtendedLocations(token string, options model.LocationListOptions) (result []models.ExtendedLocation, total int64, err error, errCode int) {
-	locations, total, err, code := this.ListLocations(token, options)
+func (this *Controller) ListExtendedLocations(ctx context.Context, token string, options model.LocationListOptions) (result []models.ExtendedLocation, total int64, err error, errCode int) {
+	locations, total, err, code := this.ListLocations(ctx, token, options)
 	if err != nil {
 		return result, total, err, code
 	}
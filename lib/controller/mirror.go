@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
@@ -30,10 +31,10 @@ func (this *Controller) MirrorUpdate() error {
 	if this.mirrorPullCallback == nil {
 		return fmt.Errorf("missing mirror pull callback")
 	}
-	this.mirrorPullCallback(this.config, this.db, true)
+	this.mirrorPullCallback(context.Background(), this.config, this.db, true)
 	return nil
 }
 
-func (this *Controller) SetMirrorPullCallback(pull func(config configuration.Config, db database.Database, checkLastUpdate bool)) {
+func (this *Controller) SetMirrorPullCallback(pull func(ctx context.Context, config configuration.Config, db database.Database, checkLastUpdate bool)) {
 	this.mirrorPullCallback = pull
 }
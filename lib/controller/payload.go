@@ -0,0 +1,173 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/payload"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func (this *Controller) ValidateServicePayload(ctx context.Context, serviceId string, options model.PayloadValidationOptions, message []byte) (result model.PayloadValidationResult, err error, code int) {
+	service, err, code := this.GetService(ctx, serviceId)
+	if err != nil {
+		return result, err, code
+	}
+	content, err, code := getServiceContent(service, options.Direction, options.ContentId)
+	if err != nil {
+		return result, err, code
+	}
+	protocols, err, code := this.getServiceProtocols(ctx, models.DeviceType{Services: []models.Service{service}})
+	if err != nil {
+		return result, err, code
+	}
+	err, code = this.ValidateContent(ctx, content, protocols[service.ProtocolId], model.ValidationOptions{})
+	if err != nil {
+		return result, fmt.Errorf("invalid service content: %w", err), code
+	}
+	characteristics, err, code := this.getServiceCharacteristics(ctx, service)
+	if err != nil {
+		return result, err, code
+	}
+	patterns, err := this.getCharacteristicPatterns(ctx, characteristics)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	result.ContentId = content.Id
+	result.Errors = payload.ValidateContent(content, message, characteristics, patterns)
+	result.Valid = len(result.Errors) == 0
+	return result, nil, http.StatusOK
+}
+
+// getServiceContent returns the content with the id contentId or, if contentId is empty, the only non-void content in direction
+func getServiceContent(service models.Service, direction string, contentId string) (result models.Content, err error, code int) {
+	var contents []models.Content
+	switch direction {
+	case model.PayloadDirectionInput:
+		contents = service.Inputs
+	case model.PayloadDirectionOutput, "":
+		contents = service.Outputs
+	default:
+		return result, model.NewFieldError(model.ErrInvalidQueryParameter, "direction", errors.New("expect 'input' or 'output'")), http.StatusBadRequest
+	}
+	if contentId != "" {
+		for _, content := range contents {
+			if content.Id == contentId {
+				return content, nil, http.StatusOK
+			}
+		}
+		return result, model.NewFieldError(model.ErrInvalidQueryParameter, "content_id", errors.New("unknown content id")), http.StatusBadRequest
+	}
+	candidates := []models.Content{}
+	for _, content := range contents {
+		if !content.ContentVariable.IsVoid {
+			candidates = append(candidates, content)
+		}
+	}
+	if len(candidates) != 1 {
+		return result, model.NewFieldError(model.ErrInvalidQueryParameter, "content_id", fmt.Errorf("service has %v non-void contents in this direction; content_id is required", len(candidates))), http.StatusBadRequest
+	}
+	return candidates[0], nil, http.StatusOK
+}
+
+func (this *Controller) GetServiceExamples(ctx context.Context, id string) (result model.ServiceExample, err error, code int) {
+	service, err, code := this.GetService(ctx, id)
+	if err != nil {
+		return result, err, code
+	}
+	list, err, code := this.getServiceExamples(ctx, service)
+	if err != nil {
+		return result, err, code
+	}
+	return list[0], nil, http.StatusOK
+}
+
+// GetDeviceTypeExamples returns examples for all services of the device-type
+// ids with the service_group_selection modifier limit the services to the selected service-group
+func (this *Controller) GetDeviceTypeExamples(ctx context.Context, token string, id string) (result []model.ServiceExample, err error, code int) {
+	deviceType, err, code := this.ReadDeviceType(ctx, id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return this.getServiceExamples(ctx, deviceType.Services...)
+}
+
+func (this *Controller) getServiceExamples(ctx context.Context, services ...models.Service) (result []model.ServiceExample, err error, code int) {
+	protocols, err, code := this.getServiceProtocols(ctx, models.DeviceType{Services: services})
+	if err != nil {
+		return result, err, code
+	}
+	characteristics, err, code := this.getServiceCharacteristics(ctx, services...)
+	if err != nil {
+		return result, err, code
+	}
+	result = []model.ServiceExample{}
+	for _, service := range services {
+		example := model.ServiceExample{
+			ServiceId: service.Id,
+			LocalId:   service.LocalId,
+			Name:      service.Name,
+		}
+		example.Inputs, err = newContentExamples(service.Inputs, protocols[service.ProtocolId], characteristics)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		example.Outputs, err = newContentExamples(service.Outputs, protocols[service.ProtocolId], characteristics)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		result = append(result, example)
+	}
+	return result, nil, http.StatusOK
+}
+
+func newContentExamples(contents []models.Content, protocol models.Protocol, characteristics map[string]models.Characteristic) (result []model.ContentExample, err error) {
+	result = []model.ContentExample{}
+	for _, content := range contents {
+		if content.ContentVariable.IsVoid {
+			continue
+		}
+		message, err := payload.ExampleContent(content, characteristics)
+		if err != nil {
+			return result, fmt.Errorf("unable to create example for content %v: %w", content.Id, err)
+		}
+		example := model.ContentExample{
+			ContentId:         content.Id,
+			ProtocolSegmentId: content.ProtocolSegmentId,
+			Serialization:     content.Serialization,
+			Payload:           string(message),
+		}
+		if s, ok := serialization.Get(content.Serialization); ok && s.Info().Binary {
+			example.Payload = base64.StdEncoding.EncodeToString(message)
+			example.PayloadEncoding = "base64"
+		}
+		for _, segment := range protocol.ProtocolSegments {
+			if segment.Id == content.ProtocolSegmentId {
+				example.ProtocolSegmentName = segment.Name
+			}
+		}
+		result = append(result, example)
+	}
+	return result, nil
+}
@@ -22,21 +22,23 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"github.com/segmentio/kafka-go"
 )
 
 type DeviceCommand struct {
-	Command string        `json:"command"`
-	Id      string        `json:"id"`
-	Device  models.Device `json:"device"`
+	Command       string                           `json:"command"`
+	Id            string                           `json:"id"`
+	Device        models.Device                    `json:"device"`
+	Configuration []model.DeviceConfigurationValue `json:"configuration,omitempty"` //ref model.DeviceWithConnectionState.Configuration
 
 	//field has been removed but can still exist as value in kafka
 	//StrictWaitBeforeDone bool          `json:"strict_wait_before_done"`
 }
 
-func (this *Publisher) PublishDevice(device models.Device) (err error) {
-	cmd := DeviceCommand{Command: "PUT", Id: device.Id, Device: device}
+func (this *Publisher) PublishDevice(device models.Device, configuration []model.DeviceConfigurationValue) (err error) {
+	cmd := DeviceCommand{Command: "PUT", Id: device.Id, Device: device, Configuration: configuration}
 	return this.PublishDeviceCommand(cmd)
 }
 
@@ -91,6 +91,15 @@ func New(conf configuration.Config, ctx context.Context) (*Publisher, error) {
 	}, nil
 }
 
+// Ping checks if the kafka broker used by the producers is reachable
+func (this *Publisher) Ping(ctx context.Context) error {
+	conn, err := kafka.DialContext(ctx, "tcp", this.config.KafkaUrl)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 func getProducer(ctx context.Context, broker string, topic string, logger *slog.Logger) (writer *kafka.Writer) {
 	kafkaLogger := slog.NewLogLogger(logger.Handler(), slog.LevelDebug)
 	kafkaLogger.SetPrefix("[KAFKA-PRODUCER] ")
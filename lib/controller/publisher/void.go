@@ -17,6 +17,9 @@
 package publisher
 
 import (
+	"context"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 )
 
@@ -24,7 +27,11 @@ type Void struct{}
 
 var VoidPublisherError error = nil //errors.New("try to use void publisher")
 
-func (this Void) PublishDevice(device models.Device) (err error) {
+func (this Void) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (this Void) PublishDevice(device models.Device, configuration []model.DeviceConfigurationValue) (err error) {
 	return VoidPublisherError
 }
 
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/rdf"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ExportRdf exports the semantic model (see rdf.FromImportExport) as rdf graph; options.IncludeOwnedInformation is ignored
+func (this *Controller) ExportRdf(ctx context.Context, token string, options model.ImportExportOptions) (result *rdf.Graph, err error, code int) {
+	options.IncludeOwnedInformation = false
+	export, err, code := this.Export(ctx, token, options)
+	if err != nil {
+		return nil, err, code
+	}
+	result, err = rdf.FromImportExport(export)
+	if err != nil {
+		return nil, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// ImportRdf imports functions, aspects, concepts, characteristics and device-classes of the graph (see rdf.ToImportExport)
+// every resource is validated and compared to the stored version; with dryRun only the resulting changes are returned
+// existing concept conversions are kept, because they are not part of the rdf representation
+func (this *Controller) ImportRdf(ctx context.Context, token string, graph *rdf.Graph, dryRun bool) (result model.ImportResult, err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutImport)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	if !jwtToken.IsAdmin() {
+		return result, errors.New("only admins may import"), http.StatusForbidden
+	}
+	importModel, err := rdf.ToImportExport(graph)
+	if err != nil {
+		return result, model.NewError(model.ErrInvalidBody, err), http.StatusBadRequest
+	}
+	result = model.ImportResult{DryRun: dryRun, Changes: []model.ImportChange{}}
+
+	pendingCharacteristicIds := map[string]bool{}
+	for _, characteristic := range importModel.Characteristics {
+		for id := range characteristicIdToBaseCharacteristic(characteristic) {
+			pendingCharacteristicIds[id] = true
+		}
+		err, code = this.ValidateCharacteristics(ctx, characteristic)
+		if err != nil {
+			return result, fmt.Errorf("invalid characteristic %v: %w", characteristic.Id, err), code
+		}
+		old, exists, err := this.db.GetCharacteristic(ctx, characteristic.Id)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		err = addImportChange(&result, "characteristics", characteristic.Id, characteristic.Name, old, exists, characteristic)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+	}
+
+	for i, concept := range importModel.Concepts {
+		old, exists, err := this.db.GetConceptWithoutCharacteristics(ctx, concept.Id)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		if exists {
+			concept.Conversions = old.Conversions
+			importModel.Concepts[i] = concept
+		}
+		err, code = this.validateConcept(ctx, concept, pendingCharacteristicIds)
+		if err != nil {
+			return result, fmt.Errorf("invalid concept %v: %w", concept.Id, err), code
+		}
+		err = addImportChange(&result, "concepts", concept.Id, concept.Name, old, exists, concept)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+	}
+
+	for _, function := range importModel.Functions {
+		err, code = this.ValidateFunction(ctx, function)
+		if err != nil {
+			return result, fmt.Errorf("invalid function %v: %w", function.Id, err), code
+		}
+		old, exists, err := this.db.GetFunction(ctx, function.Id)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		err = addImportChange(&result, "functions", function.Id, function.Name, old, exists, function)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+	}
+
+	for _, aspect := range importModel.Aspects {
+		err, code = this.ValidateAspect(ctx, aspect)
+		if err != nil {
+			return result, fmt.Errorf("invalid aspect %v: %w", aspect.Id, err), code
+		}
+		old, exists, err := this.db.GetAspect(ctx, aspect.Id)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		err = addImportChange(&result, "aspects", aspect.Id, aspect.Name, old, exists, aspect)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+	}
+
+	for _, deviceClass := range importModel.DeviceClasses {
+		err, code = this.ValidateDeviceClass(ctx, deviceClass)
+		if err != nil {
+			return result, fmt.Errorf("invalid device-class %v: %w", deviceClass.Id, err), code
+		}
+		old, exists, err := this.db.GetDeviceClass(ctx, deviceClass.Id)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		err = addImportChange(&result, "device-classes", deviceClass.Id, deviceClass.Name, old, exists, deviceClass)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+	}
+
+	if dryRun {
+		return result, nil, http.StatusOK
+	}
+	changedIds := []string{}
+	for _, change := range result.Changes {
+		if change.Action != model.ImportActionUnchanged {
+			changedIds = append(changedIds, change.Id)
+		}
+	}
+	if len(changedIds) == 0 {
+		return result, nil, http.StatusOK
+	}
+	err, code = this.Import(ctx, token, importModel, model.ImportExportOptions{
+		FilterResourceTypes: []string{"characteristics", "concepts", "functions", "aspects", "device-classes"},
+		FilterIds:           changedIds,
+	})
+	if err != nil {
+		return result, err, code
+	}
+	return result, nil, http.StatusOK
+}
+
+func addImportChange[T any](result *model.ImportResult, resourceType string, id string, name string, old T, exists bool, updated T) error {
+	change := model.ImportChange{ResourceType: resourceType, Id: id, Name: name, Action: model.ImportActionCreate}
+	if exists {
+		fields, err := model.DiffFields(old, updated)
+		if err != nil {
+			return err
+		}
+		change.Action = model.ImportActionUnchanged
+		if len(fields) > 0 {
+			change.Action = model.ImportActionUpdate
+			change.Fields = fields
+		}
+	}
+	result.Changes = append(result.Changes, change)
+	return nil
+}
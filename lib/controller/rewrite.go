@@ -0,0 +1,168 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// referenceRewrite holds device-types, device-type-templates and device-type-extensions with rewritten content-variables (ref planReferenceRewrite)
+type referenceRewrite struct {
+	deviceTypes []models.DeviceType
+	templates   []model.DeviceTypeTemplate
+	extensions  []model.DeviceTypeExtension
+}
+
+func (this referenceRewrite) DeviceTypeIds() (result []string) {
+	result = []string{}
+	for _, dt := range this.deviceTypes {
+		result = append(result, dt.Id)
+	}
+	return result
+}
+
+func (this referenceRewrite) TemplateIds() (result []string) {
+	result = []string{}
+	for _, template := range this.templates {
+		result = append(result, template.Id)
+	}
+	return result
+}
+
+// planReferenceRewrite applies rewrite to all content-variables matching match
+// of the given device-types, their extension overrides and all device-type-templates; nothing is stored (ref applyReferenceRewrite)
+func (this *Controller) planReferenceRewrite(ctx context.Context, deviceTypeIds []string, match func(models.ContentVariable) bool, rewrite func(models.ContentVariable) models.ContentVariable) (plan referenceRewrite, err error) {
+	mapper := func(variable models.ContentVariable) models.ContentVariable {
+		if match(variable) {
+			return rewrite(variable)
+		}
+		return variable
+	}
+	plan.deviceTypes = []models.DeviceType{}
+	plan.templates = []model.DeviceTypeTemplate{}
+	plan.extensions = []model.DeviceTypeExtension{}
+	for _, id := range deviceTypeIds {
+		dt, exists, err := this.db.GetDeviceType(ctx, id)
+		if err != nil {
+			return plan, err
+		}
+		if !exists {
+			continue
+		}
+		dt.Services = mapServiceVariables(dt.Services, mapper)
+		plan.deviceTypes = append(plan.deviceTypes, dt)
+		extension, exists, err := this.db.GetDeviceTypeExtension(ctx, id)
+		if err != nil {
+			return plan, err
+		}
+		if exists && servicesContainVariable(extension.Overrides.Services, match) {
+			extension.Overrides.Services = mapServiceVariables(extension.Overrides.Services, mapper)
+			plan.extensions = append(plan.extensions, extension)
+		}
+	}
+	templates, _, err := this.db.ListDeviceTypeTemplates(ctx, model.DeviceTypeTemplateListOptions{})
+	if err != nil {
+		return plan, err
+	}
+	for _, template := range templates {
+		if servicesContainVariable(template.Services, match) {
+			template.Services = mapServiceVariables(template.Services, mapper)
+			plan.templates = append(plan.templates, template)
+		}
+	}
+	return plan, nil
+}
+
+// applyReferenceRewrite stores and publishes the planned changes; device-group criteria are updated by the device-type sync handler
+// applied contains the stored changes, even if err != nil; a new plan for the same rewrite only contains the remaining changes
+func (this *Controller) applyReferenceRewrite(ctx context.Context, plan referenceRewrite) (applied referenceRewrite, err error) {
+	applied.deviceTypes = []models.DeviceType{}
+	applied.templates = []model.DeviceTypeTemplate{}
+	applied.extensions = []model.DeviceTypeExtension{}
+	for _, template := range plan.templates {
+		err = this.db.SetDeviceTypeTemplate(ctx, template)
+		if err != nil {
+			return applied, err
+		}
+		applied.templates = append(applied.templates, template)
+	}
+	for _, extension := range plan.extensions {
+		err = this.db.SetDeviceTypeExtension(ctx, extension)
+		if err != nil {
+			return applied, err
+		}
+		applied.extensions = append(applied.extensions, extension)
+	}
+	for _, dt := range plan.deviceTypes {
+		err = this.setDeviceType(ctx, dt)
+		if err != nil {
+			return applied, err
+		}
+		applied.deviceTypes = append(applied.deviceTypes, dt)
+	}
+	return applied, nil
+}
+
+func servicesContainVariable(services []models.Service, match func(models.ContentVariable) bool) bool {
+	for _, service := range services {
+		for _, content := range slices.Concat(service.Inputs, service.Outputs) {
+			if variableContains(content.ContentVariable, match) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func variableContains(variable models.ContentVariable, match func(models.ContentVariable) bool) bool {
+	return match(variable) || slices.ContainsFunc(variable.SubContentVariables, func(sub models.ContentVariable) bool {
+		return variableContains(sub, match)
+	})
+}
+
+// mapServiceVariables returns a copy of services with every content-variable replaced by the result of f
+func mapServiceVariables(services []models.Service, f func(models.ContentVariable) models.ContentVariable) []models.Service {
+	result := slices.Clone(services)
+	for i, service := range result {
+		service.Inputs = mapContentVariables(service.Inputs, f)
+		service.Outputs = mapContentVariables(service.Outputs, f)
+		result[i] = service
+	}
+	return result
+}
+
+func mapContentVariables(contents []models.Content, f func(models.ContentVariable) models.ContentVariable) []models.Content {
+	result := slices.Clone(contents)
+	for i, content := range result {
+		content.ContentVariable = mapVariable(content.ContentVariable, f)
+		result[i] = content
+	}
+	return result
+}
+
+func mapVariable(variable models.ContentVariable, f func(models.ContentVariable) models.ContentVariable) models.ContentVariable {
+	variable = f(variable)
+	variable.SubContentVariables = slices.Clone(variable.SubContentVariables)
+	for i, sub := range variable.SubContentVariables {
+		variable.SubContentVariables[i] = mapVariable(sub, f)
+	}
+	return variable
+}
@@ -19,83 +19,87 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
 	"strings"
-	"time"
 )
 
-func (this *Controller) ValidateService(service models.Service, protocolCache *map[string]models.Protocol, options model.ValidationOptions) (error, int) {
+func (this *Controller) ValidateService(ctx context.Context, service models.Service, protocolCache *map[string]models.Protocol, options model.ValidationOptions) (error, int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
 	if service.Id == "" {
-		return errors.New("missing service id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing service id")), http.StatusBadRequest
 	}
 	if service.Name == "" {
-		return errors.New("missing service name"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "name", errors.New("missing service name")), http.StatusBadRequest
 	}
 	if service.LocalId == "" {
-		return errors.New("missing service local id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "local_id", errors.New("missing service local id")), http.StatusBadRequest
 	}
 	if service.ProtocolId == "" {
-		return errors.New("missing service protocol id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "protocol_id", errors.New("missing service protocol id")), http.StatusBadRequest
 	}
 
 	var protocol models.Protocol
 	var ok bool
 	var err error
 	if protocol, ok = (*protocolCache)[service.ProtocolId]; !ok {
-		ctx, _ := getTimeoutContext()
 		protocol, ok, err = this.db.GetProtocol(ctx, service.ProtocolId)
 		if err != nil {
 			return err, http.StatusBadRequest
 		}
 		if !ok {
-			return errors.New("unknown protocol"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeUnknownProtocol, "protocol_id", errors.New("unknown protocol")), http.StatusBadRequest
 		}
 		(*protocolCache)[service.ProtocolId] = protocol
 	}
 
 	if contains(protocol.Constraints, model.SenergyConnectorLocalIdConstraint) {
 		if strings.ContainsAny(service.LocalId, "+#/") {
-			return errors.New("service local id may not contain any +#/"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidServiceLocalId, "local_id", errors.New("service local id may not contain any +#/")), http.StatusBadRequest
 		}
 	}
 
 	knownContentNames := map[string]bool{}
-	for _, content := range service.Inputs {
+	for i, content := range service.Inputs {
 		if _, ok := knownContentNames[content.ContentVariable.Name]; ok {
-			return errors.New("reused input content name: " + content.ContentVariable.Name), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeReusedContentName, fmt.Sprintf("inputs[%v].content_variable.name", i), errors.New("reused input content name: "+content.ContentVariable.Name)), http.StatusBadRequest
 		} else {
 			knownContentNames[content.ContentVariable.Name] = true
 		}
 	}
 	knownContentNames = map[string]bool{}
-	for _, content := range service.Outputs {
+	for i, content := range service.Outputs {
 		if _, ok := knownContentNames[content.ContentVariable.Name]; ok {
-			return errors.New("reused output content name: " + content.ContentVariable.Name), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeReusedContentName, fmt.Sprintf("outputs[%v].content_variable.name", i), errors.New("reused output content name: "+content.ContentVariable.Name)), http.StatusBadRequest
 		} else {
 			knownContentNames[content.ContentVariable.Name] = true
 		}
 	}
 
-	for _, content := range service.Inputs {
-		err, code := this.ValidateContent(content, protocol, options)
+	for i, content := range service.Inputs {
+		field := fmt.Sprintf("inputs[%v]", i)
+		err, code := this.ValidateContent(ctx, content, protocol, options)
 		if err != nil {
-			return err, code
+			return model.PrefixErrorField(err, field), code
 		}
 		err = validateFunctionTypeUse(content.ContentVariable, true)
 		if err != nil {
-			return err, http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeFunctionUse, field+".content_variable", err), http.StatusBadRequest
 		}
 	}
-	for _, content := range service.Outputs {
-		err, code := this.ValidateContent(content, protocol, options)
+	for i, content := range service.Outputs {
+		field := fmt.Sprintf("outputs[%v]", i)
+		err, code := this.ValidateContent(ctx, content, protocol, options)
 		if err != nil {
-			return err, code
+			return model.PrefixErrorField(err, field), code
 		}
 		err = validateFunctionTypeUse(content.ContentVariable, false)
 		if err != nil {
-			return err, http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeFunctionUse, field+".content_variable", err), http.StatusBadRequest
 		}
 	}
 	return nil, http.StatusOK
@@ -122,8 +126,9 @@ func isControllingFunction(functionId string) bool {
 	return false
 }
 
-func (this *Controller) GetService(id string) (result models.Service, err error, code int) {
-	ctx, _ := context.WithTimeout(context.Background(), 2*time.Second)
+func (this *Controller) GetService(ctx context.Context, id string) (result models.Service, err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutValidation)
+	defer cancel()
 	dts, err := this.db.GetDeviceTypesByServiceId(ctx, id)
 	if err != nil {
 		return result, err, http.StatusInternalServerError
@@ -0,0 +1,249 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const defaultModellingSuggestionLimit = 3
+const minModellingSuggestionSimilarity = 0.5
+
+// SuggestDeviceTypeModelling proposes function, aspect and characteristic for every not annotated content-variable of dt,
+// based on similar content-variables (name, path, type and unit) of existing device-types
+func (this *Controller) SuggestDeviceTypeModelling(ctx context.Context, dt models.DeviceType, limit int) (result []model.ModellingSuggestion, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	if limit <= 0 {
+		limit = defaultModellingSuggestionLimit
+	}
+	result = []model.ModellingSuggestion{}
+
+	criteria, err := this.db.GetDeviceTypeCriteriaWithFunction(ctx)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if dt.Id != "" {
+		criteria = slices.DeleteFunc(criteria, func(c model.DeviceTypeCriteria) bool {
+			return c.PureDeviceTypeId == dt.Id
+		})
+	}
+
+	units := map[string]string{}
+	getUnit := func(characteristicId string) (string, error) {
+		if characteristicId == "" {
+			return "", nil
+		}
+		if unit, ok := units[characteristicId]; ok {
+			return unit, nil
+		}
+		characteristic, _, err := this.db.GetCharacteristic(ctx, characteristicId)
+		if err != nil {
+			return "", err
+		}
+		units[characteristicId] = normalizeUnit(characteristic.DisplayUnit)
+		return units[characteristicId], nil
+	}
+
+	for _, service := range dt.Services {
+		for _, contents := range []struct {
+			isInput bool
+			list    []models.Content
+		}{{isInput: true, list: service.Inputs}, {isInput: false, list: service.Outputs}} {
+			for _, content := range contents.list {
+				referencedUnits := map[string]string{}
+				_ = walkLintVariables(content.ContentVariable, "", "", func(variable models.ContentVariable, _ string, _ string) error {
+					if unit, ok := variable.Value.(string); ok {
+						referencedUnits[variable.Name] = normalizeUnit(unit)
+					}
+					return nil
+				})
+				err = walkLintVariables(content.ContentVariable, "", "", func(variable models.ContentVariable, _ string, path string) error {
+					if variable.FunctionId != "" || variable.IsVoid {
+						return nil
+					}
+					unit, err := getUnit(variable.CharacteristicId)
+					if err != nil {
+						return err
+					}
+					if unit == "" && variable.UnitReference != "" {
+						unit = referencedUnits[variable.UnitReference]
+					}
+					suggestion, err := suggestContentVariableModelling(criteria, variable, path, unit, contents.isInput, limit, getUnit)
+					if err != nil {
+						return err
+					}
+					if suggestion.Support == 0 {
+						return nil
+					}
+					suggestion.ServiceId = service.Id
+					suggestion.ServiceLocalId = service.LocalId
+					result = append(result, suggestion)
+					return nil
+				})
+				if err != nil {
+					return result, err, http.StatusInternalServerError
+				}
+			}
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+func suggestContentVariableModelling(criteria []model.DeviceTypeCriteria, variable models.ContentVariable, path string, unit string, isInput bool, limit int, getUnit func(characteristicId string) (string, error)) (result model.ModellingSuggestion, err error) {
+	result = model.ModellingSuggestion{
+		ContentVariableId:   variable.Id,
+		ContentVariablePath: path,
+		IsInput:             isInput,
+		Functions:           []model.SuggestedReference{},
+		Aspects:             []model.SuggestedReference{},
+		Characteristics:     []model.SuggestedReference{},
+	}
+	variableNameTokens := nameTokens(variable.Name)
+	variablePathTokens := nameTokens(path)
+	functions := suggestionVotes{}
+	aspects := suggestionVotes{}
+	characteristics := suggestionVotes{}
+	for _, c := range criteria {
+		if c.IsInput != isInput {
+			continue
+		}
+		nameSimilarity := jaccard(variableNameTokens, nameTokens(lastPathSegment(c.ContentVariablePath)))
+		if nameSimilarity == 0 {
+			continue
+		}
+		similarity := 0.5*nameSimilarity + 0.2*jaccard(variablePathTokens, nameTokens(c.ContentVariablePath))
+		if c.Type == variable.Type {
+			similarity = similarity + 0.2
+		}
+		candidateUnit, err := getUnit(c.CharacteristicId)
+		if err != nil {
+			return result, err
+		}
+		switch {
+		case unit == "" || candidateUnit == "":
+			similarity = similarity + 0.05 //unknown
+		case unit == candidateUnit:
+			similarity = similarity + 0.1
+		}
+		if similarity < minModellingSuggestionSimilarity {
+			continue
+		}
+		result.Support++
+		functions.add(c.FunctionId, similarity)
+		aspects.add(c.AspectId, similarity)
+		characteristics.add(c.CharacteristicId, similarity)
+	}
+	result.Functions = functions.top(result.Support, limit)
+	result.Aspects = aspects.top(result.Support, limit)
+	result.Characteristics = characteristics.top(result.Support, limit)
+	return result, nil
+}
+
+type suggestionVotes map[string]*model.SuggestedReference
+
+func (this suggestionVotes) add(id string, similarity float64) {
+	if id == "" {
+		return
+	}
+	if _, ok := this[id]; !ok {
+		this[id] = &model.SuggestedReference{Id: id}
+	}
+	this[id].Support++
+	this[id].Confidence = this[id].Confidence + similarity
+}
+
+// top returns the best references; the confidence is the share of similar content-variables using the reference, weighted by their similarity
+func (this suggestionVotes) top(total int, limit int) (result []model.SuggestedReference) {
+	result = []model.SuggestedReference{}
+	for _, vote := range this {
+		result = append(result, model.SuggestedReference{
+			Id:         vote.Id,
+			Support:    vote.Support,
+			Confidence: math.Round(vote.Confidence/float64(total)*100) / 100,
+		})
+	}
+	slices.SortFunc(result, func(a, b model.SuggestedReference) int {
+		switch {
+		case a.Confidence > b.Confidence:
+			return -1
+		case a.Confidence < b.Confidence:
+			return 1
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// nameTokens splits names like "currentTemperature", "current_temperature" or "state.current-temperature" into lowercase words
+func nameTokens(name string) (result []string) {
+	word := []rune{}
+	flush := func() {
+		if len(word) > 0 && !slices.Contains(result, string(word)) {
+			result = append(result, string(word))
+		}
+		word = []rune{}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				flush()
+			}
+			word = append(word, unicode.ToLower(r))
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+	return result
+}
+
+func jaccard(a []string, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	intersection := 0
+	for _, e := range a {
+		if slices.Contains(b, e) {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+func lastPathSegment(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+func normalizeUnit(unit string) string {
+	return strings.ToLower(strings.TrimSpace(unit))
+}
@@ -17,6 +17,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
@@ -27,7 +28,7 @@ import (
 	"time"
 )
 
-func (this *Controller) DeleteUser(adminToken string, userId string) (err error, errCode int) {
+func (this *Controller) DeleteUser(ctx context.Context, adminToken string, userId string) (err error, errCode int) {
 	jwtToken, err := jwt.Parse(adminToken)
 	if err != nil {
 		return err, http.StatusInternalServerError
@@ -47,7 +48,7 @@ func (this *Controller) DeleteUser(adminToken string, userId string) (err error,
 		return err, http.StatusInternalServerError
 	}
 	for _, id := range devicesToDelete {
-		err = this.deleteDevice(id)
+		err = this.deleteDevice(ctx, id)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
@@ -65,7 +66,7 @@ func (this *Controller) DeleteUser(adminToken string, userId string) (err error,
 		return err, http.StatusInternalServerError
 	}
 	for _, id := range deviceGroupToDelete {
-		err = this.deleteDeviceGroup(id)
+		err = this.deleteDeviceGroup(ctx, id)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
@@ -83,7 +84,7 @@ func (this *Controller) DeleteUser(adminToken string, userId string) (err error,
 		return err, http.StatusInternalServerError
 	}
 	for _, id := range hubToDelete {
-		err = this.deleteHub(id)
+		err = this.deleteHub(ctx, id)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
@@ -101,7 +102,7 @@ func (this *Controller) DeleteUser(adminToken string, userId string) (err error,
 		return err, http.StatusInternalServerError
 	}
 	for _, id := range locationToDelete {
-		err = this.deleteLocation(id)
+		err = this.deleteLocation(ctx, id)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
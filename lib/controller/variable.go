@@ -20,38 +20,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"net/http"
 	"regexp"
 	"strconv"
-	"time"
 )
 
-func (this *Controller) ValidateVariable(variable models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
+func (this *Controller) ValidateVariable(ctx context.Context, variable models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
+	ctx, cancel := this.getOperationTimeoutContext(ctx, configuration.TimeoutValidation)
+	defer cancel()
 	if variable.Id == "" {
-		return errors.New("missing content variable id"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrMissingField, "id", errors.New("missing content variable id")), http.StatusBadRequest
 	}
 
 	if variable.OmitEmpty {
 		switch v := variable.Value.(type) {
 		case string:
 			if v != "" {
-				return fmt.Errorf("validation error: %v has a value (%v) set while omit_empty is true", variable.Name, variable.Value), http.StatusBadRequest
+				return model.NewFieldError(model.ErrInvalidField, "value", fmt.Errorf("validation error: %v has a value (%v) set while omit_empty is true", variable.Name, variable.Value)), http.StatusBadRequest
 			}
 		case float64:
 			if v != 0 {
-				return fmt.Errorf("validation error: %v has a value (%v) set while omit_empty is true", variable.Name, variable.Value), http.StatusBadRequest
+				return model.NewFieldError(model.ErrInvalidField, "value", fmt.Errorf("validation error: %v has a value (%v) set while omit_empty is true", variable.Name, variable.Value)), http.StatusBadRequest
 			}
 		}
 	}
 
 	if !variable.IsVoid {
 		if variable.Name == "" {
-			return errors.New("missing content variable name"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrMissingField, "name", errors.New("missing content variable name")), http.StatusBadRequest
 		}
 		if variable.Type == "" {
-			return errors.New("missing content variable type for " + variable.Name), http.StatusBadRequest
+			return model.NewFieldError(model.ErrMissingField, "type", errors.New("missing content variable type for "+variable.Name)), http.StatusBadRequest
 		}
 
 		err, code = ValidateVariableName(variable.Name)
@@ -62,57 +64,62 @@ func (this *Controller) ValidateVariable(variable models.ContentVariable, serial
 		switch variable.Type {
 		case models.String:
 			if len(variable.SubContentVariables) > 0 {
-				return errors.New("strings can not have sub content variables for " + variable.Name), http.StatusBadRequest
+				return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("strings can not have sub content variables for "+variable.Name)), http.StatusBadRequest
 			}
 		case models.Integer:
 			if len(variable.SubContentVariables) > 0 {
-				return errors.New("integers can not have sub content variables for " + variable.Name), http.StatusBadRequest
+				return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("integers can not have sub content variables for "+variable.Name)), http.StatusBadRequest
 			}
 		case models.Float:
 			if len(variable.SubContentVariables) > 0 {
-				return errors.New("floats can not have sub content variables for " + variable.Name), http.StatusBadRequest
+				return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("floats can not have sub content variables for "+variable.Name)), http.StatusBadRequest
 			}
 		case models.Boolean:
 			if len(variable.SubContentVariables) > 0 {
-				return errors.New("booleans can not have sub content variables for " + variable.Name), http.StatusBadRequest
+				return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("booleans can not have sub content variables for "+variable.Name)), http.StatusBadRequest
 			}
 		case models.List:
-			err, code = this.ValidateListSubVariables(variable.SubContentVariables, serialization, options)
+			err, code = this.ValidateListSubVariables(ctx, variable.SubContentVariables, serialization, options)
 			if err != nil {
 				return err, code
 			}
 		case models.Structure:
-			err, code = this.ValidateStructureSubVariables(variable.SubContentVariables, serialization, options)
+			err, code = this.ValidateStructureSubVariables(ctx, variable.SubContentVariables, serialization, options)
 			if err != nil {
 				return err, code
 			}
 		default:
-			return errors.New("unknown content value type: " + string(variable.Type) + " in " + variable.Name), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "type", errors.New("unknown content value type: "+string(variable.Type)+" in "+variable.Name)), http.StatusBadRequest
 		}
 	}
 
-	ctx, _ := context.WithTimeout(context.Background(), 2*time.Second)
 	if variable.AspectId != "" && this != nil {
 		aspectNode, exists, err := this.db.GetAspectNode(ctx, variable.AspectId)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
 		if !exists {
-			return errors.New("unknown aspect id:" + variable.AspectId), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeUnknownAspect, "aspect_id", errors.New("unknown aspect id:"+variable.AspectId)), http.StatusBadRequest
 		}
 		if !options.CheckAllowNoneLeafAspectNodesInDeviceTypes(this.config) && len(aspectNode.DescendentIds) > 0 {
-			return errors.New("only leaf aspects are allowed in device-types " + variable.Name), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeNoneLeafAspect, "aspect_id", errors.New("only leaf aspects are allowed in device-types "+variable.Name)), http.StatusBadRequest
+		}
+	}
+
+	if variable.CharacteristicId != "" && !variable.IsVoid && this != nil {
+		err, code = this.validateVariableCharacteristic(ctx, variable, serialization)
+		if err != nil {
+			return err, code
 		}
 	}
 
-	ctx, _ = context.WithTimeout(context.Background(), 2*time.Second)
 	if variable.FunctionId != "" && this != nil {
 		function, exists, err := this.db.GetFunction(ctx, variable.FunctionId)
 		if err != nil {
 			return err, http.StatusInternalServerError
 		}
 		if !exists {
-			return errors.New("unknown function id:" + variable.FunctionId), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeUnknownFunction, "function_id", errors.New("unknown function id:"+variable.FunctionId)), http.StatusBadRequest
 		}
 		if variable.CharacteristicId != "" && function.ConceptId != "" {
 			concept, exists, err := this.db.GetConceptWithoutCharacteristics(ctx, function.ConceptId)
@@ -120,8 +127,8 @@ func (this *Controller) ValidateVariable(variable models.ContentVariable, serial
 				return err, http.StatusInternalServerError
 			}
 			if exists && !contains(concept.CharacteristicIds, variable.CharacteristicId) {
-				return errors.New(fmt.Sprintf("variable characteristicId does not match variable function: %v, %v, %v, %v, %v",
-					variable.Id, variable.Name, function.Id, function.ConceptId, variable.CharacteristicId)), http.StatusInternalServerError
+				return model.NewFieldError(model.ErrDeviceTypeCharacteristicMismatch, "characteristic_id", errors.New(fmt.Sprintf("variable characteristicId does not match variable function: %v, %v, %v, %v, %v",
+					variable.Id, variable.Name, function.Id, function.ConceptId, variable.CharacteristicId))), http.StatusInternalServerError
 			}
 		}
 	}
@@ -133,7 +140,7 @@ func ValidateName(name string) (err error, code int) {
 	pattern := `^[A-Za-z_][A-Za-z0-9-_]*$`
 	re := regexp.MustCompile(pattern)
 	if !re.MatchString(name) {
-		return errors.New("invalid name:" + name), http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariableName, "name", errors.New("invalid name:"+name)), http.StatusBadRequest
 	}
 	return nil, http.StatusOK
 }
@@ -152,54 +159,55 @@ func ValidateVariableName(name string) (err error, code int) {
 	return ValidateName(name)
 }
 
-func (this *Controller) ValidateListSubVariables(variables []models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
+func (this *Controller) ValidateListSubVariables(ctx context.Context, variables []models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
 	if len(variables) == 0 {
-		return errors.New("lists expect sub content variables"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("lists expect sub content variables")), http.StatusBadRequest
 	}
 	if variables[0].Name == "*" {
 		if len(variables) != 1 {
-			return errors.New("lists with name placeholder '*' have a variable length -> only one sub variable may be defined"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("lists with name placeholder '*' have a variable length -> only one sub variable may be defined")), http.StatusBadRequest
 		}
-		return this.ValidateVariable(variables[0], serialization, options)
+		err, code = this.ValidateVariable(ctx, variables[0], serialization, options)
+		return model.PrefixErrorField(err, "sub_content_variables[0]"), code
 	}
 	nameIndex := map[string]bool{}
-	for _, variable := range variables {
+	for i, variable := range variables {
 		_, err = strconv.Atoi(variable.Name)
 		if err != nil {
-			return errors.New("name of list variable should be a number (if list is variable in length is may be defined with one element and the placeholder '*' as name)"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariableName, fmt.Sprintf("sub_content_variables[%v].name", i), errors.New("name of list variable should be a number (if list is variable in length is may be defined with one element and the placeholder '*' as name)")), http.StatusBadRequest
 		}
 		nameIndex[variable.Name] = true
-		err, code = this.ValidateVariable(variable, serialization, options)
+		err, code = this.ValidateVariable(ctx, variable, serialization, options)
 		if err != nil {
-			return err, code
+			return model.PrefixErrorField(err, fmt.Sprintf("sub_content_variables[%v]", i)), code
 		}
 	}
 	for i := 0; i < len(variables); i++ {
 		if !nameIndex[strconv.Itoa(i)] {
-			return errors.New("missing index name '" + strconv.Itoa(i) + "' in list content variable"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("missing index name '"+strconv.Itoa(i)+"' in list content variable")), http.StatusBadRequest
 		}
 	}
 	return nil, http.StatusOK
 }
 
-func (this *Controller) ValidateStructureSubVariables(variables []models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
+func (this *Controller) ValidateStructureSubVariables(ctx context.Context, variables []models.ContentVariable, serialization models.Serialization, options model.ValidationOptions) (err error, code int) {
 	if len(variables) == 0 {
-		return errors.New("structures expect sub content variables"), http.StatusBadRequest
+		return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("structures expect sub content variables")), http.StatusBadRequest
 	}
 	if variables[0].Name == "*" {
 		if len(variables) != 1 {
-			return errors.New("structures with name placeholder '*' work as maps of variable length -> only one sub content variable may be defined"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariable, "sub_content_variables", errors.New("structures with name placeholder '*' work as maps of variable length -> only one sub content variable may be defined")), http.StatusBadRequest
 		}
 	}
 	nameIndex := map[string]bool{}
-	for _, variable := range variables {
+	for i, variable := range variables {
 		if _, exists := nameIndex[variable.Name]; exists {
-			return errors.New("structure sub content variable reuses name '" + variable.Name + "'"), http.StatusBadRequest
+			return model.NewFieldError(model.ErrDeviceTypeInvalidContentVariableName, fmt.Sprintf("sub_content_variables[%v].name", i), errors.New("structure sub content variable reuses name '"+variable.Name+"'")), http.StatusBadRequest
 		}
 		nameIndex[variable.Name] = true
-		err, code = this.ValidateVariable(variable, serialization, options)
+		err, code = this.ValidateVariable(ctx, variable, serialization, options)
 		if err != nil {
-			return err, code
+			return model.PrefixErrorField(err, fmt.Sprintf("sub_content_variables[%v]", i)), code
 		}
 	}
 	return nil, http.StatusOK
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (this *Controller) ListWebhooks(ctx context.Context, token string, options model.WebhookListOptions) (result []model.Webhook, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, total, err, http.StatusUnauthorized
+	}
+	if !jwtToken.IsAdmin() {
+		options.OwnerId = jwtToken.GetUserId()
+	}
+	result, total, err = this.db.ListWebhooks(ctx, options)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	for i := range result {
+		result[i].Secret = ""
+	}
+	return result, total, nil, http.StatusOK
+}
+
+func (this *Controller) GetWebhook(ctx context.Context, token string, id string) (result model.Webhook, err error, errCode int) {
+	result, err, errCode = this.getAccessibleWebhook(ctx, token, id)
+	if err != nil {
+		return result, err, errCode
+	}
+	result.Secret = ""
+	return result, nil, http.StatusOK
+}
+
+// getAccessibleWebhook returns the webhook if the token belongs to its owner or an admin
+func (this *Controller) getAccessibleWebhook(ctx context.Context, token string, id string) (result model.Webhook, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusUnauthorized
+	}
+	result, exists, err := this.db.GetWebhook(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	//unknown and foreign webhooks are indistinguishable for the requesting user
+	if !exists || (result.OwnerId != jwtToken.GetUserId() && !jwtToken.IsAdmin()) {
+		return model.Webhook{}, fmt.Errorf("webhook %w", model.ErrNotFound), http.StatusNotFound
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetWebhook creates a new webhook if webhook.Id is empty, otherwise the existing webhook is updated
+// an empty secret keeps the stored secret; re-enabling a disabled webhook resets its failure count
+func (this *Controller) SetWebhook(ctx context.Context, token string, webhook model.Webhook) (result model.Webhook, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	jwtToken, err := jwt.Parse(token)
+	if err != nil {
+		return result, err, http.StatusUnauthorized
+	}
+	err = ValidateWebhook(webhook)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	u, _ := url.Parse(webhook.Url)
+	err = CheckWebhookHost(ctx, u.Hostname(), this.config.WebhookAllowedNetworks)
+	if err != nil {
+		return result, model.NewFieldError(model.ErrInvalidField, "url", err), http.StatusBadRequest
+	}
+	webhook.OwnerId = jwtToken.GetUserId()
+	webhook.ConsecutiveFailures = 0
+	if webhook.Disabled {
+		webhook.DisabledReason = "disabled by user"
+	} else {
+		webhook.DisabledReason = ""
+	}
+	if webhook.Id == "" {
+		webhook.GenerateId()
+	} else {
+		existing, err, code := this.getAccessibleWebhook(ctx, token, webhook.Id)
+		if err != nil {
+			return result, err, code
+		}
+		if existing.OwnerId != webhook.OwnerId {
+			//admin updates foreign webhook: keep owner
+			webhook.OwnerId = existing.OwnerId
+		}
+		if webhook.Secret == "" {
+			webhook.Secret = existing.Secret
+		}
+		if webhook.Disabled == existing.Disabled {
+			webhook.ConsecutiveFailures = existing.ConsecutiveFailures
+			webhook.DisabledReason = existing.DisabledReason
+		}
+	}
+	err = this.db.SetWebhook(ctx, webhook)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	webhook.Secret = ""
+	return webhook, nil, http.StatusOK
+}
+
+func ValidateWebhook(webhook model.Webhook) error {
+	if webhook.Url == "" {
+		return model.NewFieldError(model.ErrMissingField, "url", errors.New("missing webhook url"))
+	}
+	u, err := url.Parse(webhook.Url)
+	if err != nil {
+		return model.NewFieldError(model.ErrInvalidField, "url", fmt.Errorf("invalid webhook url: %w", err))
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return model.NewFieldError(model.ErrInvalidField, "url", errors.New("webhook url must be an absolute http or https url"))
+	}
+	if len(webhook.ResourceTypes) == 0 {
+		return model.NewFieldError(model.ErrMissingField, "resource_types", errors.New("missing webhook resource_types"))
+	}
+	for i, resourceType := range webhook.ResourceTypes {
+		if !slices.Contains(model.WebhookResourceTypes, resourceType) {
+			return model.NewFieldError(model.ErrInvalidField, fmt.Sprintf("resource_types[%v]", i), fmt.Errorf("unknown resource type %v, expected one of %v", resourceType, model.WebhookResourceTypes))
+		}
+	}
+	for i, event := range webhook.Filter.Events {
+		if event != model.WebhookEventSet && event != model.WebhookEventDelete {
+			return model.NewFieldError(model.ErrInvalidField, fmt.Sprintf("filter.events[%v]", i), fmt.Errorf("unknown event %v, expected %v or %v", event, model.WebhookEventSet, model.WebhookEventDelete))
+		}
+	}
+	return nil
+}
+
+// CheckWebhookHost resolves the host of a webhook url and rejects it, if one of its addresses is rejected by CheckWebhookAddress
+// the delivery checks the connected address again, because the resolved addresses may change after the registration
+func CheckWebhookHost(ctx context.Context, host string, allowedNetworks []string) error {
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("unable to resolve webhook host %v: %w", host, err)
+	}
+	for _, addr := range addrs {
+		err = CheckWebhookAddress(addr, allowedNetworks)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nonGlobalWebhookNetworks are special-purpose networks (ref IANA ipv4/ipv6 special-purpose address registries),
+// which are not covered by the netip.Addr checks and are not globally reachable
+var nonGlobalWebhookNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       //this network
+	netip.MustParsePrefix("100.64.0.0/10"),   //shared address space (carrier-grade nat)
+	netip.MustParsePrefix("192.0.0.0/24"),    //ietf protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    //documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   //benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), //documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  //documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     //reserved and limited broadcast
+	netip.MustParsePrefix("64:ff9b:1::/48"),  //local-use ipv4/ipv6 translation
+	netip.MustParsePrefix("100::/64"),        //discard-only
+	netip.MustParsePrefix("2001:db8::/32"),   //documentation
+	netip.MustParsePrefix("2002::/16"),       //6to4; may embed any ipv4 address
+	netip.MustParsePrefix("fec0::/10"),       //deprecated site-local
+}
+
+// nat64WebhookNetwork embeds ipv4 addresses in the last 32 bits, which are checked like plain ipv4 addresses
+var nat64WebhookNetwork = netip.MustParsePrefix("64:ff9b::/96")
+
+// CheckWebhookAddress rejects addresses which are not globally reachable (e.g. loopback, link-local, private, shared, multicast or unspecified),
+// unless they are part of allowedNetworks (ref configuration.Config.WebhookAllowedNetworks); invalid networks are ignored
+func CheckWebhookAddress(addr netip.Addr, allowedNetworks []string) error {
+	addr = addr.Unmap()
+	for _, network := range allowedNetworks {
+		prefix, err := netip.ParsePrefix(network)
+		if err == nil && prefix.Contains(addr) {
+			return nil
+		}
+	}
+	if nat64WebhookNetwork.Contains(addr) {
+		embedded := addr.As16()
+		return CheckWebhookAddress(netip.AddrFrom4([4]byte(embedded[12:])), allowedNetworks)
+	}
+	if addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsMulticast() || addr.IsPrivate() || addr.IsUnspecified() {
+		return fmt.Errorf("webhook address %v is not allowed", addr)
+	}
+	for _, network := range nonGlobalWebhookNetworks {
+		if network.Contains(addr) {
+			return fmt.Errorf("webhook address %v is not allowed", addr)
+		}
+	}
+	return nil
+}
+
+func (this *Controller) DeleteWebhook(ctx context.Context, token string, id string) (err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	_, err, errCode = this.getAccessibleWebhook(ctx, token, id)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, http.StatusOK
+	}
+	if err != nil {
+		return err, errCode
+	}
+	err = this.db.RemoveWebhook(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
+
+func (this *Controller) ListWebhookDeliveries(ctx context.Context, token string, id string, options model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error, errCode int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	_, err, errCode = this.getAccessibleWebhook(ctx, token, id)
+	if err != nil {
+		return result, total, err, errCode
+	}
+	result, total, err = this.db.ListWebhookDeliveries(ctx, id, options)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	return result, total, nil, http.StatusOK
+}
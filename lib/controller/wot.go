@@ -0,0 +1,274 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/wot"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func (this *Controller) GetDeviceTypeWotTm(ctx context.Context, token string, id string) (result wot.Thing, err error, code int) {
+	deviceType, err, code := this.ReadDeviceType(ctx, id, token)
+	if err != nil {
+		return result, err, code
+	}
+	protocols, err, code := this.getServiceProtocols(ctx, deviceType)
+	if err != nil {
+		return result, err, code
+	}
+	return wot.NewThingModel(deviceType, protocols), nil, http.StatusOK
+}
+
+func (this *Controller) GetDeviceWotTd(ctx context.Context, token string, id string) (result wot.Thing, err error, code int) {
+	device, err, code := this.ReadDevice(ctx, id, token, model.READ)
+	if err != nil {
+		return result, err, code
+	}
+	deviceType, err, code := this.ReadDeviceType(ctx, device.DeviceTypeId, token)
+	if err != nil {
+		return result, err, code
+	}
+	protocols, err, code := this.getServiceProtocols(ctx, deviceType)
+	if err != nil {
+		return result, err, code
+	}
+	return wot.NewThingDescription(device, deviceType, protocols), nil, http.StatusOK
+}
+
+// getServiceProtocols returns the known protocols used by the services of deviceType
+func (this *Controller) getServiceProtocols(ctx context.Context, deviceType models.DeviceType) (result map[string]models.Protocol, err error, code int) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result = map[string]models.Protocol{}
+	for _, service := range deviceType.Services {
+		if _, known := result[service.ProtocolId]; known || service.ProtocolId == "" {
+			continue
+		}
+		protocol, exists, err := this.db.GetProtocol(ctx, service.ProtocolId)
+		if err != nil {
+			return result, err, http.StatusInternalServerError
+		}
+		if exists {
+			result[service.ProtocolId] = protocol
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+// ImportWotThingModel creates a device-type draft from a WoT Thing Model (ref wot.NewDeviceType)
+// content-variables without function get functions and aspects suggested by name matching (ref suggestWotSemantics)
+// with options.Save the draft is saved like by SetDeviceType; otherwise it is validated and returned for review
+func (this *Controller) ImportWotThingModel(ctx context.Context, token string, thing wot.Thing, options model.WotImportOptions) (result model.WotImportResult, err error, code int) {
+	if options.ProtocolId == "" {
+		return result, model.NewFieldError(model.ErrMissingField, "protocol_id", errors.New("missing protocol id")), http.StatusBadRequest
+	}
+	protocol, exists, err := this.db.GetProtocol(ctx, options.ProtocolId)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, model.NewFieldError(model.ErrDeviceTypeUnknownProtocol, "protocol_id", errors.New("unknown protocol")), http.StatusBadRequest
+	}
+	result.DeviceType, err = wot.NewDeviceType(thing, protocol)
+	if err != nil {
+		return result, model.NewError(model.ErrInvalidBody, fmt.Errorf("unable to use thing model: %w", err)), http.StatusBadRequest
+	}
+	result.Suggestions, err = this.suggestWotSemantics(ctx, &result.DeviceType)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	result.DeviceType.GenerateId()
+
+	if options.Save {
+		result.DeviceType, err, code = this.SetDeviceType(ctx, token, result.DeviceType, model.DeviceTypeUpdateOptions{})
+		if err != nil {
+			return result, err, code
+		}
+		result.Saved = true
+		return result, nil, http.StatusOK
+	}
+
+	err, code = this.ValidateDeviceType(ctx, result.DeviceType, model.ValidationOptions{})
+	if err != nil {
+		if code >= http.StatusInternalServerError {
+			return result, err, code
+		}
+		problem := model.NewProblem(err, code)
+		result.ValidationProblem = &problem
+	}
+	return result, nil, http.StatusOK
+}
+
+type wotNameCandidate struct {
+	id    string
+	words []string
+}
+
+// suggestWotSemantics sets functions and aspects of leaf content-variables without function
+// a function or aspect matches, if all words of its name (ignoring 'get', 'set' and 'function') are found in the
+// content-variable path; if nothing matches, the service name and local id are used for services with a single leaf
+// and for aspects additionally the device-type name; the candidate with the most words wins
+// inputs only get controlling functions, outputs only measuring functions; only leaf aspects are suggested
+func (this *Controller) suggestWotSemantics(ctx context.Context, deviceType *models.DeviceType) (result []model.WotImportSuggestion, err error) {
+	ctx, cancel := this.getTimeoutContext(ctx)
+	defer cancel()
+	result = []model.WotImportSuggestion{}
+	controllingFunctions := []wotNameCandidate{}
+	measuringFunctions := []wotNameCandidate{}
+	var limit int64 = 1000
+	for offset := int64(0); ; offset += limit {
+		functions, _, err := this.db.ListFunctions(ctx, model.FunctionListOptions{Limit: limit, Offset: offset, SortBy: "id.asc"})
+		if err != nil {
+			return result, err
+		}
+		for _, f := range functions {
+			candidate := wotNameCandidate{id: f.Id, words: nameWords(f.Name, "get", "set", "function")}
+			if isControllingFunction(f.Id) {
+				controllingFunctions = append(controllingFunctions, candidate)
+			} else {
+				measuringFunctions = append(measuringFunctions, candidate)
+			}
+		}
+		if int64(len(functions)) < limit {
+			break
+		}
+	}
+	nodes, err := this.db.ListAllAspectNodes(ctx)
+	if err != nil {
+		return result, err
+	}
+	slices.SortFunc(nodes, func(a, b models.AspectNode) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	aspects := []wotNameCandidate{}
+	for _, node := range nodes {
+		if len(node.ChildIds) == 0 {
+			aspects = append(aspects, wotNameCandidate{id: node.Id, words: nameWords(node.Name)})
+		}
+	}
+
+	for i, service := range deviceType.Services {
+		serviceWords := nameWords(service.Name + " " + service.LocalId)
+		deviceTypeWords := nameWords(deviceType.Name)
+		suggest := func(contents []models.Content, functions []wotNameCandidate) {
+			leafCount := 0
+			for _, content := range contents {
+				walkLeafVariables(&content.ContentVariable, nil, func(*models.ContentVariable, []string) { leafCount++ })
+			}
+			for j := range contents {
+				walkLeafVariables(&contents[j].ContentVariable, nil, func(variable *models.ContentVariable, path []string) {
+					if variable.FunctionId != "" {
+						return
+					}
+					pathWords := nameWords(strings.Join(path, " "))
+					levels := [][]string{pathWords}
+					if leafCount == 1 {
+						levels = append(levels, append(pathWords, serviceWords...))
+					}
+					variable.FunctionId = bestNameMatch(functions, levels...)
+					if variable.FunctionId == "" {
+						return
+					}
+					suggestion := model.WotImportSuggestion{
+						ServiceLocalId:      service.LocalId,
+						ContentVariablePath: strings.Join(path, "."),
+						FunctionId:          variable.FunctionId,
+					}
+					if variable.AspectId == "" {
+						variable.AspectId = bestNameMatch(aspects, append(levels, append(levels[len(levels)-1], deviceTypeWords...))...)
+						suggestion.AspectId = variable.AspectId
+					}
+					result = append(result, suggestion)
+				})
+			}
+		}
+		suggest(deviceType.Services[i].Inputs, controllingFunctions)
+		suggest(deviceType.Services[i].Outputs, measuringFunctions)
+	}
+	return result, nil
+}
+
+func walkLeafVariables(variable *models.ContentVariable, path []string, f func(variable *models.ContentVariable, path []string)) {
+	path = append(slices.Clone(path), variable.Name)
+	if len(variable.SubContentVariables) == 0 {
+		f(variable, path)
+		return
+	}
+	for i := range variable.SubContentVariables {
+		walkLeafVariables(&variable.SubContentVariables[i], path, f)
+	}
+}
+
+// bestNameMatch returns the id of the candidate with the most words, whose words are all contained in the first level with any match
+func bestNameMatch(candidates []wotNameCandidate, levels ...[]string) string {
+	for _, words := range levels {
+		best := wotNameCandidate{}
+		for _, candidate := range candidates {
+			if len(candidate.words) <= len(best.words) {
+				continue
+			}
+			matches := true
+			for _, word := range candidate.words {
+				if !slices.Contains(words, word) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				best = candidate
+			}
+		}
+		if best.id != "" {
+			return best.id
+		}
+	}
+	return ""
+}
+
+// nameWords splits names like "Get Temperature", "get_temperature" or "getTemperatures" into lowercase words without plural 's'
+func nameWords(name string, ignore ...string) (result []string) {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		start := 0
+		runes := []rune(word)
+		for i := 1; i <= len(runes); i++ {
+			if i < len(runes) && !(unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1])) {
+				continue
+			}
+			part := strings.ToLower(string(runes[start:i]))
+			start = i
+			if len(part) > 3 {
+				part = strings.TrimSuffix(part, "s")
+			}
+			if !slices.Contains(ignore, part) {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
@@ -26,8 +26,9 @@ import (
 )
 
 type Database interface {
-	RunStartupMigrations(methods mongo.GeneratedDeviceGroupMigrationMethods) error
+	RunStartupMigrations(ctx context.Context, methods mongo.GeneratedDeviceGroupMigrationMethods) error
 	Disconnect()
+	Ping(ctx context.Context) error
 
 	GetDevice(ctx context.Context, id string) (device model.DeviceWithConnectionState, exists bool, err error)
 	ListDevices(ctx context.Context, options model.DeviceListOptions, withTotal bool) (devices []model.DeviceWithConnectionState, total int64, err error)
@@ -67,6 +68,7 @@ type Database interface {
 	GetDeviceTypeIdsByFilterCriteria(ctx context.Context, criteria []model.FilterCriteria, interactionsFilter []string, includeModified bool) (result []interface{}, err error)
 	GetDeviceTypeIdsByFilterCriteriaV2(ctx context.Context, criteria []model.FilterCriteria, includeModified bool) (result []interface{}, err error)
 	GetConfigurableCandidates(ctx context.Context, serviceId string) (result []model.DeviceTypeCriteria, err error)
+	GetDeviceTypeCriteriaWithFunction(ctx context.Context) (result []model.DeviceTypeCriteria, err error) //criteria of not modified device-types referencing a function
 
 	GetDeviceGroup(ctx context.Context, id string) (deviceGroup models.DeviceGroup, exists bool, err error)
 	ListDeviceGroups(ctx context.Context, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error)
@@ -162,6 +164,34 @@ type Database interface {
 	ListGraphs(ctx context.Context, listOptions model.GraphListOptions) (result []models.Graph, total int64, err error)
 	RetryGraphSync(lockduration time.Duration, syncDeleteHandler func(models.Graph) error, syncHandler func(models.Graph) error) error
 
+	GetWebhook(ctx context.Context, id string) (webhook model.Webhook, exists bool, err error)
+	ListWebhooks(ctx context.Context, options model.WebhookListOptions) (result []model.Webhook, total int64, err error)
+	SetWebhook(ctx context.Context, webhook model.Webhook) error
+	RemoveWebhook(ctx context.Context, id string) error                                                              //also removes the delivery logs of the webhook
+	RecordWebhookDeliveryResult(ctx context.Context, id string, success bool) (consecutiveFailures int64, err error) //increments or resets Webhook.ConsecutiveFailures
+	DisableWebhook(ctx context.Context, id string, reason string) error
+	AddWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	ListWebhookDeliveries(ctx context.Context, webhookId string, options model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error) //newest first
+
+	GetDeviceTypeTemplate(ctx context.Context, id string) (template model.DeviceTypeTemplate, exists bool, err error)
+	ListDeviceTypeTemplates(ctx context.Context, options model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error)
+	SetDeviceTypeTemplate(ctx context.Context, template model.DeviceTypeTemplate) error
+	RemoveDeviceTypeTemplate(ctx context.Context, id string) error
+	GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (extension model.DeviceTypeExtension, exists bool, err error)
+	ListDeviceTypeExtensions(ctx context.Context, templateId string) (result []model.DeviceTypeExtension, err error)
+	SetDeviceTypeExtension(ctx context.Context, extension model.DeviceTypeExtension) error
+	RemoveDeviceTypeExtension(ctx context.Context, deviceTypeId string) error
+
+	GetFunctionDeprecation(ctx context.Context, functionId string) (deprecation model.FunctionDeprecation, exists bool, err error)
+	ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error)
+	SetFunctionDeprecation(ctx context.Context, deprecation model.FunctionDeprecation) error
+	RemoveFunctionDeprecation(ctx context.Context, functionId string) error
+
+	GetCharacteristicPattern(ctx context.Context, characteristicId string) (pattern model.CharacteristicPattern, exists bool, err error)
+	ListCharacteristicPatterns(ctx context.Context) (result []model.CharacteristicPattern, err error)
+	SetCharacteristicPattern(ctx context.Context, pattern model.CharacteristicPattern) error
+	RemoveCharacteristicPattern(ctx context.Context, characteristicId string) error
+
 	DesyncUnknownLocations(ctx context.Context, knownLocations []string) (err error)
 	DesyncUnknownHubs(ctx context.Context, knownHubs []string) (err error)
 	DesyncUnknownDeviceGroups(ctx context.Context, knownDeviceGroups []string) (err error)
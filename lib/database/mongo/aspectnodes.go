@@ -169,9 +169,9 @@ func (this *Mongo) ListAllAspectNodes(ctx context.Context) (result []models.Aspe
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.AspectNode{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		aspectNode := models.AspectNode{}
 		err = cursor.Decode(&aspectNode)
 		if err != nil {
@@ -209,9 +209,9 @@ func (this *Mongo) ListAspectNodesWithMeasuringFunction(ctx context.Context, anc
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.AspectNode{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		aspectNode := models.AspectNode{}
 		err = cursor.Decode(&aspectNode)
 		if err != nil {
@@ -235,9 +235,9 @@ func (this *Mongo) ListAspectNodesByIdList(ctx context.Context, ids []string) (r
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.AspectNode{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		aspectNode := models.AspectNode{}
 		err = cursor.Decode(&aspectNode)
 		if err != nil {
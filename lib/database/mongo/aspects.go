@@ -218,9 +218,9 @@ func (this *Mongo) ListAllAspects(ctx context.Context) (result []models.Aspect,
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.Aspect{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		aspect := models.Aspect{}
 		err = cursor.Decode(&aspect)
 		if err != nil {
@@ -269,9 +269,9 @@ func (this *Mongo) ListAspectsWithMeasuringFunction(ctx context.Context, ancesto
 			return nil, err
 		}
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.Aspect{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		aspect := models.Aspect{}
 		err = cursor.Decode(&aspect)
 		if err != nil {
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var CharacteristicPatternBson = getBsonFieldObject[model.CharacteristicPattern]()
+
+func init() {
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.characteristicPatternCollection()
+		return db.ensureIndex(collection, "characteristicpatterncharacteristicindex", CharacteristicPatternBson.CharacteristicId, true, true)
+	})
+}
+
+func (this *Mongo) characteristicPatternCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoCharacteristicPatternCollection)
+}
+
+func (this *Mongo) GetCharacteristicPattern(ctx context.Context, characteristicId string) (pattern model.CharacteristicPattern, exists bool, err error) {
+	result := this.characteristicPatternCollection().FindOne(ctx, bson.M{CharacteristicPatternBson.CharacteristicId: characteristicId})
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return pattern, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = result.Decode(&pattern)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return pattern, false, nil
+	}
+	return pattern, true, err
+}
+
+func (this *Mongo) ListCharacteristicPatterns(ctx context.Context) (result []model.CharacteristicPattern, err error) {
+	cursor, err := this.characteristicPatternCollection().Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{CharacteristicPatternBson.CharacteristicId, 1}}))
+	if err != nil {
+		return nil, err
+	}
+	result = []model.CharacteristicPattern{}
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func (this *Mongo) SetCharacteristicPattern(ctx context.Context, pattern model.CharacteristicPattern) error {
+	_, err := this.characteristicPatternCollection().ReplaceOne(ctx, bson.M{CharacteristicPatternBson.CharacteristicId: pattern.CharacteristicId}, pattern, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) RemoveCharacteristicPattern(ctx context.Context, characteristicId string) error {
+	_, err := this.characteristicPatternCollection().DeleteOne(ctx, bson.M{CharacteristicPatternBson.CharacteristicId: characteristicId})
+	return err
+}
@@ -218,8 +218,8 @@ func (this *Mongo) ListAllCharacteristics(ctx context.Context) (result []models.
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		characteristic := models.Characteristic{}
 		err = cursor.Decode(&characteristic)
 		if err != nil {
@@ -239,8 +239,8 @@ func (this *Mongo) getCharacteristicsByIds(ctx context.Context, ids []string) (r
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		characteristic := models.Characteristic{}
 		err = cursor.Decode(&characteristic)
 		if err != nil {
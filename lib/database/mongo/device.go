@@ -280,6 +280,20 @@ func (this *Mongo) ListDevices(ctx context.Context, listOptions model.DeviceList
 	}
 	opt.SetSort(bson.D{{sortby, direction}})
 
+	if listOptions.Fields != nil {
+		//fields used by the controller (default attributes, id modifiers, extended devices) are always loaded
+		opt.SetProjection(getProjection[model.DeviceWithConnectionState](listOptions.Fields,
+			sortby,
+			DeviceBson.Id,
+			DeviceBson.LocalId,
+			DeviceBson.Name,
+			DeviceBson.DisplayName,
+			DeviceBson.OwnerId,
+			DeviceBson.DeviceTypeId,
+			"attributes",
+		))
+	}
+
 	andFilter := []interface{}{bson.M{NotDeletedFilterKey: NotDeletedFilterValue}}
 	filter := bson.M{}
 	if listOptions.Ids != nil {
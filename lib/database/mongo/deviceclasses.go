@@ -243,8 +243,8 @@ func (this *Mongo) ListAllDeviceClasses(ctx context.Context) (result []models.De
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceClass := models.DeviceClass{}
 		err = cursor.Decode(&deviceClass)
 		if err != nil {
@@ -268,8 +268,8 @@ func (this *Mongo) ListAllDeviceClassesUsedWithControllingFunctions(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceClass := models.DeviceClass{}
 		err = cursor.Decode(&deviceClass)
 		if err != nil {
@@ -114,8 +114,8 @@ func (this *Mongo) ListDeviceTypes(ctx context.Context, limit int64, offset int6
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceType := models.DeviceType{}
 		err = cursor.Decode(&deviceType)
 		if err != nil {
@@ -174,8 +174,8 @@ func (this *Mongo) ListDeviceTypesV2(ctx context.Context, limit int64, offset in
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceType := models.DeviceType{}
 		err = cursor.Decode(&deviceType)
 		if err != nil {
@@ -226,6 +226,11 @@ func (this *Mongo) ListDeviceTypesV3(ctx context.Context, listOptions model.Devi
 	}
 	opt.SetSort(bson.D{{sortby, direction}})
 
+	//modified device-types are derived from complete device-types
+	if listOptions.Fields != nil && !listOptions.IncludeModified {
+		opt.SetProjection(getProjection[models.DeviceType](listOptions.Fields, DeviceTypeBson.Id, DeviceTypeBson.Name))
+	}
+
 	filter := bson.M{NotDeletedFilterKey: NotDeletedFilterValue}
 	if listOptions.Ids != nil {
 		filter[DeviceTypeBson.Id] = bson.M{"$in": listOptions.Ids}
@@ -268,8 +273,8 @@ func (this *Mongo) ListDeviceTypesV3(ctx context.Context, listOptions model.Devi
 	if err != nil {
 		return nil, 0, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceType := models.DeviceType{}
 		err = cursor.Decode(&deviceType)
 		if err != nil {
@@ -465,8 +470,8 @@ func (this *Mongo) GetDeviceTypesByServiceId(ctx context.Context, serviceId stri
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		deviceType := models.DeviceType{}
 		err = cursor.Decode(&deviceType)
 		if err != nil {
@@ -128,30 +128,28 @@ func (this *Mongo) setDeviceTypeCriteria(ctx context.Context, dt models.DeviceTy
 	if err != nil {
 		return err
 	}
-	return this.addDeviceTypeCriteria(ctx, createCriteriaListFromDeviceType(dt))
+	criteria, err := createCriteriaListFromDeviceType(dt)
+	if err != nil {
+		return err
+	}
+	return this.addDeviceTypeCriteria(ctx, criteria)
 }
 
-func createCriteriaListFromDeviceType(dt models.DeviceType) (result []model.DeviceTypeCriteria) {
+// createCriteriaListFromDeviceType creates the criteria of the device-type and of its indexed id-modifier variants (ref idmodifier.IndexedModifier)
+func createCriteriaListFromDeviceType(dt models.DeviceType) (result []model.DeviceTypeCriteria, err error) {
 	for _, s := range dt.Services {
 		result = append(result, createCriteriaFromService(dt.Id, dt.Id, dt.DeviceClassId, s)...)
 	}
-	servicesByServiceGroup := map[string][]models.Service{}
-	unassignedServices := []models.Service{}
-	for _, s := range dt.Services {
-		if s.ServiceGroupKey == "" {
-			unassignedServices = append(unassignedServices, s)
-		} else {
-			servicesByServiceGroup[s.ServiceGroupKey] = append(servicesByServiceGroup[s.ServiceGroupKey], s)
-		}
+	variants, err := idmodifier.IndexedVariants(dt)
+	if err != nil {
+		return result, err
 	}
-	for key, sg := range servicesByServiceGroup {
-		modifiedId := dt.Id + idmodifier.Seperator + idmodifier.EncodeModifierParameter(map[string][]string{"service_group_selection": {key}})
-		services := append(sg, unassignedServices...)
-		for _, s := range services {
-			result = append(result, createCriteriaFromService(dt.Id, modifiedId, dt.DeviceClassId, s)...)
+	for _, variant := range variants {
+		for _, s := range variant.Services {
+			result = append(result, createCriteriaFromService(dt.Id, variant.Id, variant.DeviceClassId, s)...)
 		}
 	}
-	return result
+	return result, nil
 }
 
 func createCriteriaFromService(pureDeviceTypeId string, deviceTypeId string, deviceClassId string, service models.Service) (result []model.DeviceTypeCriteria) {
@@ -215,8 +213,8 @@ func (this *Mongo) GetDeviceTypeCriteriaByAspectIds(ctx context.Context, ids []s
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -239,8 +237,8 @@ func (this *Mongo) GetDeviceTypeCriteriaByFunctionIds(ctx context.Context, ids [
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -263,8 +261,8 @@ func (this *Mongo) GetDeviceTypeCriteriaByDeviceClassIds(ctx context.Context, id
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -287,8 +285,8 @@ func (this *Mongo) GetDeviceTypeCriteriaByCharacteristicIds(ctx context.Context,
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -340,8 +338,8 @@ func (this *Mongo) GetDeviceTypeCriteriaForDeviceTypeIdsAndFilterCriteria(ctx co
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -365,8 +363,30 @@ func (this *Mongo) GetConfigurableCandidates(ctx context.Context, serviceId stri
 	if err != nil {
 		return result, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		dtCriteria := model.DeviceTypeCriteria{}
+		err = cursor.Decode(&dtCriteria)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, dtCriteria)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (this *Mongo) GetDeviceTypeCriteriaWithFunction(ctx context.Context) (result []model.DeviceTypeCriteria, err error) {
+	filter := bson.M{
+		DeviceTypeCriteriaBson.FunctionId: bson.M{"$exists": true, "$ne": ""},
+		deviceTypeCriteriaIsIdModifiedKey: bson.M{"$ne": true},
+	}
+	cursor, err := this.deviceTypeCriteriaCollection().Find(ctx, filter)
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		dtCriteria := model.DeviceTypeCriteria{}
 		err = cursor.Decode(&dtCriteria)
 		if err != nil {
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var DeviceTypeTemplateBson = getBsonFieldObject[model.DeviceTypeTemplate]()
+var DeviceTypeExtensionBson = getBsonFieldObject[model.DeviceTypeExtension]()
+
+func init() {
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.deviceTypeTemplateCollection()
+		err := db.ensureIndex(collection, "devicetypetemplateidindex", DeviceTypeTemplateBson.Id, true, true)
+		if err != nil {
+			return err
+		}
+		err = db.ensureIndex(collection, "devicetypetemplatenameindex", DeviceTypeTemplateBson.Name, true, false)
+		if err != nil {
+			return err
+		}
+		collection = db.deviceTypeExtensionCollection()
+		err = db.ensureIndex(collection, "devicetypeextensiondevicetypeindex", DeviceTypeExtensionBson.DeviceTypeId, true, true)
+		if err != nil {
+			return err
+		}
+		return db.ensureIndex(collection, "devicetypeextensiontemplateindex", DeviceTypeExtensionBson.TemplateId, true, false)
+	})
+}
+
+func (this *Mongo) deviceTypeTemplateCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoDeviceTypeTemplateCollection)
+}
+
+func (this *Mongo) deviceTypeExtensionCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoDeviceTypeExtensionCollection)
+}
+
+func (this *Mongo) GetDeviceTypeTemplate(ctx context.Context, id string) (template model.DeviceTypeTemplate, exists bool, err error) {
+	result := this.deviceTypeTemplateCollection().FindOne(ctx, bson.M{DeviceTypeTemplateBson.Id: id})
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return template, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = result.Decode(&template)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return template, false, nil
+	}
+	return template, true, err
+}
+
+func (this *Mongo) ListDeviceTypeTemplates(ctx context.Context, listOptions model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error) {
+	opt := options.Find()
+	if listOptions.Limit > 0 {
+		opt.SetLimit(listOptions.Limit)
+	}
+	if listOptions.Offset > 0 {
+		opt.SetSkip(listOptions.Offset)
+	}
+	opt.SetSort(bson.D{{DeviceTypeTemplateBson.Name, 1}, {DeviceTypeTemplateBson.Id, 1}})
+
+	filter := bson.M{}
+	search := strings.TrimSpace(listOptions.Search)
+	if search != "" {
+		filter[DeviceTypeTemplateBson.Name] = bson.M{"$regex": regexp.QuoteMeta(search), "$options": "i"}
+	}
+	cursor, err := this.deviceTypeTemplateCollection().Find(ctx, filter, opt)
+	if err != nil {
+		return nil, 0, err
+	}
+	result = []model.DeviceTypeTemplate{}
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = this.deviceTypeTemplateCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+func (this *Mongo) SetDeviceTypeTemplate(ctx context.Context, template model.DeviceTypeTemplate) error {
+	_, err := this.deviceTypeTemplateCollection().ReplaceOne(ctx, bson.M{DeviceTypeTemplateBson.Id: template.Id}, template, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) RemoveDeviceTypeTemplate(ctx context.Context, id string) error {
+	_, err := this.deviceTypeTemplateCollection().DeleteOne(ctx, bson.M{DeviceTypeTemplateBson.Id: id})
+	return err
+}
+
+func (this *Mongo) GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (extension model.DeviceTypeExtension, exists bool, err error) {
+	result := this.deviceTypeExtensionCollection().FindOne(ctx, bson.M{DeviceTypeExtensionBson.DeviceTypeId: deviceTypeId})
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return extension, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = result.Decode(&extension)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return extension, false, nil
+	}
+	return extension, true, err
+}
+
+func (this *Mongo) ListDeviceTypeExtensions(ctx context.Context, templateId string) (result []model.DeviceTypeExtension, err error) {
+	cursor, err := this.deviceTypeExtensionCollection().Find(ctx, bson.M{DeviceTypeExtensionBson.TemplateId: templateId}, options.Find().SetSort(bson.D{{DeviceTypeExtensionBson.DeviceTypeId, 1}}))
+	if err != nil {
+		return nil, err
+	}
+	result = []model.DeviceTypeExtension{}
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func (this *Mongo) SetDeviceTypeExtension(ctx context.Context, extension model.DeviceTypeExtension) error {
+	_, err := this.deviceTypeExtensionCollection().ReplaceOne(ctx, bson.M{DeviceTypeExtensionBson.DeviceTypeId: extension.DeviceTypeId}, extension, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) RemoveDeviceTypeExtension(ctx context.Context, deviceTypeId string) error {
+	_, err := this.deviceTypeExtensionCollection().DeleteOne(ctx, bson.M{DeviceTypeExtensionBson.DeviceTypeId: deviceTypeId})
+	return err
+}
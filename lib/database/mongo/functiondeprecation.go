@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var FunctionDeprecationBson = getBsonFieldObject[model.FunctionDeprecation]()
+
+func init() {
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.functionDeprecationCollection()
+		err := db.ensureIndex(collection, "functiondeprecationfunctionindex", FunctionDeprecationBson.FunctionId, true, true)
+		if err != nil {
+			return err
+		}
+		return db.ensureIndex(collection, "functiondeprecationreplacementindex", FunctionDeprecationBson.ReplacementFunctionId, true, false)
+	})
+}
+
+func (this *Mongo) functionDeprecationCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoFunctionDeprecationCollection)
+}
+
+func (this *Mongo) GetFunctionDeprecation(ctx context.Context, functionId string) (deprecation model.FunctionDeprecation, exists bool, err error) {
+	result := this.functionDeprecationCollection().FindOne(ctx, bson.M{FunctionDeprecationBson.FunctionId: functionId})
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return deprecation, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = result.Decode(&deprecation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return deprecation, false, nil
+	}
+	return deprecation, true, err
+}
+
+func (this *Mongo) ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error) {
+	cursor, err := this.functionDeprecationCollection().Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{FunctionDeprecationBson.FunctionId, 1}}))
+	if err != nil {
+		return nil, err
+	}
+	result = []model.FunctionDeprecation{}
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func (this *Mongo) SetFunctionDeprecation(ctx context.Context, deprecation model.FunctionDeprecation) error {
+	_, err := this.functionDeprecationCollection().ReplaceOne(ctx, bson.M{FunctionDeprecationBson.FunctionId: deprecation.FunctionId}, deprecation, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) RemoveFunctionDeprecation(ctx context.Context, functionId string) error {
+	_, err := this.functionDeprecationCollection().DeleteOne(ctx, bson.M{FunctionDeprecationBson.FunctionId: functionId})
+	return err
+}
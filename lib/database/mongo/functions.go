@@ -233,8 +233,8 @@ func (this *Mongo) ListAllFunctionsByType(ctx context.Context, rdfType string) (
 		return nil, err
 	}
 	result = []models.Function{}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		function := models.Function{}
 		err = cursor.Decode(&function)
 		if err != nil {
@@ -279,9 +279,9 @@ func (this *Mongo) ListAllMeasuringFunctionsByAspect(ctx context.Context, aspect
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.Function{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		function := models.Function{}
 		err = cursor.Decode(&function)
 		if err != nil {
@@ -305,9 +305,9 @@ func (this *Mongo) ListAllFunctionsByDeviceClass(ctx context.Context, class stri
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.Function{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		function := models.Function{}
 		err = cursor.Decode(&function)
 		if err != nil {
@@ -332,9 +332,9 @@ func (this *Mongo) ListAllControllingFunctionsByDeviceClass(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 	result = []models.Function{}
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		function := models.Function{}
 		err = cursor.Decode(&function)
 		if err != nil {
@@ -41,6 +41,14 @@ func init() {
 		if err != nil {
 			return err
 		}
+		err = db.ensureIndex(collection, "locationdeviceidindex", LocationBson.DeviceIds[0], true, false)
+		if err != nil {
+			return err
+		}
+		err = db.ensureIndex(collection, "locationdevicegroupidindex", LocationBson.DeviceGroupIds[0], true, false)
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 }
@@ -196,6 +204,12 @@ func (this *Mongo) ListLocations(ctx context.Context, listOptions model.Location
 	if listOptions.Ids != nil {
 		filter[LocationBson.Id] = bson.M{"$in": listOptions.Ids}
 	}
+	if listOptions.DeviceIds != nil || listOptions.DeviceGroupIds != nil {
+		filter["$and"] = []interface{}{bson.M{"$or": []interface{}{
+			bson.M{LocationBson.DeviceIds[0]: bson.M{"$in": append([]string{}, listOptions.DeviceIds...)}},
+			bson.M{LocationBson.DeviceGroupIds[0]: bson.M{"$in": append([]string{}, listOptions.DeviceGroupIds...)}},
+		}}}
+	}
 	search := strings.TrimSpace(listOptions.Search)
 	if search != "" {
 		escapedSearch := regexp.QuoteMeta(search)
@@ -24,12 +24,12 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 )
 
-func (this *Mongo) RunStartupMigrations(helper GeneratedDeviceGroupMigrationMethods) error {
+func (this *Mongo) RunStartupMigrations(ctx context.Context, helper GeneratedDeviceGroupMigrationMethods) error {
 	if !this.config.RunStartupMigrations {
 		this.config.GetLogger().Info("skip startup migration because config.RunStartupMigrations=false")
 		return nil
 	}
-	err := this.runDeviceGroupMigration(helper)
+	err := this.runDeviceGroupMigration(ctx, helper)
 	if err != nil {
 		return err
 	}
@@ -38,17 +38,17 @@ func (this *Mongo) RunStartupMigrations(helper GeneratedDeviceGroupMigrationMeth
 
 type GeneratedDeviceGroupMigrationMethods interface {
 	DeviceIdToGeneratedDeviceGroupId(deviceId string) string
-	EnsureGeneratedDeviceGroup(old models.Device, device models.Device) (err error)
+	EnsureGeneratedDeviceGroup(ctx context.Context, old models.Device, device models.Device) (err error)
 }
 
-func (this *Mongo) runDeviceGroupMigration(helper GeneratedDeviceGroupMigrationMethods) error {
+func (this *Mongo) runDeviceGroupMigration(ctx context.Context, helper GeneratedDeviceGroupMigrationMethods) error {
 	this.config.GetLogger().Info("start runDeviceGroupMigration()")
-	cursor, err := this.deviceCollection().Find(context.Background(), bson.M{})
+	cursor, err := this.deviceCollection().Find(ctx, bson.M{})
 	if err != nil {
 		return err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		if cursor.Err() != nil {
 			debug.PrintStack()
 			return cursor.Err()
@@ -60,14 +60,14 @@ func (this *Mongo) runDeviceGroupMigration(helper GeneratedDeviceGroupMigrationM
 			return err
 		}
 		id := helper.DeviceIdToGeneratedDeviceGroupId(device.Id)
-		_, exists, err := this.GetDeviceGroup(context.Background(), id)
+		_, exists, err := this.GetDeviceGroup(ctx, id)
 		if err != nil {
 			debug.PrintStack()
 			return err
 		}
 		if !exists {
 			this.config.GetLogger().Debug("generate device-group", "deviceId", device.Id, "deviceName", device.Name)
-			err = helper.EnsureGeneratedDeviceGroup(device, device)
+			err = helper.EnsureGeneratedDeviceGroup(ctx, device, device)
 			if err != nil {
 				debug.PrintStack()
 				return err
@@ -26,6 +26,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"log"
 	"net/http"
 	"reflect"
@@ -122,6 +123,10 @@ func (this *Mongo) Disconnect() {
 	log.Println(this.client.Disconnect(timeout))
 }
 
+func (this *Mongo) Ping(ctx context.Context) error {
+	return this.client.Ping(ctx, readpref.Primary())
+}
+
 func getBsonFieldName(obj interface{}, fieldName string) (bsonName string, err error) {
 	field, found := reflect.TypeOf(obj).FieldByName(fieldName)
 	if !found {
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+)
+
+// getProjection translates json field paths (see model.ParseFields) of T into a mongo projection
+// unknown paths are ignored; the bson paths in required are always included
+// returns nil (no projection) if fields is nil
+func getProjection[T any](fields []string, required ...string) bson.D {
+	if fields == nil {
+		return nil
+	}
+	paths := slices.Clone(required)
+	t := reflect.TypeFor[T]()
+	for _, field := range fields {
+		if path, ok := jsonPathToBsonPath(t, strings.Split(field, ".")); ok {
+			paths = append(paths, path)
+		}
+	}
+	//mongo rejects projections that contain a path and one of its sub paths
+	slices.SortStableFunc(paths, func(a, b string) int {
+		return len(a) - len(b)
+	})
+	kept := []string{}
+	for _, path := range paths {
+		if !slices.ContainsFunc(kept, func(parent string) bool {
+			return path == parent || strings.HasPrefix(path, parent+".")
+		}) {
+			kept = append(kept, path)
+		}
+	}
+	result := bson.D{}
+	for _, path := range kept {
+		result = append(result, bson.E{Key: path, Value: 1})
+	}
+	return result
+}
+
+func jsonPathToBsonPath(t reflect.Type, segments []string) (string, bool) {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if len(segments) == 0 {
+		return "", true
+	}
+	if t.Kind() == reflect.Map {
+		return strings.Join(segments, "."), true
+	}
+	if t.Kind() != reflect.Struct {
+		return "", false
+	}
+	name, fieldType, ok := findBsonFieldByJsonName(t, segments[0])
+	if !ok {
+		return "", false
+	}
+	rest, ok := jsonPathToBsonPath(fieldType, segments[1:])
+	if !ok {
+		return "", false
+	}
+	return joinBsonPath(name, rest), true
+}
+
+// findBsonFieldByJsonName searches the struct field that is encoded as jsonName, including fields of embedded structs
+func findBsonFieldByJsonName(t reflect.Type, jsonName string) (bsonName string, fieldType reflect.Type, found bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		jsonTag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if jsonTag == "-" {
+			continue
+		}
+		tags, err := bsoncodec.DefaultStructTagParser.ParseStructTags(field)
+		if err != nil || tags.Skip {
+			continue
+		}
+		if field.Anonymous && jsonTag == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() != reflect.Struct {
+				continue
+			}
+			name, fieldType, ok := findBsonFieldByJsonName(embedded, jsonName)
+			if !ok {
+				continue
+			}
+			if tags.Inline {
+				return name, fieldType, true
+			}
+			return joinBsonPath(tags.Name, name), fieldType, true
+		}
+		if jsonTag == "" {
+			jsonTag = field.Name
+		}
+		if jsonTag == jsonName {
+			return tags.Name, field.Type, true
+		}
+	}
+	return "", nil, false
+}
+
+func joinBsonPath(prefix string, path string) string {
+	if prefix == "" {
+		return path
+	}
+	if path == "" {
+		return prefix
+	}
+	return prefix + "." + path
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type projectionTestEmbedded struct {
+	OwnerId string `json:"owner_id" bson:"owner_id"`
+}
+
+type projectionTestService struct {
+	Id        string `json:"id" bson:"id"`
+	LocalName string `json:"local_name" bson:"localname"`
+}
+
+type projectionTestElement struct {
+	projectionTestEmbedded `bson:",inline"`
+	Id                     string                  `json:"id" bson:"id"`
+	Name                   string                  `json:"name" bson:"name"`
+	Services               []projectionTestService `json:"services" bson:"services"`
+	Attributes             map[string]string       `json:"attributes" bson:"attributes"`
+	Secret                 string                  `json:"-" bson:"secret"`
+}
+
+func TestGetProjection(t *testing.T) {
+	if result := getProjection[projectionTestElement](nil, "id"); result != nil {
+		t.Error(result)
+	}
+	result := getProjection[projectionTestElement]([]string{"services.local_name", "services", "owner_id", "attributes.foo", "secret", "unknown", "name.foo"}, "id")
+	expected := bson.D{{Key: "id", Value: 1}, {Key: "services", Value: 1}, {Key: "owner_id", Value: 1}, {Key: "attributes.foo", Value: 1}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("\n%#v\n%#v", result, expected)
+	}
+}
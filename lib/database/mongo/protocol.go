@@ -92,8 +92,8 @@ func (this *Mongo) ListProtocols(ctx context.Context, limit int64, offset int64,
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	for cursor.Next(context.Background()) {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
 		protocol := models.Protocol{}
 		err = cursor.Decode(&protocol)
 		if err != nil {
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var WebhookBson = getBsonFieldObject[model.Webhook]()
+var WebhookDeliveryBson = getBsonFieldObject[model.WebhookDelivery]()
+
+// bson names of fields that are not filled by getBsonFieldObject (no string fields)
+const webhookDisabledField = "disabled"
+const webhookConsecutiveFailuresField = "consecutive_failures"
+const webhookDeliveryTimeField = "time"
+
+func init() {
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.webhookCollection()
+		err := db.ensureIndex(collection, "webhookidindex", WebhookBson.Id, true, true)
+		if err != nil {
+			return err
+		}
+		err = db.ensureIndex(collection, "webhookownerindex", WebhookBson.OwnerId, true, false)
+		if err != nil {
+			return err
+		}
+		err = db.ensureIndex(collection, "webhookresourcetypeindex", WebhookBson.ResourceTypes[0], true, false)
+		if err != nil {
+			return err
+		}
+		collection = db.webhookDeliveryCollection()
+		err = db.ensureIndex(collection, "webhookdeliveryidindex", WebhookDeliveryBson.Id, true, true)
+		if err != nil {
+			return err
+		}
+		err = db.ensureCompoundIndex(collection, "webhookdeliverywebhooktimeindex", true, false, WebhookDeliveryBson.WebhookId, webhookDeliveryTimeField)
+		if err != nil {
+			return err
+		}
+		return db.ensureWebhookDeliveryRetentionIndex(collection)
+	})
+}
+
+func (this *Mongo) webhookCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoWebhookCollection)
+}
+
+func (this *Mongo) webhookDeliveryCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoWebhookDeliveryCollection)
+}
+
+func (this *Mongo) ensureWebhookDeliveryRetentionIndex(collection *mongo.Collection) error {
+	indexname := "webhookdeliveryretentionindex"
+	if this.config.WebhookDeliveryLogRetention == "-" {
+		return this.removeIndex(collection, indexname)
+	}
+	retention := 7 * 24 * time.Hour
+	if this.config.WebhookDeliveryLogRetention != "" {
+		var err error
+		retention, err = time.ParseDuration(this.config.WebhookDeliveryLogRetention)
+		if err != nil {
+			return err
+		}
+	}
+	//a changed retention needs a new index definition
+	err := this.removeIndex(collection, indexname)
+	if err != nil {
+		return err
+	}
+	ctx, _ := getTimeoutContext()
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{webhookDeliveryTimeField, 1}},
+		Options: options.Index().SetName(indexname).SetExpireAfterSeconds(int32(retention.Seconds())),
+	})
+	return err
+}
+
+func (this *Mongo) GetWebhook(ctx context.Context, id string) (webhook model.Webhook, exists bool, err error) {
+	result := this.webhookCollection().FindOne(ctx, bson.M{WebhookBson.Id: id})
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return webhook, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = result.Decode(&webhook)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return webhook, false, nil
+	}
+	return webhook, true, err
+}
+
+func (this *Mongo) ListWebhooks(ctx context.Context, listOptions model.WebhookListOptions) (result []model.Webhook, total int64, err error) {
+	opt := options.Find()
+	if listOptions.Limit > 0 {
+		opt.SetLimit(listOptions.Limit)
+	}
+	if listOptions.Offset > 0 {
+		opt.SetSkip(listOptions.Offset)
+	}
+	opt.SetSort(bson.D{{WebhookBson.Id, 1}})
+
+	filter := bson.M{}
+	if listOptions.OwnerId != "" {
+		filter[WebhookBson.OwnerId] = listOptions.OwnerId
+	}
+	if listOptions.ResourceType != "" {
+		filter[WebhookBson.ResourceTypes[0]] = listOptions.ResourceType
+	}
+	if listOptions.ExcludeDisabled {
+		filter[webhookDisabledField] = bson.M{"$ne": true}
+	}
+	cursor, err := this.webhookCollection().Find(ctx, filter, opt)
+	if err != nil {
+		return nil, 0, err
+	}
+	result = []model.Webhook{}
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = this.webhookCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+func (this *Mongo) SetWebhook(ctx context.Context, webhook model.Webhook) error {
+	_, err := this.webhookCollection().ReplaceOne(ctx, bson.M{WebhookBson.Id: webhook.Id}, webhook, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) RemoveWebhook(ctx context.Context, id string) error {
+	_, err := this.webhookCollection().DeleteOne(ctx, bson.M{WebhookBson.Id: id})
+	if err != nil {
+		return err
+	}
+	_, err = this.webhookDeliveryCollection().DeleteMany(ctx, bson.M{WebhookDeliveryBson.WebhookId: id})
+	return err
+}
+
+func (this *Mongo) RecordWebhookDeliveryResult(ctx context.Context, id string, success bool) (consecutiveFailures int64, err error) {
+	update := bson.M{"$inc": bson.M{webhookConsecutiveFailuresField: 1}}
+	if success {
+		update = bson.M{"$set": bson.M{webhookConsecutiveFailuresField: 0}}
+	}
+	result := this.webhookCollection().FindOneAndUpdate(ctx, bson.M{WebhookBson.Id: id}, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	err = result.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil //webhook has been removed during delivery
+	}
+	if err != nil {
+		return 0, err
+	}
+	webhook := model.Webhook{}
+	err = result.Decode(&webhook)
+	return webhook.ConsecutiveFailures, err
+}
+
+func (this *Mongo) DisableWebhook(ctx context.Context, id string, reason string) error {
+	_, err := this.webhookCollection().UpdateOne(ctx, bson.M{WebhookBson.Id: id}, bson.M{"$set": bson.M{
+		webhookDisabledField:       true,
+		WebhookBson.DisabledReason: reason,
+	}})
+	return err
+}
+
+func (this *Mongo) AddWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	_, err := this.webhookDeliveryCollection().InsertOne(ctx, delivery)
+	return err
+}
+
+func (this *Mongo) ListWebhookDeliveries(ctx context.Context, webhookId string, listOptions model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error) {
+	opt := options.Find()
+	if listOptions.Limit > 0 {
+		opt.SetLimit(listOptions.Limit)
+	}
+	if listOptions.Offset > 0 {
+		opt.SetSkip(listOptions.Offset)
+	}
+	opt.SetSort(bson.D{{webhookDeliveryTimeField, -1}})
+	filter := bson.M{WebhookDeliveryBson.WebhookId: webhookId}
+	cursor, err := this.webhookDeliveryCollection().Find(ctx, filter, opt)
+	if err != nil {
+		return nil, 0, err
+	}
+	result = []model.WebhookDelivery{}
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = this.webhookDeliveryCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
@@ -45,7 +45,10 @@ func (db *DB) AddAspectNode(_ context.Context, node models.AspectNode) error {
 	return set(node.Id, db.aspectNodes, node, nil)
 }
 func (db *DB) RemoveAspectNodesByRootId(_ context.Context, id string) error {
-	panic("not implemented")
+	maps.DeleteFunc(db.aspectNodes, func(_ string, node models.AspectNode) bool {
+		return node.RootId == id
+	})
+	return nil
 }
 
 func (db *DB) GetAspectNode(_ context.Context, id string) (result models.AspectNode, exists bool, err error) {
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testdb
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func (db *DB) GetCharacteristicPattern(ctx context.Context, characteristicId string) (pattern model.CharacteristicPattern, exists bool, err error) {
+	return get(characteristicId, db.characteristicPatterns)
+}
+
+func (db *DB) ListCharacteristicPatterns(ctx context.Context) (result []model.CharacteristicPattern, err error) {
+	result = iterToSlice(maps.Values(db.characteristicPatterns))
+	slices.SortFunc(result, func(a, b model.CharacteristicPattern) int {
+		return strings.Compare(a.CharacteristicId, b.CharacteristicId)
+	})
+	return result, nil
+}
+
+func (db *DB) SetCharacteristicPattern(ctx context.Context, pattern model.CharacteristicPattern) error {
+	return set(pattern.CharacteristicId, db.characteristicPatterns, pattern, nil)
+}
+
+func (db *DB) RemoveCharacteristicPattern(ctx context.Context, characteristicId string) error {
+	return del(characteristicId, db.characteristicPatterns, nil)
+}
@@ -47,6 +47,9 @@ func (db *DB) ListCharacteristics(ctx context.Context, options model.Characteris
 		}
 	}
 	limit := options.Limit
+	if limit == 0 {
+		limit = int64(len(result))
+	}
 	offset := options.Offset
 	if offset >= int64(len(result)) {
 		return []models.Characteristic{}, int64(len(result)), nil
@@ -80,6 +80,9 @@ func (db *DB) ListDevices(ctx context.Context, options model.DeviceListOptions,
 		if options.Ids != nil && !slices.Contains(options.Ids, device.Id) {
 			continue
 		}
+		if options.DeviceTypeIds != nil && !slices.Contains(options.DeviceTypeIds, device.DeviceTypeId) {
+			continue
+		}
 		if options.ConnectionState != nil && *options.ConnectionState != device.ConnectionState {
 			continue
 		}
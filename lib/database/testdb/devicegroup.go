@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"maps"
+	"slices"
 
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -49,7 +50,13 @@ func (db *DB) GetDeviceGroup(_ context.Context, id string) (deviceGroup models.D
 }
 
 func (db *DB) ListDeviceGroups(_ context.Context, options model.DeviceGroupListOptions) (result []models.DeviceGroup, total int64, err error) {
-	return iterToSlice(maps.Values(db.deviceGroups)), int64(len(db.deviceGroups)), nil
+	result = iterToSlice(maps.Values(db.deviceGroups))
+	if options.DeviceIds != nil {
+		result = slices.DeleteFunc(result, func(dg models.DeviceGroup) bool {
+			return !slices.ContainsFunc(dg.DeviceIds, func(id string) bool { return slices.Contains(options.DeviceIds, id) })
+		})
+	}
+	return result, int64(len(result)), nil
 }
 
 func (db *DB) DesyncUnknownDeviceGroups(ctx context.Context, knownDeviceGroups []string) (err error) {
@@ -18,15 +18,78 @@ package testdb
 
 import (
 	"context"
+	"slices"
+	"strings"
+
 	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
 )
 
+// GetDeviceTypeCriteriaByAspectIds is a reduced implementation (ref listReducedCriteria)
 func (db *DB) GetDeviceTypeCriteriaByAspectIds(ctx context.Context, ids []string, includeModified bool) (result []model.DeviceTypeCriteria, err error) {
-	panic("implement me")
+	return db.listReducedCriteria(func(variable models.ContentVariable) bool {
+		return slices.Contains(ids, variable.AspectId)
+	}), nil
 }
 
+// GetDeviceTypeCriteriaByFunctionIds is a reduced implementation (ref listReducedCriteria)
 func (db *DB) GetDeviceTypeCriteriaByFunctionIds(ctx context.Context, ids []string, includeModified bool) (result []model.DeviceTypeCriteria, err error) {
-	panic("implement me")
+	return db.listReducedCriteria(func(variable models.ContentVariable) bool {
+		return slices.Contains(ids, variable.FunctionId)
+	}), nil
+}
+
+// listReducedCriteria creates criteria for all content-variables matching match; modified device-types are ignored
+func (db *DB) listReducedCriteria(match func(variable models.ContentVariable) bool) (result []model.DeviceTypeCriteria) {
+	result = []model.DeviceTypeCriteria{}
+	for _, dt := range db.deviceTypes {
+		for _, service := range dt.Services {
+			for _, content := range slices.Concat(service.Inputs, service.Outputs) {
+				isInput := slices.ContainsFunc(service.Inputs, func(input models.Content) bool { return input.Id == content.Id })
+				walkVariables(content.ContentVariable, "", func(variable models.ContentVariable, path string) {
+					if match(variable) {
+						result = append(result, model.DeviceTypeCriteria{
+							PureDeviceTypeId:      dt.Id,
+							DeviceTypeId:          dt.Id,
+							ServiceId:             service.Id,
+							ContentVariableId:     variable.Id,
+							ContentVariablePath:   path,
+							FunctionId:            variable.FunctionId,
+							Interaction:           string(service.Interaction),
+							IsControllingFunction: strings.HasPrefix(variable.FunctionId, model.CONTROLLING_FUNCTION_PREFIX),
+							DeviceClassId:         dt.DeviceClassId,
+							AspectId:              variable.AspectId,
+							CharacteristicId:      variable.CharacteristicId,
+							IsVoid:                variable.IsVoid,
+							Value:                 variable.Value,
+							Type:                  variable.Type,
+							IsLeaf:                len(variable.SubContentVariables) == 0,
+							IsInput:               isInput,
+						})
+					}
+				})
+			}
+		}
+	}
+	slices.SortFunc(result, func(a, b model.DeviceTypeCriteria) int {
+		return strings.Compare(a.DeviceTypeId+a.ServiceId+a.ContentVariablePath, b.DeviceTypeId+b.ServiceId+b.ContentVariablePath)
+	})
+	return result
+}
+
+func hasFunction(variable models.ContentVariable) bool {
+	return variable.FunctionId != ""
+}
+
+func walkVariables(variable models.ContentVariable, parentPath string, f func(variable models.ContentVariable, path string)) {
+	path := variable.Name
+	if parentPath != "" {
+		path = parentPath + "." + variable.Name
+	}
+	f(variable, path)
+	for _, sub := range variable.SubContentVariables {
+		walkVariables(sub, path, f)
+	}
 }
 
 func (db *DB) GetDeviceTypeCriteriaByDeviceClassIds(ctx context.Context, ids []string, includeModified bool) (result []model.DeviceTypeCriteria, err error) {
@@ -37,17 +100,78 @@ func (db *DB) GetDeviceTypeCriteriaByCharacteristicIds(ctx context.Context, ids
 	panic("implement me")
 }
 
+// GetDeviceTypeCriteriaForDeviceTypeIdsAndFilterCriteria is a reduced implementation (ref listReducedCriteria)
 func (db *DB) GetDeviceTypeCriteriaForDeviceTypeIdsAndFilterCriteria(ctx context.Context, deviceTypeIds []interface{}, criteria model.FilterCriteria, includeModified bool) (result []model.DeviceTypeCriteria, err error) {
-	panic("not implemented")
+	match, err := db.filterCriteriaMatcher(ctx, criteria)
+	if err != nil {
+		return result, err
+	}
+	result = []model.DeviceTypeCriteria{}
+	for _, c := range db.listReducedCriteria(hasFunction) {
+		if slices.Contains(deviceTypeIds, interface{}(c.DeviceTypeId)) && match(c) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
 }
 func (db *DB) GetDeviceTypeIdsByFilterCriteria(ctx context.Context, criteria []model.FilterCriteria, interactionsFilter []string, includeModified bool) (result []interface{}, err error) {
 	panic("not implemented")
 }
 
+// GetDeviceTypeCriteriaWithFunction is a reduced implementation (ref listReducedCriteria)
+func (db *DB) GetDeviceTypeCriteriaWithFunction(ctx context.Context) (result []model.DeviceTypeCriteria, err error) {
+	return db.listReducedCriteria(hasFunction), nil
+}
+
+// GetConfigurableCandidates is a reduced implementation (ref listReducedCriteria)
 func (db *DB) GetConfigurableCandidates(_ context.Context, serviceId string) (result []model.DeviceTypeCriteria, err error) {
-	panic("not implemented")
+	result = []model.DeviceTypeCriteria{}
+	for _, c := range db.listReducedCriteria(func(variable models.ContentVariable) bool { return true }) {
+		if c.ServiceId == serviceId && c.IsLeaf && c.IsInput && !c.IsVoid {
+			result = append(result, c)
+		}
+	}
+	return result, nil
 }
 
+// GetDeviceTypeIdsByFilterCriteriaV2 is a reduced implementation (ref listReducedCriteria)
 func (db *DB) GetDeviceTypeIdsByFilterCriteriaV2(ctx context.Context, criteria []model.FilterCriteria, includeModified bool) (result []interface{}, err error) {
-	panic("implement me")
+	all := db.listReducedCriteria(hasFunction)
+	for i, filter := range criteria {
+		match, err := db.filterCriteriaMatcher(ctx, filter)
+		if err != nil {
+			return result, err
+		}
+		ids := []interface{}{}
+		for _, c := range all {
+			if match(c) && !slices.Contains(ids, interface{}(c.DeviceTypeId)) && (i == 0 || slices.Contains(result, interface{}(c.DeviceTypeId))) {
+				ids = append(ids, c.DeviceTypeId)
+			}
+		}
+		result = ids
+	}
+	return result, nil
+}
+
+func (db *DB) filterCriteriaMatcher(ctx context.Context, criteria model.FilterCriteria) (func(c model.DeviceTypeCriteria) bool, error) {
+	aspectIds := []string{criteria.AspectId}
+	if criteria.AspectId != "" {
+		node, exists, err := db.GetAspectNode(ctx, criteria.AspectId)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			aspectIds = append(aspectIds, node.DescendentIds...)
+		}
+	}
+	interactions := []string{string(criteria.Interaction)}
+	if criteria.Interaction == models.REQUEST || criteria.Interaction == models.EVENT {
+		interactions = append(interactions, string(models.EVENT_AND_REQUEST))
+	}
+	return func(c model.DeviceTypeCriteria) bool {
+		return (criteria.FunctionId == "" || c.FunctionId == criteria.FunctionId) &&
+			(criteria.DeviceClassId == "" || c.DeviceClassId == criteria.DeviceClassId) &&
+			(criteria.AspectId == "" || slices.Contains(aspectIds, c.AspectId)) &&
+			(criteria.Interaction == "" || slices.Contains(interactions, c.Interaction))
+	}, nil
 }
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func (db *DB) GetDeviceTypeTemplate(ctx context.Context, id string) (template model.DeviceTypeTemplate, exists bool, err error) {
+	return get(id, db.deviceTypeTemplates)
+}
+
+func (db *DB) ListDeviceTypeTemplates(ctx context.Context, options model.DeviceTypeTemplateListOptions) (result []model.DeviceTypeTemplate, total int64, err error) {
+	result = []model.DeviceTypeTemplate{}
+	for _, template := range db.deviceTypeTemplates {
+		if options.Search == "" || strings.Contains(strings.ToLower(template.Name), strings.ToLower(options.Search)) {
+			result = append(result, template)
+		}
+	}
+	slices.SortFunc(result, func(a, b model.DeviceTypeTemplate) int {
+		return strings.Compare(a.Name+a.Id, b.Name+b.Id)
+	})
+	return page(result, options.Limit, options.Offset), int64(len(result)), nil
+}
+
+func (db *DB) SetDeviceTypeTemplate(ctx context.Context, template model.DeviceTypeTemplate) error {
+	return set(template.Id, db.deviceTypeTemplates, template, nil)
+}
+
+func (db *DB) RemoveDeviceTypeTemplate(ctx context.Context, id string) error {
+	return del(id, db.deviceTypeTemplates, nil)
+}
+
+func (db *DB) GetDeviceTypeExtension(ctx context.Context, deviceTypeId string) (extension model.DeviceTypeExtension, exists bool, err error) {
+	return get(deviceTypeId, db.deviceTypeExtensions)
+}
+
+func (db *DB) ListDeviceTypeExtensions(ctx context.Context, templateId string) (result []model.DeviceTypeExtension, err error) {
+	result = []model.DeviceTypeExtension{}
+	for _, extension := range db.deviceTypeExtensions {
+		if extension.TemplateId == templateId {
+			result = append(result, extension)
+		}
+	}
+	slices.SortFunc(result, func(a, b model.DeviceTypeExtension) int {
+		return strings.Compare(a.DeviceTypeId, b.DeviceTypeId)
+	})
+	return result, nil
+}
+
+func (db *DB) SetDeviceTypeExtension(ctx context.Context, extension model.DeviceTypeExtension) error {
+	return set(extension.DeviceTypeId, db.deviceTypeExtensions, extension, nil)
+}
+
+func (db *DB) RemoveDeviceTypeExtension(ctx context.Context, deviceTypeId string) error {
+	return del(deviceTypeId, db.deviceTypeExtensions, nil)
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testdb
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+func (db *DB) GetFunctionDeprecation(ctx context.Context, functionId string) (deprecation model.FunctionDeprecation, exists bool, err error) {
+	return get(functionId, db.functionDeprecations)
+}
+
+func (db *DB) ListFunctionDeprecations(ctx context.Context) (result []model.FunctionDeprecation, err error) {
+	result = iterToSlice(maps.Values(db.functionDeprecations))
+	slices.SortFunc(result, func(a, b model.FunctionDeprecation) int {
+		return strings.Compare(a.FunctionId, b.FunctionId)
+	})
+	return result, nil
+}
+
+func (db *DB) SetFunctionDeprecation(ctx context.Context, deprecation model.FunctionDeprecation) error {
+	return set(deprecation.FunctionId, db.functionDeprecations, deprecation, nil)
+}
+
+func (db *DB) RemoveFunctionDeprecation(ctx context.Context, functionId string) error {
+	return del(functionId, db.functionDeprecations, nil)
+}
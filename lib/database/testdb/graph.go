@@ -19,6 +19,7 @@ package testdb
 import (
 	"context"
 	"maps"
+	"slices"
 	"time"
 
 	"github.com/SENERGY-Platform/device-repository/lib/model"
@@ -40,7 +41,15 @@ func (db *DB) GetGraph(ctx context.Context, id string) (graph models.Graph, exis
 }
 
 func (db *DB) ListGraphs(ctx context.Context, listOptions model.GraphListOptions) (result []models.Graph, total int64, err error) {
-	return iterToSlice(maps.Values(db.graphs)), int64(len(db.graphs)), nil
+	result = iterToSlice(maps.Values(db.graphs))
+	if listOptions.DeviceIds != nil {
+		result = slices.DeleteFunc(result, func(graph models.Graph) bool {
+			return !slices.ContainsFunc(graph.Nodes, func(node models.Node) bool {
+				return node.ResourceType == models.GraphResourceTypeDevice && slices.Contains(listOptions.DeviceIds, node.ResourceId)
+			})
+		})
+	}
+	return result, int64(len(result)), nil
 }
 
 func (db *DB) RetryGraphSync(lockduration time.Duration, syncDeleteHandler func(models.Graph) error, syncHandler func(models.Graph) error) error {
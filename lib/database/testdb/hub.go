@@ -45,7 +45,7 @@ func (db *DB) GetHub(_ context.Context, id string) (hub model.HubWithConnectionS
 func (db *DB) GetHubsByDeviceId(_ context.Context, id string) (hubs []model.HubWithConnectionState, err error) {
 	for i := range db.hubs {
 		for j := range db.hubs[i].DeviceIds {
-			if db.hubs[i].DeviceLocalIds[j] == id {
+			if db.hubs[i].DeviceIds[j] == id {
 				hubs = append(hubs, db.hubs[i])
 				break
 			}
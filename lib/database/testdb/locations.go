@@ -59,6 +59,11 @@ func (db *DB) ListLocations(ctx context.Context, options model.LocationListOptio
 		if options.Ids != nil && !slices.Contains(options.Ids, location.Id) {
 			continue
 		}
+		if (options.DeviceIds != nil || options.DeviceGroupIds != nil) &&
+			!slices.ContainsFunc(location.DeviceIds, func(id string) bool { return slices.Contains(options.DeviceIds, id) }) &&
+			!slices.ContainsFunc(location.DeviceGroupIds, func(id string) bool { return slices.Contains(options.DeviceGroupIds, id) }) {
+			continue
+		}
 		if options.Search != "" && r != nil {
 			if !r.MatchString(location.Name) {
 				continue
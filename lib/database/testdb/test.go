@@ -17,6 +17,7 @@
 package testdb
 
 import (
+	"context"
 	"iter"
 	"sync"
 
@@ -43,6 +44,12 @@ type DB struct {
 	functions               map[string]models.Function
 	locations               map[string]models.Location
 	graphs                  map[string]models.Graph
+	webhooks                map[string]model.Webhook
+	webhookDeliveries       []model.WebhookDelivery
+	deviceTypeTemplates     map[string]model.DeviceTypeTemplate
+	deviceTypeExtensions    map[string]model.DeviceTypeExtension
+	functionDeprecations    map[string]model.FunctionDeprecation
+	characteristicPatterns  map[string]model.CharacteristicPattern
 	permissions             []Resource
 	mux                     sync.Mutex
 }
@@ -70,12 +77,21 @@ func NewTestDB(config configuration.Config) database.Database {
 		functions:               make(map[string]models.Function),
 		locations:               make(map[string]models.Location),
 		graphs:                  make(map[string]models.Graph),
+		webhooks:                make(map[string]model.Webhook),
+		deviceTypeTemplates:     make(map[string]model.DeviceTypeTemplate),
+		deviceTypeExtensions:    make(map[string]model.DeviceTypeExtension),
+		functionDeprecations:    make(map[string]model.FunctionDeprecation),
+		characteristicPatterns:  make(map[string]model.CharacteristicPattern),
 	}
 }
 
 func (db *DB) Disconnect() {}
 
-func (db *DB) RunStartupMigrations(methods mongo.GeneratedDeviceGroupMigrationMethods) error {
+func (db *DB) Ping(_ context.Context) error {
+	return nil
+}
+
+func (db *DB) RunStartupMigrations(_ context.Context, methods mongo.GeneratedDeviceGroupMigrationMethods) error {
 	return nil
 }
 
@@ -124,3 +140,14 @@ func iterToSlice[T any](seq iter.Seq[T]) (result []T) {
 	}
 	return
 }
+
+func page[T any](list []T, limit int64, offset int64) []T {
+	if offset >= int64(len(list)) {
+		return []T{}
+	}
+	list = list[offset:]
+	if limit > 0 && limit < int64(len(list)) {
+		list = list[:limit]
+	}
+	return list
+}
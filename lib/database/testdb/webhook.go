@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+// webhook methods are locked, because deliveries run concurrently to api requests
+
+func (db *DB) GetWebhook(ctx context.Context, id string) (webhook model.Webhook, exists bool, err error) {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	return get(id, db.webhooks)
+}
+
+func (db *DB) ListWebhooks(ctx context.Context, options model.WebhookListOptions) (result []model.Webhook, total int64, err error) {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	result = []model.Webhook{}
+	for _, webhook := range db.webhooks {
+		if options.OwnerId != "" && webhook.OwnerId != options.OwnerId {
+			continue
+		}
+		if options.ResourceType != "" && !slices.Contains(webhook.ResourceTypes, options.ResourceType) {
+			continue
+		}
+		if options.ExcludeDisabled && webhook.Disabled {
+			continue
+		}
+		result = append(result, webhook)
+	}
+	slices.SortFunc(result, func(a, b model.Webhook) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return page(result, options.Limit, options.Offset), int64(len(result)), nil
+}
+
+func (db *DB) SetWebhook(ctx context.Context, webhook model.Webhook) error {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	return set(webhook.Id, db.webhooks, webhook, nil)
+}
+
+func (db *DB) RemoveWebhook(ctx context.Context, id string) error {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	db.webhookDeliveries = slices.DeleteFunc(db.webhookDeliveries, func(delivery model.WebhookDelivery) bool {
+		return delivery.WebhookId == id
+	})
+	return del(id, db.webhooks, nil)
+}
+
+func (db *DB) RecordWebhookDeliveryResult(ctx context.Context, id string, success bool) (consecutiveFailures int64, err error) {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	webhook, ok := db.webhooks[id]
+	if !ok {
+		return 0, nil
+	}
+	if success {
+		webhook.ConsecutiveFailures = 0
+	} else {
+		webhook.ConsecutiveFailures++
+	}
+	db.webhooks[id] = webhook
+	return webhook.ConsecutiveFailures, nil
+}
+
+func (db *DB) DisableWebhook(ctx context.Context, id string, reason string) error {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	webhook, ok := db.webhooks[id]
+	if !ok {
+		return nil
+	}
+	webhook.Disabled = true
+	webhook.DisabledReason = reason
+	db.webhooks[id] = webhook
+	return nil
+}
+
+func (db *DB) AddWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	db.webhookDeliveries = append(db.webhookDeliveries, delivery)
+	return nil
+}
+
+func (db *DB) ListWebhookDeliveries(ctx context.Context, webhookId string, options model.WebhookDeliveryListOptions) (result []model.WebhookDelivery, total int64, err error) {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	result = []model.WebhookDelivery{}
+	for _, delivery := range db.webhookDeliveries {
+		if delivery.WebhookId == webhookId {
+			result = append(result, delivery)
+		}
+	}
+	slices.Reverse(result)
+	return page(result, options.Limit, options.Offset), int64(len(result)), nil
+}
@@ -0,0 +1,321 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idmodifier
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const DisplayNameAttributeName = "shared/nickname"
+
+const ServiceGroupSelection = "service_group_selection"
+const InteractionFilter = "interaction_filter"
+const FunctionFilter = "function_filter"
+const NameOverlay = "name"
+const AttributeOverlay = "attribute"
+
+func init() {
+	Register(ServiceGroupSelectionModifier{})
+	Register(InteractionFilterModifier{})
+	Register(FunctionFilterModifier{})
+	Register(NameOverlayModifier{})
+	Register(AttributeOverlayModifier{})
+}
+
+// ServiceGroupSelectionModifier limits the services to the service-group params[0] and services without service-group
+// example: urn:infai:ses:device-type:1$service_group_selection=left
+type ServiceGroupSelectionModifier struct{}
+
+func (this ServiceGroupSelectionModifier) Key() string {
+	return ServiceGroupSelection
+}
+
+func (this ServiceGroupSelectionModifier) ModifyDeviceType(dt models.DeviceType, params []string) (result models.DeviceType, err error) {
+	if len(params) == 0 {
+		return result, fmt.Errorf("missing service-group-key: %w", ErrInvalidParameter)
+	}
+	result = dt
+	sgKey := params[0]
+	result.Services = []models.Service{}
+	for _, service := range dt.Services {
+		if service.ServiceGroupKey == sgKey || service.ServiceGroupKey == "" {
+			result.Services = append(result.Services, service)
+		}
+	}
+	for _, sg := range dt.ServiceGroups {
+		if sg.Key == sgKey {
+			result.Name = result.Name + " " + sg.Name
+			break
+		}
+	}
+	return result, nil
+}
+
+func (this ServiceGroupSelectionModifier) ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (result models.Device, err error) {
+	if len(params) == 0 {
+		return result, fmt.Errorf("missing service-group-key: %w", ErrInvalidParameter)
+	}
+	result = device
+	sgKey := params[0]
+	result.DeviceTypeId = AppendModifier(result.DeviceTypeId, ServiceGroupSelection, params)
+	serviceGroupList := dt.ServiceGroups
+	if options.AllowUnknownServiceGroup {
+		serviceGroupList = append(slices.Clone(dt.ServiceGroups), models.ServiceGroup{
+			Key:  sgKey,
+			Name: sgKey,
+		})
+	}
+	for _, sg := range serviceGroupList {
+		if sg.Key == sgKey {
+			if result.Name != "" {
+				result.Name = result.Name + " " + sg.Name
+			}
+			result.Attributes = slices.Clone(result.Attributes)
+			for i, attr := range result.Attributes {
+				if attr.Key == DisplayNameAttributeName {
+					attr.Value = attr.Value + " " + sg.Name
+				}
+				result.Attributes[i] = attr
+			}
+			return result, nil
+		}
+	}
+	return result, fmt.Errorf("no matching service-group-key found: %w", ErrInvalidParameter)
+}
+
+// Variants returns one variant per used service-group
+func (this ServiceGroupSelectionModifier) Variants(dt models.DeviceType) (result [][]string) {
+	keys := []string{}
+	for _, service := range dt.Services {
+		if service.ServiceGroupKey != "" && !slices.Contains(keys, service.ServiceGroupKey) {
+			keys = append(keys, service.ServiceGroupKey)
+		}
+	}
+	for _, key := range keys {
+		result = append(result, []string{key})
+	}
+	return result
+}
+
+// InteractionFilterModifier limits the services to the interactions in params
+// services with the interaction event+request match the event and request filter
+// example: urn:infai:ses:device-type:1$interaction_filter=event
+type InteractionFilterModifier struct{}
+
+func (this InteractionFilterModifier) Key() string {
+	return InteractionFilter
+}
+
+func (this InteractionFilterModifier) ModifyDeviceType(dt models.DeviceType, params []string) (result models.DeviceType, err error) {
+	if len(params) == 0 {
+		return result, fmt.Errorf("missing interaction: %w", ErrInvalidParameter)
+	}
+	for _, param := range params {
+		if !slices.Contains([]models.Interaction{models.EVENT, models.REQUEST, models.EVENT_AND_REQUEST}, models.Interaction(param)) {
+			return result, fmt.Errorf("unknown interaction %v: %w", param, ErrInvalidParameter)
+		}
+	}
+	result = dt
+	result.Services = []models.Service{}
+	for _, service := range dt.Services {
+		matches := slices.Contains(params, string(service.Interaction))
+		if service.Interaction == models.EVENT_AND_REQUEST {
+			matches = matches || slices.Contains(params, string(models.EVENT)) || slices.Contains(params, string(models.REQUEST))
+		}
+		if matches {
+			result.Services = append(result.Services, service)
+		}
+	}
+	return result, nil
+}
+
+func (this InteractionFilterModifier) ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (result models.Device, err error) {
+	_, err = this.ModifyDeviceType(dt, params)
+	if err != nil {
+		return result, err
+	}
+	result = device
+	result.DeviceTypeId = AppendModifier(result.DeviceTypeId, InteractionFilter, params)
+	return result, nil
+}
+
+// Variants returns one variant per interaction (event, request, event+request)
+// interactions selecting no or all services are skipped, these variants would be empty or repeat the device-type
+func (this InteractionFilterModifier) Variants(dt models.DeviceType) (result [][]string) {
+	for _, interaction := range []models.Interaction{models.EVENT, models.REQUEST, models.EVENT_AND_REQUEST} {
+		params := []string{string(interaction)}
+		variant, err := this.ModifyDeviceType(dt, params)
+		if err == nil && isPartialServiceSelection(dt, variant) {
+			result = append(result, params)
+		}
+	}
+	return result
+}
+
+// FunctionFilterModifier limits the services to services with at least one content-variable using a function in params
+// example: urn:infai:ses:device-type:1$function_filter=urn:infai:ses:measuring-function:temperature
+type FunctionFilterModifier struct{}
+
+func (this FunctionFilterModifier) Key() string {
+	return FunctionFilter
+}
+
+func (this FunctionFilterModifier) ModifyDeviceType(dt models.DeviceType, params []string) (result models.DeviceType, err error) {
+	if len(params) == 0 || slices.Contains(params, "") {
+		return result, fmt.Errorf("missing function id: %w", ErrInvalidParameter)
+	}
+	result = dt
+	result.Services = []models.Service{}
+	for _, service := range dt.Services {
+		if serviceUsesFunction(service, params) {
+			result.Services = append(result.Services, service)
+		}
+	}
+	return result, nil
+}
+
+func (this FunctionFilterModifier) ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (result models.Device, err error) {
+	_, err = this.ModifyDeviceType(dt, params)
+	if err != nil {
+		return result, err
+	}
+	result = device
+	result.DeviceTypeId = AppendModifier(result.DeviceTypeId, FunctionFilter, params)
+	return result, nil
+}
+
+// Variants returns one variant per function used by the device-type
+// functions used by all services are skipped, these variants would repeat the device-type
+func (this FunctionFilterModifier) Variants(dt models.DeviceType) (result [][]string) {
+	functionIds := []string{}
+	var collect func(variable models.ContentVariable)
+	collect = func(variable models.ContentVariable) {
+		if variable.FunctionId != "" && !slices.Contains(functionIds, variable.FunctionId) {
+			functionIds = append(functionIds, variable.FunctionId)
+		}
+		for _, sub := range variable.SubContentVariables {
+			collect(sub)
+		}
+	}
+	for _, service := range dt.Services {
+		for _, content := range slices.Concat(service.Inputs, service.Outputs) {
+			collect(content.ContentVariable)
+		}
+	}
+	for _, functionId := range functionIds {
+		params := []string{functionId}
+		variant, err := this.ModifyDeviceType(dt, params)
+		if err == nil && isPartialServiceSelection(dt, variant) {
+			result = append(result, params)
+		}
+	}
+	return result
+}
+
+// isPartialServiceSelection checks if the variant contains some but not all services of dt
+func isPartialServiceSelection(dt models.DeviceType, variant models.DeviceType) bool {
+	return len(variant.Services) > 0 && len(variant.Services) < len(dt.Services)
+}
+
+func serviceUsesFunction(service models.Service, functionIds []string) bool {
+	var variableUsesFunction func(variable models.ContentVariable) bool
+	variableUsesFunction = func(variable models.ContentVariable) bool {
+		return slices.Contains(functionIds, variable.FunctionId) || slices.ContainsFunc(variable.SubContentVariables, variableUsesFunction)
+	}
+	contentUsesFunction := func(content models.Content) bool {
+		return variableUsesFunction(content.ContentVariable)
+	}
+	return slices.ContainsFunc(service.Inputs, contentUsesFunction) || slices.ContainsFunc(service.Outputs, contentUsesFunction)
+}
+
+// NameOverlayModifier replaces the name with params[0]; for devices the display-name attribute is replaced too
+// the device-type of a device is not changed
+// example: urn:infai:ses:device:1$name=Kitchen%20Lamp
+type NameOverlayModifier struct{}
+
+func (this NameOverlayModifier) Key() string {
+	return NameOverlay
+}
+
+func (this NameOverlayModifier) ModifyDeviceType(dt models.DeviceType, params []string) (result models.DeviceType, err error) {
+	if len(params) == 0 || params[0] == "" {
+		return result, fmt.Errorf("missing name: %w", ErrInvalidParameter)
+	}
+	result = dt
+	result.Name = params[0]
+	return result, nil
+}
+
+func (this NameOverlayModifier) ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (result models.Device, err error) {
+	if len(params) == 0 || params[0] == "" {
+		return result, fmt.Errorf("missing name: %w", ErrInvalidParameter)
+	}
+	result = device
+	result.Name = params[0]
+	result.Attributes = slices.Clone(result.Attributes)
+	for i, attr := range result.Attributes {
+		if attr.Key == DisplayNameAttributeName {
+			result.Attributes[i].Value = params[0]
+		}
+	}
+	return result, nil
+}
+
+// AttributeOverlayModifier sets attributes; each parameter has the form key=value (url-encoded within the id)
+// the device-type of a device is not changed
+// example: urn:infai:ses:device-type:1$attribute=vendor%3Dacme
+type AttributeOverlayModifier struct{}
+
+func (this AttributeOverlayModifier) Key() string {
+	return AttributeOverlay
+}
+
+func (this AttributeOverlayModifier) ModifyDeviceType(dt models.DeviceType, params []string) (result models.DeviceType, err error) {
+	result = dt
+	result.Attributes, err = overlayAttributes(dt.Attributes, params)
+	return result, err
+}
+
+func (this AttributeOverlayModifier) ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (result models.Device, err error) {
+	result = device
+	result.Attributes, err = overlayAttributes(device.Attributes, params)
+	return result, err
+}
+
+func overlayAttributes(attributes []models.Attribute, params []string) (result []models.Attribute, err error) {
+	if len(params) == 0 {
+		return result, fmt.Errorf("missing attribute: %w", ErrInvalidParameter)
+	}
+	result = slices.Clone(attributes)
+	for _, param := range params {
+		key, value, found := strings.Cut(param, "=")
+		if !found || key == "" {
+			return result, fmt.Errorf("expected key=value, got %v: %w", param, ErrInvalidParameter)
+		}
+		index := slices.IndexFunc(result, func(attr models.Attribute) bool { return attr.Key == key })
+		if index < 0 {
+			result = append(result, models.Attribute{Key: key, Value: value})
+		} else {
+			result[index].Value = value
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idmodifier
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const testFunction = "urn:infai:ses:measuring-function:temperature"
+
+var testDeviceType = models.DeviceType{
+	Id:            "dt",
+	Name:          "lamp",
+	Attributes:    []models.Attribute{{Key: "vendor", Value: "a"}},
+	ServiceGroups: []models.ServiceGroup{{Key: "left", Name: "Left"}, {Key: "right", Name: "Right"}},
+	Services: []models.Service{
+		{Id: "s1", Interaction: models.EVENT},
+		{Id: "s2", Interaction: models.REQUEST, ServiceGroupKey: "left"},
+		{Id: "s3", Interaction: models.EVENT_AND_REQUEST, ServiceGroupKey: "right", Outputs: []models.Content{{ContentVariable: models.ContentVariable{
+			Name:                "payload",
+			SubContentVariables: []models.ContentVariable{{Name: "temperature", FunctionId: testFunction}},
+		}}}},
+	},
+}
+
+func serviceIds(dt models.DeviceType) (result []string) {
+	result = []string{}
+	for _, service := range dt.Services {
+		result = append(result, service.Id)
+	}
+	return result
+}
+
+func TestModifyDeviceType(t *testing.T) {
+	for id, expectedServices := range map[string][]string{
+		"dt$service_group_selection=left":                             {"s1", "s2"},
+		"dt$interaction_filter=event":                                 {"s1", "s3"},
+		"dt$interaction_filter=event%2Brequest":                       {"s3"},
+		"dt$function_filter=" + testFunction:                          {"s3"},
+		"dt$interaction_filter=request&service_group_selection=right": {"s3"},
+		"dt$unknown=foo":                                              {"s1", "s2", "s3"},
+	} {
+		_, modifier := SplitModifier(id)
+		result, err := ModifyDeviceType(testDeviceType, modifier)
+		if err != nil {
+			t.Error(id, err)
+			continue
+		}
+		if !reflect.DeepEqual(serviceIds(result), expectedServices) {
+			t.Error(id, serviceIds(result), expectedServices)
+		}
+	}
+
+	_, modifier := SplitModifier("dt$attribute=vendor%3Db&attribute=model%3D1&name=renamed&service_group_selection=left")
+	result, err := ModifyDeviceType(testDeviceType, modifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Name != "renamed" {
+		t.Error(result.Name)
+	}
+	if !reflect.DeepEqual(result.Attributes, []models.Attribute{{Key: "vendor", Value: "b"}, {Key: "model", Value: "1"}}) {
+		t.Error(result.Attributes)
+	}
+	if testDeviceType.Attributes[0].Value != "a" {
+		t.Error("source modified")
+	}
+
+	for _, id := range []string{"dt$interaction_filter=foo", "dt$attribute=vendor", "dt$function_filter=", "dt$name="} {
+		_, modifier := SplitModifier(id)
+		_, err = ModifyDeviceType(testDeviceType, modifier)
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Error(id, err)
+		}
+	}
+}
+
+func TestModifyDevice(t *testing.T) {
+	device := models.Device{
+		Id:           "d",
+		Name:         "device",
+		DeviceTypeId: "dt",
+		Attributes:   []models.Attribute{{Key: DisplayNameAttributeName, Value: "nick"}},
+	}
+	_, modifier := SplitModifier("d$interaction_filter=event&service_group_selection=left")
+	result, err := ModifyDevice(device, testDeviceType, modifier, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := models.Device{
+		Id:           "d",
+		Name:         "device Left",
+		DeviceTypeId: "dt$service_group_selection=left&interaction_filter=event",
+		Attributes:   []models.Attribute{{Key: DisplayNameAttributeName, Value: "nick Left"}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("\n%#v\n%#v", result, expected)
+	}
+	if device.Attributes[0].Value != "nick" {
+		t.Error("source modified")
+	}
+
+	_, modifier = SplitModifier("d$name=kitchen&attribute=room%3Dkitchen")
+	result, err = ModifyDevice(device, testDeviceType, modifier, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = models.Device{
+		Id:           "d",
+		Name:         "kitchen",
+		DeviceTypeId: "dt",
+		Attributes:   []models.Attribute{{Key: DisplayNameAttributeName, Value: "kitchen"}, {Key: "room", Value: "kitchen"}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("\n%#v\n%#v", result, expected)
+	}
+
+	_, modifier = SplitModifier("d$service_group_selection=unknown")
+	_, err = ModifyDevice(device, testDeviceType, modifier, Options{})
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Error(err)
+	}
+	result, err = ModifyDevice(device, testDeviceType, modifier, Options{AllowUnknownServiceGroup: true})
+	if err != nil || result.Name != "device unknown" {
+		t.Error(result.Name, err)
+	}
+}
+
+func TestIndexedVariants(t *testing.T) {
+	variants, err := IndexedVariants(testDeviceType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"dt$service_group_selection=left", "s1", "s2"},
+		{"dt$service_group_selection=right", "s1", "s3"},
+		{"dt$interaction_filter=event", "s1", "s3"},
+		{"dt$interaction_filter=request", "s2", "s3"},
+		{"dt$interaction_filter=event%2Brequest", "s3"},
+		{AppendModifier("dt", FunctionFilter, []string{testFunction}), "s3"},
+	}
+	actual := [][]string{}
+	for _, variant := range variants {
+		actual = append(actual, append([]string{variant.Id}, serviceIds(variant)...))
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\n%#v\n%#v", actual, expected)
+	}
+
+	requestOnly := models.DeviceType{Id: "request-only", Services: []models.Service{
+		{Id: "s1", Interaction: models.REQUEST, Outputs: testDeviceType.Services[2].Outputs},
+		{Id: "s2", Interaction: models.REQUEST, Outputs: testDeviceType.Services[2].Outputs},
+	}}
+	variants, err = IndexedVariants(requestOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variants) != 0 {
+		t.Error("expected no variants for filters selecting no or all services", variants)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idmodifier
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// ErrInvalidParameter is returned by modifiers for missing or malformed parameters
+var ErrInvalidParameter = errors.New("invalid id-modifier parameter")
+
+// Modifier implements an id-modifier key
+// the modifiers of an id (ref SplitModifier) are applied in the order of their registration; unknown keys are ignored
+type Modifier interface {
+	Key() string
+
+	// ModifyDeviceType returns the device-type variant described by params; the id is not changed
+	ModifyDeviceType(dt models.DeviceType, params []string) (models.DeviceType, error)
+
+	// ModifyDevice returns the device variant described by params; dt is the unmodified device-type of the device
+	// modifiers, that change the device-type, add themselves to device.DeviceTypeId (ref AppendModifier)
+	ModifyDevice(device models.Device, dt models.DeviceType, params []string, options Options) (models.Device, error)
+}
+
+// IndexedModifier is implemented by modifiers with a finite set of variants per device-type
+// these variants are stored in the device-type criteria index (ref model.DeviceTypeCriteria.IsIdModified)
+// and are listed by device-type list endpoints and selectables with include-modified
+type IndexedModifier interface {
+	Modifier
+	Variants(dt models.DeviceType) [][]string
+}
+
+// Options contains installation specific modifier settings
+type Options struct {
+	AllowUnknownServiceGroup bool //ref configuration.Config.DeviceServiceGroupSelectionAllowNotFound
+}
+
+var registryMux sync.RWMutex
+var registry = []Modifier{}
+
+// Register adds the modifier to the registry; a modifier with the same key is replaced
+func Register(modifier Modifier) {
+	registryMux.Lock()
+	defer registryMux.Unlock()
+	for i, m := range registry {
+		if m.Key() == modifier.Key() {
+			registry[i] = modifier
+			return
+		}
+	}
+	registry = append(registry, modifier)
+}
+
+// Get returns the registered modifier for key
+func Get(key string) (modifier Modifier, ok bool) {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+	for _, m := range registry {
+		if m.Key() == key {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// Registered returns all registered modifiers in the order they are applied
+func Registered() []Modifier {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+	return append([]Modifier{}, registry...)
+}
+
+// IsKnown checks if the modifier contains at least one registered key
+func IsKnown(modifier map[string][]string) bool {
+	for _, m := range Registered() {
+		if _, ok := modifier[m.Key()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ModifyDeviceType applies all registered modifiers in modifier to dt
+func ModifyDeviceType(dt models.DeviceType, modifier map[string][]string) (result models.DeviceType, err error) {
+	result = dt
+	for _, m := range Registered() {
+		params, ok := modifier[m.Key()]
+		if !ok {
+			continue
+		}
+		result, err = m.ModifyDeviceType(result, params)
+		if err != nil {
+			return result, fmt.Errorf("%v: %w", m.Key(), err)
+		}
+	}
+	return result, nil
+}
+
+// ModifyDevice applies all registered modifiers in modifier to device; dt is the unmodified device-type of the device
+func ModifyDevice(device models.Device, dt models.DeviceType, modifier map[string][]string, options Options) (result models.Device, err error) {
+	result = device
+	for _, m := range Registered() {
+		params, ok := modifier[m.Key()]
+		if !ok {
+			continue
+		}
+		result, err = m.ModifyDevice(result, dt, params, options)
+		if err != nil {
+			return result, fmt.Errorf("%v: %w", m.Key(), err)
+		}
+	}
+	return result, nil
+}
+
+// IndexedVariants returns the variants of all registered IndexedModifier with their modified ids
+func IndexedVariants(dt models.DeviceType) (result []models.DeviceType, err error) {
+	for _, m := range Registered() {
+		indexed, ok := m.(IndexedModifier)
+		if !ok {
+			continue
+		}
+		for _, params := range indexed.Variants(dt) {
+			variant, err := indexed.ModifyDeviceType(dt, params)
+			if err != nil {
+				return result, fmt.Errorf("%v: %w", m.Key(), err)
+			}
+			variant.Id = AppendModifier(dt.Id, m.Key(), params)
+			result = append(result, variant)
+		}
+	}
+	return result, nil
+}
+
+// AppendModifier adds the modifier key with params to id
+func AppendModifier(id string, key string, params []string) string {
+	if strings.Contains(id, Seperator) {
+		id = id + "&"
+	} else {
+		id = id + Seperator
+	}
+	return id + EncodeModifierParameter(map[string][]string{key: params})
+}
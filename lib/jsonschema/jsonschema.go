@@ -0,0 +1,241 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsonschema describes the contents of services as JSON Schema (https://json-schema.org/draft/2020-12)
+package jsonschema
+
+import (
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	ContentMediaType     string             `json:"contentMediaType,omitempty"`
+	Const                interface{}        `json:"const,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Minimum              interface{}        `json:"minimum,omitempty"`
+	Maximum              interface{}        `json:"maximum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PrefixItems          []*Schema          `json:"prefixItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+type DeviceTypeSchema struct {
+	DeviceTypeId string          `json:"device_type_id"`
+	Name         string          `json:"name"`
+	Services     []ServiceSchema `json:"services"`
+}
+
+type ServiceSchema struct {
+	ServiceId string          `json:"service_id"`
+	LocalId   string          `json:"local_id"`
+	Name      string          `json:"name"`
+	Inputs    []ContentSchema `json:"inputs"`
+	Outputs   []ContentSchema `json:"outputs"`
+}
+
+type ContentSchema struct {
+	ContentId           string               `json:"content_id"`
+	ProtocolSegmentId   string               `json:"protocol_segment_id"`
+	ProtocolSegmentName string               `json:"protocol_segment_name,omitempty"`
+	Serialization       models.Serialization `json:"serialization"`
+	Schema              *Schema              `json:"schema"`
+}
+
+// NewDeviceTypeSchema describes all services of deviceType (ref NewServiceSchema)
+func NewDeviceTypeSchema(deviceType models.DeviceType, protocols map[string]models.Protocol, characteristics map[string]models.Characteristic) DeviceTypeSchema {
+	result := DeviceTypeSchema{
+		DeviceTypeId: deviceType.Id,
+		Name:         deviceType.Name,
+		Services:     []ServiceSchema{},
+	}
+	for _, service := range deviceType.Services {
+		result.Services = append(result.Services, NewServiceSchema(service, protocols[service.ProtocolId], characteristics))
+	}
+	return result
+}
+
+// NewServiceSchema describes every non-void input and output content of service
+// the protocol is optional and only used to name the protocol segments of the contents
+// characteristics are optional and used for min/max values and allowed values (ref CharacteristicIds, FlattenCharacteristics)
+func NewServiceSchema(service models.Service, protocol models.Protocol, characteristics map[string]models.Characteristic) ServiceSchema {
+	return ServiceSchema{
+		ServiceId: service.Id,
+		LocalId:   service.LocalId,
+		Name:      service.Name,
+		Inputs:    newContentSchemas(service.Inputs, protocol, characteristics),
+		Outputs:   newContentSchemas(service.Outputs, protocol, characteristics),
+	}
+}
+
+func newContentSchemas(contents []models.Content, protocol models.Protocol, characteristics map[string]models.Characteristic) []ContentSchema {
+	result := []ContentSchema{}
+	for _, content := range contents {
+		if content.ContentVariable.IsVoid {
+			continue
+		}
+		schema := NewContentSchema(content, characteristics)
+		element := ContentSchema{
+			ContentId:         content.Id,
+			ProtocolSegmentId: content.ProtocolSegmentId,
+			Serialization:     content.Serialization,
+			Schema:            &schema,
+		}
+		for _, segment := range protocol.ProtocolSegments {
+			if segment.Id == content.ProtocolSegmentId {
+				element.ProtocolSegmentName = segment.Name
+			}
+		}
+		result = append(result, element)
+	}
+	return result
+}
+
+// NewContentSchema describes the content variable of content
+// contents of other serializations than json are described by the json equivalent of their values
+// with the media type of the serialization as contentMediaType (ref serialization.Get); for xml the title is the name of the root element
+func NewContentSchema(content models.Content, characteristics map[string]models.Characteristic) Schema {
+	result := NewVariableSchema(content.ContentVariable, characteristics)
+	result.Schema = Draft
+	if s, ok := serialization.Get(content.Serialization); ok && content.Serialization != models.JSON {
+		result.ContentMediaType = s.Info().MediaType
+	}
+	return result
+}
+
+// NewVariableSchema describes the value of variable:
+//   - structures become objects; sub variables without omit_empty are required; a sub variable named '*' describes additionalProperties
+//   - lists become arrays; a sub variable named '*' describes the items, otherwise the sub variables describe the prefixItems of a fixed length array
+//   - fixed values become const
+//   - min/max values of the variable characteristic become minimum/maximum, its allowed values become enum
+func NewVariableSchema(variable models.ContentVariable, characteristics map[string]models.Characteristic) Schema {
+	result := Schema{
+		Title: variable.Name,
+		Const: variable.Value,
+	}
+	switch variable.Type {
+	case models.String:
+		result.Type = "string"
+	case models.Integer:
+		result.Type = "integer"
+	case models.Float:
+		result.Type = "number"
+	case models.Boolean:
+		result.Type = "boolean"
+	case models.Structure:
+		result.Type = "object"
+		for _, sub := range variable.SubContentVariables {
+			if sub.IsVoid {
+				continue
+			}
+			subSchema := NewVariableSchema(sub, characteristics)
+			if sub.Name == "*" {
+				result.AdditionalProperties = &subSchema
+				continue
+			}
+			if result.Properties == nil {
+				result.Properties = map[string]*Schema{}
+			}
+			result.Properties[sub.Name] = &subSchema
+			if !sub.OmitEmpty {
+				result.Required = append(result.Required, sub.Name)
+			}
+		}
+	case models.List:
+		result.Type = "array"
+		if len(variable.SubContentVariables) == 1 && variable.SubContentVariables[0].Name == "*" {
+			items := NewVariableSchema(variable.SubContentVariables[0], characteristics)
+			result.Items = &items
+			break
+		}
+		for _, sub := range variable.SubContentVariables {
+			subSchema := NewVariableSchema(sub, characteristics)
+			result.PrefixItems = append(result.PrefixItems, &subSchema)
+		}
+		if len(result.PrefixItems) > 0 {
+			length := len(result.PrefixItems)
+			result.MinItems = &length
+			result.MaxItems = &length
+		}
+	}
+	if characteristic, ok := characteristics[variable.CharacteristicId]; ok && variable.CharacteristicId != "" {
+		if isNumber(characteristic.MinValue) && (result.Type == "integer" || result.Type == "number") {
+			result.Minimum = characteristic.MinValue
+		}
+		if isNumber(characteristic.MaxValue) && (result.Type == "integer" || result.Type == "number") {
+			result.Maximum = characteristic.MaxValue
+		}
+		if len(characteristic.AllowedValues) > 0 {
+			result.Enum = slices.Clone(characteristic.AllowedValues)
+		}
+	}
+	return result
+}
+
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case float64, float32, int, int32, int64:
+		return true
+	default:
+		return false
+	}
+}
+
+// CharacteristicIds returns the characteristic ids referenced by the content variables of services
+func CharacteristicIds(services ...models.Service) (result []string) {
+	var collect func(variable models.ContentVariable)
+	collect = func(variable models.ContentVariable) {
+		if variable.CharacteristicId != "" && !slices.Contains(result, variable.CharacteristicId) {
+			result = append(result, variable.CharacteristicId)
+		}
+		for _, sub := range variable.SubContentVariables {
+			collect(sub)
+		}
+	}
+	for _, service := range services {
+		for _, content := range append(slices.Clone(service.Inputs), service.Outputs...) {
+			collect(content.ContentVariable)
+		}
+	}
+	return result
+}
+
+// FlattenCharacteristics indexes characteristics and their sub-characteristics by id
+func FlattenCharacteristics(characteristics ...models.Characteristic) map[string]models.Characteristic {
+	result := map[string]models.Characteristic{}
+	var add func(characteristic models.Characteristic)
+	add = func(characteristic models.Characteristic) {
+		result[characteristic.Id] = characteristic
+		for _, sub := range characteristic.SubCharacteristics {
+			add(sub)
+		}
+	}
+	for _, characteristic := range characteristics {
+		add(characteristic)
+	}
+	return result
+}
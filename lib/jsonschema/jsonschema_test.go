@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestNewServiceSchema(t *testing.T) {
+	characteristics := FlattenCharacteristics(models.Characteristic{
+		Id:   "urn:infai:ses:characteristic:color",
+		Type: models.Structure,
+		SubCharacteristics: []models.Characteristic{
+			{Id: "urn:infai:ses:characteristic:color-r", Type: models.Integer, MinValue: 0.0, MaxValue: 255.0},
+			{Id: "urn:infai:ses:characteristic:color-mode", Type: models.String, AllowedValues: []interface{}{"rgb", "hsb"}},
+		},
+	})
+	service := models.Service{
+		Id:      "urn:infai:ses:service:color",
+		LocalId: "color",
+		Name:    "Color",
+		Inputs: []models.Content{
+			{
+				Id:                "urn:infai:ses:content:payload",
+				ProtocolSegmentId: "urn:infai:ses:segment:payload",
+				Serialization:     models.JSON,
+				ContentVariable: models.ContentVariable{
+					Name: "color",
+					Type: models.Structure,
+					SubContentVariables: []models.ContentVariable{
+						{Name: "r", Type: models.Integer, CharacteristicId: "urn:infai:ses:characteristic:color-r"},
+						{Name: "mode", Type: models.String, CharacteristicId: "urn:infai:ses:characteristic:color-mode", OmitEmpty: true},
+						{Name: "version", Type: models.Integer, Value: 2.0},
+						{Name: "ignored", IsVoid: true},
+						{Name: "tags", Type: models.List, SubContentVariables: []models.ContentVariable{{Name: "*", Type: models.String}}},
+						{Name: "point", Type: models.List, SubContentVariables: []models.ContentVariable{{Name: "0", Type: models.Float}, {Name: "1", Type: models.Float}}},
+						{Name: "extra", Type: models.Structure, OmitEmpty: true, SubContentVariables: []models.ContentVariable{{Name: "*", Type: models.Boolean}}},
+					},
+				},
+			},
+			{Id: "urn:infai:ses:content:void", ContentVariable: models.ContentVariable{Name: "void", IsVoid: true}},
+		},
+		Outputs: []models.Content{{
+			Id:                "urn:infai:ses:content:status",
+			ProtocolSegmentId: "urn:infai:ses:segment:header",
+			Serialization:     models.PlainText,
+			ContentVariable:   models.ContentVariable{Name: "status", Type: models.String},
+		}},
+	}
+	protocol := models.Protocol{ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:payload", Name: "payload"}}}
+
+	result := NewServiceSchema(service, protocol, characteristics)
+	b, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual interface{}
+	err = json.Unmarshal(b, &actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected interface{}
+	err = json.Unmarshal([]byte(`{
+		"service_id": "urn:infai:ses:service:color",
+		"local_id": "color",
+		"name": "Color",
+		"inputs": [{
+			"content_id": "urn:infai:ses:content:payload",
+			"protocol_segment_id": "urn:infai:ses:segment:payload",
+			"protocol_segment_name": "payload",
+			"serialization": "json",
+			"schema": {
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "color",
+				"type": "object",
+				"properties": {
+					"r": {"title": "r", "type": "integer", "minimum": 0, "maximum": 255},
+					"mode": {"title": "mode", "type": "string", "enum": ["rgb", "hsb"]},
+					"version": {"title": "version", "type": "integer", "const": 2},
+					"tags": {"title": "tags", "type": "array", "items": {"title": "*", "type": "string"}},
+					"point": {"title": "point", "type": "array", "prefixItems": [{"title": "0", "type": "number"}, {"title": "1", "type": "number"}], "minItems": 2, "maxItems": 2},
+					"extra": {"title": "extra", "type": "object", "additionalProperties": {"title": "*", "type": "boolean"}}
+				},
+				"required": ["r", "version", "tags", "point"]
+			}
+		}],
+		"outputs": [{
+			"content_id": "urn:infai:ses:content:status",
+			"protocol_segment_id": "urn:infai:ses:segment:header",
+			"serialization": "plain-text",
+			"schema": {"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "status", "type": "string", "contentMediaType": "text/plain"}
+		}]
+	}`), &expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\n%v\n%v", string(b), expected)
+	}
+
+	if ids := CharacteristicIds(service); !reflect.DeepEqual(ids, []string{"urn:infai:ses:characteristic:color-r", "urn:infai:ses:characteristic:color-mode"}) {
+		t.Error(ids)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/SENERGY-Platform/device-repository/lib/controller/publisher"
 	"github.com/SENERGY-Platform/device-repository/lib/database"
 	"github.com/SENERGY-Platform/device-repository/lib/mgwmirror"
+	"github.com/SENERGY-Platform/device-repository/lib/webhooks"
 	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
 	"github.com/SENERGY-Platform/service-commons/pkg/util"
 )
@@ -76,6 +77,9 @@ func Start(baseCtx context.Context, wg *sync.WaitGroup, conf configuration.Confi
 	} else {
 		p, err = publisher.New(conf, ctx)
 	}
+	if err == nil {
+		p, err = webhooks.New(ctx, conf, db, permClient, p)
+	}
 
 	if err != nil {
 		db.Disconnect()
@@ -90,8 +94,16 @@ func Start(baseCtx context.Context, wg *sync.WaitGroup, conf configuration.Confi
 		return err
 	}
 
+	//the api is started before the startup migrations, so that /health/* is served during startup;
+	//other requests are answered with 503 until ctrl.SetStartupFinished()
+	err = api.Start(ctx, conf, ctrl)
+	if err != nil {
+		conf.GetLogger().Error("unable to start api", "error", err)
+		return err
+	}
+
 	if conf.RunStartupMigrations && !conf.AsMgwMirror {
-		err = db.RunStartupMigrations(ctrl)
+		err = db.RunStartupMigrations(ctx, ctrl)
 		if err != nil {
 			db.Disconnect()
 			conf.GetLogger().Error("unable to run startup migrations", "error", err)
@@ -119,13 +131,8 @@ func Start(baseCtx context.Context, wg *sync.WaitGroup, conf configuration.Confi
 		ctrl.SetMirrorPullCallback(mgwmirror.Pull)
 	}
 
-	err = api.Start(ctx, conf, ctrl)
-	if err != nil {
-		conf.GetLogger().Error("unable to start api", "error", err)
-		return err
-	}
-
-	return err
+	ctrl.SetStartupFinished()
+	return nil
 }
 
 func deleteUnknownPermissions(ctx context.Context, config configuration.Config, permClient client.Client, topic string, check func(id string) (bool, error)) error {
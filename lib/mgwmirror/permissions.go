@@ -51,9 +51,13 @@ func (this *MgwMirrorPerm) CheckMultiplePermissions(token string, topicId string
 }
 
 func (this *MgwMirrorPerm) ListAccessibleResourceIds(token string, topicId string, options model2.ListOptions, permissions ...model2.Permission) (ids []string, err error, code int) {
+	return this.ListAccessibleResourceIdsContext(context.Background(), token, topicId, options, permissions...)
+}
+
+func (this *MgwMirrorPerm) ListAccessibleResourceIdsContext(ctx context.Context, token string, topicId string, options model2.ListOptions, permissions ...model2.Permission) (ids []string, err error, code int) {
 	switch topicId {
 	case this.config.DeviceTopic:
-		devices, _, err := this.db.ListDevices(context.Background(), model.DeviceListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset}, false)
+		devices, _, err := this.db.ListDevices(ctx, model.DeviceListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset}, false)
 		if err != nil {
 			return nil, err, 500
 		}
@@ -62,7 +66,7 @@ func (this *MgwMirrorPerm) ListAccessibleResourceIds(token string, topicId strin
 		}
 		return ids, nil, 200
 	case this.config.DeviceGroupTopic:
-		groups, _, err := this.db.ListDeviceGroups(context.Background(), model.DeviceGroupListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset})
+		groups, _, err := this.db.ListDeviceGroups(ctx, model.DeviceGroupListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset})
 		if err != nil {
 			return nil, err, 500
 		}
@@ -71,7 +75,7 @@ func (this *MgwMirrorPerm) ListAccessibleResourceIds(token string, topicId strin
 		}
 		return ids, nil, 200
 	case this.config.HubTopic:
-		hubs, _, err := this.db.ListHubs(context.Background(), model.HubListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset}, false)
+		hubs, _, err := this.db.ListHubs(ctx, model.HubListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset}, false)
 		if err != nil {
 			return nil, err, 500
 		}
@@ -80,7 +84,7 @@ func (this *MgwMirrorPerm) ListAccessibleResourceIds(token string, topicId strin
 		}
 		return ids, nil, 200
 	case this.config.LocationTopic:
-		locations, _, err := this.db.ListLocations(context.Background(), model.LocationListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset})
+		locations, _, err := this.db.ListLocations(ctx, model.LocationListOptions{Ids: options.Ids, Limit: options.Limit, Offset: options.Offset})
 		if err != nil {
 			return nil, err, 500
 		}
@@ -179,10 +183,6 @@ func (this *MgwMirrorPerm) CheckMultiplePermissionsContext(ctx context.Context,
 	return this.CheckMultiplePermissions(token, topicId, ids, permissions...)
 }
 
-func (this *MgwMirrorPerm) ListAccessibleResourceIdsContext(ctx context.Context, token string, topicId string, options model2.ListOptions, permissions ...model2.Permission) (ids []string, err error, code int) {
-	return this.ListAccessibleResourceIds(token, topicId, options, permissions...)
-}
-
 func (this *MgwMirrorPerm) ListComputedPermissionsContext(ctx context.Context, token string, topic string, ids []string) (result []model2.ComputedPermissions, err error, code int) {
 	return this.ListComputedPermissions(token, topic, ids)
 }
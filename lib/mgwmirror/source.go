@@ -39,7 +39,7 @@ func StartSourcePullWorker(ctx context.Context, wg *sync.WaitGroup, config confi
 	if err != nil {
 		return err
 	}
-	go Pull(config, db, false)
+	go Pull(ctx, config, db, false)
 	ticker := time.NewTicker(interval)
 	if wg != nil {
 		wg.Add(1)
@@ -54,14 +54,14 @@ func StartSourcePullWorker(ctx context.Context, wg *sync.WaitGroup, config confi
 				ticker.Stop()
 				return
 			case <-ticker.C:
-				Pull(config, db, true)
+				Pull(ctx, config, db, true)
 			}
 		}
 	}()
 	return nil
 }
 
-func Pull(config configuration.Config, db database.Database, checkLastUpdate bool) {
+func Pull(ctx context.Context, config configuration.Config, db database.Database, checkLastUpdate bool) {
 	config.GetLogger().Info("start mgw mirror pull")
 	defer config.GetLogger().Info("finished mgw mirror pull")
 	c := client.NewClient(config.MgwMirrorSourceUrl, nil)
@@ -79,14 +79,14 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 	}
 
 	if checkLastUpdate {
-		sourceLastUpdateTimestamps, err, _ := c.GetLastUpdateTimestamps(token, "")
+		sourceLastUpdateTimestamps, err, _ := c.GetLastUpdateTimestamps(ctx, token, "")
 		if err != nil {
 			config.GetLogger().Error("error while getting source last update timestamps for mgw mirror pull", "error", err)
 			return
 		}
 		config.GetLogger().Debug("source last update timestamps", "source_last_update_timestamps", fmt.Sprintf("%#v", sourceLastUpdateTimestamps))
 
-		localLastUpdateTimestamps, err := db.GetLastUpdateTimestampsForUser(context.Background(), userId)
+		localLastUpdateTimestamps, err := db.GetLastUpdateTimestampsForUser(ctx, userId)
 		if err != nil {
 			config.GetLogger().Error("error while getting local last update timestamps for mgw mirror pull", "error", err)
 			return
@@ -129,14 +129,14 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoProtocolCollection) {
 		for p, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Protocol, err error) {
-			list, err, _ = c.ListProtocols(token, limit, offset, "")
+			list, err, _ = c.ListProtocols(ctx, token, limit, offset, "")
 			return
 		}) {
 			if err != nil {
 				config.GetLogger().Error("error while listing protocols for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetProtocol(context.Background(), p, func(models.Protocol) error { return nil })
+			err = db.SetProtocol(ctx, p, func(models.Protocol) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting protocol for mgw mirror pull", "error", err)
 				break
@@ -146,7 +146,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoAspectCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Aspect, err error) {
-			list, _, err, _ = c.ListAspects(client.AspectListOptions{
+			list, _, err, _ = c.ListAspects(ctx, client.AspectListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -156,7 +156,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing aspects for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetAspect(context.Background(), e, func(models.Aspect) error { return nil })
+			err = db.SetAspect(ctx, e, func(models.Aspect) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting aspects for mgw mirror pull", "error", err)
 				break
@@ -164,7 +164,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 		}
 
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.AspectNode, err error) {
-			list, _, err, _ = c.ListAspectNodes(client.AspectListOptions{
+			list, _, err, _ = c.ListAspectNodes(ctx, client.AspectListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -174,7 +174,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing aspect-nodes for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetAspectNode(context.Background(), e)
+			err = db.SetAspectNode(ctx, e)
 			if err != nil {
 				config.GetLogger().Error("error while setting aspect-nodes for mgw mirror pull", "error", err)
 				break
@@ -184,7 +184,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoCharacteristicCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Characteristic, err error) {
-			list, _, err, _ = c.ListCharacteristics(client.CharacteristicListOptions{
+			list, _, err, _ = c.ListCharacteristics(ctx, client.CharacteristicListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -194,7 +194,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing characteristics for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetCharacteristic(context.Background(), e, func(models.Characteristic) error { return nil })
+			err = db.SetCharacteristic(ctx, e, func(models.Characteristic) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting characteristics for mgw mirror pull", "error", err)
 				break
@@ -204,7 +204,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoConceptCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Concept, err error) {
-			list, _, err, _ = c.ListConcepts(client.ConceptListOptions{
+			list, _, err, _ = c.ListConcepts(ctx, client.ConceptListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -214,7 +214,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing concepts for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetConcept(context.Background(), e, func(models.Concept) error { return nil })
+			err = db.SetConcept(ctx, e, func(models.Concept) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting concepts for mgw mirror pull", "error", err)
 				break
@@ -224,7 +224,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoDeviceClassCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.DeviceClass, err error) {
-			list, _, err, _ = c.ListDeviceClasses(client.DeviceClassListOptions{
+			list, _, err, _ = c.ListDeviceClasses(ctx, client.DeviceClassListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -234,7 +234,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing device-class for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetDeviceClass(context.Background(), e, func(models.DeviceClass) error { return nil })
+			err = db.SetDeviceClass(ctx, e, func(models.DeviceClass) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting device-class for mgw mirror pull", "error", err)
 				break
@@ -244,7 +244,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoFunctionCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Function, err error) {
-			list, _, err, _ = c.ListFunctions(client.FunctionListOptions{
+			list, _, err, _ = c.ListFunctions(ctx, client.FunctionListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -254,7 +254,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing functions for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetFunction(context.Background(), e, func(models.Function) error { return nil })
+			err = db.SetFunction(ctx, e, func(models.Function) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting functions for mgw mirror pull", "error", err)
 				break
@@ -264,7 +264,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoDeviceTypeCollection) {
 		for e, err := range util.IterBatch(100, func(limit int64, offset int64) (list []models.DeviceType, err error) {
-			list, _, err, _ = c.ListDeviceTypesV3(token, client.DeviceTypeListOptions{
+			list, _, err, _ = c.ListDeviceTypesV3(ctx, token, client.DeviceTypeListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -274,7 +274,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing device-type for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetDeviceType(context.Background(), e, func(models.DeviceType) error { return nil })
+			err = db.SetDeviceType(ctx, e, func(models.DeviceType) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting device-type for mgw mirror pull", "error", err)
 				break
@@ -283,8 +283,9 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 	}
 
 	if checkLastUpdateF(config.MongoDeviceCollection) {
-		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Device, err error) {
-			list, err, _ = c.ListDevices(token, client.DeviceListOptions{
+		//the extended list contains the device configuration values
+		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []model.ExtendedDevice, err error) {
+			list, _, err, _ = c.ListExtendedDevicesWithConfiguration(ctx, token, client.ExtendedDeviceListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -294,7 +295,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing devices for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetDevice(context.Background(), client.DeviceWithConnectionState{Device: e}, func(client.DeviceWithConnectionState, client.DeviceWithConnectionState) error { return nil })
+			err = db.SetDevice(ctx, client.DeviceWithConnectionState{Device: e.Device, Configuration: e.Configuration}, func(client.DeviceWithConnectionState, client.DeviceWithConnectionState) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting devices for mgw mirror pull", "error", err)
 				break
@@ -304,7 +305,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoDeviceGroupCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.DeviceGroup, err error) {
-			list, _, err, _ = c.ListDeviceGroups(token, client.DeviceGroupListOptions{
+			list, _, err, _ = c.ListDeviceGroups(ctx, token, client.DeviceGroupListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -314,7 +315,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing device-groups for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetDeviceGroup(context.Background(), e, func(models.DeviceGroup, string) error { return nil }, userId)
+			err = db.SetDeviceGroup(ctx, e, func(models.DeviceGroup, string) error { return nil }, userId)
 			if err != nil {
 				config.GetLogger().Error("error while setting device-groups for mgw mirror pull", "error", err)
 				break
@@ -324,7 +325,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoHubCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Hub, err error) {
-			list, err, _ = c.ListHubs(token, client.HubListOptions{
+			list, err, _ = c.ListHubs(ctx, token, client.HubListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -334,7 +335,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing hubs for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetHub(context.Background(), model.HubWithConnectionState{Hub: e}, func(model.HubWithConnectionState) error { return nil })
+			err = db.SetHub(ctx, model.HubWithConnectionState{Hub: e}, func(model.HubWithConnectionState) error { return nil })
 			if err != nil {
 				config.GetLogger().Error("error while setting hubs for mgw mirror pull", "error", err)
 				break
@@ -344,7 +345,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 
 	if checkLastUpdateF(config.MongoLocationCollection) {
 		for e, err := range util.IterBatch(500, func(limit int64, offset int64) (list []models.Location, err error) {
-			list, _, err, _ = c.ListLocations(token, client.LocationListOptions{
+			list, _, err, _ = c.ListLocations(ctx, token, client.LocationListOptions{
 				Limit:  limit,
 				Offset: offset,
 			})
@@ -354,7 +355,7 @@ func Pull(config configuration.Config, db database.Database, checkLastUpdate boo
 				config.GetLogger().Error("error while listing locations for mgw mirror pull", "error", err)
 				break
 			}
-			err = db.SetLocation(context.Background(), e, func(models.Location, string) error { return nil }, userId)
+			err = db.SetLocation(ctx, e, func(models.Location, string) error { return nil }, userId)
 			if err != nil {
 				config.GetLogger().Error("error while setting locations for mgw mirror pull", "error", err)
 				break
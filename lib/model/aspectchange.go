@@ -0,0 +1,26 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// AspectChangeImpact lists the elements that are updated by an aspect merge or move (ref POST /aspects/{id}/merge and POST /aspects/{id}/move)
+type AspectChangeImpact struct {
+	ChangedAspectIds      []string `json:"changed_aspect_ids"`       //root aspects that are stored with a changed tree
+	DeletedAspectIds      []string `json:"deleted_aspect_ids"`       //root aspects that are removed, because they are merged or moved into another tree
+	DeviceTypeIds         []string `json:"device_type_ids"`          //device-types with rewritten aspect references
+	DeviceTypeTemplateIds []string `json:"device_type_template_ids"` //device-type-templates with rewritten aspect references
+	DeviceGroupIds        []string `json:"device_group_ids"`         //device-groups with recomputed criteria
+}
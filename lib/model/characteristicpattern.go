@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"regexp"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// CharacteristicPattern restricts string values of a characteristic to a regular expression (RE2 syntax)
+// enumerations are expressed by the characteristics allowed_values
+type CharacteristicPattern struct {
+	CharacteristicId string `json:"characteristic_id" bson:"characteristic_id"`
+	Pattern          string `json:"pattern" bson:"pattern"`                   //has to match the whole value
+	Description      string `json:"description,omitempty" bson:"description"` //hint for users, e.g. "hex color like #ff00ff"
+}
+
+// Compile returns the pattern as regexp anchored to the whole value
+func (this CharacteristicPattern) Compile() (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + this.Pattern + ")$")
+}
+
+// ValueConstraints are the constraints of a characteristic for content-variable values (ref ServicePathOption.Constraints)
+type ValueConstraints struct {
+	Type               models.Type   `json:"type,omitempty"`
+	MinValue           interface{}   `json:"min_value,omitempty"`
+	MaxValue           interface{}   `json:"max_value,omitempty"`
+	AllowedValues      []interface{} `json:"allowed_values,omitempty"`
+	Pattern            string        `json:"pattern,omitempty"`
+	PatternDescription string        `json:"pattern_description,omitempty"`
+}
@@ -20,8 +20,9 @@ import "github.com/SENERGY-Platform/models/go/models"
 
 type DeviceWithConnectionState struct {
 	models.Device   `bson:",inline"`
-	ConnectionState models.ConnectionState `json:"connection_state" bson:"connection_state"`
-	DisplayName     string                 `json:"display_name" bson:"display_name"`
+	ConnectionState models.ConnectionState     `json:"connection_state" bson:"connection_state"`
+	DisplayName     string                     `json:"display_name" bson:"display_name"`
+	Configuration   []DeviceConfigurationValue `json:"configuration,omitempty" bson:"configuration,omitempty"` //ref PUT /devices/{id}/configuration
 }
 
 type HubWithConnectionState struct {
@@ -32,6 +32,9 @@ const SES_ONTOLOGY_CONCEPT = "https://senergy.infai.org/ontology/Concept"
 const SES_ONTOLOGY_CHARACTERISTIC = "https://senergy.infai.org/ontology/Characteristic"
 const SES_ONTOLOGY_COUNT = "https://senergy.infai.org/ontology/Count"
 const SES_ONTOLOGY_LOCATION = "https://senergy.infai.org/ontology/Location"
+const SES_ONTOLOGY_PROTOCOL = "https://senergy.infai.org/ontology/Protocol"
+const SES_ONTOLOGY_CONTENT = "https://senergy.infai.org/ontology/Content"
+const SES_ONTOLOGY_CONTENT_VARIABLE = "https://senergy.infai.org/ontology/ContentVariable"
 
 // Properties
 
@@ -50,6 +53,21 @@ const SES_ONTOLOGY_REFERS_TO = "https://senergy.infai.org/ontology/refersTo"
 const SES_ONTOLOGY_EXPOSES_FUNCTION = "https://senergy.infai.org/ontology/exposesFunction"
 const SES_ONTOLOGY_TOTAL_COUNT = "https://senergy.infai.org/ontology/totalCount"
 const SES_ONTOLOGY_HAS_IMAGE = "https://senergy.infai.org/ontology/hasImage"
+const SES_ONTOLOGY_HAS_SUB_ASPECT = "https://senergy.infai.org/ontology/hasSubAspect"
+const SES_ONTOLOGY_HAS_DISPLAY_NAME = "https://senergy.infai.org/ontology/hasDisplayName"
+const SES_ONTOLOGY_HAS_DISPLAY_UNIT = "https://senergy.infai.org/ontology/hasDisplayUnit"
+const SES_ONTOLOGY_HAS_ALLOWED_VALUE = "https://senergy.infai.org/ontology/hasAllowedValue"
+const SES_ONTOLOGY_HAS_LOCAL_ID = "https://senergy.infai.org/ontology/hasLocalId"
+const SES_ONTOLOGY_HAS_INPUT = "https://senergy.infai.org/ontology/hasInput"
+const SES_ONTOLOGY_HAS_OUTPUT = "https://senergy.infai.org/ontology/hasOutput"
+const SES_ONTOLOGY_HAS_SERIALIZATION = "https://senergy.infai.org/ontology/hasSerialization"
+const SES_ONTOLOGY_HAS_PROTOCOL_SEGMENT = "https://senergy.infai.org/ontology/hasProtocolSegment"
+const SES_ONTOLOGY_HAS_CONTENT_VARIABLE = "https://senergy.infai.org/ontology/hasContentVariable"
+const SES_ONTOLOGY_HAS_SUB_CONTENT_VARIABLE = "https://senergy.infai.org/ontology/hasSubContentVariable"
+const SES_ONTOLOGY_HAS_UNIT_REFERENCE = "https://senergy.infai.org/ontology/hasUnitReference"
+const SES_ONTOLOGY_HAS_SERIALIZATION_OPTION = "https://senergy.infai.org/ontology/hasSerializationOption"
+const SES_ONTOLOGY_IS_VOID = "https://senergy.infai.org/ontology/isVoid"
+const SES_ONTOLOGY_OMIT_EMPTY = "https://senergy.infai.org/ontology/omitEmpty"
 
 const SES_ONTOLOGY_HAS_INTERACTION = "https://senergy.infai.org/ontology/interaction"
 
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+// DeviceConfigurationValue is the device specific value of a configurable content-variable (ref Configurable)
+type DeviceConfigurationValue struct {
+	ServiceId string      `json:"service_id" bson:"service_id"`
+	Path      string      `json:"path" bson:"path"` //content-variable path as in Configurable.Path (e.g. "payload.duration")
+	Value     interface{} `json:"value" bson:"value"`
+}
+
+// DeviceWithConfiguration is the payload of device webhooks
+type DeviceWithConfiguration struct {
+	models.Device `bson:",inline"`
+	Configuration []DeviceConfigurationValue `json:"configuration,omitempty" bson:"configuration,omitempty"`
+}
+
+// ExtendedDevice adds the device configuration to models.ExtendedDevice
+type ExtendedDevice struct {
+	models.ExtendedDevice `bson:",inline"`
+	Configuration         []DeviceConfigurationValue `json:"configuration,omitempty" bson:"configuration,omitempty"`
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+// DeviceQuery selects devices by semantic criteria (ref POST /query/devices)
+type DeviceQuery struct {
+	Criteria                     []FilterCriteria      `json:"criteria"`                         //aspects match their descendants; a device-type must match every criteria
+	ServicesMustMatchAllCriteria bool                  `json:"services_must_match_all_criteria"` //only services matching all criteria are returned (ref GetDeviceTypeSelectablesV2)
+	LocationId                   string                `json:"location_id,omitempty"`            //filter; devices of the location and of its device-groups
+	HubId                        string                `json:"hub_id,omitempty"`                 //filter
+	DeviceGroupId                string                `json:"device_group_id,omitempty"`        //filter
+	Attributes                   []models.Attribute    `json:"attributes,omitempty"`             //filter; every attribute must exist on the device; value and origin are only checked if set
+	Permission                   models.PermissionFlag `json:"permission,omitempty"`             //defaults to read
+	Limit                        int64                 `json:"limit,omitempty"`                  //0 returns all matches
+	Offset                       int64                 `json:"offset,omitempty"`
+}
+
+type DeviceQueryResult struct {
+	Device             models.Device                  `json:"device"`
+	ServicePathOptions map[string][]ServicePathOption `json:"service_path_options"` //matching content-variable paths by service id
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"slices"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// DeviceTypeCloneOptions describes changes applied to a cloned device-type (ref DeviceTypeCloneOptions.Clone)
+type DeviceTypeCloneOptions struct {
+	Name              string             `json:"name,omitempty"`               //replaces the name of the source if set
+	Attributes        []models.Attribute `json:"attributes,omitempty"`         //replace source attributes with the same key or are added
+	RemovedAttributes []string           `json:"removed_attributes,omitempty"` //keys of source attributes, that are not copied
+}
+
+// Clone returns a copy of source with the id deviceTypeId
+// service, content and content-variable ids are derived from deviceTypeId and the source ids (ref deriveServiceIds),
+// service-group keys are local to the device-type and stay valid
+func (this DeviceTypeCloneOptions) Clone(deviceTypeId string, source models.DeviceType) models.DeviceType {
+	result := source
+	result.Id = deviceTypeId
+	if this.Name != "" {
+		result.Name = this.Name
+	}
+	result.Attributes = []models.Attribute{}
+	for _, attr := range source.Attributes {
+		if !slices.Contains(this.RemovedAttributes, attr.Key) && !slices.ContainsFunc(this.Attributes, func(o models.Attribute) bool { return o.Key == attr.Key }) {
+			result.Attributes = append(result.Attributes, attr)
+		}
+	}
+	result.Attributes = append(result.Attributes, this.Attributes...)
+	result.ServiceGroups = slices.Clone(source.ServiceGroups)
+	result.Services = make([]models.Service, 0, len(source.Services))
+	for _, service := range source.Services {
+		service.Attributes = slices.Clone(service.Attributes)
+		result.Services = append(result.Services, deriveServiceIds(deviceTypeId, service))
+	}
+	return result
+}
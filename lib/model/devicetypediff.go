@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+// DeviceTypeDiff is the structural difference of a stored device-type to a submitted or another stored device-type (ref POST /device-types/{id}/diff)
+// services are matched by id or else by local_id, contents by id or else by direction and content-variable name, content-variables by id or else by path
+type DeviceTypeDiff struct {
+	DeviceTypeId      string               `json:"device_type_id"`
+	OtherDeviceTypeId string               `json:"other_device_type_id,omitempty"`
+	Fields            []string             `json:"fields"` //changed device-type fields without services (e.g. "name", "attributes[0].value")
+	AddedServices     []DiffService        `json:"added_services"`
+	RemovedServices   []DiffService        `json:"removed_services"`
+	ChangedServices   []ServiceDiff        `json:"changed_services"`
+	Impact            DeviceTypeDiffImpact `json:"impact"`
+}
+
+type DiffService struct {
+	Id      string `json:"id"`
+	LocalId string `json:"local_id"`
+	Name    string `json:"name"`
+}
+
+type ServiceDiff struct {
+	Id              string        `json:"id"`
+	OtherId         string        `json:"other_id,omitempty"` //set if the matched service has a different id
+	LocalId         string        `json:"local_id"`
+	Fields          []string      `json:"fields"` //changed service fields without inputs and outputs (e.g. "interaction")
+	AddedContents   []DiffContent `json:"added_contents"`
+	RemovedContents []DiffContent `json:"removed_contents"`
+	ChangedContents []ContentDiff `json:"changed_contents"`
+}
+
+type DiffContent struct {
+	Id        string `json:"id"`
+	Direction string `json:"direction"` //PayloadDirectionInput or PayloadDirectionOutput
+	Name      string `json:"name"`      //name of the root content-variable
+}
+
+type ContentDiff struct {
+	Id               string                `json:"id"`
+	OtherId          string                `json:"other_id,omitempty"` //set if the matched content has a different id
+	Direction        string                `json:"direction"`
+	Fields           []string              `json:"fields"` //changed content fields without the content-variable (e.g. "serialization")
+	AddedVariables   []DiffVariable        `json:"added_variables"`
+	RemovedVariables []DiffVariable        `json:"removed_variables"`
+	ChangedVariables []ContentVariableDiff `json:"changed_variables"`
+}
+
+type DiffVariable struct {
+	Id   string `json:"id"`
+	Path string `json:"path"`
+}
+
+type ContentVariableDiff struct {
+	Id          string             `json:"id"`
+	Path        string             `json:"path"`
+	OtherPath   string             `json:"other_path,omitempty"` //set if the content-variable was renamed or moved
+	Fields      []string           `json:"fields"`               //changed fields without annotations and sub content-variables (e.g. "type", "value")
+	Annotations []AnnotationChange `json:"annotations"`
+}
+
+type AnnotationChange struct {
+	Field string `json:"field"` //function_id, aspect_id or characteristic_id
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// DeviceTypeDiffImpact describes the effect of replacing the stored device-type with the other device-type
+type DeviceTypeDiffImpact struct {
+	DeviceCount     int                                `json:"device_count"`     //devices of the stored device-type, readable by the requesting user
+	AddedCriteria   []models.DeviceGroupFilterCriteria `json:"added_criteria"`   //device-group criteria, only provided by the other device-type
+	RemovedCriteria []models.DeviceGroupFilterCriteria `json:"removed_criteria"` //device-group criteria, only provided by the stored device-type
+	DeviceGroupIds  []string                           `json:"device_group_ids"` //device-groups readable by the requesting user, whose criteria would change
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/google/uuid"
+)
+
+// DeviceTypeTemplate is a partial device-type, that device-types may extend (ref DeviceTypeExtension)
+type DeviceTypeTemplate struct {
+	Id            string                `json:"id" bson:"id"`
+	Name          string                `json:"name" bson:"name"`
+	Description   string                `json:"description" bson:"description"`
+	DeviceClassId string                `json:"device_class_id" bson:"device_class_id"`
+	Attributes    []models.Attribute    `json:"attributes" bson:"attributes"`
+	ServiceGroups []models.ServiceGroup `json:"service_groups" bson:"service_groups"`
+	Services      []models.Service      `json:"services" bson:"services"`
+}
+
+// DeviceTypeTemplateUpdateResult is the stored template and the ids of the re-materialized device-types extending it
+type DeviceTypeTemplateUpdateResult struct {
+	DeviceTypeTemplate
+	UpdatedDeviceTypeIds []string `json:"updated_device_type_ids"`
+}
+
+func (this *DeviceTypeTemplate) GenerateId() {
+	if this.Id == "" {
+		this.Id = URN_PREFIX + "device-type-template:" + uuid.NewString()
+	}
+	for i := range this.Services {
+		this.Services[i].GenerateId()
+	}
+}
+
+// DeviceTypeExtension defines a device-type by a template and explicit overrides
+// the effective device-type (ref DeviceTypeExtension.Materialize) is stored as normal device-type
+type DeviceTypeExtension struct {
+	DeviceTypeId string `json:"device_type_id" bson:"device_type_id"`
+	TemplateId   string `json:"template_id" bson:"template_id"`
+
+	// name, description and device_class_id replace the template values if set
+	// services (by local_id), service_groups (by key) and attributes (by key) replace template elements or are added
+	Overrides models.DeviceType `json:"overrides" bson:"overrides"`
+
+	RemovedServices      []string `json:"removed_services,omitempty" bson:"removed_services"`             //local ids of template services, that are not inherited
+	RemovedServiceGroups []string `json:"removed_service_groups,omitempty" bson:"removed_service_groups"` //keys of template service-groups, that are not inherited
+	RemovedAttributes    []string `json:"removed_attributes,omitempty" bson:"removed_attributes"`         //keys of template attributes, that are not inherited
+}
+
+type DeviceTypeTemplateListOptions struct {
+	Limit  int64  //default 100; 0 lists all
+	Offset int64  //default 0
+	Search string //filter by name
+}
+
+// Materialize returns the effective device-type of the extension
+// inherited services get ids derived from the device-type id and the template ids, to be unique and stable across template changes
+func (this DeviceTypeExtension) Materialize(template DeviceTypeTemplate) models.DeviceType {
+	result := models.DeviceType{
+		Id:            this.DeviceTypeId,
+		Name:          template.Name,
+		Description:   template.Description,
+		DeviceClassId: template.DeviceClassId,
+		Attributes:    []models.Attribute{},
+		ServiceGroups: []models.ServiceGroup{},
+		Services:      []models.Service{},
+	}
+	if this.Overrides.Name != "" {
+		result.Name = this.Overrides.Name
+	}
+	if this.Overrides.Description != "" {
+		result.Description = this.Overrides.Description
+	}
+	if this.Overrides.DeviceClassId != "" {
+		result.DeviceClassId = this.Overrides.DeviceClassId
+	}
+
+	for _, attr := range template.Attributes {
+		if !slices.Contains(this.RemovedAttributes, attr.Key) && !slices.ContainsFunc(this.Overrides.Attributes, func(o models.Attribute) bool { return o.Key == attr.Key }) {
+			result.Attributes = append(result.Attributes, attr)
+		}
+	}
+	result.Attributes = append(result.Attributes, this.Overrides.Attributes...)
+
+	for _, group := range template.ServiceGroups {
+		if !slices.Contains(this.RemovedServiceGroups, group.Key) && !slices.ContainsFunc(this.Overrides.ServiceGroups, func(o models.ServiceGroup) bool { return o.Key == group.Key }) {
+			result.ServiceGroups = append(result.ServiceGroups, group)
+		}
+	}
+	result.ServiceGroups = append(result.ServiceGroups, this.Overrides.ServiceGroups...)
+
+	for _, service := range template.Services {
+		if !slices.Contains(this.RemovedServices, service.LocalId) && !slices.ContainsFunc(this.Overrides.Services, func(o models.Service) bool { return o.LocalId == service.LocalId }) {
+			result.Services = append(result.Services, deriveServiceIds(this.DeviceTypeId, service))
+		}
+	}
+	result.Services = append(result.Services, this.Overrides.Services...)
+	return result
+}
+
+func deriveServiceIds(deviceTypeId string, service models.Service) models.Service {
+	service.Id = deriveId(deviceTypeId, service.Id)
+	service.Inputs = slices.Clone(service.Inputs)
+	for i, content := range service.Inputs {
+		content.Id = deriveId(deviceTypeId, content.Id)
+		content.ContentVariable = deriveVariableIds(deviceTypeId, content.ContentVariable)
+		service.Inputs[i] = content
+	}
+	service.Outputs = slices.Clone(service.Outputs)
+	for i, content := range service.Outputs {
+		content.Id = deriveId(deviceTypeId, content.Id)
+		content.ContentVariable = deriveVariableIds(deviceTypeId, content.ContentVariable)
+		service.Outputs[i] = content
+	}
+	return service
+}
+
+func deriveVariableIds(deviceTypeId string, variable models.ContentVariable) models.ContentVariable {
+	variable.Id = deriveId(deviceTypeId, variable.Id)
+	variable.SubContentVariables = slices.Clone(variable.SubContentVariables)
+	for i, sub := range variable.SubContentVariables {
+		variable.SubContentVariables[i] = deriveVariableIds(deviceTypeId, sub)
+	}
+	return variable
+}
+
+// deriveId keeps the urn prefix of id (e.g. "urn:infai:ses:service:") and replaces the rest with a name based uuid
+func deriveId(deviceTypeId string, id string) string {
+	if id == "" {
+		return ""
+	}
+	prefix := ""
+	if strings.HasPrefix(id, URN_PREFIX) {
+		prefix = id[:strings.LastIndex(id, ":")+1]
+	}
+	return prefix + uuid.NewSHA1(uuid.NameSpaceURL, []byte(deviceTypeId+"\n"+id)).String()
+}
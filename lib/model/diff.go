@@ -0,0 +1,101 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// DiffFields compares the json representations of a and b and returns the sorted json paths of differing fields (e.g. "services[0].name")
+// arrays of different length are reported as a whole
+func DiffFields(a interface{}, b interface{}) ([]string, error) {
+	aValue, err := toJsonValue(a)
+	if err != nil {
+		return nil, err
+	}
+	bValue, err := toJsonValue(b)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	diffJsonValues("", aValue, bValue, &result)
+	slices.Sort(result)
+	return result, nil
+}
+
+func toJsonValue(value interface{}) (result interface{}, err error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &result)
+	return result, err
+}
+
+func diffJsonValues(path string, a interface{}, b interface{}, result *[]string) {
+	switch aValue := a.(type) {
+	case map[string]interface{}:
+		bValue, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for key, element := range aValue {
+			diffJsonValues(joinDiffPath(path, key), element, bValue[key], result)
+		}
+		for key, element := range bValue {
+			if _, known := aValue[key]; !known {
+				diffJsonValues(joinDiffPath(path, key), nil, element, result)
+			}
+		}
+		return
+	case []interface{}:
+		bValue, ok := b.([]interface{})
+		if !ok || len(aValue) != len(bValue) {
+			break
+		}
+		for i := range aValue {
+			diffJsonValues(path+"["+strconv.Itoa(i)+"]", aValue[i], bValue[i], result)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) && !(isEmptyJsonValue(a) && isEmptyJsonValue(b)) {
+		*result = append(*result, path)
+	}
+}
+
+// isEmptyJsonValue treats null, empty lists and empty objects as equal
+func isEmptyJsonValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func joinDiffPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDiffFields(t *testing.T) {
+	a := models.Aspect{Id: "a", Name: "a", SubAspects: []models.Aspect{{Id: "b", Name: "b"}}}
+	b := models.Aspect{Id: "a", Name: "a2", SubAspects: []models.Aspect{{Id: "b", Name: "b2", SubAspects: []models.Aspect{}}}}
+	fields, err := DiffFields(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fields, []string{"name", "sub_aspects[0].name"}) {
+		t.Error(fields)
+	}
+	b.SubAspects = append(b.SubAspects, models.Aspect{Id: "c"})
+	fields, err = DiffFields(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fields, []string{"name", "sub_aspects"}) {
+		t.Error(fields)
+	}
+	fields, err = DiffFields(a, a)
+	if err != nil || len(fields) != 0 {
+		t.Error(fields, err)
+	}
+}
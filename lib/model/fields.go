@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// ParseFields parses the 'fields' query parameter: a comma-separated list of dot-separated json paths (e.g. "id,name,services.id")
+// arrays are traversed transparently, "services.id" selects the id of every service
+// returns nil for an empty parameter, which selects complete elements
+func ParseFields(param string) (fields []string, err error) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		return nil, nil
+	}
+	for _, field := range strings.Split(param, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		for _, segment := range strings.Split(field, ".") {
+			if segment == "" {
+				return nil, NewFieldError(ErrInvalidQueryParameter, "fields", errors.New("invalid field path '"+field+"'"))
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// FieldSelected checks if fields (see ParseFields) select path or any part of it
+// nil fields select everything
+func FieldSelected(fields []string, path string) bool {
+	if fields == nil {
+		return true
+	}
+	for _, field := range fields {
+		if field == path || strings.HasPrefix(field, path+".") || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectFields reduces a json value (as decoded into interface{}) to the given fields (see ParseFields)
+// arrays are projected element wise; nil fields return the value unchanged
+func ProjectFields(value interface{}, fields []string) interface{} {
+	if fields == nil {
+		return value
+	}
+	result, _ := projectFields(value, newFieldTree(fields))
+	return result
+}
+
+// fieldTree maps json keys to sub-selections; a nil sub-selection selects the complete value
+type fieldTree map[string]fieldTree
+
+func newFieldTree(fields []string) fieldTree {
+	root := fieldTree{}
+	for _, field := range fields {
+		node := root
+		segments := strings.Split(field, ".")
+		for i, segment := range segments {
+			child, exists := node[segment]
+			if exists && child == nil {
+				break //parent is already selected completely
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if !exists {
+				child = fieldTree{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	return root
+}
+
+// projectFields returns false if the value has no part selected by tree (e.g. "name.foo" for a string name)
+func projectFields(value interface{}, tree fieldTree) (interface{}, bool) {
+	if tree == nil {
+		return value, true
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			if projected, ok := projectFields(element, tree); ok {
+				result = append(result, projected)
+			}
+		}
+		return result, true
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for key, sub := range tree {
+			if element, exists := v[key]; exists {
+				if projected, ok := projectFields(element, sub); ok {
+					result[key] = projected
+				}
+			}
+		}
+		return result, true
+	case nil:
+		return nil, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields("")
+	if err != nil || fields != nil {
+		t.Error(fields, err)
+	}
+	fields, err = ParseFields(" id, name,services.id,")
+	if err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(fields, []string{"id", "name", "services.id"}) {
+		t.Error(fields)
+	}
+	_, err = ParseFields("id,services..id")
+	if !errors.Is(err, ErrInvalidQueryParameter) {
+		t.Error(err)
+	}
+}
+
+func TestFieldSelected(t *testing.T) {
+	if !FieldSelected(nil, "device_type") {
+		t.Error("nil fields should select everything")
+	}
+	fields := []string{"id", "device_type.name"}
+	if !FieldSelected(fields, "device_type") {
+		t.Error("expect device_type to be selected by sub-path")
+	}
+	if !FieldSelected(fields, "id.foo") {
+		t.Error("expect id.foo to be selected by parent")
+	}
+	if FieldSelected(fields, "name") || FieldSelected(fields, "device") {
+		t.Error("unexpected selection")
+	}
+}
+
+func TestProjectFields(t *testing.T) {
+	var value interface{}
+	err := json.Unmarshal([]byte(`[
+		{"id": "dt1", "name": "foo", "attributes": null, "services": [{"id": "s1", "name": "s"}, {"id": "s2"}]},
+		{"id": "dt2", "name": "bar", "services": []}
+	]`), &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := json.Marshal(ProjectFields(value, []string{"id", "attributes", "services.id", "name.foo", "unknown"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"attributes":null,"id":"dt1","services":[{"id":"s1"},{"id":"s2"}]},{"id":"dt2","services":[]}]`
+	if string(result) != expected {
+		t.Errorf("\n%v\n%v", string(result), expected)
+	}
+
+	result, err = json.Marshal(ProjectFields(value, []string{"services.name", "services"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `[{"services":[{"id":"s1","name":"s"},{"id":"s2"}]},{"services":[]}]`
+	if string(result) != expected {
+		t.Errorf("\n%v\n%v", string(result), expected)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// FunctionDeprecation marks a function as deprecated and names its replacement (ref POST /functions/{id}/migrate)
+type FunctionDeprecation struct {
+	FunctionId            string `json:"function_id" bson:"function_id"`
+	ReplacementFunctionId string `json:"replacement_function_id" bson:"replacement_function_id"`
+
+	// maps characteristic ids of the deprecated functions concept to characteristic ids of the replacement functions concept
+	// required for every used characteristic, if the concepts differ
+	CharacteristicMapping map[string]string `json:"characteristic_mapping,omitempty" bson:"characteristic_mapping"`
+
+	Reason string `json:"reason,omitempty" bson:"reason"`
+}
+
+// FunctionUsage is a content-variable referencing a function
+type FunctionUsage struct {
+	DeviceTypeId        string `json:"device_type_id"`
+	ServiceId           string `json:"service_id"`
+	ContentVariableId   string `json:"content_variable_id"`
+	ContentVariablePath string `json:"content_variable_path"`
+	CharacteristicId    string `json:"characteristic_id,omitempty"`
+}
+
+type FunctionMigrationResult struct {
+	FunctionId            string          `json:"function_id"`
+	ReplacementFunctionId string          `json:"replacement_function_id"`
+	Usages                []FunctionUsage `json:"usages"`                   //migrated usages
+	DeviceTypeIds         []string        `json:"device_type_ids"`          //device-types with rewritten function references
+	DeviceTypeTemplateIds []string        `json:"device_type_template_ids"` //device-type-templates with rewritten function references
+	DeviceGroupIds        []string        `json:"device_group_ids"`         //device-groups with recomputed criteria
+
+	RewrittenDeviceTypeIds         []string `json:"rewritten_device_type_ids"`          //device-types stored by this migration; empty on dry-run
+	RewrittenDeviceTypeTemplateIds []string `json:"rewritten_device_type_template_ids"` //device-type-templates stored by this migration; empty on dry-run
+}
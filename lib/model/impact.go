@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+const (
+	ImpactActionUpdate = "update"
+	ImpactActionDelete = "delete"
+)
+
+// ImpactQuery describes a proposed update or delete of a resource (ref POST /query/impact)
+type ImpactQuery struct {
+	Resource string `json:"resource"` //"concepts", "characteristics", "aspects", "functions", "device-classes" or "device-types"
+	Id       string `json:"id"`
+	Action   string `json:"action"` //"update" or "delete"; defaults to "update"
+
+	//optional proposed device-type for resource="device-types" and action="update"; limits the affected services to changed or removed services
+	DeviceType *models.DeviceType `json:"device_type,omitempty"`
+}
+
+type ImpactResult struct {
+	Resource     string                 `json:"resource"`
+	Id           string                 `json:"id"`
+	Action       string                 `json:"action"`
+	DeviceTypes  []ImpactDeviceType     `json:"device_types"`
+	Devices      []ImpactElement        `json:"devices"`       //devices of affected device-types
+	DeviceGroups []ImpactElement        `json:"device_groups"` //device-groups containing affected devices; their criteria are affected
+	Hubs         []ImpactElement        `json:"hubs"`          //hubs containing affected devices
+	Locations    []ImpactElement        `json:"locations"`     //locations containing affected devices or device-groups
+	Graphs       []ImpactElement        `json:"graphs"`        //graphs with nodes of affected devices
+	Owners       map[string]ImpactCount `json:"owners"`        //counts per owner; elements with multiple owners (device-groups, locations) are counted for every owner
+}
+
+type ImpactDeviceType struct {
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	ServiceIds []string `json:"service_ids"` //affected services
+}
+
+type ImpactElement struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	OwnerIds []string `json:"owner_ids"` //owner of devices, hubs and graphs; users with administrate rights for device-groups and locations
+}
+
+type ImpactCount struct {
+	Devices      int `json:"devices"`
+	DeviceGroups int `json:"device_groups"`
+	Hubs         int `json:"hubs"`
+	Locations    int `json:"locations"`
+	Graphs       int `json:"graphs"`
+}
@@ -32,12 +32,17 @@ type ImportExport struct {
 	DeviceClasses   []models.DeviceClass    `json:"device_classes,omitempty"`
 	DeviceTypes     []models.DeviceType     `json:"device_types,omitempty"`
 
+	DeviceTypeTemplates  []DeviceTypeTemplate  `json:"device_type_templates,omitempty"`
+	DeviceTypeExtensions []DeviceTypeExtension `json:"device_type_extensions,omitempty"` //exported and imported with 'device-types'; filtered by DeviceTypeId
+
 	//include_owned_information == true
 	Devices      []models.Device        `json:"devices,omitempty"`
 	DeviceGroups []models.DeviceGroup   `json:"device_groups,omitempty"`
 	Hubs         []models.Hub           `json:"hubs,omitempty"`
 	Locations    []models.Location      `json:"locations,omitempty"`
 	Permissions  []permissions.Resource `json:"permissions,omitempty"`
+
+	DeviceConfigurations map[string][]DeviceConfigurationValue `json:"device_configurations,omitempty"` //device id -> configuration values (ref PUT /devices/{id}/configuration); exported and imported with 'devices'
 }
 
 type ImportExportOptions struct {
@@ -46,6 +51,26 @@ type ImportExportOptions struct {
 	FilterIds               []string `json:"filter_ids,omitempty"`            //ref ids of any resource-type; null->all; []->none
 }
 
+const (
+	ImportActionCreate    = "create"
+	ImportActionUpdate    = "update"
+	ImportActionUnchanged = "unchanged"
+)
+
+// ImportResult lists the changes of an import; with DryRun no change has been applied
+type ImportResult struct {
+	DryRun  bool           `json:"dry_run"`
+	Changes []ImportChange `json:"changes"`
+}
+
+type ImportChange struct {
+	ResourceType string   `json:"resource_type"` //plural like the http endpoints, e.g. 'aspects'
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	Action       string   `json:"action"`           //ImportActionCreate, ImportActionUpdate or ImportActionUnchanged
+	Fields       []string `json:"fields,omitempty"` //json paths of changed fields, if Action == ImportActionUpdate
+}
+
 type ImportFromOptions struct {
 	FilterResourceTypes    []string `json:"filter_resource_types,omitempty"` //ref resource types-like 'device-types' similar to http-endpoints; null->all; []->none
 	FilterIds              []string `json:"filter_ids,omitempty"`            //ref ids of any resource-type; null->all; []->none
@@ -75,6 +100,12 @@ func (this *ImportExport) Sort() {
 	slices.SortFunc(this.DeviceTypes, func(a, b models.DeviceType) int {
 		return strings.Compare(a.Id, b.Id)
 	})
+	slices.SortFunc(this.DeviceTypeTemplates, func(a, b DeviceTypeTemplate) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	slices.SortFunc(this.DeviceTypeExtensions, func(a, b DeviceTypeExtension) int {
+		return strings.Compare(a.DeviceTypeId, b.DeviceTypeId)
+	})
 	slices.SortFunc(this.Devices, func(a, b models.Device) int {
 		return strings.Compare(a.Id, b.Id)
 	})
@@ -91,3 +122,23 @@ func (this *ImportExport) Sort() {
 		return strings.Compare(a.Id, b.Id)
 	})
 }
+
+type WotImportOptions struct {
+	ProtocolId string //protocol used by all services of the device-type
+	Save       bool   //save the device-type; otherwise the draft is only returned for review
+}
+
+// WotImportResult contains the device-type draft created from a WoT Thing Model
+type WotImportResult struct {
+	Saved             bool                  `json:"saved"`
+	DeviceType        models.DeviceType     `json:"device_type"`
+	Suggestions       []WotImportSuggestion `json:"suggestions"`                  //functions and aspects set in DeviceType by name matching
+	ValidationProblem *Problem              `json:"validation_problem,omitempty"` //set if the draft is not yet valid
+}
+
+type WotImportSuggestion struct {
+	ServiceLocalId      string `json:"service_local_id"`
+	ContentVariablePath string `json:"content_variable_path"`
+	FunctionId          string `json:"function_id,omitempty"`
+	AspectId            string `json:"aspect_id,omitempty"`
+}
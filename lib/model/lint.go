@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// LintFinding is a single rule violation; rules and severities are defined in the configuration package (ref configuration.LintRuleValidation)
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"` // "error" or "warning"
+	Field    string `json:"field,omitempty"`
+	Message  string `json:"message"`
+}
+
+type LintResult struct {
+	DeviceTypeId   string        `json:"device_type_id"`
+	DeviceTypeName string        `json:"device_type_name"`
+	Errors         int           `json:"errors"`
+	Warnings       int           `json:"warnings"`
+	Findings       []LintFinding `json:"findings"`
+}
+
+type DeviceTypeLintListOptions struct {
+	Limit      int64  //default 100; limit of checked device-types, not of results
+	Offset     int64  //default 0
+	SortBy     string //default name.asc
+	Validation ValidationOptions
+}
@@ -63,6 +63,7 @@ type ServicePathOption struct {
 	Configurables         []Configurable     `json:"configurables,omitempty"`
 	Type                  models.Type        `json:"type,omitempty"`
 	Interaction           models.Interaction `json:"interaction"`
+	Constraints           *ValueConstraints  `json:"constraints,omitempty"` //constraints of the characteristic, nil if unconstrained
 }
 
 type Configurable struct {
@@ -72,6 +73,7 @@ type Configurable struct {
 	FunctionId       string            `json:"function_id"`
 	Value            interface{}       `json:"value,omitempty"`
 	Type             models.Type       `json:"type,omitempty"`
+	Constraints      *ValueConstraints `json:"constraints,omitempty"` //constraints of the characteristic, nil if unconstrained
 }
 
 type FunctionList struct {
@@ -16,7 +16,10 @@
 
 package model
 
-import "github.com/SENERGY-Platform/models/go/models"
+import (
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/models/go/models"
+)
 
 type DeviceListOptions struct {
 	Ids                      []string                //filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;
@@ -32,15 +35,18 @@ type DeviceListOptions struct {
 	AttributeKeys            []string              //filter; ignored if nil; AttributeKeys and AttributeValues are independently evaluated, needs local filtering if a search like "attr1"="value1" is needed
 	AttributeValues          []string              //filter; ignored if nil; AttributeKeys and AttributeValues are independently evaluated, needs local filtering if a search like "attr1"="value1" is needed
 	DeviceAttributeBlacklist []models.Attribute    //filter; attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted
+	Fields                   []string              //optional projection, see ParseFields; nil selects complete elements; elements may contain additional fields
 }
 
 type LocationListOptions struct {
-	Ids        []string //filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;
-	Search     string
-	Limit      int64                 //default 100, will be ignored if 'ids' is set (Ids != nil)
-	Offset     int64                 //default 0, will be ignored if 'ids' is set (Ids != nil)
-	SortBy     string                //default name.asc
-	Permission models.PermissionFlag //defaults to read
+	Ids            []string //filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;
+	DeviceIds      []string //filter; find locations with any of the listed devices or any of the listed DeviceGroupIds
+	DeviceGroupIds []string //filter; find locations with any of the listed device-groups or any of the listed DeviceIds
+	Search         string
+	Limit          int64                 //default 100, will be ignored if 'ids' is set (Ids != nil)
+	Offset         int64                 //default 0, will be ignored if 'ids' is set (Ids != nil)
+	SortBy         string                //default name.asc
+	Permission     models.PermissionFlag //defaults to read
 }
 
 type FunctionListOptions struct {
@@ -100,6 +106,7 @@ type ExtendedDeviceListOptions struct {
 	AttributeValues          []string              //filter; ignored if nil; AttributeKeys and AttributeValues are independently evaluated, needs local filtering if a search like "attr1"="value1" is needed
 	FullDt                   bool                  //if true, result contains full device-type
 	DeviceAttributeBlacklist []models.Attribute    //filter; attribute value and origin will only be checked if set, otherwise all values or origins will be blacklisted
+	Fields                   []string              //optional projection, see ParseFields; nil selects complete elements; elements may contain additional fields; FullDt is ignored if no device_type field is selected
 }
 
 func (this ExtendedDeviceListOptions) ToDeviceListOptions() DeviceListOptions {
@@ -117,6 +124,7 @@ func (this ExtendedDeviceListOptions) ToDeviceListOptions() DeviceListOptions {
 		Owner:                    this.Owner,
 		LocalIds:                 this.LocalIds,
 		DeviceAttributeBlacklist: this.DeviceAttributeBlacklist,
+		Fields:                   this.Fields,
 	}
 }
 
@@ -144,6 +152,7 @@ type DeviceTypeListOptions struct {
 	ProtocolIds      []string
 	IncludeModified  bool
 	IgnoreUnmodified bool
+	Fields           []string //optional projection, see ParseFields; nil selects complete elements; elements may contain additional fields; ignored if IncludeModified is set
 }
 
 type DeviceGroupListOptions struct {
@@ -167,6 +176,14 @@ type DeviceUpdateOptions struct {
 
 type DeviceTypeUpdateOptions struct {
 	DistinctAttributes []string
+	Lint               *bool //reject device-types with lint errors; defaults to configuration.Config.LintDeviceTypesOnSetDefault
+}
+
+func (this DeviceTypeUpdateOptions) CheckLint(defaults configuration.Config) bool {
+	if this.Lint != nil {
+		return *this.Lint
+	}
+	return defaults.LintDeviceTypesOnSetDefault
 }
 
 type HubUpdateOptions struct {
@@ -183,3 +200,16 @@ type GraphListOptions struct {
 	Permission models.PermissionFlag //defaults to read
 	Attributes []models.Attribute
 }
+
+type WebhookListOptions struct {
+	Limit           int64  //default 100; 0 lists all
+	Offset          int64  //default 0
+	OwnerId         string //filter; set to the requesting user for non-admins
+	ResourceType    string //filter
+	ExcludeDisabled bool
+}
+
+type WebhookDeliveryListOptions struct {
+	Limit  int64 //default 100; 0 lists all
+	Offset int64 //default 0
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+const (
+	PayloadDirectionInput  = "input"
+	PayloadDirectionOutput = "output"
+)
+
+type PayloadValidationOptions struct {
+	Direction string //PayloadDirectionInput or PayloadDirectionOutput; defaults to PayloadDirectionOutput
+	ContentId string //may be omitted if the service has only one non-void content in Direction
+}
+
+// PayloadValidationResult lists the mismatches between a payload and a service content
+// the Field of an error is the path in the payload, starting with the name of the content-variable (e.g. "color.values[0]")
+type PayloadValidationResult struct {
+	Valid     bool           `json:"valid"`
+	ContentId string         `json:"content_id"`
+	Errors    []ProblemField `json:"errors"`
+}
+
+type ServiceExample struct {
+	ServiceId string           `json:"service_id"`
+	LocalId   string           `json:"local_id"`
+	Name      string           `json:"name"`
+	Inputs    []ContentExample `json:"inputs"`
+	Outputs   []ContentExample `json:"outputs"`
+}
+
+// ContentExample contains an example message of a non-void content, encoded with the serialization of the content
+type ContentExample struct {
+	ContentId           string               `json:"content_id"`
+	ProtocolSegmentId   string               `json:"protocol_segment_id"`
+	ProtocolSegmentName string               `json:"protocol_segment_name,omitempty"`
+	Serialization       models.Serialization `json:"serialization"`
+	Payload             string               `json:"payload"`
+	PayloadEncoding     string               `json:"payload_encoding,omitempty"` //"base64" for binary serializations (ref SerializationInfo.Binary)
+}
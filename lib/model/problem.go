@@ -0,0 +1,270 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrorCode is a stable, machine-readable identifier of an error cause.
+// ErrorCode implements error, so that it may be used as target of errors.Is(), e.g. errors.Is(err, model.ErrDeviceLocalIdConflict)
+type ErrorCode string
+
+func (this ErrorCode) Error() string {
+	return string(this)
+}
+
+// generic codes, used if an error has no specific code
+const (
+	ErrBadRequest   ErrorCode = "bad_request"
+	ErrUnauthorized ErrorCode = "unauthorized"
+	ErrForbidden    ErrorCode = "forbidden"
+	ErrNotFoundCode ErrorCode = "not_found" //ErrNotFound is the sentinel error used by the controller and database
+	ErrConflict     ErrorCode = "conflict"
+	ErrTimeout      ErrorCode = "timeout"
+	ErrInternal     ErrorCode = "internal"
+	ErrUnavailable  ErrorCode = "unavailable"
+	ErrUnknown      ErrorCode = "unknown"
+)
+
+// request errors
+const (
+	ErrInvalidQueryParameter ErrorCode = "request.invalid_query_parameter"
+	ErrInvalidBody           ErrorCode = "request.invalid_body"
+	ErrDryRunRequired        ErrorCode = "request.dry_run_required"
+	ErrIdMismatch            ErrorCode = "request.id_mismatch"
+	ErrPresetId              ErrorCode = "request.preset_id"
+	ErrMissingId             ErrorCode = "request.missing_id"
+)
+
+// validation errors
+const (
+	ErrMissingField ErrorCode = "validation.missing_field"
+	ErrInvalidField ErrorCode = "validation.invalid_field"
+
+	ErrDeviceLocalIdConflict ErrorCode = "device.local_id_conflict"
+	ErrDeviceInvalidLocalId  ErrorCode = "device.invalid_local_id"
+
+	ErrDeviceConfigurationUnknownService ErrorCode = "device.configuration_unknown_service"
+	ErrDeviceConfigurationUnknownPath    ErrorCode = "device.configuration_unknown_path"
+	ErrDeviceConfigurationDuplicate      ErrorCode = "device.configuration_duplicate"
+	ErrDeviceConfigurationInvalidValue   ErrorCode = "device.configuration_invalid_value"
+
+	ErrDeviceTypeUnknownAspect              ErrorCode = "device_type.unknown_aspect"
+	ErrDeviceTypeNoneLeafAspect             ErrorCode = "device_type.none_leaf_aspect"
+	ErrDeviceTypeUnknownFunction            ErrorCode = "device_type.unknown_function"
+	ErrDeviceTypeFunctionUse                ErrorCode = "device_type.invalid_function_use"
+	ErrDeviceTypeCharacteristicMismatch     ErrorCode = "device_type.characteristic_function_mismatch"
+	ErrDeviceTypeUnknownProtocol            ErrorCode = "device_type.unknown_protocol"
+	ErrDeviceTypeUnknownProtocolSegment     ErrorCode = "device_type.unknown_protocol_segment"
+	ErrDeviceTypeUnknownSerialization       ErrorCode = "device_type.unknown_serialization"
+	ErrDeviceTypeSerializationMismatch      ErrorCode = "device_type.serialization_mismatch"
+	ErrDeviceTypeInvalidSerializationOption ErrorCode = "device_type.invalid_serialization_option"
+	ErrDeviceTypeReusedServiceId            ErrorCode = "device_type.reused_service_id"
+	ErrDeviceTypeReusedContentName          ErrorCode = "device_type.reused_content_name"
+	ErrDeviceTypeInvalidServiceLocalId      ErrorCode = "device_type.invalid_service_local_id"
+	ErrDeviceTypeInvalidServiceGroup        ErrorCode = "device_type.invalid_service_group"
+	ErrDeviceTypeInvalidContentVariable     ErrorCode = "device_type.invalid_content_variable"
+	ErrDeviceTypeInvalidContentVariableName ErrorCode = "device_type.invalid_content_variable_name"
+	ErrDeviceTypeCharacteristicTypeMismatch ErrorCode = "device_type.characteristic_type_mismatch"
+	ErrDeviceTypeInvalidValue               ErrorCode = "device_type.invalid_value"
+
+	ErrDeviceGroupUnknownAspect ErrorCode = "device_group.unknown_aspect"
+
+	ErrDeviceTypeInUse           ErrorCode = "device_type.in_use"
+	ErrDeviceTypeExtendsTemplate ErrorCode = "device_type.extends_template"
+	ErrDeviceTypeLint            ErrorCode = "device_type.lint"
+	ErrDeviceTypeTemplateInUse   ErrorCode = "device_type_template.in_use"
+	ErrAspectInUse               ErrorCode = "aspect.in_use"
+	ErrAspectInvalidMove         ErrorCode = "aspect.invalid_move"
+
+	ErrFunctionNotDeprecated                ErrorCode = "function.not_deprecated"
+	ErrFunctionInvalidReplacement           ErrorCode = "function.invalid_replacement"
+	ErrFunctionMissingCharacteristicMapping ErrorCode = "function.missing_characteristic_mapping"
+
+	ErrCharacteristicInvalidConstraint ErrorCode = "characteristic.invalid_constraint"
+)
+
+// payload validation errors (ref POST /services/{id}/validate-payload)
+const (
+	ErrPayloadInvalidSerialization ErrorCode = "payload.invalid_serialization"
+	ErrPayloadTypeMismatch         ErrorCode = "payload.type_mismatch"
+	ErrPayloadMissingField         ErrorCode = "payload.missing_field"
+	ErrPayloadUnexpectedField      ErrorCode = "payload.unexpected_field"
+	ErrPayloadOutOfRange           ErrorCode = "payload.out_of_range"
+	ErrPayloadNotAllowedValue      ErrorCode = "payload.not_allowed_value"
+	ErrPayloadPatternMismatch      ErrorCode = "payload.pattern_mismatch"
+)
+
+// ErrorCodeFromStatus returns the generic ErrorCode of a http status code
+func ErrorCodeFromStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrBadRequest
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFoundCode
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return ErrTimeout
+	case http.StatusServiceUnavailable:
+		return ErrUnavailable
+	}
+	if status >= 500 {
+		return ErrInternal
+	}
+	return ErrUnknown
+}
+
+// CodedError annotates an error with an ErrorCode and the json path of the invalid field (e.g. "services[0].inputs[1].content_variable.aspect_id")
+type CodedError struct {
+	Code  ErrorCode
+	Field string
+	Err   error
+}
+
+func NewError(code ErrorCode, err error) *CodedError {
+	return &CodedError{Code: code, Err: err}
+}
+
+func NewFieldError(code ErrorCode, field string, err error) *CodedError {
+	return &CodedError{Code: code, Field: field, Err: err}
+}
+
+func (this *CodedError) Error() string {
+	if this.Err == nil {
+		return string(this.Code)
+	}
+	return this.Err.Error()
+}
+
+func (this *CodedError) Unwrap() []error {
+	result := []error{}
+	if this.Err != nil {
+		result = append(result, this.Err)
+	}
+	if this.Code != "" {
+		result = append(result, this.Code)
+	}
+	return result
+}
+
+// PrefixErrorField prepends prefix to the field path of err
+// is used by nested validations, e.g. PrefixErrorField(err, fmt.Sprintf("services[%v]", i))
+func PrefixErrorField(err error, prefix string) error {
+	if err == nil || prefix == "" {
+		return err
+	}
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		if coded == err {
+			return &CodedError{Code: coded.Code, Field: joinFieldPath(prefix, coded.Field), Err: coded.Err}
+		}
+		return &CodedError{Code: coded.Code, Field: joinFieldPath(prefix, coded.Field), Err: err}
+	}
+	return &CodedError{Field: prefix, Err: err}
+}
+
+func joinFieldPath(prefix string, field string) string {
+	if field == "" {
+		return prefix
+	}
+	if strings.HasPrefix(field, "[") {
+		return prefix + field
+	}
+	return prefix + "." + field
+}
+
+const ProblemContentType = "application/problem+json"
+const ProblemTypePrefix = "urn:device-repository:error:"
+
+// Problem is a RFC 7807 problem detail, returned by the api on errors
+// Problem implements error and is returned by lib/client; use errors.As() to access it or errors.Is() to check its Code
+type Problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail,omitempty"`
+	Code   ErrorCode      `json:"code"`
+	Errors []ProblemField `json:"errors,omitempty"`
+}
+
+type ProblemField struct {
+	Field  string    `json:"field"`
+	Code   ErrorCode `json:"code,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+func NewProblem(err error, status int) Problem {
+	result := Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   ErrorCodeFromStatus(status),
+	}
+	if err == nil {
+		result.Type = ProblemTypePrefix + string(result.Code)
+		return result
+	}
+	result.Detail = err.Error()
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		if coded.Code != "" {
+			result.Code = coded.Code
+		}
+		if coded.Field != "" {
+			result.Errors = []ProblemField{{Field: coded.Field, Code: coded.Code, Detail: coded.Error()}}
+		}
+	} else {
+		var code ErrorCode
+		if errors.As(err, &code) {
+			result.Code = code
+		}
+	}
+	result.Type = ProblemTypePrefix + string(result.Code)
+	return result
+}
+
+func (this *Problem) Error() string {
+	if this.Detail != "" {
+		return this.Detail
+	}
+	return this.Title
+}
+
+func (this *Problem) Unwrap() error {
+	if this.Code == "" {
+		return nil
+	}
+	return this.Code
+}
+
+// Is allows errors.Is(problem, model.ErrNotFound) and errors.Is(problem, model.PermissionCheckFailed)
+func (this *Problem) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return this.Status == http.StatusNotFound
+	case PermissionCheckFailed:
+		return this.Status == http.StatusForbidden
+	}
+	return false
+}
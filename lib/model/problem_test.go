@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestProblem(t *testing.T) {
+	var err error = NewFieldError(ErrDeviceTypeUnknownAspect, "aspect_id", errors.New("unknown aspect id:foo"))
+	err = PrefixErrorField(err, "sub_content_variables[1]")
+	err = PrefixErrorField(err, "content_variable")
+	err = PrefixErrorField(err, "inputs[0]")
+	err = PrefixErrorField(err, "services[2]")
+
+	if !errors.Is(err, ErrDeviceTypeUnknownAspect) {
+		t.Error("expect errors.Is(err, ErrDeviceTypeUnknownAspect)")
+	}
+
+	problem := NewProblem(err, http.StatusBadRequest)
+	if problem.Code != ErrDeviceTypeUnknownAspect {
+		t.Errorf("%#v", problem)
+	}
+	if len(problem.Errors) != 1 || problem.Errors[0].Field != "services[2].inputs[0].content_variable.sub_content_variables[1].aspect_id" {
+		t.Errorf("%#v", problem.Errors)
+	}
+	if problem.Detail != "unknown aspect id:foo" {
+		t.Errorf("%#v", problem.Detail)
+	}
+
+	//problem as received by the client
+	b, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := &Problem{}
+	err = json.Unmarshal(b, received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = received
+	if !errors.Is(err, ErrDeviceTypeUnknownAspect) {
+		t.Error("expect errors.Is(err, ErrDeviceTypeUnknownAspect)")
+	}
+	if errors.Is(err, ErrDeviceLocalIdConflict) {
+		t.Error("unexpected errors.Is(err, ErrDeviceLocalIdConflict)")
+	}
+	var p *Problem
+	if !errors.As(err, &p) || p.Status != http.StatusBadRequest {
+		t.Errorf("%#v", p)
+	}
+}
+
+func TestGenericProblem(t *testing.T) {
+	problem := NewProblem(errors.New("not found"), http.StatusNotFound)
+	if problem.Code != ErrNotFoundCode || problem.Type != ProblemTypePrefix+"not_found" || problem.Errors != nil {
+		t.Errorf("%#v", problem)
+	}
+	var err error = &problem
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expect errors.Is(err, ErrNotFound)")
+	}
+	if !errors.Is(err, ErrNotFoundCode) {
+		t.Error("expect errors.Is(err, ErrNotFoundCode)")
+	}
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "github.com/SENERGY-Platform/models/go/models"
+
+// SerializationInfo describes a registered content serialization (ref GET /serializations)
+type SerializationInfo struct {
+	Key          models.Serialization  `json:"key"`
+	Description  string                `json:"description"`
+	MediaType    string                `json:"media_type"`
+	Binary       bool                  `json:"binary"`        //messages are no text; example payloads are base64 encoded
+	StringValues bool                  `json:"string_values"` //primitive values are decoded as strings (e.g. xml)
+	Options      []SerializationOption `json:"options"`       //serialization_options of content-variables, used as "key=value"
+}
+
+type SerializationOption struct {
+	Key         string   `json:"key"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`         //required for non-void primitive content-variables
+	Values      []string `json:"values,omitempty"` //allowed values, if the option is an enumeration
+}
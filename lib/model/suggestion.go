@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// ModellingSuggestion proposes annotations for a content-variable of a draft device-type (ref POST /device-types/modelling-suggestions)
+type ModellingSuggestion struct {
+	ServiceId           string               `json:"service_id,omitempty"`
+	ServiceLocalId      string               `json:"service_local_id"`
+	ContentVariableId   string               `json:"content_variable_id,omitempty"`
+	ContentVariablePath string               `json:"content_variable_path"`
+	IsInput             bool                 `json:"is_input"`
+	Support             int                  `json:"support"` //count of similar annotated content-variables in the repository
+	Functions           []SuggestedReference `json:"functions"`
+	Aspects             []SuggestedReference `json:"aspects"`
+	Characteristics     []SuggestedReference `json:"characteristics"`
+}
+
+type SuggestedReference struct {
+	Id         string  `json:"id"`
+	Confidence float64 `json:"confidence"` //between 0 and 1
+	Support    int     `json:"support"`    //count of similar content-variables annotated with this id
+}
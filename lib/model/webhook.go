@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	WebhookEventSet    = "set"
+	WebhookEventDelete = "delete"
+)
+
+const (
+	WebhookResourceDevices         = "devices"
+	WebhookResourceDeviceTypes     = "device-types"
+	WebhookResourceDeviceGroups    = "device-groups"
+	WebhookResourceHubs            = "hubs"
+	WebhookResourceProtocols       = "protocols"
+	WebhookResourceConcepts        = "concepts"
+	WebhookResourceCharacteristics = "characteristics"
+	WebhookResourceAspects         = "aspects"
+	WebhookResourceFunctions       = "functions"
+	WebhookResourceDeviceClasses   = "device-classes"
+	WebhookResourceLocations       = "locations"
+)
+
+var WebhookResourceTypes = []string{
+	WebhookResourceDevices,
+	WebhookResourceDeviceTypes,
+	WebhookResourceDeviceGroups,
+	WebhookResourceHubs,
+	WebhookResourceProtocols,
+	WebhookResourceConcepts,
+	WebhookResourceCharacteristics,
+	WebhookResourceAspects,
+	WebhookResourceFunctions,
+	WebhookResourceDeviceClasses,
+	WebhookResourceLocations,
+}
+
+// headers set on every webhook notification request
+const (
+	WebhookHeaderSignature = "X-Webhook-Signature" //"sha256=" + hex encoded HMAC-SHA256 of WebhookHeaderTimestamp + "." + body, keyed with Webhook.Secret; missing if the webhook has no secret
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp" //unix seconds
+	WebhookHeaderId        = "X-Webhook-Id"
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+)
+
+type Webhook struct {
+	Id                  string        `json:"id" bson:"id"`
+	OwnerId             string        `json:"owner_id" bson:"owner_id"`
+	Url                 string        `json:"url" bson:"url"`
+	ResourceTypes       []string      `json:"resource_types" bson:"resource_types"` //values of WebhookResourceTypes
+	Filter              WebhookFilter `json:"filter" bson:"filter"`
+	Secret              string        `json:"secret,omitempty" bson:"secret"` //write only; used to sign notifications; an empty secret on update keeps the stored one
+	Disabled            bool          `json:"disabled" bson:"disabled"`
+	DisabledReason      string        `json:"disabled_reason,omitempty" bson:"disabled_reason"`
+	ConsecutiveFailures int64         `json:"consecutive_failures" bson:"consecutive_failures"` //read only; reset on successful delivery or when the webhook is re-enabled
+}
+
+type WebhookFilter struct {
+	Ids    []string `json:"ids,omitempty" bson:"ids"`       //optional; only notify for these resource ids
+	Events []string `json:"events,omitempty" bson:"events"` //optional; WebhookEventSet and/or WebhookEventDelete; defaults to all events
+}
+
+func (this *Webhook) GenerateId() {
+	this.Id = "urn:infai:ses:webhook:" + uuid.NewString()
+}
+
+// Matches checks the resource type and filter of the webhook
+// permissions are not checked
+func (this Webhook) Matches(event string, resourceType string, resourceId string) bool {
+	if !slices.Contains(this.ResourceTypes, resourceType) {
+		return false
+	}
+	if len(this.Filter.Events) > 0 && !slices.Contains(this.Filter.Events, event) {
+		return false
+	}
+	if len(this.Filter.Ids) > 0 && !slices.Contains(this.Filter.Ids, resourceId) {
+		return false
+	}
+	return true
+}
+
+// WebhookNotification is the json body sent to the webhook url
+type WebhookNotification struct {
+	Id           string      `json:"id"` //equal to WebhookDelivery.Id and the WebhookHeaderDelivery header; stays the same for retries
+	WebhookId    string      `json:"webhook_id"`
+	Event        string      `json:"event"`
+	ResourceType string      `json:"resource_type"`
+	ResourceId   string      `json:"resource_id"`
+	Time         time.Time   `json:"time"`
+	Resource     interface{} `json:"resource,omitempty"` //missing for delete events of resources that are only known by id
+}
+
+// WebhookDelivery logs the outcome of a notification, after all retries
+type WebhookDelivery struct {
+	Id           string    `json:"id" bson:"id"`
+	WebhookId    string    `json:"webhook_id" bson:"webhook_id"`
+	Event        string    `json:"event" bson:"event"`
+	ResourceType string    `json:"resource_type" bson:"resource_type"`
+	ResourceId   string    `json:"resource_id" bson:"resource_id"`
+	Success      bool      `json:"success" bson:"success"`
+	Attempts     int64     `json:"attempts" bson:"attempts"`
+	StatusCode   int       `json:"status_code,omitempty" bson:"status_code"` //status code of the last attempt
+	Error        string    `json:"error,omitempty" bson:"error"`             //error of the last attempt
+	Time         time.Time `json:"time" bson:"time"`                         //time of the last attempt
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package payload decodes and validates raw device messages against service contents
+package payload
+
+import (
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// Decode decodes a raw message with the registered serialization of content (ref serialization.Serialization.Decode)
+// xml documents are returned as map with the root element name as single key
+func Decode(content models.Content, message []byte) (result interface{}, err error) {
+	return serialization.Decode(content, message)
+}
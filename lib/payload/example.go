@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package payload
+
+import (
+	"math"
+
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// Example returns an example value for variable, using (in this order) the fixed value of the variable,
+// the value of its characteristic, the first allowed value of its characteristic, the center of the characteristic
+// min/max range and a default value of the variable type
+// structures contain all non-void sub variables; the placeholder '*' is used with the key "key" and lists of variable length contain one element
+func Example(variable models.ContentVariable, characteristics map[string]models.Characteristic) interface{} {
+	if variable.Value != nil {
+		return variable.Value
+	}
+	switch variable.Type {
+	case models.Structure:
+		result := map[string]interface{}{}
+		for _, sub := range variable.SubContentVariables {
+			if sub.IsVoid {
+				continue
+			}
+			key := sub.Name
+			if key == "*" {
+				key = "key"
+			}
+			result[key] = Example(sub, characteristics)
+		}
+		return result
+	case models.List:
+		result := []interface{}{}
+		for _, sub := range variable.SubContentVariables {
+			result = append(result, Example(sub, characteristics))
+		}
+		return result
+	}
+	characteristic, ok := characteristics[variable.CharacteristicId]
+	if ok && variable.CharacteristicId != "" {
+		if characteristic.Value != nil {
+			return characteristic.Value
+		}
+		if len(characteristic.AllowedValues) > 0 {
+			return characteristic.AllowedValues[0]
+		}
+		if variable.Type == models.Integer || variable.Type == models.Float {
+			min, hasMin := toFloat(characteristic.MinValue)
+			max, hasMax := toFloat(characteristic.MaxValue)
+			var value float64
+			switch {
+			case hasMin && hasMax:
+				value = min + (max-min)/2
+			case hasMin:
+				value = min
+			case hasMax:
+				value = max
+			}
+			if variable.Type == models.Integer {
+				return int64(math.Round(value))
+			}
+			return value
+		}
+	}
+	switch variable.Type {
+	case models.String:
+		return "string"
+	case models.Integer:
+		return 0
+	case models.Float:
+		return 0.0
+	case models.Boolean:
+		return false
+	}
+	return nil
+}
+
+// ExampleContent renders an example message of content in its serialization (ref Example, Encode)
+func ExampleContent(content models.Content, characteristics map[string]models.Characteristic) ([]byte, error) {
+	return Encode(content, Example(content.ContentVariable, characteristics))
+}
+
+// Encode renders value with the registered serialization of content (ref serialization.Serialization.Encode)
+func Encode(content models.Content, value interface{}) ([]byte, error) {
+	return serialization.Encode(content, value)
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package payload
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+var testVariable = models.ContentVariable{
+	Name: "color",
+	Type: models.Structure,
+	SubContentVariables: []models.ContentVariable{
+		{Name: "r", Type: models.Integer, CharacteristicId: "urn:infai:ses:characteristic:color-r"},
+		{Name: "mode", Type: models.String, CharacteristicId: "urn:infai:ses:characteristic:color-mode", OmitEmpty: true},
+		{Name: "on", Type: models.Boolean},
+		{Name: "tags", Type: models.List, OmitEmpty: true, SubContentVariables: []models.ContentVariable{{Name: "*", Type: models.String}}},
+		{Name: "point", Type: models.List, OmitEmpty: true, SubContentVariables: []models.ContentVariable{{Name: "0", Type: models.Float}, {Name: "1", Type: models.Float}}},
+	},
+}
+
+var testCharacteristics = map[string]models.Characteristic{
+	"urn:infai:ses:characteristic:color-r":    {Name: "r", MinValue: 0.0, MaxValue: 255.0},
+	"urn:infai:ses:characteristic:color-mode": {Name: "mode", AllowedValues: []interface{}{"rgb", "hsb"}},
+}
+
+func TestValidateContentJson(t *testing.T) {
+	content := models.Content{Serialization: models.JSON, ContentVariable: testVariable}
+	t.Run("valid", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`{"r": 255, "mode": "rgb", "on": true, "tags": ["a", "b"], "point": [1.5, 2]}`), testCharacteristics, nil)
+		if len(result) != 0 {
+			t.Error(result)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`{"r": 256.5, "mode": "cmyk", "tags": ["a", 1], "point": [1, 2, 3], "foo": null}`), testCharacteristics, nil)
+		expected := []model.ProblemField{
+			{Field: "color.r", Code: model.ErrPayloadTypeMismatch, Detail: "expected integer, got number 256.5"},
+			{Field: "color.mode", Code: model.ErrPayloadNotAllowedValue, Detail: "value cmyk is not in the allowed values [rgb hsb] of mode"},
+			{Field: "color.on", Code: model.ErrPayloadMissingField, Detail: "missing field on"},
+			{Field: "color.tags[1]", Code: model.ErrPayloadTypeMismatch, Detail: "expected string, got number 1"},
+			{Field: "color.point[2]", Code: model.ErrPayloadUnexpectedField, Detail: "unexpected list element 2"},
+			{Field: "color.foo", Code: model.ErrPayloadUnexpectedField, Detail: "unexpected field foo"},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("\n%#v\n%#v", result, expected)
+		}
+	})
+	t.Run("out of range", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`{"r": -1, "on": false}`), testCharacteristics, nil)
+		if len(result) != 1 || result[0].Code != model.ErrPayloadOutOfRange || result[0].Field != "color.r" {
+			t.Error(result)
+		}
+	})
+	t.Run("invalid json", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`{"r": 1`), testCharacteristics, nil)
+		if len(result) != 1 || result[0].Code != model.ErrPayloadInvalidSerialization {
+			t.Error(result)
+		}
+	})
+}
+
+func TestValidateContentXml(t *testing.T) {
+	content := models.Content{Serialization: models.XML, ContentVariable: testVariable}
+	t.Run("valid", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`<color><r>12</r><on>true</on><tags>a</tags><point>1.5</point><point>2</point></color>`), testCharacteristics, nil)
+		if len(result) != 0 {
+			t.Error(result)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`<color unit="x"><r>1.5</r><on>yes</on></color>`), testCharacteristics, nil)
+		expected := []model.ProblemField{
+			{Field: "color.r", Code: model.ErrPayloadTypeMismatch, Detail: `expected integer, got "1.5"`},
+			{Field: "color.on", Code: model.ErrPayloadTypeMismatch, Detail: `expected boolean, got "yes"`},
+			{Field: "color.-unit", Code: model.ErrPayloadUnexpectedField, Detail: "unexpected field -unit"},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("\n%#v\n%#v", result, expected)
+		}
+	})
+	t.Run("root", func(t *testing.T) {
+		result := ValidateContent(content, []byte(`<colour><r>1</r></colour>`), testCharacteristics, nil)
+		if len(result) != 1 || result[0].Field != "colour" || result[0].Code != model.ErrPayloadUnexpectedField {
+			t.Error(result)
+		}
+	})
+}
+
+func TestValidateContentPlainText(t *testing.T) {
+	content := models.Content{Serialization: models.PlainText, ContentVariable: models.ContentVariable{Name: "mode", Type: models.String, CharacteristicId: "urn:infai:ses:characteristic:color-mode"}}
+	if result := ValidateContent(content, []byte(`hsb`), testCharacteristics, nil); len(result) != 0 {
+		t.Error(result)
+	}
+	if result := ValidateContent(content, []byte(`HSB`), testCharacteristics, nil); len(result) != 1 || result[0].Code != model.ErrPayloadNotAllowedValue {
+		t.Error(result)
+	}
+}
+
+func TestValidateContentPattern(t *testing.T) {
+	content := models.Content{Serialization: models.PlainText, ContentVariable: models.ContentVariable{Name: "color", Type: models.String, CharacteristicId: "urn:infai:ses:characteristic:color-hex"}}
+	patterns := map[string]model.CharacteristicPattern{
+		"urn:infai:ses:characteristic:color-hex": {CharacteristicId: "urn:infai:ses:characteristic:color-hex", Pattern: "#[0-9a-f]{6}"},
+	}
+	if result := ValidateContent(content, []byte(`#ff00ff`), nil, patterns); len(result) != 0 {
+		t.Error(result)
+	}
+	if result := ValidateContent(content, []byte(`x#ff00ff`), nil, patterns); len(result) != 1 || result[0].Code != model.ErrPayloadPatternMismatch {
+		t.Error(result)
+	}
+}
+
+func TestExampleContent(t *testing.T) {
+	characteristics := map[string]models.Characteristic{
+		"urn:infai:ses:characteristic:color-r":    {Name: "r", MinValue: 0.0, MaxValue: 255.0},
+		"urn:infai:ses:characteristic:color-mode": {Name: "mode", AllowedValues: []interface{}{"rgb", "hsb"}, Value: "hsb"},
+	}
+	for serialization, expected := range map[models.Serialization]string{
+		models.JSON: `{"mode":"hsb","on":false,"point":[0,0],"r":128,"tags":["string"]}`,
+		models.XML:  `<color><mode>hsb</mode><on>false</on><point>0</point><point>0</point><r>128</r><tags>string</tags></color>`,
+	} {
+		content := models.Content{Serialization: serialization, ContentVariable: testVariable}
+		message, err := ExampleContent(content, characteristics)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(message) != expected {
+			t.Errorf("\n%v\n%v", string(message), expected)
+		}
+		if result := ValidateContent(content, message, characteristics, nil); len(result) != 0 {
+			t.Error(serialization, result)
+		}
+	}
+
+	message, err := ExampleContent(models.Content{Serialization: models.PlainText, ContentVariable: models.ContentVariable{Name: "v", Type: models.String, Value: "fixed"}}, nil)
+	if err != nil || string(message) != "fixed" {
+		t.Error(string(message), err)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package payload
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// ValidateContent decodes message with the serialization of content and compares it to the content variable (ref Decode, Validate)
+// characteristics and patterns (by characteristic id) are optional and used for min/max values, allowed values and string patterns
+func ValidateContent(content models.Content, message []byte, characteristics map[string]models.Characteristic, patterns map[string]model.CharacteristicPattern) (result []model.ProblemField) {
+	value, err := Decode(content, message)
+	if err != nil {
+		return []model.ProblemField{{Code: model.ErrPayloadInvalidSerialization, Detail: err.Error()}}
+	}
+	variable := content.ContentVariable
+	if content.Serialization == models.XML {
+		root := value.(map[string]interface{})
+		if _, ok := root[variable.Name]; !ok {
+			for name := range root {
+				result = append(result, model.ProblemField{Field: name, Code: model.ErrPayloadUnexpectedField, Detail: fmt.Sprintf("expected root element %v", variable.Name)})
+			}
+			return result
+		}
+		value = root[variable.Name]
+	}
+	return Validate(variable, value, serialization.StringValues(content.Serialization), characteristics, patterns)
+}
+
+// Validate compares a decoded value to variable and reports type mismatches, missing fields, unexpected fields and
+// values out of the range, not in the allowed values or not matching the pattern of the variable characteristic
+// with xmlValues (ref serialization.StringValues), primitive values are expected as strings and lists with a single element may be represented by the element (ref Decode)
+func Validate(variable models.ContentVariable, value interface{}, xmlValues bool, characteristics map[string]models.Characteristic, patterns map[string]model.CharacteristicPattern) (result []model.ProblemField) {
+	v := validator{xml: xmlValues, characteristics: characteristics, patterns: patterns, result: []model.ProblemField{}}
+	v.validate(variable, value, variable.Name)
+	return v.result
+}
+
+type validator struct {
+	xml             bool
+	characteristics map[string]models.Characteristic
+	patterns        map[string]model.CharacteristicPattern
+	result          []model.ProblemField
+}
+
+func (this *validator) add(path string, code model.ErrorCode, format string, args ...interface{}) {
+	this.result = append(this.result, model.ProblemField{Field: path, Code: code, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (this *validator) validate(variable models.ContentVariable, value interface{}, path string) {
+	if variable.IsVoid {
+		return
+	}
+	switch variable.Type {
+	case models.String, models.Integer, models.Float, models.Boolean:
+		primitive, ok := this.primitive(variable.Type, value)
+		if !ok {
+			this.add(path, model.ErrPayloadTypeMismatch, "expected %v, got %v", typeName(variable.Type), describe(value))
+			return
+		}
+		this.checkCharacteristic(variable, primitive, path)
+	case models.Structure:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			if this.xml && value == "" {
+				m = map[string]interface{}{}
+			} else {
+				this.add(path, model.ErrPayloadTypeMismatch, "expected structure, got %v", describe(value))
+				return
+			}
+		}
+		this.validateStructure(variable, m, path)
+	case models.List:
+		list, ok := value.([]interface{})
+		if !ok {
+			if !this.xml || value == nil {
+				this.add(path, model.ErrPayloadTypeMismatch, "expected list, got %v", describe(value))
+				return
+			}
+			list = []interface{}{value}
+		}
+		this.validateList(variable, list, path)
+	}
+}
+
+func (this *validator) validateStructure(variable models.ContentVariable, value map[string]interface{}, path string) {
+	var placeholder *models.ContentVariable
+	known := map[string]bool{}
+	for _, sub := range variable.SubContentVariables {
+		if sub.Name == "*" {
+			placeholder = &sub
+			continue
+		}
+		known[sub.Name] = true
+		field, exists := value[sub.Name]
+		if !exists || field == nil {
+			if !sub.OmitEmpty && !sub.IsVoid {
+				this.add(path+"."+sub.Name, model.ErrPayloadMissingField, "missing field %v", sub.Name)
+			}
+			continue
+		}
+		this.validate(sub, field, path+"."+sub.Name)
+	}
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if known[key] {
+			continue
+		}
+		if placeholder != nil {
+			this.validate(*placeholder, value[key], path+"."+key)
+			continue
+		}
+		this.add(path+"."+key, model.ErrPayloadUnexpectedField, "unexpected field %v", key)
+	}
+}
+
+func (this *validator) validateList(variable models.ContentVariable, value []interface{}, path string) {
+	if len(variable.SubContentVariables) == 1 && variable.SubContentVariables[0].Name == "*" {
+		for i, element := range value {
+			this.validate(variable.SubContentVariables[0], element, fmt.Sprintf("%v[%v]", path, i))
+		}
+		return
+	}
+	for _, sub := range variable.SubContentVariables {
+		index, err := strconv.Atoi(sub.Name)
+		if err != nil {
+			continue
+		}
+		elementPath := fmt.Sprintf("%v[%v]", path, index)
+		if index >= len(value) || value[index] == nil {
+			if !sub.OmitEmpty && !sub.IsVoid {
+				this.add(elementPath, model.ErrPayloadMissingField, "missing list element %v", index)
+			}
+			continue
+		}
+		this.validate(sub, value[index], elementPath)
+	}
+	if len(value) > len(variable.SubContentVariables) {
+		for i := len(variable.SubContentVariables); i < len(value); i++ {
+			this.add(fmt.Sprintf("%v[%v]", path, i), model.ErrPayloadUnexpectedField, "unexpected list element %v", i)
+		}
+	}
+}
+
+// primitive returns value as string, float64 or bool, if it matches the variable type
+func (this *validator) primitive(variableType models.Type, value interface{}) (result interface{}, ok bool) {
+	if this.xml {
+		str, isString := value.(string)
+		if !isString {
+			return nil, false
+		}
+		switch variableType {
+		case models.String:
+			return str, true
+		case models.Integer:
+			i, err := strconv.ParseInt(str, 10, 64)
+			return float64(i), err == nil
+		case models.Float:
+			f, err := strconv.ParseFloat(str, 64)
+			return f, err == nil
+		case models.Boolean:
+			b, err := strconv.ParseBool(str)
+			return b, err == nil
+		}
+		return nil, false
+	}
+	switch variableType {
+	case models.String:
+		str, isString := value.(string)
+		return str, isString
+	case models.Boolean:
+		b, isBool := value.(bool)
+		return b, isBool
+	case models.Integer, models.Float:
+		var f float64
+		switch number := value.(type) {
+		case json.Number:
+			var err error
+			f, err = number.Float64()
+			if err != nil {
+				return nil, false
+			}
+		case float64:
+			f = number
+		default:
+			return nil, false
+		}
+		if variableType == models.Integer && f != math.Trunc(f) {
+			return nil, false
+		}
+		return f, true
+	}
+	return nil, false
+}
+
+func (this *validator) checkCharacteristic(variable models.ContentVariable, value interface{}, path string) {
+	if variable.CharacteristicId == "" {
+		return
+	}
+	if pattern, ok := this.patterns[variable.CharacteristicId]; ok {
+		if str, isString := value.(string); isString {
+			re, err := pattern.Compile()
+			if err == nil && !re.MatchString(str) {
+				this.add(path, model.ErrPayloadPatternMismatch, "value %v does not match the pattern %v of %v", strconv.Quote(str), pattern.Pattern, variable.CharacteristicId)
+			}
+		}
+	}
+	characteristic, ok := this.characteristics[variable.CharacteristicId]
+	if !ok {
+		return
+	}
+	if number, isNumber := value.(float64); isNumber {
+		if min, ok := toFloat(characteristic.MinValue); ok && number < min {
+			this.add(path, model.ErrPayloadOutOfRange, "value %v is less than the min value %v of %v", number, min, characteristic.Name)
+		}
+		if max, ok := toFloat(characteristic.MaxValue); ok && number > max {
+			this.add(path, model.ErrPayloadOutOfRange, "value %v is greater than the max value %v of %v", number, max, characteristic.Name)
+		}
+	}
+	if len(characteristic.AllowedValues) > 0 {
+		allowed := slices.ContainsFunc(characteristic.AllowedValues, func(element interface{}) bool {
+			if f, ok := toFloat(element); ok {
+				element = f
+			}
+			return reflect.DeepEqual(element, value)
+		})
+		if !allowed {
+			this.add(path, model.ErrPayloadNotAllowedValue, "value %v is not in the allowed values %v of %v", value, characteristic.AllowedValues, characteristic.Name)
+		}
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func typeName(t models.Type) string {
+	switch t {
+	case models.String:
+		return "string"
+	case models.Integer:
+		return "integer"
+	case models.Float:
+		return "float"
+	case models.Boolean:
+		return "boolean"
+	case models.Structure:
+		return "structure"
+	case models.List:
+		return "list"
+	}
+	return string(t)
+}
+
+func describe(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	case map[string]interface{}:
+		return "structure"
+	case []interface{}:
+		return "list"
+	case json.Number:
+		return "number " + v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
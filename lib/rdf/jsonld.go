@@ -0,0 +1,345 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const JsonLdContentType = "application/ld+json"
+
+// WriteJsonLd serializes the graph as flattened JSON-LD document with one node object per subject in '@graph'
+func WriteJsonLd(writer io.Writer, graph *Graph) error {
+	context := map[string]string{}
+	for prefix, namespace := range Prefixes {
+		context[prefix] = namespace
+	}
+	nodes := []map[string]interface{}{}
+	for _, subject := range graph.Subjects() {
+		node := map[string]interface{}{"@id": jsonLdId(subject)}
+		for _, t := range graph.Properties(subject) {
+			if t.Predicate == RdfType && t.Object.Kind == KindIri {
+				types, _ := node["@type"].([]string)
+				node["@type"] = append(types, compactIri(t.Object.Value))
+				continue
+			}
+			key := compactIri(t.Predicate)
+			values, _ := node[key].([]interface{})
+			node[key] = append(values, jsonLdValue(t.Object))
+		}
+		nodes = append(nodes, node)
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(map[string]interface{}{"@context": context, "@graph": nodes})
+}
+
+func jsonLdId(term Term) string {
+	if term.Kind == KindBlankNode {
+		return "_:" + term.Value
+	}
+	return term.Value
+}
+
+func jsonLdValue(term Term) map[string]interface{} {
+	if term.IsResource() {
+		return map[string]interface{}{"@id": jsonLdId(term)}
+	}
+	result := map[string]interface{}{"@value": term.Value}
+	if term.Language != "" {
+		result["@language"] = term.Language
+	} else if term.Datatype != "" {
+		result["@type"] = compactIri(term.Datatype)
+	}
+	return result
+}
+
+func compactIri(iri string) string {
+	for prefix, namespace := range Prefixes {
+		if local, ok := strings.CutPrefix(iri, namespace); ok && turtleLocalName.MatchString(local) {
+			return prefix + ":" + local
+		}
+	}
+	return iri
+}
+
+// ReadJsonLd parses JSON-LD documents as written by WriteJsonLd
+// supported are flattened and nested node objects, '@graph', prefix and term definitions (including "@type": "@id" coercion) and '@vocab' in the top level '@context'
+// remote contexts, '@list' and '@reverse' are not supported
+func ReadJsonLd(reader io.Reader) (*Graph, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	var document interface{}
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+	p := &jsonLdParser{graph: NewGraph(), terms: map[string]jsonLdTerm{}, blankNodes: map[string]Term{}}
+	switch doc := document.(type) {
+	case []interface{}:
+		for _, element := range doc {
+			if _, err = p.node(element); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		if err = p.context(doc["@context"]); err != nil {
+			return nil, err
+		}
+		if nodes, ok := doc["@graph"]; ok {
+			list, ok := nodes.([]interface{})
+			if !ok {
+				list = []interface{}{nodes}
+			}
+			for _, element := range list {
+				if _, err = p.node(element); err != nil {
+					return nil, err
+				}
+			}
+		} else if _, err = p.node(doc); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("expect json-ld document to be an object or array")
+	}
+	return p.graph, nil
+}
+
+type jsonLdTerm struct {
+	iri    string
+	isId   bool
+	vocab  bool
+	dtType string
+}
+
+type jsonLdParser struct {
+	graph      *Graph
+	terms      map[string]jsonLdTerm
+	vocab      string
+	blankNodes map[string]Term
+}
+
+func (this *jsonLdParser) context(context interface{}) error {
+	switch c := context.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		for _, element := range c {
+			if err := this.context(element); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		if vocab, ok := c["@vocab"].(string); ok {
+			this.vocab = vocab
+		}
+		//prefixes first, so that term definitions may use them
+		for key, value := range c {
+			if iri, ok := value.(string); ok && !strings.HasPrefix(key, "@") {
+				this.terms[key] = jsonLdTerm{iri: iri}
+			}
+		}
+		for key, value := range c {
+			definition, ok := value.(map[string]interface{})
+			if !ok || strings.HasPrefix(key, "@") {
+				continue
+			}
+			term := jsonLdTerm{iri: key}
+			if id, ok := definition["@id"].(string); ok {
+				term.iri = id
+			}
+			if t, ok := definition["@type"].(string); ok {
+				switch t {
+				case "@id":
+					term.isId = true
+				case "@vocab":
+					term.isId = true
+					term.vocab = true
+				default:
+					term.dtType = t
+				}
+			}
+			this.terms[key] = term
+		}
+		for key, term := range this.terms {
+			term.iri = this.expandIri(term.iri, true)
+			if term.dtType != "" {
+				term.dtType = this.expandIri(term.dtType, true)
+			}
+			this.terms[key] = term
+		}
+		return nil
+	case string:
+		return errors.New("remote json-ld contexts are not supported: " + c)
+	default:
+		return errors.New("invalid json-ld context")
+	}
+}
+
+// expandIri resolves terms, compact iris and (if vocab is true) '@vocab' relative iris
+func (this *jsonLdParser) expandIri(value string, vocab bool) string {
+	if strings.HasPrefix(value, "_:") {
+		return value
+	}
+	if vocab {
+		if term, ok := this.terms[value]; ok && term.iri != value {
+			return term.iri
+		}
+	}
+	if prefix, local, ok := strings.Cut(value, ":"); ok {
+		if term, ok := this.terms[prefix]; ok && !strings.HasPrefix(local, "//") {
+			return term.iri + local
+		}
+		return value
+	}
+	if vocab && this.vocab != "" {
+		return this.vocab + value
+	}
+	return value
+}
+
+func (this *jsonLdParser) resource(id string) Term {
+	if label, ok := strings.CutPrefix(id, "_:"); ok {
+		if node, ok := this.blankNodes[label]; ok {
+			return node
+		}
+		node := this.graph.NewBlankNode()
+		this.blankNodes[label] = node
+		return node
+	}
+	return Iri(this.expandIri(id, false))
+}
+
+func (this *jsonLdParser) node(value interface{}) (subject Term, err error) {
+	node, ok := value.(map[string]interface{})
+	if !ok {
+		return subject, errors.New("expect json-ld node object")
+	}
+	if id, ok := node["@id"].(string); ok {
+		subject = this.resource(id)
+	} else {
+		subject = this.graph.NewBlankNode()
+	}
+	types := node["@type"]
+	if t, ok := types.(string); ok {
+		types = []interface{}{t}
+	}
+	if list, ok := types.([]interface{}); ok {
+		for _, t := range list {
+			if s, ok := t.(string); ok {
+				this.graph.Add(subject, RdfType, Iri(this.expandIri(s, true)))
+			}
+		}
+	}
+	for key, values := range node {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		term, known := this.terms[key]
+		predicate := this.expandIri(key, true)
+		if !known {
+			term = jsonLdTerm{iri: predicate}
+		}
+		list, ok := values.([]interface{})
+		if !ok {
+			list = []interface{}{values}
+		}
+		for _, element := range list {
+			object, ok, err := this.value(element, term)
+			if err != nil {
+				return subject, fmt.Errorf("%v: %w", key, err)
+			}
+			if ok {
+				this.graph.Add(subject, predicate, object)
+			}
+		}
+	}
+	return subject, nil
+}
+
+func (this *jsonLdParser) value(value interface{}, term jsonLdTerm) (result Term, ok bool, err error) {
+	switch v := value.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		if term.isId {
+			if term.vocab {
+				return Iri(this.expandIri(v, true)), true, nil
+			}
+			return this.resource(v), true, nil
+		}
+		if term.dtType != "" {
+			return TypedLiteral(v, term.dtType), true, nil
+		}
+		return String(v), true, nil
+	case bool:
+		return TypedLiteral(strconv.FormatBool(v), XsdBoolean), true, nil
+	case json.Number:
+		result, err = Literal(v)
+		return result, err == nil, err
+	case map[string]interface{}:
+		if literal, isValue := v["@value"]; isValue {
+			if literal == nil {
+				return result, false, nil
+			}
+			if s, isString := literal.(string); isString {
+				if lang, ok := v["@language"].(string); ok {
+					return Term{Kind: KindLiteral, Value: s, Language: lang}, true, nil
+				}
+				if t, ok := v["@type"].(string); ok {
+					return TypedLiteral(s, this.expandIri(t, true)), true, nil
+				}
+				return String(s), true, nil
+			}
+			return this.value(literal, jsonLdTerm{})
+		}
+		if _, isList := v["@list"]; isList {
+			return result, false, errors.New("json-ld @list is not supported")
+		}
+		if id, isRef := v["@id"].(string); isRef && len(v) == 1 {
+			return this.resource(id), true, nil
+		}
+		result, err = this.node(v)
+		return result, err == nil, err
+	default:
+		return result, false, fmt.Errorf("unexpected json-ld value %#v", value)
+	}
+}
+
+// MarshalJSON encodes the graph as JSON-LD (see WriteJsonLd)
+func (this *Graph) MarshalJSON() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	err := WriteJsonLd(&buffer, this)
+	return buffer.Bytes(), err
+}
+
+// UnmarshalJSON decodes JSON-LD (see ReadJsonLd)
+func (this *Graph) UnmarshalJSON(b []byte) error {
+	graph, err := ReadJsonLd(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	*this = *graph
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	NamespaceSes  = "https://senergy.infai.org/ontology/"
+	NamespaceRdf  = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	NamespaceRdfs = "http://www.w3.org/2000/01/rdf-schema#"
+	NamespaceXsd  = "http://www.w3.org/2001/XMLSchema#"
+)
+
+const (
+	XsdString  = NamespaceXsd + "string"
+	XsdBoolean = NamespaceXsd + "boolean"
+	XsdInteger = NamespaceXsd + "integer"
+	XsdDouble  = NamespaceXsd + "double"
+	XsdDecimal = NamespaceXsd + "decimal"
+	RdfJson    = NamespaceRdf + "JSON"
+	RdfType    = NamespaceRdf + "type"
+)
+
+// Prefixes are used to abbreviate IRIs in serialized documents
+var Prefixes = map[string]string{
+	"ses":  NamespaceSes,
+	"rdf":  NamespaceRdf,
+	"rdfs": NamespaceRdfs,
+	"xsd":  NamespaceXsd,
+}
+
+type TermKind int
+
+const (
+	KindIri TermKind = iota
+	KindBlankNode
+	KindLiteral
+)
+
+// Term is a node or literal of an rdf graph
+// literals without Datatype and Language are xsd:string
+type Term struct {
+	Kind     TermKind
+	Value    string
+	Datatype string
+	Language string
+}
+
+func Iri(iri string) Term {
+	return Term{Kind: KindIri, Value: iri}
+}
+
+func BlankNode(label string) Term {
+	return Term{Kind: KindBlankNode, Value: label}
+}
+
+func String(value string) Term {
+	return Term{Kind: KindLiteral, Value: value}
+}
+
+func TypedLiteral(value string, datatype string) Term {
+	if datatype == XsdString {
+		datatype = ""
+	}
+	return Term{Kind: KindLiteral, Value: value, Datatype: datatype}
+}
+
+// Literal converts a json compatible value into a typed literal
+// objects and arrays are stored as rdf:JSON literals
+func Literal(value interface{}) (Term, error) {
+	switch v := value.(type) {
+	case string:
+		return String(v), nil
+	case bool:
+		return TypedLiteral(strconv.FormatBool(v), XsdBoolean), nil
+	case int:
+		return TypedLiteral(strconv.Itoa(v), XsdInteger), nil
+	case int64:
+		return TypedLiteral(strconv.FormatInt(v, 10), XsdInteger), nil
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return TypedLiteral(strconv.FormatFloat(v, 'f', -1, 64), XsdInteger), nil
+		}
+		return TypedLiteral(strconv.FormatFloat(v, 'g', -1, 64), XsdDouble), nil
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return TypedLiteral(v.String(), XsdInteger), nil
+		}
+		return TypedLiteral(v.String(), XsdDouble), nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return Term{}, err
+		}
+		return TypedLiteral(string(b), RdfJson), nil
+	}
+}
+
+// Interface converts a literal back into the json compatible value used by Literal
+func (this Term) Interface() (interface{}, error) {
+	if this.Kind != KindLiteral {
+		return this.Value, nil
+	}
+	switch this.Datatype {
+	case XsdBoolean:
+		return strconv.ParseBool(this.Value)
+	case XsdInteger, XsdDouble, XsdDecimal:
+		return strconv.ParseFloat(this.Value, 64)
+	case RdfJson:
+		var result interface{}
+		err := json.Unmarshal([]byte(this.Value), &result)
+		return result, err
+	default:
+		return this.Value, nil
+	}
+}
+
+func (this Term) IsResource() bool {
+	return this.Kind == KindIri || this.Kind == KindBlankNode
+}
+
+func (this Term) String() string {
+	switch this.Kind {
+	case KindIri:
+		return "<" + this.Value + ">"
+	case KindBlankNode:
+		return "_:" + this.Value
+	default:
+		if this.Language != "" {
+			return strconv.Quote(this.Value) + "@" + this.Language
+		}
+		if this.Datatype != "" {
+			return strconv.Quote(this.Value) + "^^<" + this.Datatype + ">"
+		}
+		return strconv.Quote(this.Value)
+	}
+}
+
+type Triple struct {
+	Subject   Term
+	Predicate string
+	Object    Term
+}
+
+// Graph is an ordered set of triples; serializations keep the order of first appearance of subjects
+type Graph struct {
+	triples    []Triple
+	known      map[Triple]bool
+	bySubject  map[Term][]int
+	blankNodes int
+}
+
+func NewGraph() *Graph {
+	return &Graph{known: map[Triple]bool{}, bySubject: map[Term][]int{}}
+}
+
+func (this *Graph) Add(subject Term, predicate string, object Term) {
+	triple := Triple{Subject: subject, Predicate: predicate, Object: object}
+	if this.known[triple] {
+		return
+	}
+	this.known[triple] = true
+	this.bySubject[subject] = append(this.bySubject[subject], len(this.triples))
+	this.triples = append(this.triples, triple)
+}
+
+func (this *Graph) Triples() []Triple {
+	return this.triples
+}
+
+func (this *Graph) Len() int {
+	return len(this.triples)
+}
+
+// AddValue adds value as literal; nil values are ignored
+func (this *Graph) AddValue(subject Term, predicate string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	object, err := Literal(value)
+	if err != nil {
+		return fmt.Errorf("unable to convert value of %v %v: %w", subject, predicate, err)
+	}
+	this.Add(subject, predicate, object)
+	return nil
+}
+
+// AddString adds value as string literal; empty values are ignored
+func (this *Graph) AddString(subject Term, predicate string, value string) {
+	if value != "" {
+		this.Add(subject, predicate, String(value))
+	}
+}
+
+// AddIri adds iri as object; empty values are ignored
+func (this *Graph) AddIri(subject Term, predicate string, iri string) {
+	if iri != "" {
+		this.Add(subject, predicate, Iri(iri))
+	}
+}
+
+// NewBlankNode returns a blank node that is unique in this graph
+func (this *Graph) NewBlankNode() Term {
+	for {
+		this.blankNodes++
+		node := BlankNode("b" + strconv.Itoa(this.blankNodes))
+		if _, used := this.bySubject[node]; !used {
+			return node
+		}
+	}
+}
+
+// Subjects lists all subjects in order of their first appearance
+func (this *Graph) Subjects() []Term {
+	result := []Term{}
+	for i, t := range this.triples {
+		if this.bySubject[t.Subject][0] == i {
+			result = append(result, t.Subject)
+		}
+	}
+	return result
+}
+
+// SubjectsOfType lists all subjects with the given rdf:type
+func (this *Graph) SubjectsOfType(typeIri string) []Term {
+	result := []Term{}
+	for _, t := range this.triples {
+		if t.Predicate == RdfType && t.Object == Iri(typeIri) {
+			result = append(result, t.Subject)
+		}
+	}
+	return result
+}
+
+// Properties returns all triples of subject
+func (this *Graph) Properties(subject Term) []Triple {
+	result := []Triple{}
+	for _, i := range this.bySubject[subject] {
+		result = append(result, this.triples[i])
+	}
+	return result
+}
+
+func (this *Graph) Objects(subject Term, predicate string) []Term {
+	result := []Term{}
+	for _, i := range this.bySubject[subject] {
+		if this.triples[i].Predicate == predicate {
+			result = append(result, this.triples[i].Object)
+		}
+	}
+	return result
+}
+
+// Object returns the first object of subject and predicate
+func (this *Graph) Object(subject Term, predicate string) (Term, bool) {
+	for _, i := range this.bySubject[subject] {
+		if this.triples[i].Predicate == predicate {
+			return this.triples[i].Object, true
+		}
+	}
+	return Term{}, false
+}
+
+func (this *Graph) Types(subject Term) []string {
+	result := []string{}
+	for _, object := range this.Objects(subject, RdfType) {
+		result = append(result, object.Value)
+	}
+	return result
+}
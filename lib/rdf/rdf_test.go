@@ -0,0 +1,267 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func testExport() model.ImportExport {
+	return model.ImportExport{
+		Functions: []models.Function{{Id: model.MEASURING_FUNCTION_PREFIX + "temperature", Name: "Get Temperature", Description: "line 1\nline \"2\"", ConceptId: "urn:infai:ses:concept:temperature"}},
+		Aspects:   []models.Aspect{{Id: "urn:infai:ses:aspect:air", Name: "Air", SubAspects: []models.Aspect{{Id: "urn:infai:ses:aspect:inside-air", Name: "Inside Air"}}}},
+		Concepts:  []models.Concept{{Id: "urn:infai:ses:concept:temperature", Name: "Temperature", BaseCharacteristicId: "urn:infai:ses:characteristic:celsius", CharacteristicIds: []string{"urn:infai:ses:characteristic:celsius"}}},
+		Characteristics: []models.Characteristic{{
+			Id:       "urn:infai:ses:characteristic:celsius",
+			Name:     "Celsius",
+			Type:     models.Type("https://schema.org/Float"),
+			MinValue: float64(-273.15),
+			MaxValue: float64(1000),
+		}},
+		DeviceClasses: []models.DeviceClass{{Id: "urn:infai:ses:device-class:thermometer", Name: "Thermometer"}},
+		DeviceTypes: []models.DeviceType{{
+			Id:            "urn:infai:ses:device-type:1",
+			Name:          "Thermometer",
+			DeviceClassId: "urn:infai:ses:device-class:thermometer",
+			Services: []models.Service{{
+				Id:          "urn:infai:ses:service:1",
+				LocalId:     "getTemperature",
+				Name:        "Get Temperature",
+				Interaction: models.Interaction("event"),
+				ProtocolId:  "urn:infai:ses:protocol:mqtt",
+				Outputs: []models.Content{{
+					Serialization: models.Serialization("json"),
+					ContentVariable: models.ContentVariable{
+						Name: "value",
+						Type: models.Type("https://schema.org/StructuredValue"),
+						SubContentVariables: []models.ContentVariable{{
+							Id:               "urn:infai:ses:content-variable:temperature",
+							Name:             "temperature",
+							Type:             models.Type("https://schema.org/Float"),
+							CharacteristicId: "urn:infai:ses:characteristic:celsius",
+							FunctionId:       model.MEASURING_FUNCTION_PREFIX + "temperature",
+							AspectId:         "urn:infai:ses:aspect:inside-air",
+						}},
+					},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestFromImportExport(t *testing.T) {
+	graph, err := FromImportExport(testExport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.SubjectsOfType(model.SES_ONTOLOGY_MEASURING_FUNCTION)) != 1 {
+		t.Error("missing measuring function")
+	}
+	if len(graph.SubjectsOfType(model.SES_ONTOLOGY_ASPECT)) != 2 {
+		t.Error("expect sub aspect to be exported")
+	}
+	sub := graph.Objects(Iri("urn:infai:ses:aspect:air"), model.SES_ONTOLOGY_HAS_SUB_ASPECT)
+	if len(sub) != 1 || sub[0] != Iri("urn:infai:ses:aspect:inside-air") {
+		t.Error(sub)
+	}
+	variables := graph.SubjectsOfType(model.SES_ONTOLOGY_CONTENT_VARIABLE)
+	if len(variables) != 2 || variables[0].Kind != KindBlankNode {
+		t.Error(variables)
+	}
+	min, _ := graph.Object(Iri("urn:infai:ses:characteristic:celsius"), model.SES_ONTOLOGY_HAS_MIN_VALUE)
+	if min != TypedLiteral("-273.15", XsdDouble) {
+		t.Error(min)
+	}
+
+	buffer := bytes.Buffer{}
+	err = WriteTurtle(&buffer, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	turtle := buffer.String()
+	for _, expected := range []string{
+		"@prefix ses: <https://senergy.infai.org/ontology/> .",
+		"<urn:infai:ses:aspect:air>\n    a ses:Aspect ;\n    rdfs:label \"Air\" ;\n    ses:hasSubAspect <urn:infai:ses:aspect:inside-air> .",
+		`rdfs:comment "line 1\nline \"2\""`,
+		`ses:hasMaxValue "1000"^^xsd:integer`,
+	} {
+		if !strings.Contains(turtle, expected) {
+			t.Errorf("missing %q in:\n%v", expected, turtle)
+		}
+	}
+}
+
+func TestJsonLdRoundTrip(t *testing.T) {
+	graph, err := FromImportExport(testExport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := &Graph{}
+	err = json.Unmarshal(b, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Len() != graph.Len() {
+		t.Fatalf("%v != %v\n%v", result.Len(), graph.Len(), string(b))
+	}
+	for _, triple := range graph.Triples() {
+		if triple.Subject.Kind == KindBlankNode || triple.Object.Kind == KindBlankNode {
+			continue //blank node labels are not preserved
+		}
+		if !result.known[triple] {
+			t.Errorf("missing %v %v %v", triple.Subject, triple.Predicate, triple.Object)
+		}
+	}
+}
+
+func TestReadJsonLdContext(t *testing.T) {
+	graph, err := ReadJsonLd(strings.NewReader(`{
+		"@context": {
+			"ses": "https://senergy.infai.org/ontology/",
+			"name": "http://www.w3.org/2000/01/rdf-schema#label",
+			"concept": {"@id": "ses:hasConcept", "@type": "@id"}
+		},
+		"@id": "urn:infai:ses:controlling-function:on",
+		"@type": "ses:ControllingFunction",
+		"name": "On",
+		"concept": "urn:infai:ses:concept:on"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := Iri("urn:infai:ses:controlling-function:on")
+	if types := graph.Types(subject); len(types) != 1 || types[0] != model.SES_ONTOLOGY_CONTROLLING_FUNCTION {
+		t.Error(types)
+	}
+	if name, _ := graph.Object(subject, model.RDFS_LABEL); name != String("On") {
+		t.Error(name)
+	}
+	if concept, _ := graph.Object(subject, model.SES_ONTOLOGY_HAS_CONCEPT); concept != Iri("urn:infai:ses:concept:on") {
+		t.Error(concept)
+	}
+}
+
+func TestTurtleRoundTrip(t *testing.T) {
+	graph, err := FromImportExport(testExport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := bytes.Buffer{}
+	err = WriteTurtle(&buffer, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := ReadTurtle(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Len() != graph.Len() {
+		t.Fatalf("%v != %v", result.Len(), graph.Len())
+	}
+	for _, triple := range graph.Triples() {
+		if triple.Subject.Kind == KindBlankNode || triple.Object.Kind == KindBlankNode {
+			continue
+		}
+		if !result.known[triple] {
+			t.Errorf("missing %v %v %v", triple.Subject, triple.Predicate, triple.Object)
+		}
+	}
+	export, err := ToImportExport(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := testExport()
+	expected.Functions[0].RdfType = model.SES_ONTOLOGY_MEASURING_FUNCTION
+	expected.Aspects[0].SubAspects[0].SubAspects = []models.Aspect{}
+	expected.Characteristics[0].SubCharacteristics = []models.Characteristic{}
+	for _, pair := range [][2]interface{}{
+		{export.Functions, expected.Functions},
+		{export.Aspects, expected.Aspects},
+		{export.Concepts, expected.Concepts},
+		{export.Characteristics, expected.Characteristics},
+		{export.DeviceClasses, expected.DeviceClasses},
+	} {
+		a, _ := json.Marshal(pair[0])
+		b, _ := json.Marshal(pair[1])
+		if string(a) != string(b) {
+			t.Errorf("\n%v\n%v", string(a), string(b))
+		}
+	}
+}
+
+func TestReadTurtle(t *testing.T) {
+	graph, err := ReadTurtle(strings.NewReader(`
+		# comment
+		BASE <http://example.org/characteristics/>
+		PREFIX ses: <https://senergy.infai.org/ontology/>
+		@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+		<foo> a ses:Characteristic;
+			rdfs:label "Foo"@en , 'bar' ;
+			rdfs:comment """multi
+line "comment" ä""" ;
+			ses:hasValue 1.5, -2, 1e3, true ;
+			ses:hasSubCharacteristic [ a ses:Characteristic ; rdfs:label "sub" ], _:x ;
+			.
+		_:x rdfs:label "x\t\"y\"" .
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := Iri("http://example.org/characteristics/foo")
+	if types := graph.Types(subject); len(types) != 1 || types[0] != model.SES_ONTOLOGY_CHARACTERISTIC {
+		t.Error(types)
+	}
+	labels := graph.Objects(subject, model.RDFS_LABEL)
+	if len(labels) != 2 || labels[0].Language != "en" || labels[1] != String("bar") {
+		t.Error(labels)
+	}
+	if comment, _ := graph.Object(subject, model.RDFS_COMMENT); comment.Value != "multi\nline \"comment\" ä" {
+		t.Error(comment)
+	}
+	values := graph.Objects(subject, model.SES_ONTOLOGY_HAS_VALUE)
+	expected := []Term{TypedLiteral("1.5", XsdDecimal), TypedLiteral("-2", XsdInteger), TypedLiteral("1e3", XsdDouble), TypedLiteral("true", XsdBoolean)}
+	if len(values) != len(expected) {
+		t.Fatal(values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Error(values[i], expected[i])
+		}
+	}
+	subs := graph.Objects(subject, model.SES_ONTOLOGY_HAS_SUB_CHARACTERISTIC)
+	if len(subs) != 2 || subs[0].Kind != KindBlankNode || subs[1].Kind != KindBlankNode {
+		t.Fatal(subs)
+	}
+	if label, _ := graph.Object(subs[1], model.RDFS_LABEL); label.Value != "x\t\"y\"" {
+		t.Error(label)
+	}
+
+	_, err = ReadTurtle(strings.NewReader(`<urn:a> unknown:b <urn:c> .`))
+	if err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Error(err)
+	}
+}
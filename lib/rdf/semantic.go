@@ -0,0 +1,349 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// FromImportExport maps the semantic resources of an export (protocols, functions, aspects, concepts, characteristics, device-classes and device-types)
+// onto the SENERGY ontology (model.SES_ONTOLOGY_*); owned resources like devices are ignored
+// resources without id (e.g. content variables of older device-types) are represented as blank nodes
+func FromImportExport(export model.ImportExport) (*Graph, error) {
+	graph := NewGraph()
+	for _, protocol := range export.Protocols {
+		subject := Iri(protocol.Id)
+		graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_PROTOCOL))
+		graph.AddString(subject, model.RDFS_LABEL, protocol.Name)
+	}
+	for _, function := range export.Functions {
+		addFunction(graph, function)
+	}
+	for _, aspect := range export.Aspects {
+		addAspect(graph, aspect)
+	}
+	for _, characteristic := range export.Characteristics {
+		if err := addCharacteristic(graph, characteristic); err != nil {
+			return nil, err
+		}
+	}
+	for _, concept := range export.Concepts {
+		subject := Iri(concept.Id)
+		graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_CONCEPT))
+		graph.AddString(subject, model.RDFS_LABEL, concept.Name)
+		graph.AddIri(subject, model.SES_ONTOLOGY_HAS_BASE_CHARACTERISTIC, concept.BaseCharacteristicId)
+		for _, id := range concept.CharacteristicIds {
+			graph.AddIri(subject, model.SES_ONTOLOGY_HAS_CHARACTERISTIC, id)
+		}
+	}
+	for _, deviceClass := range export.DeviceClasses {
+		subject := Iri(deviceClass.Id)
+		graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_DEVICE_CLASS))
+		graph.AddString(subject, model.RDFS_LABEL, deviceClass.Name)
+		graph.AddString(subject, model.SES_ONTOLOGY_HAS_IMAGE, deviceClass.Image)
+	}
+	for _, deviceType := range export.DeviceTypes {
+		if err := addDeviceType(graph, deviceType); err != nil {
+			return nil, err
+		}
+	}
+	return graph, nil
+}
+
+func resourceOrBlankNode(graph *Graph, id string) Term {
+	if id == "" {
+		return graph.NewBlankNode()
+	}
+	return Iri(id)
+}
+
+func addFunction(graph *Graph, function models.Function) {
+	subject := Iri(function.Id)
+	if function.RdfType == "" {
+		model.SetFunctionRdfType(&function)
+	}
+	graph.AddIri(subject, RdfType, function.RdfType)
+	graph.AddString(subject, model.RDFS_LABEL, function.Name)
+	graph.AddString(subject, model.SES_ONTOLOGY_HAS_DISPLAY_NAME, function.DisplayName)
+	graph.AddString(subject, model.RDFS_COMMENT, function.Description)
+	graph.AddIri(subject, model.SES_ONTOLOGY_HAS_CONCEPT, function.ConceptId)
+}
+
+func addAspect(graph *Graph, aspect models.Aspect) {
+	subject := Iri(aspect.Id)
+	graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_ASPECT))
+	graph.AddString(subject, model.RDFS_LABEL, aspect.Name)
+	for _, sub := range aspect.SubAspects {
+		graph.AddIri(subject, model.SES_ONTOLOGY_HAS_SUB_ASPECT, sub.Id)
+	}
+	for _, sub := range aspect.SubAspects {
+		addAspect(graph, sub)
+	}
+}
+
+func addCharacteristic(graph *Graph, characteristic models.Characteristic) error {
+	subject := Iri(characteristic.Id)
+	graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_CHARACTERISTIC))
+	graph.AddString(subject, model.RDFS_LABEL, characteristic.Name)
+	graph.AddIri(subject, model.SES_ONTOLOGY_HAS_VALUE_TYPE, string(characteristic.Type))
+	graph.AddString(subject, model.SES_ONTOLOGY_HAS_DISPLAY_UNIT, characteristic.DisplayUnit)
+	if err := graph.AddValue(subject, model.SES_ONTOLOGY_HAS_VALUE, characteristic.Value); err != nil {
+		return err
+	}
+	if err := graph.AddValue(subject, model.SES_ONTOLOGY_HAS_MIN_VALUE, characteristic.MinValue); err != nil {
+		return err
+	}
+	if err := graph.AddValue(subject, model.SES_ONTOLOGY_HAS_MAX_VALUE, characteristic.MaxValue); err != nil {
+		return err
+	}
+	for _, value := range characteristic.AllowedValues {
+		if err := graph.AddValue(subject, model.SES_ONTOLOGY_HAS_ALLOWED_VALUE, value); err != nil {
+			return err
+		}
+	}
+	for _, sub := range characteristic.SubCharacteristics {
+		graph.AddIri(subject, model.SES_ONTOLOGY_HAS_SUB_CHARACTERISTIC, sub.Id)
+	}
+	for _, sub := range characteristic.SubCharacteristics {
+		if err := addCharacteristic(graph, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addDeviceType(graph *Graph, deviceType models.DeviceType) error {
+	subject := Iri(deviceType.Id)
+	graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_DEVICE_TYPE))
+	graph.AddString(subject, model.RDFS_LABEL, deviceType.Name)
+	graph.AddString(subject, model.RDFS_COMMENT, deviceType.Description)
+	graph.AddIri(subject, model.SES_ONTOLOGY_HAS_DEVICE_CLASS, deviceType.DeviceClassId)
+	for _, service := range deviceType.Services {
+		serviceNode := resourceOrBlankNode(graph, service.Id)
+		graph.Add(subject, model.SES_ONTOLOGY_HAS_SERVICE, serviceNode)
+		graph.Add(serviceNode, RdfType, Iri(model.SES_ONTOLOGY_SERVICE))
+		graph.AddString(serviceNode, model.RDFS_LABEL, service.Name)
+		graph.AddString(serviceNode, model.RDFS_COMMENT, service.Description)
+		graph.AddString(serviceNode, model.SES_ONTOLOGY_HAS_LOCAL_ID, service.LocalId)
+		graph.AddString(serviceNode, model.SES_ONTOLOGY_HAS_INTERACTION, string(service.Interaction))
+		graph.AddIri(serviceNode, model.SES_ONTOLOGY_HAS_PROTOCOL, service.ProtocolId)
+		for _, content := range service.Inputs {
+			if err := addContent(graph, serviceNode, model.SES_ONTOLOGY_HAS_INPUT, content); err != nil {
+				return err
+			}
+		}
+		for _, content := range service.Outputs {
+			if err := addContent(graph, serviceNode, model.SES_ONTOLOGY_HAS_OUTPUT, content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addContent(graph *Graph, service Term, predicate string, content models.Content) error {
+	contentNode := resourceOrBlankNode(graph, content.Id)
+	graph.Add(service, predicate, contentNode)
+	graph.Add(contentNode, RdfType, Iri(model.SES_ONTOLOGY_CONTENT))
+	graph.AddString(contentNode, model.SES_ONTOLOGY_HAS_SERIALIZATION, string(content.Serialization))
+	graph.AddIri(contentNode, model.SES_ONTOLOGY_HAS_PROTOCOL_SEGMENT, content.ProtocolSegmentId)
+	return addContentVariable(graph, contentNode, model.SES_ONTOLOGY_HAS_CONTENT_VARIABLE, content.ContentVariable)
+}
+
+func addContentVariable(graph *Graph, parent Term, predicate string, variable models.ContentVariable) error {
+	subject := resourceOrBlankNode(graph, variable.Id)
+	graph.Add(parent, predicate, subject)
+	graph.Add(subject, RdfType, Iri(model.SES_ONTOLOGY_CONTENT_VARIABLE))
+	graph.AddString(subject, model.RDFS_LABEL, variable.Name)
+	graph.AddIri(subject, model.SES_ONTOLOGY_HAS_VALUE_TYPE, string(variable.Type))
+	graph.AddIri(subject, model.SES_ONTOLOGY_HAS_CHARACTERISTIC, variable.CharacteristicId)
+	graph.AddIri(subject, model.SES_ONTOLOGY_EXPOSES_FUNCTION, variable.FunctionId)
+	graph.AddIri(subject, model.SES_ONTOLOGY_REFERS_TO, variable.AspectId)
+	graph.AddString(subject, model.SES_ONTOLOGY_HAS_UNIT_REFERENCE, variable.UnitReference)
+	for _, option := range variable.SerializationOptions {
+		graph.AddString(subject, model.SES_ONTOLOGY_HAS_SERIALIZATION_OPTION, option)
+	}
+	if variable.IsVoid {
+		_ = graph.AddValue(subject, model.SES_ONTOLOGY_IS_VOID, true)
+	}
+	if variable.OmitEmpty {
+		_ = graph.AddValue(subject, model.SES_ONTOLOGY_OMIT_EMPTY, true)
+	}
+	if err := graph.AddValue(subject, model.SES_ONTOLOGY_HAS_VALUE, variable.Value); err != nil {
+		return err
+	}
+	for _, sub := range variable.SubContentVariables {
+		if err := addContentVariable(graph, subject, model.SES_ONTOLOGY_HAS_SUB_CONTENT_VARIABLE, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToImportExport maps the functions, aspects, concepts, characteristics and device-classes of the graph onto their models (inverse of FromImportExport)
+// sub-aspects and sub-characteristics are only listed as part of their root resource; concept conversions are not part of the rdf representation
+func ToImportExport(graph *Graph) (result model.ImportExport, err error) {
+	for _, rdfType := range []string{model.SES_ONTOLOGY_CONTROLLING_FUNCTION, model.SES_ONTOLOGY_MEASURING_FUNCTION} {
+		for _, subject := range graph.SubjectsOfType(rdfType) {
+			if subject.Kind != KindIri {
+				return result, errors.New("function without iri")
+			}
+			result.Functions = append(result.Functions, models.Function{
+				Id:          subject.Value,
+				Name:        stringValue(graph, subject, model.RDFS_LABEL),
+				DisplayName: stringValue(graph, subject, model.SES_ONTOLOGY_HAS_DISPLAY_NAME),
+				Description: stringValue(graph, subject, model.RDFS_COMMENT),
+				ConceptId:   stringValue(graph, subject, model.SES_ONTOLOGY_HAS_CONCEPT),
+				RdfType:     rdfType,
+			})
+		}
+	}
+	for _, subject := range rootsOfType(graph, model.SES_ONTOLOGY_ASPECT, model.SES_ONTOLOGY_HAS_SUB_ASPECT) {
+		aspect, err := toAspect(graph, subject, map[Term]bool{})
+		if err != nil {
+			return result, err
+		}
+		result.Aspects = append(result.Aspects, aspect)
+	}
+	for _, subject := range rootsOfType(graph, model.SES_ONTOLOGY_CHARACTERISTIC, model.SES_ONTOLOGY_HAS_SUB_CHARACTERISTIC) {
+		characteristic, err := toCharacteristic(graph, subject, map[Term]bool{})
+		if err != nil {
+			return result, err
+		}
+		result.Characteristics = append(result.Characteristics, characteristic)
+	}
+	for _, subject := range graph.SubjectsOfType(model.SES_ONTOLOGY_CONCEPT) {
+		if subject.Kind != KindIri {
+			return result, errors.New("concept without iri")
+		}
+		concept := models.Concept{
+			Id:                   subject.Value,
+			Name:                 stringValue(graph, subject, model.RDFS_LABEL),
+			BaseCharacteristicId: stringValue(graph, subject, model.SES_ONTOLOGY_HAS_BASE_CHARACTERISTIC),
+			CharacteristicIds:    []string{},
+		}
+		for _, object := range graph.Objects(subject, model.SES_ONTOLOGY_HAS_CHARACTERISTIC) {
+			concept.CharacteristicIds = append(concept.CharacteristicIds, object.Value)
+		}
+		result.Concepts = append(result.Concepts, concept)
+	}
+	for _, subject := range graph.SubjectsOfType(model.SES_ONTOLOGY_DEVICE_CLASS) {
+		if subject.Kind != KindIri {
+			return result, errors.New("device-class without iri")
+		}
+		result.DeviceClasses = append(result.DeviceClasses, models.DeviceClass{
+			Id:    subject.Value,
+			Name:  stringValue(graph, subject, model.RDFS_LABEL),
+			Image: stringValue(graph, subject, model.SES_ONTOLOGY_HAS_IMAGE),
+		})
+	}
+	return result, nil
+}
+
+func stringValue(graph *Graph, subject Term, predicate string) string {
+	object, _ := graph.Object(subject, predicate)
+	return object.Value
+}
+
+func literalValue(graph *Graph, subject Term, predicate string) (interface{}, error) {
+	object, ok := graph.Object(subject, predicate)
+	if !ok {
+		return nil, nil
+	}
+	return object.Interface()
+}
+
+// rootsOfType lists subjects of rdfType that are no child (by childPredicate) of another subject
+func rootsOfType(graph *Graph, rdfType string, childPredicate string) []Term {
+	children := map[Term]bool{}
+	for _, t := range graph.Triples() {
+		if t.Predicate == childPredicate {
+			children[t.Object] = true
+		}
+	}
+	result := []Term{}
+	for _, subject := range graph.SubjectsOfType(rdfType) {
+		if !children[subject] {
+			result = append(result, subject)
+		}
+	}
+	return result
+}
+
+func toAspect(graph *Graph, subject Term, visited map[Term]bool) (result models.Aspect, err error) {
+	if subject.Kind != KindIri {
+		return result, errors.New("aspect without iri")
+	}
+	if visited[subject] {
+		return result, fmt.Errorf("aspect %v is its own sub-aspect", subject.Value)
+	}
+	visited[subject] = true
+	result = models.Aspect{Id: subject.Value, Name: stringValue(graph, subject, model.RDFS_LABEL), SubAspects: []models.Aspect{}}
+	for _, object := range graph.Objects(subject, model.SES_ONTOLOGY_HAS_SUB_ASPECT) {
+		sub, err := toAspect(graph, object, visited)
+		if err != nil {
+			return result, err
+		}
+		result.SubAspects = append(result.SubAspects, sub)
+	}
+	return result, nil
+}
+
+func toCharacteristic(graph *Graph, subject Term, visited map[Term]bool) (result models.Characteristic, err error) {
+	if subject.Kind != KindIri {
+		return result, errors.New("characteristic without iri")
+	}
+	if visited[subject] {
+		return result, fmt.Errorf("characteristic %v is its own sub-characteristic", subject.Value)
+	}
+	visited[subject] = true
+	result = models.Characteristic{
+		Id:                 subject.Value,
+		Name:               stringValue(graph, subject, model.RDFS_LABEL),
+		Type:               models.Type(stringValue(graph, subject, model.SES_ONTOLOGY_HAS_VALUE_TYPE)),
+		DisplayUnit:        stringValue(graph, subject, model.SES_ONTOLOGY_HAS_DISPLAY_UNIT),
+		SubCharacteristics: []models.Characteristic{},
+	}
+	if result.Value, err = literalValue(graph, subject, model.SES_ONTOLOGY_HAS_VALUE); err != nil {
+		return result, fmt.Errorf("invalid value of %v: %w", subject.Value, err)
+	}
+	if result.MinValue, err = literalValue(graph, subject, model.SES_ONTOLOGY_HAS_MIN_VALUE); err != nil {
+		return result, fmt.Errorf("invalid min value of %v: %w", subject.Value, err)
+	}
+	if result.MaxValue, err = literalValue(graph, subject, model.SES_ONTOLOGY_HAS_MAX_VALUE); err != nil {
+		return result, fmt.Errorf("invalid max value of %v: %w", subject.Value, err)
+	}
+	for _, object := range graph.Objects(subject, model.SES_ONTOLOGY_HAS_ALLOWED_VALUE) {
+		value, err := object.Interface()
+		if err != nil {
+			return result, fmt.Errorf("invalid allowed value of %v: %w", subject.Value, err)
+		}
+		result.AllowedValues = append(result.AllowedValues, value)
+	}
+	for _, object := range graph.Objects(subject, model.SES_ONTOLOGY_HAS_SUB_CHARACTERISTIC) {
+		sub, err := toCharacteristic(graph, object, visited)
+		if err != nil {
+			return result, err
+		}
+		result.SubCharacteristics = append(result.SubCharacteristics, sub)
+	}
+	return result, nil
+}
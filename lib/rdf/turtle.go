@@ -0,0 +1,139 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const TurtleContentType = "text/turtle"
+
+var turtleLocalName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// WriteTurtle serializes the graph as Turtle, grouping triples by subject
+func WriteTurtle(writer io.Writer, graph *Graph) error {
+	w := bufio.NewWriter(writer)
+	prefixes := sortedPrefixes()
+	for _, prefix := range prefixes {
+		_, _ = w.WriteString("@prefix " + prefix + ": <" + Prefixes[prefix] + "> .\n")
+	}
+	for _, subject := range graph.Subjects() {
+		_, _ = w.WriteString("\n" + turtleTerm(subject))
+		properties := graph.Properties(subject)
+		for i, t := range properties {
+			if i > 0 && properties[i-1].Predicate == t.Predicate {
+				_, _ = w.WriteString(" ,\n        " + turtleTerm(t.Object))
+				continue
+			}
+			if i > 0 {
+				_, _ = w.WriteString(" ;")
+			}
+			predicate := turtleIri(t.Predicate)
+			if t.Predicate == RdfType {
+				predicate = "a"
+			}
+			_, _ = w.WriteString("\n    " + predicate + " " + turtleTerm(t.Object))
+		}
+		_, _ = w.WriteString(" .\n")
+	}
+	return w.Flush()
+}
+
+func sortedPrefixes() []string {
+	result := []string{}
+	for prefix := range Prefixes {
+		result = append(result, prefix)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func turtleTerm(term Term) string {
+	switch term.Kind {
+	case KindIri:
+		return turtleIri(term.Value)
+	case KindBlankNode:
+		return "_:" + term.Value
+	default:
+		result := `"` + turtleEscape(term.Value) + `"`
+		if term.Language != "" {
+			return result + "@" + term.Language
+		}
+		if term.Datatype != "" && term.Datatype != XsdString {
+			return result + "^^" + turtleIri(term.Datatype)
+		}
+		return result
+	}
+}
+
+func turtleIri(iri string) string {
+	for prefix, namespace := range Prefixes {
+		if local, ok := strings.CutPrefix(iri, namespace); ok && turtleLocalName.MatchString(local) {
+			return prefix + ":" + local
+		}
+	}
+	builder := strings.Builder{}
+	builder.WriteString("<")
+	for _, r := range iri {
+		if r <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", r) {
+			builder.WriteString(uchar(r))
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+	builder.WriteString(">")
+	return builder.String()
+}
+
+func turtleEscape(value string) string {
+	builder := strings.Builder{}
+	for _, r := range value {
+		switch r {
+		case '"':
+			builder.WriteString(`\"`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				builder.WriteString(uchar(r))
+			} else {
+				builder.WriteRune(r)
+			}
+		}
+	}
+	return builder.String()
+}
+
+func uchar(r rune) string {
+	const hex = "0123456789ABCDEF"
+	result := []byte(`\u0000`)
+	for i := 5; i > 1; i-- {
+		result[i] = hex[r&0xF]
+		r >>= 4
+	}
+	return string(result)
+}
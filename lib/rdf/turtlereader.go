@@ -0,0 +1,520 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rdf
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ReadTurtle parses a Turtle document
+// collections ('( ... )') are not supported
+func ReadTurtle(reader io.Reader) (*Graph, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	p := &turtleParser{input: string(b), graph: NewGraph(), prefixes: map[string]string{}, blankNodes: map[string]Term{}}
+	err = p.document()
+	if err != nil {
+		return nil, err
+	}
+	return p.graph, nil
+}
+
+type turtleParser struct {
+	input      string
+	pos        int
+	graph      *Graph
+	base       string
+	prefixes   map[string]string
+	blankNodes map[string]Term
+}
+
+func (this *turtleParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(this.input[:this.pos], "\n") + 1
+	return fmt.Errorf("turtle: line %v: %v", line, fmt.Sprintf(format, args...))
+}
+
+func (this *turtleParser) eof() bool {
+	return this.pos >= len(this.input)
+}
+
+func (this *turtleParser) peek() byte {
+	if this.eof() {
+		return 0
+	}
+	return this.input[this.pos]
+}
+
+func (this *turtleParser) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(this.input[this.pos:], prefix)
+}
+
+// hasKeyword checks case-insensitive for a keyword followed by whitespace
+func (this *turtleParser) hasKeyword(keyword string) bool {
+	end := this.pos + len(keyword)
+	if end >= len(this.input) || !strings.EqualFold(this.input[this.pos:end], keyword) {
+		return false
+	}
+	return isTurtleWhitespace(this.input[end])
+}
+
+func isTurtleWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (this *turtleParser) skipWhitespace() {
+	for !this.eof() {
+		c := this.peek()
+		if isTurtleWhitespace(c) {
+			this.pos++
+		} else if c == '#' {
+			for !this.eof() && this.peek() != '\n' {
+				this.pos++
+			}
+		} else {
+			return
+		}
+	}
+}
+
+func (this *turtleParser) expect(c byte) error {
+	this.skipWhitespace()
+	if this.peek() != c {
+		return this.errorf("expected '%c'", c)
+	}
+	this.pos++
+	return nil
+}
+
+func (this *turtleParser) document() error {
+	for {
+		this.skipWhitespace()
+		if this.eof() {
+			return nil
+		}
+		var err error
+		switch {
+		case this.hasPrefix("@prefix"):
+			this.pos += len("@prefix")
+			err = this.prefixDirective(true)
+		case this.hasPrefix("@base"):
+			this.pos += len("@base")
+			err = this.baseDirective(true)
+		case this.hasKeyword("PREFIX"):
+			this.pos += len("PREFIX")
+			err = this.prefixDirective(false)
+		case this.hasKeyword("BASE"):
+			this.pos += len("BASE")
+			err = this.baseDirective(false)
+		default:
+			err = this.triples()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (this *turtleParser) prefixDirective(withDot bool) error {
+	this.skipWhitespace()
+	start := this.pos
+	for !this.eof() && this.peek() != ':' && !isTurtleWhitespace(this.peek()) {
+		this.pos++
+	}
+	prefix := this.input[start:this.pos]
+	if err := this.expect(':'); err != nil {
+		return err
+	}
+	this.skipWhitespace()
+	iri, err := this.iriRef()
+	if err != nil {
+		return err
+	}
+	this.prefixes[prefix] = iri
+	if withDot {
+		return this.expect('.')
+	}
+	return nil
+}
+
+func (this *turtleParser) baseDirective(withDot bool) error {
+	this.skipWhitespace()
+	iri, err := this.iriRef()
+	if err != nil {
+		return err
+	}
+	this.base = iri
+	if withDot {
+		return this.expect('.')
+	}
+	return nil
+}
+
+func (this *turtleParser) triples() (err error) {
+	var subject Term
+	if this.peek() == '[' {
+		subject, err = this.blankNodePropertyList()
+		if err != nil {
+			return err
+		}
+		this.skipWhitespace()
+		if this.peek() == '.' {
+			this.pos++
+			return nil
+		}
+	} else {
+		subject, err = this.resource()
+		if err != nil {
+			return err
+		}
+	}
+	err = this.predicateObjectList(subject)
+	if err != nil {
+		return err
+	}
+	return this.expect('.')
+}
+
+func (this *turtleParser) predicateObjectList(subject Term) error {
+	for {
+		this.skipWhitespace()
+		var predicate string
+		if this.peek() == 'a' && this.pos+1 < len(this.input) && (isTurtleWhitespace(this.input[this.pos+1]) || this.input[this.pos+1] == '<') {
+			this.pos++
+			predicate = RdfType
+		} else {
+			term, err := this.resource()
+			if err != nil {
+				return err
+			}
+			if term.Kind != KindIri {
+				return this.errorf("expected predicate iri")
+			}
+			predicate = term.Value
+		}
+		for {
+			object, err := this.object()
+			if err != nil {
+				return err
+			}
+			this.graph.Add(subject, predicate, object)
+			this.skipWhitespace()
+			if this.peek() != ',' {
+				break
+			}
+			this.pos++
+		}
+		if this.peek() != ';' {
+			return nil
+		}
+		for this.peek() == ';' {
+			this.pos++
+			this.skipWhitespace()
+		}
+		if c := this.peek(); c == '.' || c == ']' {
+			return nil
+		}
+	}
+}
+
+func (this *turtleParser) blankNodePropertyList() (Term, error) {
+	this.pos++ //'['
+	node := this.graph.NewBlankNode()
+	this.skipWhitespace()
+	if this.peek() != ']' {
+		if err := this.predicateObjectList(node); err != nil {
+			return node, err
+		}
+	}
+	return node, this.expect(']')
+}
+
+func (this *turtleParser) object() (Term, error) {
+	this.skipWhitespace()
+	c := this.peek()
+	switch {
+	case c == '[':
+		return this.blankNodePropertyList()
+	case c == '(':
+		return Term{}, this.errorf("collections are not supported")
+	case c == '"' || c == '\'':
+		return this.literal()
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return this.number()
+	case this.hasBoolean("true"):
+		this.pos += 4
+		return TypedLiteral("true", XsdBoolean), nil
+	case this.hasBoolean("false"):
+		this.pos += 5
+		return TypedLiteral("false", XsdBoolean), nil
+	default:
+		return this.resource()
+	}
+}
+
+func (this *turtleParser) hasBoolean(value string) bool {
+	if !this.hasPrefix(value) {
+		return false
+	}
+	end := this.pos + len(value)
+	return end >= len(this.input) || !isPnChar(rune(this.input[end]))
+}
+
+// resource parses an iri, prefixed name or blank node label
+func (this *turtleParser) resource() (Term, error) {
+	this.skipWhitespace()
+	if this.peek() == '<' {
+		iri, err := this.iriRef()
+		return Iri(iri), err
+	}
+	if this.hasPrefix("_:") {
+		this.pos += 2
+		label := this.name()
+		if label == "" {
+			return Term{}, this.errorf("missing blank node label")
+		}
+		if node, ok := this.blankNodes[label]; ok {
+			return node, nil
+		}
+		node := this.graph.NewBlankNode()
+		this.blankNodes[label] = node
+		return node, nil
+	}
+	start := this.pos
+	for !this.eof() && this.peek() != ':' && isPnChar(rune(this.peek())) {
+		this.pos++
+	}
+	prefix := this.input[start:this.pos]
+	if this.peek() != ':' {
+		return Term{}, this.errorf("unexpected '%v'", this.input[start:min(start+10, len(this.input))])
+	}
+	this.pos++
+	namespace, ok := this.prefixes[prefix]
+	if !ok {
+		return Term{}, this.errorf("unknown prefix '%v'", prefix)
+	}
+	return Iri(namespace + this.name()), nil
+}
+
+func isPnChar(r rune) bool {
+	return r == '_' || r == '-' || r == '.' || r == ':' || r == '%' || unicode.IsLetter(r) || unicode.IsDigit(r) || r > 0x7F
+}
+
+// name reads the local part of a prefixed name or a blank node label; a trailing '.' ends the statement
+func (this *turtleParser) name() string {
+	builder := strings.Builder{}
+	for !this.eof() {
+		r, size := utf8.DecodeRuneInString(this.input[this.pos:])
+		if r == '\\' && this.pos+1 < len(this.input) {
+			builder.WriteByte(this.input[this.pos+1])
+			this.pos += 2
+			continue
+		}
+		if !isPnChar(r) {
+			break
+		}
+		if r == '.' && (this.pos+1 >= len(this.input) || !isPnChar(rune(this.input[this.pos+1])) || this.input[this.pos+1] == '.') {
+			break
+		}
+		builder.WriteRune(r)
+		this.pos += size
+	}
+	return builder.String()
+}
+
+func (this *turtleParser) iriRef() (string, error) {
+	if this.peek() != '<' {
+		return "", this.errorf("expected iri")
+	}
+	this.pos++
+	builder := strings.Builder{}
+	for {
+		if this.eof() {
+			return "", this.errorf("unterminated iri")
+		}
+		c := this.peek()
+		if c == '>' {
+			this.pos++
+			break
+		}
+		if c == '\\' {
+			r, err := this.unicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			builder.WriteRune(r)
+			continue
+		}
+		builder.WriteByte(c)
+		this.pos++
+	}
+	return this.resolve(builder.String())
+}
+
+func (this *turtleParser) resolve(iri string) (string, error) {
+	if this.base == "" {
+		return iri, nil
+	}
+	ref, err := url.Parse(iri)
+	if err != nil {
+		return "", this.errorf("invalid iri '%v'", iri)
+	}
+	if ref.IsAbs() {
+		return iri, nil
+	}
+	base, err := url.Parse(this.base)
+	if err != nil {
+		return "", this.errorf("invalid base iri '%v'", this.base)
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+// unicodeEscape reads \uXXXX or \UXXXXXXXX
+func (this *turtleParser) unicodeEscape() (rune, error) {
+	if this.pos+1 >= len(this.input) {
+		return 0, this.errorf("invalid escape sequence")
+	}
+	length := 0
+	switch this.input[this.pos+1] {
+	case 'u':
+		length = 4
+	case 'U':
+		length = 8
+	default:
+		return 0, this.errorf("invalid escape sequence")
+	}
+	start := this.pos + 2
+	if start+length > len(this.input) {
+		return 0, this.errorf("invalid escape sequence")
+	}
+	value, err := strconv.ParseUint(this.input[start:start+length], 16, 32)
+	if err != nil {
+		return 0, this.errorf("invalid escape sequence")
+	}
+	this.pos = start + length
+	return rune(value), nil
+}
+
+func (this *turtleParser) literal() (Term, error) {
+	value, err := this.stringValue()
+	if err != nil {
+		return Term{}, err
+	}
+	if this.peek() == '@' {
+		this.pos++
+		start := this.pos
+		for !this.eof() && (this.peek() == '-' || unicode.IsLetter(rune(this.peek())) || unicode.IsDigit(rune(this.peek()))) {
+			this.pos++
+		}
+		return Term{Kind: KindLiteral, Value: value, Language: this.input[start:this.pos]}, nil
+	}
+	if this.hasPrefix("^^") {
+		this.pos += 2
+		datatype, err := this.resource()
+		if err != nil {
+			return Term{}, err
+		}
+		return TypedLiteral(value, datatype.Value), nil
+	}
+	return String(value), nil
+}
+
+func (this *turtleParser) stringValue() (string, error) {
+	quote := this.input[this.pos : this.pos+1]
+	long := this.hasPrefix(quote + quote + quote)
+	if long {
+		quote = quote + quote + quote
+	}
+	this.pos += len(quote)
+	builder := strings.Builder{}
+	for {
+		if this.eof() {
+			return "", this.errorf("unterminated string")
+		}
+		if this.hasPrefix(quote) {
+			this.pos += len(quote)
+			return builder.String(), nil
+		}
+		c := this.peek()
+		if !long && (c == '\n' || c == '\r') {
+			return "", this.errorf("unexpected line break in string")
+		}
+		if c != '\\' {
+			builder.WriteByte(c)
+			this.pos++
+			continue
+		}
+		if this.pos+1 >= len(this.input) {
+			return "", this.errorf("invalid escape sequence")
+		}
+		escaped := map[byte]string{'t': "\t", 'b': "\b", 'n': "\n", 'r': "\r", 'f': "\f", '"': "\"", '\'': "'", '\\': "\\"}
+		if replacement, ok := escaped[this.input[this.pos+1]]; ok {
+			builder.WriteString(replacement)
+			this.pos += 2
+			continue
+		}
+		r, err := this.unicodeEscape()
+		if err != nil {
+			return "", err
+		}
+		builder.WriteRune(r)
+	}
+}
+
+func (this *turtleParser) number() (Term, error) {
+	start := this.pos
+	if c := this.peek(); c == '+' || c == '-' {
+		this.pos++
+	}
+	datatype := XsdInteger
+	digits := func() int {
+		count := 0
+		for !this.eof() && this.peek() >= '0' && this.peek() <= '9' {
+			this.pos++
+			count++
+		}
+		return count
+	}
+	count := digits()
+	//a '.' directly followed by a digit is a decimal point, otherwise it ends the statement
+	if this.peek() == '.' && this.pos+1 < len(this.input) && this.input[this.pos+1] >= '0' && this.input[this.pos+1] <= '9' {
+		this.pos++
+		datatype = XsdDecimal
+		count += digits()
+	}
+	if c := this.peek(); c == 'e' || c == 'E' {
+		this.pos++
+		if c := this.peek(); c == '+' || c == '-' {
+			this.pos++
+		}
+		if digits() == 0 {
+			return Term{}, this.errorf("invalid number exponent")
+		}
+		datatype = XsdDouble
+	}
+	if count == 0 {
+		return Term{}, this.errorf("invalid number")
+	}
+	return TypedLiteral(this.input[start:this.pos], datatype), nil
+}
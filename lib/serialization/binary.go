@@ -0,0 +1,436 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const Binary models.Serialization = "binary"
+
+// serialization_options of Binary content-variables
+const (
+	BinaryOptionOffset    = "offset"
+	BinaryOptionLength    = "length"
+	BinaryOptionByteOrder = "byte_order"
+	BinaryOptionSigned    = "signed"
+)
+
+// BinaryMaxFrameSize limits offset and length of Binary content-variables, larger frames are rejected
+const BinaryMaxFrameSize = 65535
+
+// FixedBinary describes binary frames with a fixed layout (e.g. modbus registers or ble characteristics)
+// every non-void primitive content-variable addresses its bytes in the frame with serialization_options
+// (e.g. ["offset=2", "length=2", "byte_order=little", "signed=false"]); structures and lists only group fields
+// and may not use the placeholder '*'; fields may not overlap, bytes without field are ignored
+type FixedBinary struct{}
+
+func (this FixedBinary) Key() models.Serialization {
+	return Binary
+}
+
+func (this FixedBinary) Info() model.SerializationInfo {
+	return model.SerializationInfo{
+		Key:         Binary,
+		Description: "binary frame with a fixed layout; primitive content-variables address their bytes with serialization_options",
+		MediaType:   "application/octet-stream",
+		Binary:      true,
+		Options: []model.SerializationOption{
+			{Key: BinaryOptionOffset, Description: "byte offset of the field in the frame (max 65535)", Required: true},
+			{Key: BinaryOptionLength, Description: "byte length of the field; integers: 1, 2, 4 or 8 (default 2); floats: 4 or 8 (default 4); booleans: 1; strings: required, shorter values are padded with zero bytes"},
+			{Key: BinaryOptionByteOrder, Description: "byte order of integers and floats (default big)", Values: []string{"big", "little"}},
+			{Key: BinaryOptionSigned, Description: "integers are two's complement (default true)", Values: []string{"true", "false"}},
+		},
+	}
+}
+
+type binaryField struct {
+	path   string
+	offset int
+	length int
+	order  binary.ByteOrder
+	signed bool
+}
+
+func (this FixedBinary) ValidateVariable(variable models.ContentVariable) error {
+	fields := []binaryField{}
+	err := this.collectFields(variable, "content_variable", &fields)
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(fields, func(a, b binaryField) int {
+		return a.offset - b.offset
+	})
+	for i := 1; i < len(fields); i++ {
+		if fields[i].offset < fields[i-1].offset+fields[i-1].length {
+			return model.NewFieldError(model.ErrDeviceTypeInvalidSerializationOption, fields[i].path+".serialization_options", fmt.Errorf("field overlaps with %v", fields[i-1].path))
+		}
+	}
+	return nil
+}
+
+func (this FixedBinary) collectFields(variable models.ContentVariable, path string, fields *[]binaryField) error {
+	if variable.IsVoid {
+		return nil
+	}
+	switch variable.Type {
+	case models.Structure, models.List:
+		if len(variable.SerializationOptions) > 0 {
+			return model.NewFieldError(model.ErrDeviceTypeInvalidSerializationOption, path+".serialization_options", errors.New("binary serialization options are only allowed for primitive content-variables"))
+		}
+		for i, sub := range variable.SubContentVariables {
+			subPath := fmt.Sprintf("%v.sub_content_variables[%v]", path, i)
+			if sub.Name == "*" {
+				return model.NewFieldError(model.ErrDeviceTypeSerializationMismatch, subPath+".name", errors.New("binary frames have a fixed layout; the placeholder '*' is not supported"))
+			}
+			err := this.collectFields(sub, subPath, fields)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		field, err := this.field(variable)
+		if err != nil {
+			return model.NewFieldError(model.ErrDeviceTypeInvalidSerializationOption, path+".serialization_options", err)
+		}
+		field.path = path
+		*fields = append(*fields, field)
+		return nil
+	}
+}
+
+// field reads the serialization_options of a primitive content-variable
+func (this FixedBinary) field(variable models.ContentVariable) (result binaryField, err error) {
+	options, err := ParseOptions(variable, this.Info().Options)
+	if err != nil {
+		return result, err
+	}
+	offset, ok := options[BinaryOptionOffset]
+	if !ok {
+		return result, fmt.Errorf("missing serialization option %v for %v", BinaryOptionOffset, variable.Name)
+	}
+	result.offset, err = strconv.Atoi(offset)
+	if err != nil || result.offset < 0 || result.offset > BinaryMaxFrameSize {
+		return result, fmt.Errorf("invalid serialization option %v=%v for %v", BinaryOptionOffset, offset, variable.Name)
+	}
+	length, hasLength := options[BinaryOptionLength]
+	if hasLength {
+		result.length, err = strconv.Atoi(length)
+		if err != nil || result.length < 1 || result.length > BinaryMaxFrameSize {
+			return result, fmt.Errorf("invalid serialization option %v=%v for %v", BinaryOptionLength, length, variable.Name)
+		}
+	}
+	var allowedLengths []int
+	switch variable.Type {
+	case models.Integer:
+		allowedLengths = []int{1, 2, 4, 8}
+		if !hasLength {
+			result.length = 2
+		}
+	case models.Float:
+		allowedLengths = []int{4, 8}
+		if !hasLength {
+			result.length = 4
+		}
+	case models.Boolean:
+		allowedLengths = []int{1}
+		if !hasLength {
+			result.length = 1
+		}
+	case models.String:
+		if !hasLength {
+			return result, fmt.Errorf("missing serialization option %v for string %v", BinaryOptionLength, variable.Name)
+		}
+	default:
+		return result, fmt.Errorf("unsupported type %v of %v", variable.Type, variable.Name)
+	}
+	if allowedLengths != nil && !slices.Contains(allowedLengths, result.length) {
+		return result, fmt.Errorf("invalid serialization option %v=%v for %v, expected one of %v", BinaryOptionLength, result.length, variable.Name, allowedLengths)
+	}
+	result.order = binary.BigEndian
+	if options[BinaryOptionByteOrder] == "little" {
+		result.order = binary.LittleEndian
+	}
+	result.signed = options[BinaryOptionSigned] != "false"
+	return result, nil
+}
+
+func (this FixedBinary) Decode(variable models.ContentVariable, message []byte) (interface{}, error) {
+	if variable.IsVoid {
+		return nil, nil
+	}
+	switch variable.Type {
+	case models.Structure:
+		result := map[string]interface{}{}
+		for _, sub := range variable.SubContentVariables {
+			if sub.IsVoid {
+				continue
+			}
+			value, err := this.Decode(sub, message)
+			if err != nil {
+				return nil, err
+			}
+			result[sub.Name] = value
+		}
+		return result, nil
+	case models.List:
+		result := make([]interface{}, len(variable.SubContentVariables))
+		for _, sub := range variable.SubContentVariables {
+			index, err := strconv.Atoi(sub.Name)
+			if err != nil || index < 0 || index >= len(result) {
+				return nil, fmt.Errorf("invalid list index %v in %v", sub.Name, variable.Name)
+			}
+			if sub.IsVoid {
+				continue
+			}
+			result[index], err = this.Decode(sub, message)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+	field, err := this.field(variable)
+	if err != nil {
+		return nil, err
+	}
+	if field.length > len(message) || field.offset > len(message)-field.length {
+		return nil, fmt.Errorf("message too short for %v: expected at least %v bytes, got %v", variable.Name, field.offset+field.length, len(message))
+	}
+	data := message[field.offset : field.offset+field.length]
+	switch variable.Type {
+	case models.Integer:
+		u := readUint(data, field.order)
+		if field.signed && field.length < 8 && u >= 1<<(8*field.length-1) {
+			return json.Number(strconv.FormatInt(int64(u)-int64(1)<<(8*field.length), 10)), nil
+		}
+		if field.signed {
+			return json.Number(strconv.FormatInt(int64(u), 10)), nil
+		}
+		return json.Number(strconv.FormatUint(u, 10)), nil
+	case models.Float:
+		if field.length == 4 {
+			return float64(math.Float32frombits(uint32(readUint(data, field.order)))), nil
+		}
+		return math.Float64frombits(readUint(data, field.order)), nil
+	case models.Boolean:
+		return data[0] != 0, nil
+	default:
+		end := len(data)
+		for end > 0 && data[end-1] == 0 {
+			end--
+		}
+		if !utf8.Valid(data[:end]) {
+			return nil, fmt.Errorf("invalid utf-8 string in %v", variable.Name)
+		}
+		return string(data[:end]), nil
+	}
+}
+
+// Encode renders value as frame; the frame ends with the last field, missing values are encoded as zero bytes
+func (this FixedBinary) Encode(variable models.ContentVariable, value interface{}) ([]byte, error) {
+	fields := []binaryField{}
+	err := this.collectFields(variable, variable.Name, &fields)
+	if err != nil {
+		return nil, err
+	}
+	size := 0
+	for _, field := range fields {
+		size = max(size, field.offset+field.length)
+	}
+	frame := make([]byte, size)
+	err = this.encode(variable, value, frame)
+	return frame, err
+}
+
+func (this FixedBinary) encode(variable models.ContentVariable, value interface{}, frame []byte) error {
+	if variable.IsVoid || value == nil {
+		return nil
+	}
+	switch variable.Type {
+	case models.Structure:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected structure for %v, got %T", variable.Name, value)
+		}
+		for _, sub := range variable.SubContentVariables {
+			err := this.encode(sub, m[sub.Name], frame)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case models.List:
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected list for %v, got %T", variable.Name, value)
+		}
+		for _, sub := range variable.SubContentVariables {
+			index, err := strconv.Atoi(sub.Name)
+			if err != nil || index < 0 || index >= len(list) {
+				continue
+			}
+			err = this.encode(sub, list[index], frame)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	field, err := this.field(variable)
+	if err != nil {
+		return err
+	}
+	data := frame[field.offset : field.offset+field.length]
+	switch variable.Type {
+	case models.Integer:
+		n, ok := toBigInt(value)
+		if !ok {
+			return fmt.Errorf("expected integer for %v, got %v", variable.Name, value)
+		}
+		bits := uint(8 * field.length)
+		lower, upper := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), bits)
+		if field.signed {
+			lower = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+			upper = new(big.Int).Lsh(big.NewInt(1), bits-1)
+		}
+		if n.Cmp(lower) < 0 || n.Cmp(upper) >= 0 {
+			return fmt.Errorf("value %v of %v does not fit in %v bytes", n, variable.Name, field.length)
+		}
+		if n.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), bits))
+		}
+		writeUint(data, n.Uint64(), field.order)
+	case models.Float:
+		f, ok := toFloat64(value)
+		if !ok {
+			return fmt.Errorf("expected number for %v, got %v", variable.Name, value)
+		}
+		if field.length == 4 {
+			writeUint(data, uint64(math.Float32bits(float32(f))), field.order)
+		} else {
+			writeUint(data, math.Float64bits(f), field.order)
+		}
+	case models.Boolean:
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected boolean for %v, got %v", variable.Name, value)
+		}
+		if b {
+			data[0] = 1
+		}
+	default:
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected string for %v, got %v", variable.Name, value)
+		}
+		if len(str) > field.length {
+			return fmt.Errorf("value of %v is longer than %v bytes", variable.Name, field.length)
+		}
+		copy(data, str)
+	}
+	return nil
+}
+
+func readUint(data []byte, order binary.ByteOrder) (result uint64) {
+	for i := range data {
+		b := data[i]
+		if order == binary.LittleEndian {
+			b = data[len(data)-1-i]
+		}
+		result = result<<8 | uint64(b)
+	}
+	return result
+}
+
+func writeUint(data []byte, value uint64, order binary.ByteOrder) {
+	for i := len(data) - 1; i >= 0; i-- {
+		if order == binary.LittleEndian {
+			data[len(data)-1-i] = byte(value)
+		} else {
+			data[i] = byte(value)
+		}
+		value = value >> 8
+	}
+}
+
+func toBigInt(value interface{}) (*big.Int, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 10)
+		if ok {
+			return n, true
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, false
+		}
+		return toBigInt(f)
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return nil, false
+		}
+		n, _ := big.NewFloat(v).Int(nil)
+		return n, true
+	case float32:
+		return toBigInt(float64(v))
+	case int:
+		return big.NewInt(int64(v)), true
+	case int32:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	case uint64:
+		return new(big.Int).SetUint64(v), true
+	default:
+		return nil, false
+	}
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,352 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const CBOR models.Serialization = "cbor"
+
+// cborMaxDepth limits the nesting of decoded cbor items
+const cborMaxDepth = 64
+
+// Cbor (RFC 8949) accepts all content-variable structures
+// integers are decoded as json.Number, byte strings as base64 strings, tags are ignored and undefined is decoded as nil
+type Cbor struct{}
+
+func (this Cbor) Key() models.Serialization {
+	return CBOR
+}
+
+func (this Cbor) Info() model.SerializationInfo {
+	return model.SerializationInfo{
+		Key:         CBOR,
+		Description: "cbor (RFC 8949) item; structures are maps, lists are arrays",
+		MediaType:   "application/cbor",
+		Binary:      true,
+		Options:     []model.SerializationOption{},
+	}
+}
+
+func (this Cbor) ValidateVariable(variable models.ContentVariable) error {
+	return nil
+}
+
+func (this Cbor) Decode(variable models.ContentVariable, message []byte) (interface{}, error) {
+	decoder := &cborDecoder{data: message}
+	result, err := decoder.item(0)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.pos != len(message) {
+		return nil, errors.New("unexpected data after cbor item")
+	}
+	return result, nil
+}
+
+// Encode renders value with definite lengths; map keys are sorted
+func (this Cbor) Encode(variable models.ContentVariable, value interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	err := encodeCbor(buf, value)
+	return buf.Bytes(), err
+}
+
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+var errCborUnexpectedEnd = errors.New("unexpected end of cbor data")
+
+// errCborBreak signals the end of an indefinite length item
+var errCborBreak = errors.New("unexpected cbor break")
+
+func (this *cborDecoder) read(n uint64) ([]byte, error) {
+	if n > uint64(len(this.data)-this.pos) {
+		return nil, errCborUnexpectedEnd
+	}
+	result := this.data[this.pos : this.pos+int(n)]
+	this.pos = this.pos + int(n)
+	return result, nil
+}
+
+// head reads the initial byte and the argument of an item; indefinite is true for the additional information 31
+func (this *cborDecoder) head() (major byte, info byte, argument uint64, indefinite bool, err error) {
+	b, err := this.read(1)
+	if err != nil {
+		return 0, 0, 0, false, err
+	}
+	major, info = b[0]>>5, b[0]&0x1f
+	switch {
+	case info < 24:
+		return major, info, uint64(info), false, nil
+	case info <= 27:
+		data, err := this.read(1 << (info - 24))
+		if err != nil {
+			return 0, 0, 0, false, err
+		}
+		for _, element := range data {
+			argument = argument<<8 | uint64(element)
+		}
+		return major, info, argument, false, nil
+	case info == 31:
+		return major, info, 0, true, nil
+	default:
+		return 0, 0, 0, false, fmt.Errorf("invalid cbor additional information %v", info)
+	}
+}
+
+func (this *cborDecoder) item(depth int) (interface{}, error) {
+	if depth > cborMaxDepth {
+		return nil, errors.New("cbor nesting too deep")
+	}
+	major, info, argument, indefinite, err := this.head()
+	if err != nil {
+		return nil, err
+	}
+	if indefinite && (major < 2 || major == 6) {
+		return nil, fmt.Errorf("invalid indefinite length for cbor major type %v", major)
+	}
+	switch major {
+	case 0:
+		return json.Number(strconv.FormatUint(argument, 10)), nil
+	case 1:
+		n := new(big.Int).SetUint64(argument)
+		return json.Number(n.Neg(n.Add(n, big.NewInt(1))).String()), nil
+	case 2, 3:
+		data, err := this.bytes(major, argument, indefinite, depth)
+		if err != nil {
+			return nil, err
+		}
+		if major == 2 {
+			return base64.StdEncoding.EncodeToString(data), nil
+		}
+		if !utf8.Valid(data) {
+			return nil, errors.New("invalid utf-8 in cbor text string")
+		}
+		return string(data), nil
+	case 4:
+		result := []interface{}{}
+		for i := uint64(0); indefinite || i < argument; i++ {
+			element, err := this.item(depth + 1)
+			if indefinite && errors.Is(err, errCborBreak) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, element)
+		}
+		return result, nil
+	case 5:
+		result := map[string]interface{}{}
+		for i := uint64(0); indefinite || i < argument; i++ {
+			key, err := this.item(depth + 1)
+			if indefinite && errors.Is(err, errCborBreak) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			value, err := this.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			result[fmt.Sprint(key)] = value
+		}
+		return result, nil
+	case 6:
+		return this.item(depth + 1)
+	default:
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23:
+			return nil, nil
+		case 25:
+			return halfToFloat(uint16(argument)), nil
+		case 26:
+			return float64(math.Float32frombits(uint32(argument))), nil
+		case 27:
+			return math.Float64frombits(argument), nil
+		case 31:
+			return nil, errCborBreak
+		default:
+			return nil, fmt.Errorf("unsupported cbor simple value %v", argument)
+		}
+	}
+}
+
+// bytes reads the content of a byte or text string; indefinite strings are concatenated from definite chunks
+func (this *cborDecoder) bytes(major byte, argument uint64, indefinite bool, depth int) ([]byte, error) {
+	if !indefinite {
+		return this.read(argument)
+	}
+	result := []byte{}
+	for {
+		chunkMajor, _, chunkLength, chunkIndefinite, err := this.head()
+		if err != nil {
+			return nil, err
+		}
+		if chunkMajor == 7 && chunkIndefinite {
+			return result, nil
+		}
+		if chunkMajor != major || chunkIndefinite {
+			return nil, errors.New("invalid chunk in indefinite length cbor string")
+		}
+		chunk, err := this.read(chunkLength)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, chunk...)
+	}
+}
+
+func halfToFloat(half uint16) float64 {
+	exponent := int(half>>10) & 0x1f
+	mantissa := float64(half & 0x3ff)
+	var result float64
+	switch exponent {
+	case 0:
+		result = math.Ldexp(mantissa, -24)
+	case 31:
+		if mantissa == 0 {
+			result = math.Inf(1)
+		} else {
+			result = math.NaN()
+		}
+	default:
+		result = math.Ldexp(mantissa+1024, exponent-25)
+	}
+	if half&0x8000 != 0 {
+		return -result
+	}
+	return result
+}
+
+func writeCborHead(buf *bytes.Buffer, major byte, argument uint64) {
+	switch {
+	case argument < 24:
+		buf.WriteByte(major<<5 | byte(argument))
+	case argument <= math.MaxUint8:
+		buf.WriteByte(major<<5 | 24)
+		buf.WriteByte(byte(argument))
+	case argument <= math.MaxUint16:
+		buf.WriteByte(major<<5 | 25)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(argument)))
+	case argument <= math.MaxUint32:
+		buf.WriteByte(major<<5 | 26)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(argument)))
+	default:
+		buf.WriteByte(major<<5 | 27)
+		buf.Write(binary.BigEndian.AppendUint64(nil, argument))
+	}
+}
+
+func encodeCborInteger(buf *bytes.Buffer, n *big.Int) error {
+	if n.Sign() >= 0 {
+		if !n.IsUint64() {
+			return fmt.Errorf("integer %v exceeds cbor range", n)
+		}
+		writeCborHead(buf, 0, n.Uint64())
+		return nil
+	}
+	negative := new(big.Int).Sub(new(big.Int).Neg(n), big.NewInt(1))
+	if !negative.IsUint64() {
+		return fmt.Errorf("integer %v exceeds cbor range", n)
+	}
+	writeCborHead(buf, 1, negative.Uint64())
+	return nil
+}
+
+func encodeCbor(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(0xf6)
+	case bool:
+		if v {
+			buf.WriteByte(0xf5)
+		} else {
+			buf.WriteByte(0xf4)
+		}
+	case string:
+		writeCborHead(buf, 3, uint64(len(v)))
+		buf.WriteString(v)
+	case []byte:
+		writeCborHead(buf, 2, uint64(len(v)))
+		buf.Write(v)
+	case json.Number:
+		if n, ok := new(big.Int).SetString(v.String(), 10); ok {
+			return encodeCborInteger(buf, n)
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		return encodeCbor(buf, f)
+	case int, int32, int64, uint64:
+		n, _ := toBigInt(v)
+		return encodeCborInteger(buf, n)
+	case float32:
+		return encodeCbor(buf, float64(v))
+	case float64:
+		buf.WriteByte(0xfb)
+		buf.Write(binary.BigEndian.AppendUint64(nil, math.Float64bits(v)))
+	case []interface{}:
+		writeCborHead(buf, 4, uint64(len(v)))
+		for _, element := range v {
+			err := encodeCbor(buf, element)
+			if err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		writeCborHead(buf, 5, uint64(len(v)))
+		for _, key := range keys {
+			writeCborHead(buf, 3, uint64(len(key)))
+			buf.WriteString(key)
+			err := encodeCbor(buf, v[key])
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported value type %T for cbor", value)
+	}
+	return nil
+}
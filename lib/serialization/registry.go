@@ -0,0 +1,158 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package serialization is the registry of content serializations
+// each serialization declares the content-variable structures it accepts, how fields are addressed (serialization_options)
+// and how messages are decoded and encoded; content validation, payload validation, payload examples and schemas use this registry
+package serialization
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// ErrUnknownSerialization is returned for serializations without registered implementation
+var ErrUnknownSerialization = errors.New("unknown serialization")
+
+// Serialization implements a models.Serialization
+type Serialization interface {
+	Key() models.Serialization
+	Info() model.SerializationInfo
+
+	// ValidateVariable checks if the root content-variable of a content may be represented by the serialization
+	// and validates the serialization_options of the variables
+	// errors are field errors relative to the content (e.g. "content_variable.sub_content_variables[0].serialization_options")
+	ValidateVariable(variable models.ContentVariable) error
+
+	// Decode decodes a message of a content with the root content-variable variable
+	// structures are returned as map[string]interface{}, lists as []interface{}, numbers as json.Number or float64
+	// and other primitives as string or bool; with Info().StringValues all primitives are returned as strings
+	Decode(variable models.ContentVariable, message []byte) (interface{}, error)
+
+	// Encode renders value as message of a content with the root content-variable variable (inverse of Decode)
+	Encode(variable models.ContentVariable, value interface{}) ([]byte, error)
+}
+
+var registryMux sync.RWMutex
+var registry = []Serialization{}
+
+func init() {
+	Register(Json{})
+	Register(Xml{})
+	Register(PlainText{})
+	Register(Cbor{})
+	Register(FixedBinary{})
+}
+
+// Register adds the serialization to the registry; a serialization with the same key is replaced
+func Register(serialization Serialization) {
+	registryMux.Lock()
+	defer registryMux.Unlock()
+	for i, s := range registry {
+		if s.Key() == serialization.Key() {
+			registry[i] = serialization
+			return
+		}
+	}
+	registry = append(registry, serialization)
+}
+
+// Get returns the registered serialization for key
+func Get(key models.Serialization) (serialization Serialization, ok bool) {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+	for _, s := range registry {
+		if s.Key() == key {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Registered returns all registered serializations in the order of their registration
+func Registered() []Serialization {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+	return append([]Serialization{}, registry...)
+}
+
+// Infos returns the info of all registered serializations (ref Serialization.Info)
+func Infos() []model.SerializationInfo {
+	result := []model.SerializationInfo{}
+	for _, s := range Registered() {
+		result = append(result, s.Info())
+	}
+	return result
+}
+
+// Decode decodes message with the serialization of content (ref Serialization.Decode)
+func Decode(content models.Content, message []byte) (interface{}, error) {
+	s, ok := Get(content.Serialization)
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnknownSerialization, content.Serialization)
+	}
+	return s.Decode(content.ContentVariable, message)
+}
+
+// Encode renders value with the serialization of content (ref Serialization.Encode)
+func Encode(content models.Content, value interface{}) ([]byte, error) {
+	s, ok := Get(content.Serialization)
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnknownSerialization, content.Serialization)
+	}
+	return s.Encode(content.ContentVariable, value)
+}
+
+// StringValues checks if the serialization decodes primitive values as strings (ref model.SerializationInfo.StringValues)
+func StringValues(key models.Serialization) bool {
+	s, ok := Get(key)
+	return ok && s.Info().StringValues
+}
+
+// ParseOptions returns the serialization_options of variable as map; options without '=' are mapped to an empty string
+// options with an unknown key or a value not in the values of the option are returned as error
+func ParseOptions(variable models.ContentVariable, known []model.SerializationOption) (result map[string]string, err error) {
+	result = map[string]string{}
+	for _, option := range variable.SerializationOptions {
+		key, value, _ := strings.Cut(option, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		var definition *model.SerializationOption
+		for _, element := range known {
+			if element.Key == key {
+				definition = &element
+				break
+			}
+		}
+		if definition == nil {
+			return result, fmt.Errorf("unknown serialization option %v", key)
+		}
+		if _, exists := result[key]; exists {
+			return result, fmt.Errorf("serialization option %v is set multiple times", key)
+		}
+		if len(definition.Values) > 0 && !slices.Contains(definition.Values, value) {
+			return result, fmt.Errorf("invalid value %v for serialization option %v, expected one of %v", value, key, definition.Values)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// modbus like frame: status flag, signed temperature (0.1 °C) as little endian register, unsigned counter and a zero padded name
+var testFrameVariable = models.ContentVariable{
+	Name: "frame",
+	Type: models.Structure,
+	SubContentVariables: []models.ContentVariable{
+		{Name: "on", Type: models.Boolean, SerializationOptions: []string{"offset=0"}},
+		{Name: "reserved", IsVoid: true},
+		{Name: "temperature", Type: models.Integer, SerializationOptions: []string{"offset=2", "length=2", "byte_order=little"}},
+		{Name: "values", Type: models.List, SubContentVariables: []models.ContentVariable{
+			{Name: "0", Type: models.Integer, SerializationOptions: []string{"offset=4", "length=4", "signed=false"}},
+			{Name: "1", Type: models.Float, SerializationOptions: []string{"offset=8"}},
+		}},
+		{Name: "name", Type: models.String, SerializationOptions: []string{"offset=12", "length=4"}},
+	},
+}
+
+func TestRegistry(t *testing.T) {
+	keys := []models.Serialization{}
+	for _, info := range Infos() {
+		keys = append(keys, info.Key)
+	}
+	expected := []models.Serialization{models.JSON, models.XML, models.PlainText, CBOR, Binary}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("%#v", keys)
+	}
+	if _, ok := Get("yaml"); ok {
+		t.Error("unexpected serialization")
+	}
+	_, err := Decode(models.Content{Serialization: "yaml"}, []byte("foo: bar"))
+	if !errors.Is(err, ErrUnknownSerialization) {
+		t.Error(err)
+	}
+	if !StringValues(models.XML) || StringValues(models.JSON) {
+		t.Error("unexpected string values")
+	}
+}
+
+func TestPlainTextValidateVariable(t *testing.T) {
+	err := PlainText{}.ValidateVariable(models.ContentVariable{Name: "v", Type: models.Integer})
+	if !errors.Is(err, model.ErrDeviceTypeSerializationMismatch) {
+		t.Error(err)
+	}
+	err = PlainText{}.ValidateVariable(models.ContentVariable{Name: "v", Type: models.String})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBinaryValidateVariable(t *testing.T) {
+	err := FixedBinary{}.ValidateVariable(testFrameVariable)
+	if err != nil {
+		t.Error(err)
+	}
+	for name, test := range map[string]struct {
+		variable models.ContentVariable
+		expected error
+		field    string
+	}{
+		"missing offset": {
+			variable: models.ContentVariable{Name: "v", Type: models.Integer},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"invalid length": {
+			variable: models.ContentVariable{Name: "v", Type: models.Float, SerializationOptions: []string{"offset=0", "length=2"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"missing string length": {
+			variable: models.ContentVariable{Name: "v", Type: models.String, SerializationOptions: []string{"offset=0"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"offset too large": {
+			variable: models.ContentVariable{Name: "v", Type: models.Integer, SerializationOptions: []string{"offset=9223372036854775807"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"string length too large": {
+			variable: models.ContentVariable{Name: "v", Type: models.String, SerializationOptions: []string{"offset=0", "length=65536"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"unknown option": {
+			variable: models.ContentVariable{Name: "v", Type: models.Integer, SerializationOptions: []string{"offset=0", "scale=10"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"invalid byte order": {
+			variable: models.ContentVariable{Name: "v", Type: models.Integer, SerializationOptions: []string{"offset=0", "byte_order=middle"}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"structure option": {
+			variable: models.ContentVariable{Name: "v", Type: models.Structure, SerializationOptions: []string{"offset=0"}, SubContentVariables: []models.ContentVariable{
+				{Name: "a", Type: models.Integer, SerializationOptions: []string{"offset=0"}},
+			}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.serialization_options",
+		},
+		"placeholder": {
+			variable: models.ContentVariable{Name: "v", Type: models.List, SubContentVariables: []models.ContentVariable{
+				{Name: "*", Type: models.Integer, SerializationOptions: []string{"offset=0"}},
+			}},
+			expected: model.ErrDeviceTypeSerializationMismatch,
+			field:    "content_variable.sub_content_variables[0].name",
+		},
+		"overlap": {
+			variable: models.ContentVariable{Name: "v", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+				{Name: "a", Type: models.Integer, SerializationOptions: []string{"offset=0", "length=4"}},
+				{Name: "b", Type: models.Integer, SerializationOptions: []string{"offset=2"}},
+			}},
+			expected: model.ErrDeviceTypeInvalidSerializationOption,
+			field:    "content_variable.sub_content_variables[1].serialization_options",
+		},
+	} {
+		err := FixedBinary{}.ValidateVariable(test.variable)
+		if !errors.Is(err, test.expected) {
+			t.Error(name, err)
+			continue
+		}
+		var coded *model.CodedError
+		if !errors.As(err, &coded) || coded.Field != test.field {
+			t.Error(name, err)
+		}
+	}
+}
+
+func TestBinaryDecodeEncode(t *testing.T) {
+	message, _ := hex.DecodeString("0100" + "19ff" + "fffffffe" + "3fc00000" + "6c616d00")
+	value, err := FixedBinary{}.Decode(testFrameVariable, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"on":          true,
+		"temperature": json.Number("-231"),
+		"values":      []interface{}{json.Number("4294967294"), 1.5},
+		"name":        "lam",
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("\n%#v\n%#v", value, expected)
+	}
+
+	encoded, err := FixedBinary{}.Encode(testFrameVariable, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(encoded) != hex.EncodeToString(message) {
+		t.Errorf("\n%x\n%x", encoded, message)
+	}
+
+	_, err = FixedBinary{}.Decode(testFrameVariable, message[:10])
+	if err == nil {
+		t.Error("expected error for short message")
+	}
+
+	_, err = FixedBinary{}.Encode(testFrameVariable, map[string]interface{}{"temperature": 40000})
+	if err == nil {
+		t.Error("expected error for out of range value")
+	}
+}
+
+func TestCborDecode(t *testing.T) {
+	for input, expected := range map[string]interface{}{
+		"00":                         json.Number("0"),
+		"1903e8":                     json.Number("1000"),
+		"3863":                       json.Number("-100"),
+		"3bffffffffffffffff":         json.Number("-18446744073709551616"),
+		"f93e00":                     1.5,
+		"fa47c35000":                 100000.0,
+		"fb3ff199999999999a":         1.1,
+		"f5":                         true,
+		"f6":                         nil,
+		"6449455446":                 "IETF",
+		"43010203":                   "AQID",
+		"7f657374726561646d696e67ff": "streaming",
+		"9f018202039f0405ffff":       []interface{}{json.Number("1"), []interface{}{json.Number("2"), json.Number("3")}, []interface{}{json.Number("4"), json.Number("5")}},
+		"a201020304":                 map[string]interface{}{"1": json.Number("2"), "3": json.Number("4")},
+		"c11a514b67b0":               json.Number("1363896240"),
+	} {
+		message, _ := hex.DecodeString(input)
+		value, err := Cbor{}.Decode(models.ContentVariable{}, message)
+		if err != nil {
+			t.Error(input, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("%v: %#v", input, value)
+		}
+	}
+	for _, input := range []string{"", "1903", "0000", "ff", "5f01ff", "a1"} {
+		message, _ := hex.DecodeString(input)
+		_, err := Cbor{}.Decode(models.ContentVariable{}, message)
+		if err == nil {
+			t.Error("expected error for", input)
+		}
+	}
+}
+
+func TestCborEncode(t *testing.T) {
+	value := map[string]interface{}{
+		"r":     int64(128),
+		"on":    false,
+		"mode":  "hsb",
+		"point": []interface{}{1.5, json.Number("-2")},
+		"none":  nil,
+	}
+	message, err := Cbor{}.Encode(models.ContentVariable{}, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "a5" + "646d6f6465" + "63687362" + "646e6f6e65" + "f6" + "626f6e" + "f4" + "65706f696e74" + "82" + "fb3ff8000000000000" + "21" + "6172" + "1880"
+	if hex.EncodeToString(message) != expected {
+		t.Errorf("\n%x\n%v", message, expected)
+	}
+	decoded, err := Cbor{}.Decode(models.ContentVariable{}, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.(map[string]interface{})["mode"] != "hsb" || decoded.(map[string]interface{})["r"] != json.Number("128") {
+		t.Errorf("%#v", decoded)
+	}
+}
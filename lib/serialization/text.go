@@ -0,0 +1,256 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// Json accepts all content-variable structures; numbers are decoded as json.Number
+type Json struct{}
+
+func (this Json) Key() models.Serialization {
+	return models.JSON
+}
+
+func (this Json) Info() model.SerializationInfo {
+	return model.SerializationInfo{
+		Key:         models.JSON,
+		Description: "json document; structures are objects, lists are arrays",
+		MediaType:   "application/json",
+		Options:     []model.SerializationOption{},
+	}
+}
+
+func (this Json) ValidateVariable(variable models.ContentVariable) error {
+	return nil
+}
+
+func (this Json) Decode(variable models.ContentVariable, message []byte) (result interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after json value")
+	}
+	return result, nil
+}
+
+func (this Json) Encode(variable models.ContentVariable, value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// Xml accepts all content-variable structures
+// documents are decoded as map with the root element name as single key; elements with attributes or child elements
+// become maps (attributes with the prefix '-', text as '#text'), repeated elements become lists and other elements strings
+type Xml struct{}
+
+func (this Xml) Key() models.Serialization {
+	return models.XML
+}
+
+func (this Xml) Info() model.SerializationInfo {
+	return model.SerializationInfo{
+		Key:          models.XML,
+		Description:  "xml document with the content-variable name as root element; attributes are content-variables with the prefix '-'",
+		MediaType:    "application/xml",
+		StringValues: true,
+		Options:      []model.SerializationOption{},
+	}
+}
+
+func (this Xml) ValidateVariable(variable models.ContentVariable) error {
+	return nil
+}
+
+func (this Xml) Decode(variable models.ContentVariable, message []byte) (interface{}, error) {
+	return decodeXml(message)
+}
+
+// Encode renders value with the variable name as root element; xml is encoded like it is decoded by Decode
+func (this Xml) Encode(variable models.ContentVariable, value interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	encoder := xml.NewEncoder(buf)
+	err := encodeXmlElement(encoder, variable.Name, value)
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Flush()
+	return buf.Bytes(), err
+}
+
+// PlainText accepts only string contents; messages are decoded as string
+type PlainText struct{}
+
+func (this PlainText) Key() models.Serialization {
+	return models.PlainText
+}
+
+func (this PlainText) Info() model.SerializationInfo {
+	return model.SerializationInfo{
+		Key:         models.PlainText,
+		Description: "the message is the value of a single string content-variable",
+		MediaType:   "text/plain",
+		Options:     []model.SerializationOption{},
+	}
+}
+
+func (this PlainText) ValidateVariable(variable models.ContentVariable) error {
+	if variable.Type != models.String {
+		return model.NewFieldError(model.ErrDeviceTypeSerializationMismatch, "serialization", errors.New("plain-text serialization only for string content"))
+	}
+	return nil
+}
+
+func (this PlainText) Decode(variable models.ContentVariable, message []byte) (interface{}, error) {
+	return string(message), nil
+}
+
+func (this PlainText) Encode(variable models.ContentVariable, value interface{}) ([]byte, error) {
+	if value == nil {
+		return []byte{}, nil
+	}
+	return []byte(fmt.Sprint(value)), nil
+}
+
+func decodeXml(message []byte) (result map[string]interface{}, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(message))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, errors.New("missing xml root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := decodeXmlElement(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{start.Name.Local: value}, nil
+		}
+	}
+}
+
+func decodeXmlElement(decoder *xml.Decoder, start xml.StartElement) (result interface{}, err error) {
+	element := map[string]interface{}{}
+	for _, attr := range start.Attr {
+		element["-"+attr.Name.Local] = attr.Value
+	}
+	text := strings.Builder{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := decodeXmlElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := element[name].(type) {
+			case nil:
+				element[name] = value
+			case []interface{}:
+				element[name] = append(existing, value)
+			default:
+				element[name] = []interface{}{existing, value}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				element["#text"] = content
+			}
+			return element, nil
+		}
+	}
+}
+
+func encodeXmlElement(encoder *xml.Encoder, name string, value interface{}) error {
+	if list, ok := value.([]interface{}); ok {
+		for _, element := range list {
+			err := encodeXmlElement(encoder, name, element)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	m, isMap := value.(map[string]interface{})
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if attr, isAttr := strings.CutPrefix(key, "-"); isAttr {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: attr}, Value: fmt.Sprint(m[key])})
+		}
+	}
+	err := encoder.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !isMap:
+		if value != nil {
+			err = encoder.EncodeToken(xml.CharData(fmt.Sprint(value)))
+		}
+	default:
+		if text, ok := m["#text"]; ok {
+			err = encoder.EncodeToken(xml.CharData(fmt.Sprint(text)))
+			if err != nil {
+				return err
+			}
+		}
+		for _, key := range keys {
+			if strings.HasPrefix(key, "-") || key == "#text" {
+				continue
+			}
+			err = encodeXmlElement(encoder, key, m[key])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return encoder.EncodeToken(start.End())
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestAspectMergeAndMove(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const prefix = "urn:infai:ses:aspect:"
+	for _, aspect := range []models.Aspect{
+		{Id: prefix + "air", Name: "Air", SubAspects: []models.Aspect{{Id: prefix + "air_humidity", Name: "Humidity"}}},
+		{Id: prefix + "climate", Name: "Climate", SubAspects: []models.Aspect{
+			{Id: prefix + "climate_humidity", Name: "Humidity"},
+			{Id: prefix + "climate_temperature", Name: "Temperature"},
+		}},
+	} {
+		_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, aspect)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:aspect-change",
+		Name:             "aspect-change",
+		Handler:          "aspect-change",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:aspect-change-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{Id: model.MEASURING_FUNCTION_PREFIX + "aspect-change-humidity", Name: "Get Humidity", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name: "aspect change",
+		Services: []models.Service{{
+			LocalId:     "getHumidity",
+			Name:        "Get Humidity",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:aspect-change",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:aspect-change-payload",
+				ContentVariable: models.ContentVariable{
+					Name:       "humidity",
+					Type:       models.Float,
+					FunctionId: model.MEASURING_FUNCTION_PREFIX + "aspect-change-humidity",
+					AspectId:   prefix + "climate_humidity",
+				},
+			}},
+		}},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("invalid merge", func(t *testing.T) {
+		_, err, _ := c.MergeAspects(ctx, client.InternalAdminToken, prefix+"climate", prefix+"climate_humidity", true)
+		if !errors.Is(err, model.ErrAspectInvalidMove) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("merge dry-run", func(t *testing.T) {
+		impact, err, _ := c.MergeAspects(ctx, client.InternalAdminToken, prefix+"climate_humidity", prefix+"air_humidity", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(impact.ChangedAspectIds, []string{prefix + "air", prefix + "climate"}) || !reflect.DeepEqual(impact.DeviceTypeIds, []string{dt.Id}) {
+			t.Errorf("%#v", impact)
+		}
+		node, err, _ := c.GetAspectNode(ctx, prefix+"climate_humidity")
+		if err != nil || node.RootId != prefix+"climate" {
+			t.Error(err, node)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		_, err, _ := c.MergeAspects(ctx, client.InternalAdminToken, prefix+"climate_humidity", prefix+"air_humidity", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.GetAspectNode(ctx, prefix+"climate_humidity")
+		if err == nil {
+			t.Error("expected merged aspect node to be removed")
+		}
+		climate, err, _ := c.GetAspect(ctx, prefix+"climate")
+		if err != nil || len(climate.SubAspects) != 1 || climate.SubAspects[0].Id != prefix+"climate_temperature" {
+			t.Error(err, climate)
+		}
+		updated, err, _ := c.ReadDeviceType(ctx, dt.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated.Services[0].Outputs[0].ContentVariable.AspectId != prefix+"air_humidity" {
+			t.Error(updated.Services[0].Outputs[0].ContentVariable)
+		}
+	})
+
+	t.Run("move root under other root", func(t *testing.T) {
+		impact, err, _ := c.MoveAspect(ctx, client.InternalAdminToken, prefix+"climate", prefix+"air", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(impact.DeletedAspectIds, []string{prefix + "climate"}) {
+			t.Errorf("%#v", impact)
+		}
+		_, err, _ = c.GetAspect(ctx, prefix+"climate")
+		if err == nil {
+			t.Error("expected climate to be no longer a root aspect")
+		}
+		node, err, _ := c.GetAspectNode(ctx, prefix+"climate_temperature")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node.RootId != prefix+"air" || !slices.Equal(node.AncestorIds, []string{prefix + "climate", prefix + "air"}) && !slices.Equal(node.AncestorIds, []string{prefix + "air", prefix + "climate"}) {
+			t.Errorf("%#v", node)
+		}
+	})
+
+	t.Run("move to root", func(t *testing.T) {
+		_, err, _ := c.MoveAspect(ctx, client.InternalAdminToken, prefix+"climate", "", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		air, err, _ := c.GetAspect(ctx, prefix+"air")
+		if err != nil || len(air.SubAspects) != 1 {
+			t.Error(err, air)
+		}
+		climate, err, _ := c.GetAspect(ctx, prefix+"climate")
+		if err != nil || len(climate.SubAspects) != 1 {
+			t.Error(err, climate)
+		}
+		node, err, _ := c.GetAspectNode(ctx, prefix+"climate_temperature")
+		if err != nil || node.RootId != prefix+"climate" {
+			t.Error(err, node)
+		}
+	})
+}
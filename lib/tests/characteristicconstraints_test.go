@@ -0,0 +1,192 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestCharacteristicConstraints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const mode = "urn:infai:ses:characteristic:constraint-mode"
+	const hex = "urn:infai:ses:characteristic:constraint-hex"
+	const percent = "urn:infai:ses:characteristic:constraint-percent"
+	const setColor = model.CONTROLLING_FUNCTION_PREFIX + "constraint-color"
+	for _, characteristic := range []models.Characteristic{
+		{Id: mode, Name: "mode", Type: models.String, AllowedValues: []interface{}{"rgb", "hsb"}},
+		{Id: hex, Name: "hex", Type: models.String},
+		{Id: percent, Name: "percent", Type: models.Integer, MinValue: 0, MaxValue: 100},
+	} {
+		_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, characteristic)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetConcept(ctx, client.InternalAdminToken, models.Concept{Id: "urn:infai:ses:concept:constraint-color", Name: "color", CharacteristicIds: []string{hex, mode, percent}, BaseCharacteristicId: hex})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{Id: setColor, Name: "Set Color", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION, ConceptId: "urn:infai:ses:concept:constraint-color"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:constraint",
+		Name:             "constraint",
+		Handler:          "constraint",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:constraint-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("invalid characteristic enumeration", func(t *testing.T) {
+		_, err, _ := c.SetCharacteristic(ctx, client.InternalAdminToken, models.Characteristic{Id: mode, Name: "mode", Type: models.String, AllowedValues: []interface{}{"rgb", 1}})
+		if !errors.Is(err, model.ErrCharacteristicInvalidConstraint) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("set pattern", func(t *testing.T) {
+		_, err, _ := c.SetCharacteristicPattern(ctx, client.InternalAdminToken, model.CharacteristicPattern{CharacteristicId: hex, Pattern: "#[0-9a-f]{6}", Description: "hex color"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pattern, err, _ := c.GetCharacteristicPattern(ctx, hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pattern.Pattern != "#[0-9a-f]{6}" || pattern.Description != "hex color" {
+			t.Errorf("%#v", pattern)
+		}
+	})
+
+	t.Run("invalid patterns", func(t *testing.T) {
+		for characteristicId, pattern := range map[string]string{
+			hex:     "(",        //invalid regexp
+			percent: "[0-9]+",   //not a string characteristic
+			mode:    "[a-z]{2}", //allowed values do not match
+		} {
+			_, err, _ := c.SetCharacteristicPattern(ctx, client.InternalAdminToken, model.CharacteristicPattern{CharacteristicId: characteristicId, Pattern: pattern})
+			if err == nil {
+				t.Error(characteristicId, pattern)
+			}
+		}
+	})
+
+	deviceType := func(variables ...models.ContentVariable) models.DeviceType {
+		return models.DeviceType{
+			Name: "constraint lamp",
+			Services: []models.Service{{
+				LocalId:     "setColor",
+				Name:        "Set Color",
+				Interaction: models.REQUEST,
+				ProtocolId:  "urn:infai:ses:protocol:constraint",
+				Inputs: []models.Content{{
+					Serialization:     models.JSON,
+					ProtocolSegmentId: "urn:infai:ses:segment:constraint-payload",
+					ContentVariable:   models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: variables},
+				}},
+			}},
+		}
+	}
+
+	t.Run("invalid fixed values", func(t *testing.T) {
+		for name, variable := range map[string]models.ContentVariable{
+			"enumeration": {Name: "mode", Type: models.String, CharacteristicId: mode, Value: "cmyk"},
+			"pattern":     {Name: "color", Type: models.String, CharacteristicId: hex, Value: "red"},
+			"range":       {Name: "brightness", Type: models.Integer, CharacteristicId: percent, Value: 150},
+			"type":        {Name: "brightness", Type: models.Integer, CharacteristicId: percent, Value: "50"},
+		} {
+			_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(variable), model.DeviceTypeUpdateOptions{})
+			if !errors.Is(err, model.ErrDeviceTypeInvalidValue) {
+				t.Error(name, err)
+			}
+		}
+	})
+
+	t.Run("characteristic type mismatch", func(t *testing.T) {
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(models.ContentVariable{Name: "brightness", Type: models.String, CharacteristicId: percent}), model.DeviceTypeUpdateOptions{})
+		if !errors.Is(err, model.ErrDeviceTypeCharacteristicTypeMismatch) {
+			t.Error(err)
+		}
+	})
+
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(
+		models.ContentVariable{Name: "mode", Type: models.String, CharacteristicId: mode, Value: "hsb"},
+		models.ContentVariable{Name: "color", Type: models.String, CharacteristicId: hex, FunctionId: setColor},
+		models.ContentVariable{Name: "brightness", Type: models.Float, CharacteristicId: percent, FunctionId: setColor},
+	), model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("selectables", func(t *testing.T) {
+		result, err, _ := c.GetDeviceTypeSelectablesV2(ctx, []model.FilterCriteria{{FunctionId: setColor}}, "", false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 1 {
+			t.Fatalf("%#v", result)
+		}
+		options := result[0].ServicePathOptions[dt.Services[0].Id]
+		if len(options) != 2 || options[0].Path != "payload.brightness" || options[1].Path != "payload.color" {
+			t.Fatalf("%#v", options)
+		}
+		if constraints := options[0].Constraints; constraints == nil || fmt.Sprint(constraints.MinValue) != "0" || fmt.Sprint(constraints.MaxValue) != "100" || constraints.Pattern != "" {
+			t.Errorf("%#v", constraints)
+		}
+		if constraints := options[1].Constraints; constraints == nil || constraints.Pattern != "#[0-9a-f]{6}" || constraints.PatternDescription != "hex color" || constraints.Type != models.String {
+			t.Errorf("%#v", constraints)
+		}
+	})
+
+	t.Run("payload", func(t *testing.T) {
+		result, err, _ := c.ValidateServicePayload(ctx, dt.Services[0].Id, model.PayloadValidationOptions{Direction: model.PayloadDirectionInput}, []byte(`{"mode": "hsb", "color": "#zzzzzz", "brightness": 50}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != model.ErrPayloadPatternMismatch || result.Errors[0].Field != "payload.color" {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("remove pattern", func(t *testing.T) {
+		err, _ := c.RemoveCharacteristicPattern(ctx, client.InternalAdminToken, hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.GetCharacteristicPattern(ctx, hex)
+		if !errors.Is(err, model.ErrNotFound) {
+			t.Error(err)
+		}
+	})
+}
@@ -64,7 +64,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	c := client.NewClient("http://localhost:"+config.ServerPort, nil)
 
 	t.Run("create device-classes", func(t *testing.T) {
-		_, err, _ := c.SetDeviceClass(client.InternalAdminToken, models.DeviceClass{
+		_, err, _ := c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{
 			Id:   "a",
 			Name: "a",
 		})
@@ -72,7 +72,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		_, err, _ = c.SetDeviceClass(client.InternalAdminToken, models.DeviceClass{
+		_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{
 			Id:   "b",
 			Name: "b",
 		})
@@ -80,7 +80,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		_, err, _ = c.SetDeviceClass(client.InternalAdminToken, models.DeviceClass{
+		_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{
 			Id:   "c",
 			Name: "c",
 		})
@@ -91,7 +91,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("create functions", func(t *testing.T) {
-		_, err, _ := c.SetFunction(client.InternalAdminToken, models.Function{
+		_, err, _ := c.SetFunction(ctx, client.InternalAdminToken, models.Function{
 			Id:          models.URN_PREFIX + "controlling-function:f1",
 			Name:        "f1",
 			DisplayName: "f1",
@@ -101,7 +101,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		_, err, _ = c.SetFunction(client.InternalAdminToken, models.Function{
+		_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{
 			Id:          models.URN_PREFIX + "measuring-function:f2",
 			Name:        "f2",
 			DisplayName: "f2",
@@ -114,7 +114,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("create protocols", func(t *testing.T) {
-		_, err, _ := c.SetProtocol(client.InternalAdminToken, models.Protocol{
+		_, err, _ := c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
 			Id:      "p1",
 			Name:    "p1",
 			Handler: "p1",
@@ -130,7 +130,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("create aspect", func(t *testing.T) {
-		_, err, _ := c.SetAspect(client.InternalAdminToken, models.Aspect{
+		_, err, _ := c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{
 			Id:   "a1",
 			Name: "a1",
 		})
@@ -141,7 +141,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("create device-types", func(t *testing.T) {
-		_, err, _ := c.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 			Id:   "dt1",
 			Name: "dt1",
 			Services: []models.Service{
@@ -172,7 +172,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 			return
 		}
 
-		_, err, _ = c.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+		_, err, _ = c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 			Id:   "dt2",
 			Name: "dt2",
 			Services: []models.Service{
@@ -233,7 +233,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("list controlling functions", func(t *testing.T) {
-		dcList, _, err, _ := c.ListDeviceClasses(client.DeviceClassListOptions{
+		dcList, _, err, _ := c.ListDeviceClasses(ctx, client.DeviceClassListOptions{
 			UsedWithControllingFunction: true,
 		})
 		if err != nil {
@@ -251,7 +251,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("list controlling functions filtered by ids (included)", func(t *testing.T) {
-		dcList, _, err, _ := c.ListDeviceClasses(client.DeviceClassListOptions{
+		dcList, _, err, _ := c.ListDeviceClasses(ctx, client.DeviceClassListOptions{
 			Ids:                         []string{"a", "b", "c"},
 			UsedWithControllingFunction: true,
 		})
@@ -270,7 +270,7 @@ func TestListControllingDeviceClasses(t *testing.T) {
 	})
 
 	t.Run("list controlling functions filtered by ids (excluded)", func(t *testing.T) {
-		dcList, _, err, _ := c.ListDeviceClasses(client.DeviceClassListOptions{
+		dcList, _, err, _ := c.ListDeviceClasses(ctx, client.DeviceClassListOptions{
 			Ids:                         []string{"b", "c"},
 			UsedWithControllingFunction: true,
 		})
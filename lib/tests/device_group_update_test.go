@@ -83,7 +83,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			},
 		}
 		for _, char := range characteristics {
-			_, err, _ := c.SetCharacteristic(client.InternalAdminToken, char)
+			_, err, _ := c.SetCharacteristic(ctx, client.InternalAdminToken, char)
 			if err != nil {
 				t.Error(err)
 				return
@@ -109,7 +109,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			},
 		}
 		for _, concept := range concepts {
-			_, err, _ = c.SetConcept(client.InternalAdminToken, concept)
+			_, err, _ = c.SetConcept(ctx, client.InternalAdminToken, concept)
 			if err != nil {
 				t.Error(err)
 				return
@@ -144,7 +144,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			},
 		}
 		for _, function := range functions {
-			_, err, _ = c.SetFunction(client.InternalAdminToken, function)
+			_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, function)
 			if err != nil {
 				t.Error(err)
 				return
@@ -163,7 +163,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			},
 		}
 		for _, aspect := range aspects {
-			_, err, _ = c.SetAspect(client.InternalAdminToken, aspect)
+			_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, aspect)
 			if err != nil {
 				t.Error(err)
 				return
@@ -182,7 +182,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			},
 		}
 		for _, dc := range deviceClasses {
-			_, err, _ = c.SetDeviceClass(client.InternalAdminToken, dc)
+			_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, dc)
 			if err != nil {
 				t.Error(err)
 				return
@@ -194,7 +194,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			Id:   "urn:test:protocol:mqtt",
 			Name: "mqtt",
 		}
-		_, err, _ = c.SetProtocol(client.InternalAdminToken, protocol)
+		_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, protocol)
 		if err != nil {
 			t.Error(err)
 			return
@@ -278,7 +278,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		}
 
 		for _, dt := range deviceTypes {
-			_, err, _ := c.SetDeviceType(client.InternalAdminToken, dt, client.DeviceTypeUpdateOptions{})
+			_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, dt, client.DeviceTypeUpdateOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -305,7 +305,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		}
 
 		for _, device := range devices {
-			_, err, _ = c.SetDevice(client.InternalAdminToken, device, client.DeviceUpdateOptions{})
+			_, err, _ = c.SetDevice(ctx, client.InternalAdminToken, device, client.DeviceUpdateOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -356,7 +356,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		}
 
 		for _, group := range deviceGroups {
-			_, err, _ = c.SetDeviceGroup(client.InternalAdminToken, group)
+			_, err, _ = c.SetDeviceGroup(ctx, client.InternalAdminToken, group)
 			if err != nil {
 				t.Error(err)
 				return
@@ -367,7 +367,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		modifiedType := deviceTypes[0]
 		// Remove AspectId from temperature service but keep it for humidity
 		modifiedType.Services[0].Outputs[0].ContentVariable.AspectId = ""
-		_, err, _ = c.SetDeviceType(client.InternalAdminToken, modifiedType, client.DeviceTypeUpdateOptions{})
+		_, err, _ = c.SetDeviceType(ctx, client.InternalAdminToken, modifiedType, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -377,7 +377,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		time.Sleep(2 * time.Second)
 
 		// Check groups that should be affected
-		tempGroup, err, _ := c.ReadDeviceGroup(deviceGroups[0].Id, client.InternalAdminToken, false)
+		tempGroup, err, _ := c.ReadDeviceGroup(ctx, deviceGroups[0].Id, client.InternalAdminToken, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -405,7 +405,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 		}
 
 		// Check groups that should NOT be affected
-		humidityGroup, err, _ := c.ReadDeviceGroup(deviceGroups[1].Id, client.InternalAdminToken, false)
+		humidityGroup, err, _ := c.ReadDeviceGroup(ctx, deviceGroups[1].Id, client.InternalAdminToken, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -432,7 +432,7 @@ func TestDeviceGroupCriteriaUpdateOnDeviceTypeUpdate(t *testing.T) {
 			return
 		}
 
-		lightGroup, err, _ := c.ReadDeviceGroup(deviceGroups[2].Id, client.InternalAdminToken, false)
+		lightGroup, err, _ := c.ReadDeviceGroup(ctx, deviceGroups[2].Id, client.InternalAdminToken, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDeviceConfiguration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, db, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const percent = "urn:infai:ses:characteristic:configuration-percent"
+	_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, models.Characteristic{Id: percent, Name: "percent", Type: models.Integer, MinValue: 0, MaxValue: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:configuration",
+		Name:             "configuration",
+		Handler:          "configuration",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:configuration-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deviceType := models.DeviceType{
+		Name: "configuration lamp",
+		Services: []models.Service{{
+			LocalId:     "setBrightness",
+			Name:        "Set Brightness",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:configuration",
+			Inputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:configuration-payload",
+				ContentVariable: models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+					{Name: "brightness", Type: models.Integer, CharacteristicId: percent},
+					{Name: "duration", Type: models.Float},
+					{Name: "debug", Type: models.String, IsVoid: true},
+				}},
+			}},
+		}},
+	}
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceId := dt.Services[0].Id
+
+	device := models.Device{Id: "configuration-device", LocalId: "configuration-device", Name: "lamp", DeviceTypeId: dt.Id, OwnerId: "owner"}
+	err = db.SetDevice(ctx, model.DeviceWithConnectionState{Device: device}, func(model.DeviceWithConnectionState, model.DeviceWithConnectionState) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []model.DeviceConfigurationValue{
+		{ServiceId: serviceId, Path: "payload.brightness", Value: float64(42)},
+		{ServiceId: serviceId, Path: "payload.duration", Value: 1.5},
+	}
+
+	t.Run("invalid configurations", func(t *testing.T) {
+		for name, test := range map[string]struct {
+			value    model.DeviceConfigurationValue
+			expected error
+		}{
+			"unknown service": {value: model.DeviceConfigurationValue{ServiceId: "unknown", Path: "payload.brightness", Value: 1}, expected: model.ErrDeviceConfigurationUnknownService},
+			"unknown path":    {value: model.DeviceConfigurationValue{ServiceId: serviceId, Path: "payload.unknown", Value: 1}, expected: model.ErrDeviceConfigurationUnknownPath},
+			"structure path":  {value: model.DeviceConfigurationValue{ServiceId: serviceId, Path: "payload", Value: map[string]interface{}{}}, expected: model.ErrDeviceConfigurationUnknownPath},
+			"void path":       {value: model.DeviceConfigurationValue{ServiceId: serviceId, Path: "payload.debug", Value: "foo"}, expected: model.ErrDeviceConfigurationUnknownPath},
+			"type":            {value: model.DeviceConfigurationValue{ServiceId: serviceId, Path: "payload.brightness", Value: "50"}, expected: model.ErrDeviceConfigurationInvalidValue},
+			"range":           {value: model.DeviceConfigurationValue{ServiceId: serviceId, Path: "payload.brightness", Value: 150}, expected: model.ErrDeviceConfigurationInvalidValue},
+		} {
+			_, err, _ := c.SetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id, []model.DeviceConfigurationValue{test.value})
+			if !errors.Is(err, test.expected) {
+				t.Error(name, err)
+			}
+		}
+		_, err, _ := c.SetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id, []model.DeviceConfigurationValue{expected[0], expected[0]})
+		if !errors.Is(err, model.ErrDeviceConfigurationDuplicate) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("set configuration", func(t *testing.T) {
+		result, err, _ := c.SetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("read configuration", func(t *testing.T) {
+		result, err, _ := c.GetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("%#v", result)
+		}
+		extended, err, _ := c.ReadExtendedDeviceWithConfiguration(ctx, device.Id, client.InternalAdminToken, model.READ, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(extended.Configuration, expected) {
+			t.Errorf("%#v", extended.Configuration)
+		}
+	})
+
+	t.Run("export and import configuration", func(t *testing.T) {
+		options := model.ImportExportOptions{IncludeOwnedInformation: true, FilterResourceTypes: []string{"devices"}, FilterIds: []string{device.Id}}
+		exported, err, _ := c.Export(ctx, client.InternalAdminToken, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(exported.Devices) != 1 || !reflect.DeepEqual(exported.DeviceConfigurations[device.Id], expected) {
+			t.Fatalf("%#v", exported)
+		}
+		exported.DeviceConfigurations[device.Id] = expected[:1]
+		err, _ = c.Import(ctx, client.InternalAdminToken, exported, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err, _ := c.GetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, expected[:1]) {
+			t.Errorf("%#v", result)
+		}
+		_, err, _ = c.SetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("device update preserves configuration", func(t *testing.T) {
+		updated := device
+		updated.Name = "renamed lamp"
+		_, err, _ := c.SetDevice(ctx, client.InternalAdminToken, updated, model.DeviceUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err, _ := c.GetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("device-type update prunes configuration", func(t *testing.T) {
+		updated := dt
+		updated.Services = slices.Clone(dt.Services)
+		updated.Services[0].Inputs = slices.Clone(dt.Services[0].Inputs)
+		variable := updated.Services[0].Inputs[0].ContentVariable
+		variable.SubContentVariables = slices.DeleteFunc(slices.Clone(variable.SubContentVariables), func(v models.ContentVariable) bool {
+			return v.Name == "duration"
+		})
+		updated.Services[0].Inputs[0].ContentVariable = variable
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, updated, model.DeviceTypeUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err, _ := c.GetDeviceConfiguration(ctx, client.InternalAdminToken, device.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, expected[:1]) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+}
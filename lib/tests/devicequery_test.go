@@ -0,0 +1,197 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDeviceQuery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, db, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const temperature = model.MEASURING_FUNCTION_PREFIX + "query-temperature"
+	const setOn = model.CONTROLLING_FUNCTION_PREFIX + "query-on"
+	const aspect = "urn:infai:ses:aspect:query-air"
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: aspect, Name: "Air", SubAspects: []models.Aspect{{Id: aspect + "-temperature", Name: "Temperature"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{Id: "urn:infai:ses:device-class:query-lamp", Name: "Lamp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, function := range []models.Function{
+		{Id: temperature, Name: "Get Temperature", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION},
+		{Id: setOn, Name: "Set On", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION},
+	} {
+		_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, function)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:query",
+		Name:             "query",
+		Handler:          "query",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:query-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name: "query sensor",
+		Services: []models.Service{{
+			LocalId:     "getTemperature",
+			Name:        "Get Temperature",
+			Interaction: models.EVENT_AND_REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:query",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:query-payload",
+				ContentVariable: models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+					{Name: "temperature", Type: models.Float, FunctionId: temperature, AspectId: aspect + "-temperature"},
+				}},
+			}},
+		}},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lamp, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name:          "query lamp",
+		DeviceClassId: "urn:infai:ses:device-class:query-lamp",
+		Services: []models.Service{{
+			LocalId:     "setOn",
+			Name:        "Set On",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:query",
+			Inputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:query-payload",
+				ContentVariable:   models.ContentVariable{Name: "on", Type: models.Boolean, FunctionId: setOn},
+			}},
+		}},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, device := range []models.Device{
+		{Id: "query-s1", LocalId: "s1", Name: "s1", DeviceTypeId: sensor.Id, OwnerId: "owner", Attributes: []models.Attribute{{Key: "room", Value: "kitchen"}}},
+		{Id: "query-s2", LocalId: "s2", Name: "s2", DeviceTypeId: sensor.Id, OwnerId: "owner", Attributes: []models.Attribute{{Key: "room", Value: "bath"}}},
+		{Id: "query-l1", LocalId: "l1", Name: "l1", DeviceTypeId: lamp.Id, OwnerId: "owner"},
+	} {
+		_, err, _ = c.SetDevice(ctx, client.InternalAdminToken, device, model.DeviceUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = db.SetHub(ctx, model.HubWithConnectionState{Hub: models.Hub{Id: "query-hub", Name: "hub", DeviceIds: []string{"query-s2", "query-l1"}, DeviceLocalIds: []string{"s2", "l1"}, OwnerId: "owner"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deviceIds := func(result []model.DeviceQueryResult) (ids []string) {
+		ids = []string{}
+		for _, element := range result {
+			ids = append(ids, element.Device.Id)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	t.Run("aspect with descendants", func(t *testing.T) {
+		result, err, _ := c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature, AspectId: aspect}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(deviceIds(result), []string{"query-s1", "query-s2"}) {
+			t.Errorf("%#v", result)
+			return
+		}
+		paths := result[0].ServicePathOptions[sensor.Services[0].Id]
+		if len(paths) != 1 || paths[0].Path != "payload.temperature" {
+			t.Errorf("%#v", result[0].ServicePathOptions)
+		}
+	})
+
+	t.Run("interaction", func(t *testing.T) {
+		result, err, _ := c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature, Interaction: models.EVENT}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 2 {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("device-class", func(t *testing.T) {
+		result, err, _ := c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{DeviceClassId: "urn:infai:ses:device-class:query-lamp", FunctionId: setOn}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(deviceIds(result), []string{"query-l1"}) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("hub and attributes", func(t *testing.T) {
+		result, err, _ := c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature}}, HubId: "query-hub"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(deviceIds(result), []string{"query-s2"}) {
+			t.Errorf("%#v", result)
+		}
+		result, err, _ = c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature}}, Attributes: []models.Attribute{{Key: "room", Value: "kitchen"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(deviceIds(result), []string{"query-s1"}) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("limit and offset", func(t *testing.T) {
+		result, err, _ := c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature}}, Limit: 1, Offset: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(deviceIds(result), []string{"query-s2"}) {
+			t.Errorf("%#v", result)
+		}
+		result, err, _ = c.QueryDevices(ctx, client.InternalAdminToken, model.DeviceQuery{Criteria: []model.FilterCriteria{{FunctionId: temperature}}, HubId: "query-hub", Limit: 1, Offset: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 0 {
+			t.Errorf("%#v", result)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDeviceTypeClone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const deviceClass = "urn:infai:ses:device-class:clone-lamp"
+	const setOn = model.CONTROLLING_FUNCTION_PREFIX + "clone-on"
+	_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{Id: deviceClass, Name: "Lamp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{Id: setOn, Name: "Set On", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:clone",
+		Name:             "clone",
+		Handler:          "clone",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:clone-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := func(localId string, groupKey string) models.Service {
+		return models.Service{
+			LocalId:         localId,
+			Name:            localId,
+			Interaction:     models.REQUEST,
+			ProtocolId:      "urn:infai:ses:protocol:clone",
+			ServiceGroupKey: groupKey,
+			Attributes:      []models.Attribute{{Key: "service-attr", Value: localId}},
+			Inputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:clone-payload",
+				ContentVariable: models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+					{Name: "on", Type: models.Boolean, FunctionId: setOn},
+				}},
+			}},
+		}
+	}
+	source, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name:          "clone lamp",
+		DeviceClassId: deviceClass,
+		Attributes:    []models.Attribute{{Key: "vendor", Value: "a"}, {Key: "model", Value: "1"}, {Key: "legacy", Value: "true"}},
+		ServiceGroups: []models.ServiceGroup{{Key: "left", Name: "Left"}, {Key: "right", Name: "Right"}},
+		Services:      []models.Service{service("setLeft", "left"), service("setRight", "right")},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collectIds := func(dt models.DeviceType) (result []string) {
+		var walk func(variable models.ContentVariable)
+		walk = func(variable models.ContentVariable) {
+			result = append(result, variable.Id)
+			for _, sub := range variable.SubContentVariables {
+				walk(sub)
+			}
+		}
+		result = append(result, dt.Id)
+		for _, s := range dt.Services {
+			result = append(result, s.Id)
+			for _, content := range append(append([]models.Content{}, s.Inputs...), s.Outputs...) {
+				result = append(result, content.Id)
+				walk(content.ContentVariable)
+			}
+		}
+		return result
+	}
+
+	t.Run("clone", func(t *testing.T) {
+		clone, err, _ := c.CloneDeviceType(ctx, client.InternalAdminToken, source.Id, model.DeviceTypeCloneOptions{
+			Name:              "clone lamp v2",
+			Attributes:        []models.Attribute{{Key: "model", Value: "2"}},
+			RemovedAttributes: []string{"legacy"},
+		}, model.DeviceTypeUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if clone.Name != "clone lamp v2" || clone.DeviceClassId != deviceClass {
+			t.Errorf("%#v", clone)
+		}
+		if !reflect.DeepEqual(clone.Attributes, []models.Attribute{{Key: "vendor", Value: "a"}, {Key: "model", Value: "2"}}) {
+			t.Errorf("%#v", clone.Attributes)
+		}
+		if !reflect.DeepEqual(clone.ServiceGroups, source.ServiceGroups) {
+			t.Errorf("%#v", clone.ServiceGroups)
+		}
+		if len(clone.Services) != 2 || clone.Services[0].ServiceGroupKey != "left" || clone.Services[1].ServiceGroupKey != "right" || clone.Services[1].LocalId != "setRight" {
+			t.Errorf("%#v", clone.Services)
+		}
+
+		sourceIds := map[string]bool{}
+		for _, id := range collectIds(source) {
+			sourceIds[id] = true
+		}
+		cloneIds := map[string]bool{}
+		for _, id := range collectIds(clone) {
+			if id == "" || sourceIds[id] || cloneIds[id] {
+				t.Errorf("unexpected id %#v", id)
+			}
+			cloneIds[id] = true
+		}
+
+		stored, err, _ := c.ReadDeviceType(ctx, clone.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(stored, clone) {
+			t.Errorf("\n%#v\n%#v", stored, clone)
+		}
+		unchanged, err, _ := c.ReadDeviceType(ctx, source.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(unchanged, source) {
+			t.Errorf("\n%#v\n%#v", unchanged, source)
+		}
+
+		diff, err, _ := c.DiffStoredDeviceTypes(ctx, client.InternalAdminToken, source.Id, clone.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(diff.ChangedServices) != 0 || len(diff.AddedServices) != 0 || len(diff.RemovedServices) != 0 {
+			t.Errorf("%#v", diff)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err, code := c.CloneDeviceType(ctx, client.InternalAdminToken, "unknown", model.DeviceTypeCloneOptions{}, model.DeviceTypeUpdateOptions{})
+		if err == nil || code != 404 {
+			t.Error(err, code)
+		}
+	})
+}
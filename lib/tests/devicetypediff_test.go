@@ -0,0 +1,235 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDeviceTypeDiff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, db, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const temperature = model.MEASURING_FUNCTION_PREFIX + "diff-temperature"
+	const setOn = model.CONTROLLING_FUNCTION_PREFIX + "diff-on"
+	const aspect = "urn:infai:ses:aspect:diff-air"
+	const deviceClass = "urn:infai:ses:device-class:diff-lamp"
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: aspect, Name: "Air", SubAspects: []models.Aspect{{Id: aspect + "-temperature", Name: "Temperature"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{Id: deviceClass, Name: "Lamp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, function := range []models.Function{
+		{Id: temperature, Name: "Get Temperature", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION},
+		{Id: setOn, Name: "Set On", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION},
+	} {
+		_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, function)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:diff",
+		Name:             "diff",
+		Handler:          "diff",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:diff-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := func(variables ...models.ContentVariable) []models.Content {
+		return []models.Content{{
+			Serialization:     models.JSON,
+			ProtocolSegmentId: "urn:infai:ses:segment:diff-payload",
+			ContentVariable:   models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: variables},
+		}}
+	}
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name:          "diff lamp",
+		DeviceClassId: deviceClass,
+		Services: []models.Service{
+			{
+				LocalId:     "getTemperature",
+				Name:        "Get Temperature",
+				Interaction: models.EVENT,
+				ProtocolId:  "urn:infai:ses:protocol:diff",
+				Outputs: content(
+					models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: temperature, AspectId: aspect + "-temperature"},
+					models.ContentVariable{Name: "unit", Type: models.String},
+				),
+			},
+			{
+				LocalId:     "setOn",
+				Name:        "Set On",
+				Interaction: models.REQUEST,
+				ProtocolId:  "urn:infai:ses:protocol:diff",
+				Inputs:      content(models.ContentVariable{Name: "on", Type: models.Boolean, FunctionId: setOn}),
+			},
+		},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nop := func(model.DeviceWithConnectionState, model.DeviceWithConnectionState) error { return nil }
+	for _, id := range []string{"diff-d1", "diff-d2"} {
+		err = db.SetDevice(ctx, model.DeviceWithConnectionState{Device: models.Device{Id: id, Name: id, DeviceTypeId: dt.Id, OwnerId: "owner"}}, nop)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = db.SetDeviceGroup(ctx, models.DeviceGroup{Id: "diff-group", Name: "group", DeviceIds: []string{"diff-d1", "diff-d2"}, Criteria: []models.DeviceGroupFilterCriteria{
+		{FunctionId: temperature, AspectId: aspect, Interaction: models.EVENT},
+		{FunctionId: temperature, AspectId: aspect + "-temperature", Interaction: models.EVENT},
+		{FunctionId: setOn, DeviceClassId: deviceClass, Interaction: models.REQUEST},
+	}}, func(models.DeviceGroup, string) error { return nil }, "owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		result, err, _ := c.DiffDeviceType(ctx, client.InternalAdminToken, dt.Id, dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Fields) != 0 || len(result.AddedServices) != 0 || len(result.RemovedServices) != 0 || len(result.ChangedServices) != 0 {
+			t.Errorf("%#v", result)
+		}
+		if result.Impact.DeviceCount != 2 || len(result.Impact.DeviceGroupIds) != 0 || len(result.Impact.RemovedCriteria) != 0 {
+			t.Errorf("%#v", result.Impact)
+		}
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		proposal := models.DeviceType{}
+		temp, _ := json.Marshal(dt)
+		_ = json.Unmarshal(temp, &proposal)
+		proposal.Name = "diff lamp 2"
+		outputVariables := proposal.Services[0].Outputs[0].ContentVariable.SubContentVariables
+		outputVariables[0].AspectId = aspect
+		outputVariables[1].Name = "unit_name"
+		outputVariables[1].Type = models.Integer
+		proposal.Services[0].Outputs[0].ContentVariable.SubContentVariables = append(outputVariables, models.ContentVariable{Name: "humidity", Type: models.Float})
+		proposal.Services[1] = models.Service{
+			LocalId:     "getStatus",
+			Name:        "Get Status",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:diff",
+		}
+
+		result, err, _ := c.DiffDeviceType(ctx, client.InternalAdminToken, dt.Id, proposal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(result.Fields, []string{"name"}) {
+			t.Errorf("%#v", result.Fields)
+		}
+		if len(result.AddedServices) != 1 || result.AddedServices[0].LocalId != "getStatus" {
+			t.Errorf("%#v", result.AddedServices)
+		}
+		if len(result.RemovedServices) != 1 || result.RemovedServices[0].Id != dt.Services[1].Id {
+			t.Errorf("%#v", result.RemovedServices)
+		}
+		if len(result.ChangedServices) != 1 || len(result.ChangedServices[0].ChangedContents) != 1 {
+			t.Fatalf("%#v", result.ChangedServices)
+		}
+		contentDiff := result.ChangedServices[0].ChangedContents[0]
+		if contentDiff.Direction != model.PayloadDirectionOutput || len(contentDiff.Fields) != 0 || len(contentDiff.RemovedVariables) != 0 {
+			t.Errorf("%#v", contentDiff)
+		}
+		if !reflect.DeepEqual(contentDiff.AddedVariables, []model.DiffVariable{{Path: "payload.humidity"}}) {
+			t.Errorf("%#v", contentDiff.AddedVariables)
+		}
+		expectedVariables := []model.ContentVariableDiff{
+			{
+				Id:          dt.Services[0].Outputs[0].ContentVariable.SubContentVariables[0].Id,
+				Path:        "payload.temperature",
+				Fields:      []string{},
+				Annotations: []model.AnnotationChange{{Field: "aspect_id", Old: aspect + "-temperature", New: aspect}},
+			},
+			{
+				Id:          dt.Services[0].Outputs[0].ContentVariable.SubContentVariables[1].Id,
+				Path:        "payload.unit",
+				OtherPath:   "payload.unit_name",
+				Fields:      []string{"type"},
+				Annotations: []model.AnnotationChange{},
+			},
+		}
+		if !reflect.DeepEqual(contentDiff.ChangedVariables, expectedVariables) {
+			t.Errorf("\n%#v\n%#v", contentDiff.ChangedVariables, expectedVariables)
+		}
+
+		if result.Impact.DeviceCount != 2 || len(result.Impact.AddedCriteria) != 0 || !slices.Equal(result.Impact.DeviceGroupIds, []string{"diff-group"}) {
+			t.Errorf("%#v", result.Impact)
+		}
+		expectedRemovedCriteria := []models.DeviceGroupFilterCriteria{
+			{FunctionId: setOn, DeviceClassId: deviceClass, Interaction: models.REQUEST},
+			{FunctionId: temperature, AspectId: aspect + "-temperature", Interaction: models.EVENT},
+		}
+		if !reflect.DeepEqual(result.Impact.RemovedCriteria, expectedRemovedCriteria) {
+			t.Errorf("\n%#v\n%#v", result.Impact.RemovedCriteria, expectedRemovedCriteria)
+		}
+	})
+
+	t.Run("stored", func(t *testing.T) {
+		copied := models.DeviceType{}
+		temp, _ := json.Marshal(dt)
+		_ = json.Unmarshal(temp, &copied)
+		copied.Id = ""
+		copied.Name = "diff lamp copy"
+		for i := range copied.Services {
+			copied.Services[i].Id = ""
+		}
+		other, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, copied, model.DeviceTypeUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err, _ := c.DiffStoredDeviceTypes(ctx, client.InternalAdminToken, dt.Id, other.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.OtherDeviceTypeId != other.Id || !slices.Equal(result.Fields, []string{"name"}) || len(result.AddedServices) != 0 || len(result.RemovedServices) != 0 || len(result.ChangedServices) != 0 {
+			t.Errorf("%#v", result)
+		}
+		if len(result.Impact.DeviceGroupIds) != 0 {
+			t.Errorf("%#v", result.Impact)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, _, code := c.DiffStoredDeviceTypes(ctx, client.InternalAdminToken, dt.Id, "unknown")
+		if code != 404 {
+			t.Error(code)
+		}
+	})
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestDeviceTypeTemplates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:dtt",
+		Name:             "dtt",
+		Handler:          "dtt",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:dtt-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newService := func(localId string, name string) models.Service {
+		return models.Service{
+			LocalId:     localId,
+			Name:        name,
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:dtt",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:dtt-payload",
+				ContentVariable:   models.ContentVariable{Name: "value", Type: models.Float},
+			}},
+		}
+	}
+
+	created, err, _ := c.SetDeviceTypeTemplate(ctx, client.InternalAdminToken, model.DeviceTypeTemplate{
+		Name:          "Lamp",
+		Attributes:    []models.Attribute{{Key: "vendor", Value: "acme"}, {Key: "family", Value: "lamp"}},
+		ServiceGroups: []models.ServiceGroup{{Key: "main", Name: "Main"}},
+		Services:      []models.Service{newService("getState", "Get State"), newService("getPower", "Get Power")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := created.DeviceTypeTemplate
+	if template.Id == "" || template.Services[0].Id == "" || len(created.UpdatedDeviceTypeIds) != 0 {
+		t.Fatal(created)
+	}
+
+	extended, err, _ := c.SetDeviceTypeExtension(ctx, client.InternalAdminToken, model.DeviceTypeExtension{
+		TemplateId: template.Id,
+		Overrides: models.DeviceType{
+			Name:       "Kitchen Lamp",
+			Attributes: []models.Attribute{{Key: "vendor", Value: "other"}},
+			Services:   []models.Service{newService("getColor", "Get Color")},
+		},
+		RemovedServices:   []string{"getPower"},
+		RemovedAttributes: []string{"family"},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("materialized", func(t *testing.T) {
+		dt, err, _ := c.ReadDeviceType(ctx, extended.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dt.Name != "Kitchen Lamp" {
+			t.Error(dt.Name)
+		}
+		if len(dt.Attributes) != 1 || dt.Attributes[0].Value != "other" {
+			t.Error(dt.Attributes)
+		}
+		if len(dt.ServiceGroups) != 1 || dt.ServiceGroups[0].Key != "main" {
+			t.Error(dt.ServiceGroups)
+		}
+		if len(dt.Services) != 2 || dt.Services[0].LocalId != "getState" || dt.Services[1].LocalId != "getColor" {
+			t.Fatal(dt.Services)
+		}
+		if dt.Services[0].Id == template.Services[0].Id || dt.Services[0].Id == "" {
+			t.Error("expected derived service id", dt.Services[0].Id)
+		}
+		extension, err, _ := c.GetDeviceTypeExtension(ctx, dt.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if extension.TemplateId != template.Id {
+			t.Error(extension)
+		}
+	})
+
+	t.Run("direct update rejected", func(t *testing.T) {
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, extended, model.DeviceTypeUpdateOptions{})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("template update", func(t *testing.T) {
+		template.Services[0].Name = "Get Lamp State"
+		result, err, _ := c.SetDeviceTypeTemplate(ctx, client.InternalAdminToken, template)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result.UpdatedDeviceTypeIds, []string{extended.Id}) {
+			t.Error(result.UpdatedDeviceTypeIds)
+		}
+		dt, err, _ := c.ReadDeviceType(ctx, extended.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dt.Services[0].Name != "Get Lamp State" || dt.Services[0].Id != extended.Services[0].Id {
+			t.Error(dt.Services[0])
+		}
+	})
+
+	t.Run("invalid template update", func(t *testing.T) {
+		invalid := template
+		invalid.ServiceGroups = nil
+		invalid.Services = []models.Service{newService("getState", "Get State")}
+		invalid.Services[0].ServiceGroupKey = "unknown"
+		_, err, _ := c.SetDeviceTypeTemplate(ctx, client.InternalAdminToken, invalid)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("delete template in use", func(t *testing.T) {
+		err, _ := c.DeleteDeviceTypeTemplate(ctx, client.InternalAdminToken, template.Id)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("import export", func(t *testing.T) {
+		exported, err, _ := c.Export(ctx, client.InternalAdminToken, model.ImportExportOptions{FilterResourceTypes: []string{"device-types", "device-type-templates"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(exported.DeviceTypeTemplates) != 1 || exported.DeviceTypeTemplates[0].Id != template.Id {
+			t.Error(exported.DeviceTypeTemplates)
+		}
+		if len(exported.DeviceTypeExtensions) != 1 || exported.DeviceTypeExtensions[0].DeviceTypeId != extended.Id {
+			t.Fatal(exported.DeviceTypeExtensions)
+		}
+
+		direct, err, _ := c.ReadDeviceType(ctx, extended.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		direct.Name = "Imported Lamp"
+		err, _ = c.Import(ctx, client.InternalAdminToken, model.ImportExport{DeviceTypes: []models.DeviceType{direct}}, model.ImportExportOptions{})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		exported.DeviceTypeExtensions[0].Overrides.Name = "Imported Lamp"
+		err, _ = c.Import(ctx, client.InternalAdminToken, exported, model.ImportExportOptions{FilterResourceTypes: []string{"device-types", "device-type-templates"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dt, err, _ := c.ReadDeviceType(ctx, extended.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dt.Name != "Imported Lamp" || dt.Services[0].Name != "Get Lamp State" {
+			t.Error(dt.Name, dt.Services)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err, _ := c.DeleteDeviceType(ctx, client.InternalAdminToken, extended.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.GetDeviceTypeExtension(ctx, extended.Id)
+		if !errors.Is(err, model.ErrNotFound) {
+			t.Error(err)
+		}
+		err, _ = c.DeleteDeviceTypeTemplate(ctx, client.InternalAdminToken, template.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.GetDeviceTypeTemplate(ctx, template.Id)
+		if !errors.Is(err, model.ErrNotFound) {
+			t.Error(err)
+		}
+	})
+}
@@ -99,7 +99,7 @@ func TestExport(t *testing.T) {
 	devicegroups := []models.DeviceGroup{}
 	t.Run("create", func(t *testing.T) {
 		t.Run("protocol", func(t *testing.T) {
-			result, err, _ := c.SetProtocol(client.InternalAdminToken, models.Protocol{
+			result, err, _ := c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
 				Name:    "test-protocol",
 				Handler: "test-handler",
 				ProtocolSegments: []models.ProtocolSegment{
@@ -117,7 +117,7 @@ func TestExport(t *testing.T) {
 			protocolId = result.Id
 			protocols = append(protocols, result)
 
-			result, err, _ = c.SetProtocol(client.InternalAdminToken, models.Protocol{
+			result, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
 				Name:    "test-protocol-2",
 				Handler: "test-handler-2",
 				ProtocolSegments: []models.ProtocolSegment{
@@ -136,7 +136,7 @@ func TestExport(t *testing.T) {
 		})
 
 		t.Run("concepts", func(t *testing.T) {
-			temp, err, _ := c.SetConcept(client.InternalAdminToken, models.Concept{
+			temp, err, _ := c.SetConcept(ctx, client.InternalAdminToken, models.Concept{
 				Name: "test-concept",
 			})
 			if err != nil {
@@ -144,7 +144,7 @@ func TestExport(t *testing.T) {
 				return
 			}
 			concepts = append(concepts, temp)
-			temp, err, _ = c.SetConcept(client.InternalAdminToken, models.Concept{
+			temp, err, _ = c.SetConcept(ctx, client.InternalAdminToken, models.Concept{
 				Name: "test-concept-2",
 			})
 			if err != nil {
@@ -155,7 +155,7 @@ func TestExport(t *testing.T) {
 		})
 
 		t.Run("functions", func(t *testing.T) {
-			temp, err, _ := c.SetFunction(client.InternalAdminToken, models.Function{
+			temp, err, _ := c.SetFunction(ctx, client.InternalAdminToken, models.Function{
 				Name:      "test-function",
 				ConceptId: concepts[0].Id,
 				RdfType:   models.SES_ONTOLOGY_CONTROLLING_FUNCTION,
@@ -165,7 +165,7 @@ func TestExport(t *testing.T) {
 				return
 			}
 			functions = append(functions, temp)
-			temp, err, _ = c.SetFunction(client.InternalAdminToken, models.Function{
+			temp, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{
 				Name:      "test-function-2",
 				ConceptId: concepts[0].Id,
 				RdfType:   models.SES_ONTOLOGY_CONTROLLING_FUNCTION,
@@ -178,7 +178,7 @@ func TestExport(t *testing.T) {
 		})
 
 		t.Run("aspects", func(t *testing.T) {
-			temp, err, _ := c.SetAspect(client.InternalAdminToken, models.Aspect{
+			temp, err, _ := c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{
 				Name: "test-aspect",
 				SubAspects: []models.Aspect{
 					{Name: "sub1"},
@@ -190,7 +190,7 @@ func TestExport(t *testing.T) {
 				return
 			}
 			aspects = append(aspects, temp)
-			temp, err, _ = c.SetAspect(client.InternalAdminToken, models.Aspect{
+			temp, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{
 				Name: "test-aspect-2",
 			})
 			if err != nil {
@@ -201,7 +201,7 @@ func TestExport(t *testing.T) {
 		})
 
 		t.Run("device-types", func(t *testing.T) {
-			temp, err, _ := c.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+			temp, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 				Name: "test-dt",
 				Services: []models.Service{
 					{
@@ -217,7 +217,7 @@ func TestExport(t *testing.T) {
 				return
 			}
 			deviceTypes = append(deviceTypes, temp)
-			temp, err, _ = c.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+			temp, err, _ = c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 				Name: "test-dt-2",
 				Services: []models.Service{
 					{
@@ -236,7 +236,7 @@ func TestExport(t *testing.T) {
 		})
 
 		t.Run("devices", func(t *testing.T) {
-			temp, err, _ := c.CreateDevice(client.InternalAdminToken, models.Device{
+			temp, err, _ := c.CreateDevice(ctx, client.InternalAdminToken, models.Device{
 				LocalId:      "d1",
 				Name:         "d1",
 				Attributes:   nil,
@@ -247,7 +247,7 @@ func TestExport(t *testing.T) {
 				return
 			}
 			devices = append(devices, temp)
-			temp, err, _ = c.CreateDevice(client.InternalAdminToken, models.Device{
+			temp, err, _ = c.CreateDevice(ctx, client.InternalAdminToken, models.Device{
 				LocalId:      "d2",
 				Name:         "d2",
 				Attributes:   nil,
@@ -262,7 +262,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("get generated device-groups", func(t *testing.T) {
-		temp, _, err, _ := c.ListDeviceGroups(client.InternalAdminToken, client.DeviceGroupListOptions{})
+		temp, _, err, _ := c.ListDeviceGroups(ctx, client.InternalAdminToken, client.DeviceGroupListOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -272,7 +272,7 @@ func TestExport(t *testing.T) {
 
 	t.Run("export", func(t *testing.T) {
 		t.Run("default", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{})
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -290,7 +290,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("with devices", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
 			if err != nil {
 				t.Error(err)
 				return
@@ -332,7 +332,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only device-types", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types"}})
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types"}})
 			if err != nil {
 				t.Error(err)
 				return
@@ -346,7 +346,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only first device-type", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{
 				FilterResourceTypes: []string{"device-types"},
 				FilterIds:           []string{deviceTypes[0].Id},
 			})
@@ -363,7 +363,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only device-types and functions", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types", "functions"}})
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types", "functions"}})
 			if err != nil {
 				t.Error(err)
 				return
@@ -378,7 +378,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only first device-type and function", func(t *testing.T) {
-			export, err, _ := c.Export(client.InternalAdminToken, client.ImportExportOptions{
+			export, err, _ := c.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{
 				FilterResourceTypes: []string{"device-types", "functions"},
 				FilterIds:           []string{deviceTypes[0].Id, functions[0].Id},
 			})
@@ -400,7 +400,7 @@ func TestExport(t *testing.T) {
 	c2, _ := initRepo()
 
 	t.Run("import-from only concepts", func(t *testing.T) {
-		err, _ := c2.ImportFrom(client.InternalAdminToken, true, client.ImportFromOptions{
+		err, _ := c2.ImportFrom(ctx, client.InternalAdminToken, true, client.ImportFromOptions{
 			RemoteDeviceRepository: "http://localhost:" + config.ServerPort,
 			RemoteAuthToken:        client.InternalAdminToken,
 			FilterResourceTypes:    []string{"concepts"},
@@ -412,7 +412,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("export after import-from concepts", func(t *testing.T) {
-		export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
+		export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
 		if err != nil {
 			t.Error(err)
 			return
@@ -427,7 +427,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("import-from only function 0 and aspect 1", func(t *testing.T) {
-		err, _ := c2.ImportFrom(client.InternalAdminToken, true, client.ImportFromOptions{
+		err, _ := c2.ImportFrom(ctx, client.InternalAdminToken, true, client.ImportFromOptions{
 			RemoteDeviceRepository: "http://localhost:" + config.ServerPort,
 			RemoteAuthToken:        client.InternalAdminToken,
 			FilterResourceTypes:    []string{"functions", "aspects"},
@@ -440,7 +440,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("export after import-from function 0 and aspect 1", func(t *testing.T) {
-		export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
+		export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
 		if err != nil {
 			t.Error(err)
 			return
@@ -457,7 +457,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("import-from all", func(t *testing.T) {
-		err, _ := c2.ImportFrom(client.InternalAdminToken, true, client.ImportFromOptions{
+		err, _ := c2.ImportFrom(ctx, client.InternalAdminToken, true, client.ImportFromOptions{
 			RemoteDeviceRepository: "http://localhost:" + config.ServerPort,
 			RemoteAuthToken:        client.InternalAdminToken,
 		})
@@ -469,7 +469,7 @@ func TestExport(t *testing.T) {
 
 	t.Run("export after import-from all", func(t *testing.T) {
 		t.Run("default", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{})
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -487,7 +487,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("with devices", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{IncludeOwnedInformation: true})
 			if err != nil {
 				t.Error(err)
 				return
@@ -529,7 +529,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only device-types", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types"}})
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types"}})
 			if err != nil {
 				t.Error(err)
 				return
@@ -543,7 +543,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only first device-type", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{
 				FilterResourceTypes: []string{"device-types"},
 				FilterIds:           []string{deviceTypes[0].Id},
 			})
@@ -560,7 +560,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only device-types and functions", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types", "functions"}})
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{FilterResourceTypes: []string{"device-types", "functions"}})
 			if err != nil {
 				t.Error(err)
 				return
@@ -575,7 +575,7 @@ func TestExport(t *testing.T) {
 			}
 		})
 		t.Run("only first device-type and function", func(t *testing.T) {
-			export, err, _ := c2.Export(client.InternalAdminToken, client.ImportExportOptions{
+			export, err, _ := c2.Export(ctx, client.InternalAdminToken, client.ImportExportOptions{
 				FilterResourceTypes: []string{"device-types", "functions"},
 				FilterIds:           []string{deviceTypes[0].Id, functions[0].Id},
 			})
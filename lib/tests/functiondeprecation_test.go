@@ -0,0 +1,210 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestFunctionDeprecation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const oldFunction = model.MEASURING_FUNCTION_PREFIX + "deprecation-old-temperature"
+	const newFunction = model.MEASURING_FUNCTION_PREFIX + "deprecation-new-temperature"
+	for _, characteristic := range []models.Characteristic{
+		{Id: "urn:infai:ses:characteristic:deprecation-celsius", Name: "Celsius", Type: models.Float},
+		{Id: "urn:infai:ses:characteristic:deprecation-kelvin", Name: "Kelvin", Type: models.Float},
+	} {
+		_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, characteristic)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, concept := range []models.Concept{
+		{Id: "urn:infai:ses:concept:deprecation-old", Name: "old temperature", CharacteristicIds: []string{"urn:infai:ses:characteristic:deprecation-celsius"}, BaseCharacteristicId: "urn:infai:ses:characteristic:deprecation-celsius"},
+		{Id: "urn:infai:ses:concept:deprecation-new", Name: "new temperature", CharacteristicIds: []string{"urn:infai:ses:characteristic:deprecation-kelvin"}, BaseCharacteristicId: "urn:infai:ses:characteristic:deprecation-kelvin"},
+	} {
+		_, err, _ = c.SetConcept(ctx, client.InternalAdminToken, concept)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, function := range []models.Function{
+		{Id: oldFunction, Name: "Get Temperature (old)", ConceptId: "urn:infai:ses:concept:deprecation-old", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION},
+		{Id: newFunction, Name: "Get Temperature (new)", ConceptId: "urn:infai:ses:concept:deprecation-new", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION},
+	} {
+		_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, function)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:deprecation",
+		Name:             "deprecation",
+		Handler:          "deprecation",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:deprecation-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name: "deprecation",
+		Services: []models.Service{{
+			LocalId:     "getTemperature",
+			Name:        "Get Temperature",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:deprecation",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:deprecation-payload",
+				ContentVariable: models.ContentVariable{
+					Name: "payload",
+					Type: models.Structure,
+					SubContentVariables: []models.ContentVariable{{
+						Name:             "temperature",
+						Type:             models.Float,
+						FunctionId:       oldFunction,
+						CharacteristicId: "urn:infai:ses:characteristic:deprecation-celsius",
+					}},
+				},
+			}},
+		}},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("migrate without deprecation", func(t *testing.T) {
+		_, err, _ := c.MigrateDeprecatedFunction(ctx, client.InternalAdminToken, oldFunction, true)
+		if !errors.Is(err, model.ErrFunctionNotDeprecated) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("invalid replacement", func(t *testing.T) {
+		_, err, _ := c.SetFunctionDeprecation(ctx, client.InternalAdminToken, model.FunctionDeprecation{FunctionId: oldFunction, ReplacementFunctionId: oldFunction})
+		if !errors.Is(err, model.ErrFunctionInvalidReplacement) {
+			t.Error(err)
+		}
+		_, err, _ = c.SetFunctionDeprecation(ctx, client.InternalAdminToken, model.FunctionDeprecation{
+			FunctionId:            oldFunction,
+			ReplacementFunctionId: newFunction,
+			CharacteristicMapping: map[string]string{"urn:infai:ses:characteristic:deprecation-kelvin": "urn:infai:ses:characteristic:deprecation-celsius"},
+		})
+		if !errors.Is(err, model.ErrInvalidField) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("missing characteristic mapping", func(t *testing.T) {
+		_, err, _ := c.SetFunctionDeprecation(ctx, client.InternalAdminToken, model.FunctionDeprecation{FunctionId: oldFunction, ReplacementFunctionId: newFunction})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.MigrateDeprecatedFunction(ctx, client.InternalAdminToken, oldFunction, true)
+		if !errors.Is(err, model.ErrFunctionMissingCharacteristicMapping) {
+			t.Error(err)
+		}
+	})
+
+	deprecation := model.FunctionDeprecation{
+		FunctionId:            oldFunction,
+		ReplacementFunctionId: newFunction,
+		CharacteristicMapping: map[string]string{"urn:infai:ses:characteristic:deprecation-celsius": "urn:infai:ses:characteristic:deprecation-kelvin"},
+		Reason:                "concept change",
+	}
+	t.Run("deprecate", func(t *testing.T) {
+		_, err, _ := c.SetFunctionDeprecation(ctx, client.InternalAdminToken, deprecation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list, err, _ := c.ListFunctionDeprecations(ctx)
+		if err != nil || !reflect.DeepEqual(list, []model.FunctionDeprecation{deprecation}) {
+			t.Errorf("%v %#v", err, list)
+		}
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		usage, err, _ := c.GetFunctionUsage(ctx, oldFunction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(usage) != 1 || usage[0].DeviceTypeId != dt.Id || usage[0].ContentVariablePath != "payload.temperature" || usage[0].CharacteristicId != "urn:infai:ses:characteristic:deprecation-celsius" {
+			t.Errorf("%#v", usage)
+		}
+	})
+
+	t.Run("migrate dry-run", func(t *testing.T) {
+		result, err, _ := c.MigrateDeprecatedFunction(ctx, client.InternalAdminToken, oldFunction, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Usages) != 1 || !reflect.DeepEqual(result.DeviceTypeIds, []string{dt.Id}) || len(result.RewrittenDeviceTypeIds) != 0 {
+			t.Errorf("%#v", result)
+		}
+		unchanged, err, _ := c.ReadDeviceType(ctx, dt.Id, client.InternalAdminToken)
+		if err != nil || unchanged.Services[0].Outputs[0].ContentVariable.SubContentVariables[0].FunctionId != oldFunction {
+			t.Error(err, unchanged)
+		}
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		result, err, _ := c.MigrateDeprecatedFunction(ctx, client.InternalAdminToken, oldFunction, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result.RewrittenDeviceTypeIds, []string{dt.Id}) {
+			t.Errorf("%#v", result)
+		}
+		updated, err, _ := c.ReadDeviceType(ctx, dt.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		variable := updated.Services[0].Outputs[0].ContentVariable.SubContentVariables[0]
+		if variable.FunctionId != newFunction || variable.CharacteristicId != "urn:infai:ses:characteristic:deprecation-kelvin" {
+			t.Errorf("%#v", variable)
+		}
+		usage, err, _ := c.GetFunctionUsage(ctx, oldFunction)
+		if err != nil || len(usage) != 0 {
+			t.Error(err, usage)
+		}
+	})
+
+	t.Run("remove deprecation", func(t *testing.T) {
+		err, _ := c.RemoveFunctionDeprecation(ctx, client.InternalAdminToken, oldFunction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err, _ = c.GetFunctionDeprecation(ctx, oldFunction)
+		if err == nil {
+			t.Error("expected deprecation to be removed")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/idmodifier"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestIdModifiers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, db, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	dt := models.DeviceType{
+		Id:   "urn:infai:ses:device-type:modifier",
+		Name: "lamp",
+		Services: []models.Service{
+			{Id: "urn:infai:ses:service:modifier-event", LocalId: "event", Name: "event", Interaction: models.EVENT},
+			{Id: "urn:infai:ses:service:modifier-request", LocalId: "request", Name: "request", Interaction: models.REQUEST},
+		},
+	}
+	err = db.SetDeviceType(ctx, dt, func(models.DeviceType) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.SetDevice(ctx, model.DeviceWithConnectionState{Device: models.Device{Id: "modifier-device", Name: "device", DeviceTypeId: dt.Id, OwnerId: "owner"}}, func(model.DeviceWithConnectionState, model.DeviceWithConnectionState) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("device-type", func(t *testing.T) {
+		id := idmodifier.AppendModifier(dt.Id, idmodifier.InteractionFilter, []string{string(models.REQUEST)})
+		id = idmodifier.AppendModifier(id, idmodifier.NameOverlay, []string{"lamp requests"})
+		result, err, _ := c.ReadDeviceType(ctx, id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Id != id || result.Name != "lamp requests" || len(result.Services) != 1 || result.Services[0].LocalId != "request" {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("device", func(t *testing.T) {
+		id := idmodifier.AppendModifier("modifier-device", idmodifier.InteractionFilter, []string{string(models.EVENT)})
+		result, err, _ := c.ReadDevice(ctx, id, client.InternalAdminToken, model.READ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Id != id || result.DeviceTypeId != idmodifier.AppendModifier(dt.Id, idmodifier.InteractionFilter, []string{string(models.EVENT)}) {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err, code := c.ReadDeviceType(ctx, idmodifier.AppendModifier(dt.Id, idmodifier.InteractionFilter, []string{"foo"}), client.InternalAdminToken)
+		if err == nil || code != 400 {
+			t.Error(err, code)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestImpactQuery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, db, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const function = model.MEASURING_FUNCTION_PREFIX + "impact-temperature"
+	const aspect = "urn:infai:ses:aspect:impact-air"
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: aspect, Name: "Air", SubAspects: []models.Aspect{{Id: aspect + "-temperature", Name: "Temperature"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{Id: function, Name: "Get Temperature", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:impact",
+		Name:             "impact",
+		Handler:          "impact",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:impact-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := func(localId string, aspectId string) models.Service {
+		return models.Service{
+			LocalId:     localId,
+			Name:        localId,
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:impact",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:impact-payload",
+				ContentVariable:   models.ContentVariable{Name: "value", Type: models.Float, FunctionId: function, AspectId: aspectId},
+			}},
+		}
+	}
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name:     "impact",
+		Services: []models.Service{service("getAirTemperature", aspect+"-temperature"), service("getTemperature", "")},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
+		Name:     "impact other",
+		Services: []models.Service{service("getTemperature", "")},
+	}, model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nop := func(model.DeviceWithConnectionState, model.DeviceWithConnectionState) error { return nil }
+	for _, device := range []models.Device{
+		{Id: "impact-d1", Name: "d1", DeviceTypeId: dt.Id, OwnerId: "owner1"},
+		{Id: "impact-d2", Name: "d2", DeviceTypeId: dt.Id, OwnerId: "owner2"},
+		{Id: "impact-d3", Name: "d3", DeviceTypeId: other.Id, OwnerId: "owner1"},
+	} {
+		err = db.SetDevice(ctx, model.DeviceWithConnectionState{Device: device}, nop)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = db.SetHub(ctx, model.HubWithConnectionState{Hub: models.Hub{Id: "impact-hub", Name: "hub", DeviceIds: []string{"impact-d1", "impact-d3"}, DeviceLocalIds: []string{"d1", "d3"}, OwnerId: "owner1"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.SetDeviceGroup(ctx, models.DeviceGroup{Id: "impact-group", Name: "group", DeviceIds: []string{"impact-d2"}}, func(models.DeviceGroup, string) error { return nil }, "owner2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.SetLocation(ctx, models.Location{Id: "impact-location", Name: "location", DeviceGroupIds: []string{"impact-group"}}, func(models.Location, string) error { return nil }, "owner2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.SetLocation(ctx, models.Location{Id: "impact-other-location", Name: "other location", DeviceIds: []string{"impact-d3"}}, func(models.Location, string) error { return nil }, "owner1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.SetGraph(ctx, models.Graph{Id: "impact-graph", Owner: "owner3", Nodes: []models.Node{{Id: "n1", ResourceType: models.GraphResourceTypeDevice, ResourceId: "impact-d3"}}}, func(models.Graph) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("unknown resource", func(t *testing.T) {
+		_, err, _ := c.GetImpact(ctx, client.InternalAdminToken, model.ImpactQuery{Resource: "unknown", Id: "foo"})
+		if !errors.Is(err, model.ErrInvalidField) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("aspect", func(t *testing.T) {
+		result, err, _ := c.GetImpact(ctx, client.InternalAdminToken, model.ImpactQuery{Resource: "aspects", Id: aspect, Action: model.ImpactActionDelete})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.DeviceTypes) != 1 || result.DeviceTypes[0].Id != dt.Id || !reflect.DeepEqual(result.DeviceTypes[0].ServiceIds, []string{dt.Services[0].Id}) {
+			t.Errorf("%#v", result.DeviceTypes)
+		}
+		if len(result.Devices) != 2 || len(result.Hubs) != 1 || len(result.DeviceGroups) != 1 || len(result.Locations) != 1 || len(result.Graphs) != 0 {
+			t.Errorf("%#v", result)
+		}
+		expectedOwners := map[string]model.ImpactCount{
+			"owner1": {Devices: 1, Hubs: 1},
+			"owner2": {Devices: 1},
+		}
+		if !reflect.DeepEqual(result.Owners, expectedOwners) {
+			t.Errorf("%#v", result.Owners)
+		}
+	})
+
+	t.Run("function", func(t *testing.T) {
+		result, err, _ := c.GetImpact(ctx, client.InternalAdminToken, model.ImpactQuery{Resource: "functions", Id: function})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.DeviceTypes) != 2 || len(result.Devices) != 3 || len(result.Hubs) != 1 || len(result.Locations) != 2 || len(result.Graphs) != 1 {
+			t.Errorf("%#v", result)
+		}
+		if result.Owners["owner3"].Graphs != 1 || result.Owners["owner1"].Devices != 2 {
+			t.Errorf("%#v", result.Owners)
+		}
+	})
+
+	t.Run("proposed device-type", func(t *testing.T) {
+		proposal := dt
+		proposal.Name = "renamed"
+		result, err, _ := c.GetImpact(ctx, client.InternalAdminToken, model.ImpactQuery{Resource: "device-types", Id: dt.Id, DeviceType: &proposal})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.DeviceTypes) != 0 || len(result.Devices) != 0 {
+			t.Errorf("%#v", result)
+		}
+		proposal.Services = proposal.Services[:1]
+		result, err, _ = c.GetImpact(ctx, client.InternalAdminToken, model.ImpactQuery{Resource: "device-types", Id: dt.Id, DeviceType: &proposal})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.DeviceTypes) != 1 || !reflect.DeepEqual(result.DeviceTypes[0].ServiceIds, []string{dt.Services[1].Id}) || len(result.Devices) != 2 {
+			t.Errorf("%#v", result)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestLint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:lint",
+		Name:             "lint",
+		Handler:          "lint",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:lint-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, characteristic := range []models.Characteristic{
+		{Id: "urn:infai:ses:characteristic:lint-celsius", Name: "Celsius", Type: models.Float},
+		{Id: "urn:infai:ses:characteristic:lint-percent", Name: "Percent", Type: models.Float},
+	} {
+		_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, characteristic)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetConcept(ctx, client.InternalAdminToken, models.Concept{
+		Id:                   "urn:infai:ses:concept:lint-temperature",
+		Name:                 "Temperature",
+		CharacteristicIds:    []string{"urn:infai:ses:characteristic:lint-celsius"},
+		BaseCharacteristicId: "urn:infai:ses:characteristic:lint-celsius",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{
+		Id:        model.MEASURING_FUNCTION_PREFIX + "lint-temperature",
+		Name:      "Get Temperature",
+		ConceptId: "urn:infai:ses:concept:lint-temperature",
+		RdfType:   model.SES_ONTOLOGY_MEASURING_FUNCTION,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newService := func(localId string, variable models.ContentVariable) models.Service {
+		return models.Service{
+			LocalId:     localId,
+			Name:        localId,
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:lint",
+			Outputs: []models.Content{{
+				Serialization:     models.JSON,
+				ProtocolSegmentId: "urn:infai:ses:segment:lint-payload",
+				ContentVariable:   variable,
+			}},
+		}
+	}
+
+	clean := models.DeviceType{
+		Name: "lint clean",
+		Services: []models.Service{
+			newService("getTemperature", models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: model.MEASURING_FUNCTION_PREFIX + "lint-temperature", CharacteristicId: "urn:infai:ses:characteristic:lint-celsius", AspectId: ""}),
+		},
+	}
+	clean.Services[0].Outputs[0].ContentVariable.AspectId = "urn:infai:ses:aspect:lint-air"
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: "urn:infai:ses:aspect:lint-air", Name: "Air"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("clean draft", func(t *testing.T) {
+		result, err, _ := c.LintDeviceType(ctx, clean, model.ValidationOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Findings) != 0 {
+			t.Error(result.Findings)
+		}
+	})
+
+	draft := models.DeviceType{
+		Name:          "lint draft",
+		ServiceGroups: []models.ServiceGroup{{Key: "unused", Name: "Unused"}},
+		Services: []models.Service{
+			newService("getTemperature", models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: model.MEASURING_FUNCTION_PREFIX + "lint-temperature", CharacteristicId: "urn:infai:ses:characteristic:lint-percent"}),
+			newService("getLevel", models.ContentVariable{Name: "level", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+				{Name: "value", Type: models.Float},
+				{Name: "value", Type: models.Float},
+			}}),
+			newService("get_humidity", models.ContentVariable{Name: "humidity", Type: models.Float}),
+		},
+	}
+
+	t.Run("draft findings", func(t *testing.T) {
+		result, err, _ := c.LintDeviceType(ctx, draft, model.ValidationOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules := []string{}
+		for _, finding := range result.Findings {
+			rules = append(rules, finding.Rule)
+		}
+		for _, expected := range []string{
+			configuration.LintRuleValidation,
+			configuration.LintRuleMeasuringFunctionWithoutAspect,
+			configuration.LintRuleCharacteristicNotInConcept,
+			configuration.LintRuleDuplicateContentVariablePath,
+			configuration.LintRuleServiceWithoutFunction,
+			configuration.LintRuleInconsistentNaming,
+			configuration.LintRuleUnusedServiceGroup,
+		} {
+			if !slices.Contains(rules, expected) {
+				t.Error("missing finding", expected, result.Findings)
+			}
+		}
+		if result.Errors == 0 || result.Warnings == 0 {
+			t.Error(result.Errors, result.Warnings)
+		}
+	})
+
+	t.Run("gate", func(t *testing.T) {
+		gated := models.DeviceType{
+			Name:          "lint gated",
+			ServiceGroups: []models.ServiceGroup{{Key: "unused", Name: "Unused"}},
+			Services: []models.Service{
+				newService("getTemperature", models.ContentVariable{Name: "temperature", Type: models.Float}),
+			},
+		}
+		//only warnings
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, gated, model.DeviceTypeUpdateOptions{Lint: model.TruePtr})
+		if err != nil {
+			t.Error(err)
+		}
+		_, err, _ = c.SetDeviceType(ctx, client.InternalAdminToken, clean, model.DeviceTypeUpdateOptions{Lint: model.TruePtr})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		results, err, _ := c.LintDeviceTypes(ctx, client.InternalAdminToken, model.DeviceTypeLintListOptions{Limit: 100})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 || results[0].DeviceTypeName != "lint gated" || results[0].Errors != 0 || results[0].Warnings != 2 {
+			t.Error(results)
+		}
+	})
+
+}
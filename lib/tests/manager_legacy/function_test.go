@@ -17,6 +17,7 @@
 package tests
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/SENERGY-Platform/device-repository/lib/client"
 	"github.com/SENERGY-Platform/device-repository/lib/tests/manager_legacy/helper"
@@ -76,7 +77,7 @@ func testFunction(port string) func(t *testing.T) {
 			t.Fatal(result)
 		}
 
-		resultList, _, err, _ := client.NewClient("http://localhost:"+port, nil).ListFunctions(client.FunctionListOptions{
+		resultList, _, err, _ := client.NewClient("http://localhost:"+port, nil).ListFunctions(context.Background(), client.FunctionListOptions{
 			Ids: []string{function.Id},
 		})
 		if err != nil {
@@ -142,7 +142,7 @@ func TestMirror(t *testing.T) {
 	mirrorClient := client.NewClient("http://localhost:"+config.ServerPort, nil)
 
 	t.Run("create initial source state", func(t *testing.T) {
-		p1, err, _ := sourceClient.SetProtocol(client.InternalAdminToken, models.Protocol{
+		p1, err, _ := sourceClient.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
 			Id:      "p1",
 			Name:    "p1",
 			Handler: "p1",
@@ -154,7 +154,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		a1, err, _ := sourceClient.SetAspect(client.InternalAdminToken, models.Aspect{
+		a1, err, _ := sourceClient.SetAspect(ctx, client.InternalAdminToken, models.Aspect{
 			Name:       "a1",
 			SubAspects: []models.Aspect{{Name: "a1.1"}},
 		})
@@ -162,7 +162,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		c1, err, _ := sourceClient.SetCharacteristic(client.InternalAdminToken, models.Characteristic{
+		c1, err, _ := sourceClient.SetCharacteristic(ctx, client.InternalAdminToken, models.Characteristic{
 			Name: "c1",
 			Type: models.String,
 		})
@@ -170,7 +170,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		co1, err, _ := sourceClient.SetConcept(client.InternalAdminToken, models.Concept{
+		co1, err, _ := sourceClient.SetConcept(ctx, client.InternalAdminToken, models.Concept{
 			Name:                 "co1",
 			BaseCharacteristicId: c1.Id,
 			CharacteristicIds:    []string{c1.Id},
@@ -179,7 +179,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		f1, err, _ := sourceClient.SetFunction(client.InternalAdminToken, models.Function{
+		f1, err, _ := sourceClient.SetFunction(ctx, client.InternalAdminToken, models.Function{
 			Name:        "f1",
 			DisplayName: "f1",
 			ConceptId:   co1.Id,
@@ -190,11 +190,11 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		dc1, err, _ := sourceClient.SetDeviceClass(client.InternalAdminToken, models.DeviceClass{
+		dc1, err, _ := sourceClient.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{
 			Name: "dc1",
 		})
 
-		dt1, err, _ := sourceClient.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+		dt1, err, _ := sourceClient.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 			Name: "dt1",
 			Services: []models.Service{
 				{
@@ -224,7 +224,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.CreateDevice(testenv.SecondOwnerToken, models.Device{
+		_, err, _ = sourceClient.CreateDevice(ctx, testenv.SecondOwnerToken, models.Device{
 			LocalId:      "d1",
 			Name:         "d1",
 			DeviceTypeId: dt1.Id,
@@ -235,7 +235,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.CreateDevice(testenv.TestToken, models.Device{
+		_, err, _ = sourceClient.CreateDevice(ctx, testenv.TestToken, models.Device{
 			LocalId:      "shouldNotBeFound",
 			Name:         "shouldNotBeFound",
 			DeviceTypeId: dt1.Id,
@@ -246,7 +246,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetHub(testenv.SecondOwnerToken, models.Hub{
+		_, err, _ = sourceClient.SetHub(ctx, testenv.SecondOwnerToken, models.Hub{
 			Id:      "h1",
 			Name:    "h1",
 			OwnerId: testenv.SecendOwnerTokenUser,
@@ -255,7 +255,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		_, err, _ = sourceClient.SetHub(testenv.TestToken, models.Hub{
+		_, err, _ = sourceClient.SetHub(ctx, testenv.TestToken, models.Hub{
 			Id:      "shouldNotBeFound2",
 			Name:    "shouldNotBeFound2",
 			OwnerId: testenv.TestTokenUser,
@@ -265,7 +265,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetDeviceGroup(testenv.SecondOwnerToken, models.DeviceGroup{
+		_, err, _ = sourceClient.SetDeviceGroup(ctx, testenv.SecondOwnerToken, models.DeviceGroup{
 			Name: "dg1",
 		})
 		if err != nil {
@@ -273,7 +273,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetDeviceGroup(testenv.TestToken, models.DeviceGroup{
+		_, err, _ = sourceClient.SetDeviceGroup(ctx, testenv.TestToken, models.DeviceGroup{
 			Name: "shouldNotBeFound3",
 		})
 		if err != nil {
@@ -293,7 +293,7 @@ func TestMirror(t *testing.T) {
 	t.Run("check initial mirror state", func(t *testing.T) {
 		time.Sleep(2 * time.Second)
 		t.Run("protocols", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListProtocols("", 100, 0, "")
+			result, err, _ := mirrorClient.ListProtocols(ctx, "", 100, 0, "")
 			if err != nil {
 				t.Error(err)
 				return
@@ -304,7 +304,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("aspects", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListAspects(client.AspectListOptions{})
+			result, total, err, _ := mirrorClient.ListAspects(ctx, client.AspectListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -318,7 +318,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("aspect-nodes", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListAspectNodes(client.AspectListOptions{})
+			result, total, err, _ := mirrorClient.ListAspectNodes(ctx, client.AspectListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -333,7 +333,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("devices", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListDevices("", client.DeviceListOptions{})
+			result, err, _ := mirrorClient.ListDevices(ctx, "", client.DeviceListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -346,7 +346,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("device-groups", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListDeviceGroups("", client.DeviceGroupListOptions{})
+			result, total, err, _ := mirrorClient.ListDeviceGroups(ctx, "", client.DeviceGroupListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -362,7 +362,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("hubs", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListHubs("", client.HubListOptions{})
+			result, err, _ := mirrorClient.ListHubs(ctx, "", client.HubListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -373,7 +373,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("concepts", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListConcepts(client.ConceptListOptions{})
+			result, total, err, _ := mirrorClient.ListConcepts(ctx, client.ConceptListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -387,7 +387,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("functions", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListFunctions(client.FunctionListOptions{})
+			result, total, err, _ := mirrorClient.ListFunctions(ctx, client.FunctionListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -402,7 +402,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("characteristics", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListCharacteristics(client.CharacteristicListOptions{})
+			result, total, err, _ := mirrorClient.ListCharacteristics(ctx, client.CharacteristicListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -415,7 +415,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("device-types", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListDeviceTypesV3("", client.DeviceTypeListOptions{})
+			result, total, err, _ := mirrorClient.ListDeviceTypesV3(ctx, "", client.DeviceTypeListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -433,7 +433,7 @@ func TestMirror(t *testing.T) {
 	})
 
 	t.Run("update source state", func(t *testing.T) {
-		p2, err, _ := sourceClient.SetProtocol(client.InternalAdminToken, models.Protocol{
+		p2, err, _ := sourceClient.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
 			Id:      "p2",
 			Name:    "p2",
 			Handler: "p2",
@@ -445,7 +445,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		a2, err, _ := sourceClient.SetAspect(client.InternalAdminToken, models.Aspect{
+		a2, err, _ := sourceClient.SetAspect(ctx, client.InternalAdminToken, models.Aspect{
 			Name:       "a2",
 			SubAspects: []models.Aspect{{Name: "a2.2"}},
 		})
@@ -453,7 +453,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		c2, err, _ := sourceClient.SetCharacteristic(client.InternalAdminToken, models.Characteristic{
+		c2, err, _ := sourceClient.SetCharacteristic(ctx, client.InternalAdminToken, models.Characteristic{
 			Name: "c2",
 			Type: models.String,
 		})
@@ -461,7 +461,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		co2, err, _ := sourceClient.SetConcept(client.InternalAdminToken, models.Concept{
+		co2, err, _ := sourceClient.SetConcept(ctx, client.InternalAdminToken, models.Concept{
 			Name:                 "co2",
 			BaseCharacteristicId: c2.Id,
 			CharacteristicIds:    []string{c2.Id},
@@ -470,7 +470,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		f2, err, _ := sourceClient.SetFunction(client.InternalAdminToken, models.Function{
+		f2, err, _ := sourceClient.SetFunction(ctx, client.InternalAdminToken, models.Function{
 			Name:        "f2",
 			DisplayName: "f2",
 			ConceptId:   co2.Id,
@@ -481,11 +481,11 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		dc2, err, _ := sourceClient.SetDeviceClass(client.InternalAdminToken, models.DeviceClass{
+		dc2, err, _ := sourceClient.SetDeviceClass(ctx, client.InternalAdminToken, models.DeviceClass{
 			Name: "dc2",
 		})
 
-		dt2, err, _ := sourceClient.SetDeviceType(client.InternalAdminToken, models.DeviceType{
+		dt2, err, _ := sourceClient.SetDeviceType(ctx, client.InternalAdminToken, models.DeviceType{
 			Name: "dt2",
 			Services: []models.Service{
 				{
@@ -515,7 +515,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		device, err, _ := sourceClient.CreateDevice(testenv.SecondOwnerToken, models.Device{
+		device, err, _ := sourceClient.CreateDevice(ctx, testenv.SecondOwnerToken, models.Device{
 			LocalId:      "d2",
 			Name:         "d2",
 			DeviceTypeId: dt2.Id,
@@ -527,7 +527,7 @@ func TestMirror(t *testing.T) {
 		}
 		t.Logf("device: %#v", device)
 
-		_, err, _ = sourceClient.CreateDevice(testenv.TestToken, models.Device{
+		_, err, _ = sourceClient.CreateDevice(ctx, testenv.TestToken, models.Device{
 			LocalId:      "shouldNotBeFound_2",
 			Name:         "shouldNotBeFound_2",
 			DeviceTypeId: dt2.Id,
@@ -538,7 +538,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetHub(testenv.SecondOwnerToken, models.Hub{
+		_, err, _ = sourceClient.SetHub(ctx, testenv.SecondOwnerToken, models.Hub{
 			Id:      "h2",
 			Name:    "h2",
 			OwnerId: testenv.SecendOwnerTokenUser,
@@ -547,7 +547,7 @@ func TestMirror(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		_, err, _ = sourceClient.SetHub(testenv.TestToken, models.Hub{
+		_, err, _ = sourceClient.SetHub(ctx, testenv.TestToken, models.Hub{
 			Id:      "shouldNotBeFound2_2",
 			Name:    "shouldNotBeFound2_2",
 			OwnerId: testenv.TestTokenUser,
@@ -557,7 +557,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetDeviceGroup(testenv.SecondOwnerToken, models.DeviceGroup{
+		_, err, _ = sourceClient.SetDeviceGroup(ctx, testenv.SecondOwnerToken, models.DeviceGroup{
 			Name: "dg2",
 		})
 		if err != nil {
@@ -565,7 +565,7 @@ func TestMirror(t *testing.T) {
 			return
 		}
 
-		_, err, _ = sourceClient.SetDeviceGroup(testenv.TestToken, models.DeviceGroup{
+		_, err, _ = sourceClient.SetDeviceGroup(ctx, testenv.TestToken, models.DeviceGroup{
 			Name: "shouldNotBeFound3_2",
 		})
 		if err != nil {
@@ -577,7 +577,7 @@ func TestMirror(t *testing.T) {
 	t.Run("check updated mirror", func(t *testing.T) {
 		time.Sleep(15 * time.Second)
 		t.Run("protocols", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListProtocols("", 100, 0, "")
+			result, err, _ := mirrorClient.ListProtocols(ctx, "", 100, 0, "")
 			if err != nil {
 				t.Error(err)
 				return
@@ -588,7 +588,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("aspects", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListAspects(client.AspectListOptions{})
+			result, total, err, _ := mirrorClient.ListAspects(ctx, client.AspectListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -602,7 +602,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("aspect-nodes", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListAspectNodes(client.AspectListOptions{})
+			result, total, err, _ := mirrorClient.ListAspectNodes(ctx, client.AspectListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -617,7 +617,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("devices", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListDevices("", client.DeviceListOptions{})
+			result, err, _ := mirrorClient.ListDevices(ctx, "", client.DeviceListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -628,7 +628,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("device-groups", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListDeviceGroups("", client.DeviceGroupListOptions{})
+			result, total, err, _ := mirrorClient.ListDeviceGroups(ctx, "", client.DeviceGroupListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -642,7 +642,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("hubs", func(t *testing.T) {
-			result, err, _ := mirrorClient.ListHubs("", client.HubListOptions{})
+			result, err, _ := mirrorClient.ListHubs(ctx, "", client.HubListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -653,7 +653,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("concepts", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListConcepts(client.ConceptListOptions{})
+			result, total, err, _ := mirrorClient.ListConcepts(ctx, client.ConceptListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -667,7 +667,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("functions", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListFunctions(client.FunctionListOptions{})
+			result, total, err, _ := mirrorClient.ListFunctions(ctx, client.FunctionListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -682,7 +682,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("characteristics", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListCharacteristics(client.CharacteristicListOptions{})
+			result, total, err, _ := mirrorClient.ListCharacteristics(ctx, client.CharacteristicListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -695,7 +695,7 @@ func TestMirror(t *testing.T) {
 			}
 		})
 		t.Run("device-types", func(t *testing.T) {
-			result, total, err, _ := mirrorClient.ListDeviceTypesV3("", client.DeviceTypeListOptions{})
+			result, total, err, _ := mirrorClient.ListDeviceTypesV3(ctx, "", client.DeviceTypeListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -715,7 +715,7 @@ func TestMirror(t *testing.T) {
 	t.Run("check limited proxy requests without changes", func(t *testing.T) {
 		proxyReqCount.Store(0)
 		t.Run("update other user", func(t *testing.T) {
-			dts, _, err, _ := sourceClient.ListDeviceTypesV3("", client.DeviceTypeListOptions{})
+			dts, _, err, _ := sourceClient.ListDeviceTypesV3(ctx, "", client.DeviceTypeListOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -724,7 +724,7 @@ func TestMirror(t *testing.T) {
 				t.Error("missing device types")
 				return
 			}
-			_, err, _ = sourceClient.CreateDevice(testenv.TestToken, models.Device{
+			_, err, _ = sourceClient.CreateDevice(ctx, testenv.TestToken, models.Device{
 				LocalId:      "shouldNotBeFound_3",
 				Name:         "shouldNotBeFound_3",
 				DeviceTypeId: dts[0].Id,
@@ -735,7 +735,7 @@ func TestMirror(t *testing.T) {
 				return
 			}
 
-			_, err, _ = sourceClient.SetHub(testenv.TestToken, models.Hub{
+			_, err, _ = sourceClient.SetHub(ctx, testenv.TestToken, models.Hub{
 				Id:      "shouldNotBeFound_32",
 				Name:    "shouldNotBeFound_32",
 				OwnerId: testenv.TestTokenUser,
@@ -745,7 +745,7 @@ func TestMirror(t *testing.T) {
 				return
 			}
 
-			_, err, _ = sourceClient.SetDeviceGroup(testenv.TestToken, models.DeviceGroup{
+			_, err, _ = sourceClient.SetDeviceGroup(ctx, testenv.TestToken, models.DeviceGroup{
 				Name: "shouldNotBeFound_33",
 			})
 			if err != nil {
@@ -765,7 +765,7 @@ func TestMirror(t *testing.T) {
 
 	t.Run("forward updates", func(t *testing.T) {
 		t.Run("create resources via mirror", func(t *testing.T) {
-			protocols, err, _ := mirrorClient.ListProtocols("", 100, 0, "")
+			protocols, err, _ := mirrorClient.ListProtocols(ctx, "", 100, 0, "")
 			if err != nil {
 				t.Error(err)
 				return
@@ -776,7 +776,7 @@ func TestMirror(t *testing.T) {
 			}
 			p3 := protocols[0]
 
-			dt3, err, _ := mirrorClient.SetDeviceType("", models.DeviceType{
+			dt3, err, _ := mirrorClient.SetDeviceType(ctx, "", models.DeviceType{
 				Name: "dt3",
 				Services: []models.Service{
 					{
@@ -803,7 +803,7 @@ func TestMirror(t *testing.T) {
 			}
 
 			t.Run("create device", func(t *testing.T) {
-				_, err, _ = mirrorClient.CreateDevice("", models.Device{
+				_, err, _ = mirrorClient.CreateDevice(ctx, "", models.Device{
 					LocalId:      "d3",
 					Name:         "d3",
 					DeviceTypeId: dt3.Id,
@@ -815,7 +815,7 @@ func TestMirror(t *testing.T) {
 			})
 
 			t.Run("create hub", func(t *testing.T) {
-				_, err, _ = mirrorClient.SetHub("", models.Hub{
+				_, err, _ = mirrorClient.SetHub(ctx, "", models.Hub{
 					Id:   "h3",
 					Name: "h3",
 				}, client.HubUpdateOptions{})
@@ -826,7 +826,7 @@ func TestMirror(t *testing.T) {
 			})
 
 			t.Run("create device-group", func(t *testing.T) {
-				_, err, _ = mirrorClient.SetDeviceGroup("", models.DeviceGroup{
+				_, err, _ = mirrorClient.SetDeviceGroup(ctx, "", models.DeviceGroup{
 					Name: "dg3",
 				})
 				if err != nil {
@@ -839,7 +839,7 @@ func TestMirror(t *testing.T) {
 
 		t.Run("check mirror", func(t *testing.T) {
 			t.Run("devices", func(t *testing.T) {
-				result, err, _ := mirrorClient.ListDevices("", client.DeviceListOptions{})
+				result, err, _ := mirrorClient.ListDevices(ctx, "", client.DeviceListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -850,7 +850,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("device-groups", func(t *testing.T) {
-				result, total, err, _ := mirrorClient.ListDeviceGroups("", client.DeviceGroupListOptions{})
+				result, total, err, _ := mirrorClient.ListDeviceGroups(ctx, "", client.DeviceGroupListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -864,7 +864,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("hubs", func(t *testing.T) {
-				result, err, _ := mirrorClient.ListHubs("", client.HubListOptions{})
+				result, err, _ := mirrorClient.ListHubs(ctx, "", client.HubListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -875,7 +875,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("device-types", func(t *testing.T) {
-				result, total, err, _ := mirrorClient.ListDeviceTypesV3("", client.DeviceTypeListOptions{})
+				result, total, err, _ := mirrorClient.ListDeviceTypesV3(ctx, "", client.DeviceTypeListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -892,7 +892,7 @@ func TestMirror(t *testing.T) {
 		})
 		t.Run("check source", func(t *testing.T) {
 			t.Run("devices", func(t *testing.T) {
-				result, err, _ := sourceClient.ListDevices(testenv.SecondOwnerToken, client.DeviceListOptions{})
+				result, err, _ := sourceClient.ListDevices(ctx, testenv.SecondOwnerToken, client.DeviceListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -903,7 +903,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("device-groups", func(t *testing.T) {
-				result, total, err, _ := sourceClient.ListDeviceGroups(testenv.SecondOwnerToken, client.DeviceGroupListOptions{})
+				result, total, err, _ := sourceClient.ListDeviceGroups(ctx, testenv.SecondOwnerToken, client.DeviceGroupListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -917,7 +917,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("hubs", func(t *testing.T) {
-				result, err, _ := sourceClient.ListHubs(testenv.SecondOwnerToken, client.HubListOptions{})
+				result, err, _ := sourceClient.ListHubs(ctx, testenv.SecondOwnerToken, client.HubListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
@@ -928,7 +928,7 @@ func TestMirror(t *testing.T) {
 				}
 			})
 			t.Run("device-types", func(t *testing.T) {
-				result, total, err, _ := sourceClient.ListDeviceTypesV3(testenv.SecondOwnerToken, client.DeviceTypeListOptions{})
+				result, total, err, _ := sourceClient.ListDeviceTypesV3(ctx, testenv.SecondOwnerToken, client.DeviceTypeListOptions{})
 				if err != nil {
 					t.Error(err)
 					return
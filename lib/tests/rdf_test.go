@@ -0,0 +1,136 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/rdf"
+)
+
+const rdfImportTestTurtle = `
+@prefix ses: <https://senergy.infai.org/ontology/> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+
+<urn:infai:ses:characteristic:celsius> a ses:Characteristic ;
+    rdfs:label "Celsius" ;
+    ses:hasValueType <https://schema.org/Float> ;
+    ses:hasMinValue -273.15 .
+
+<urn:infai:ses:concept:temperature> a ses:Concept ;
+    rdfs:label "Temperature" ;
+    ses:hasBaseCharacteristic <urn:infai:ses:characteristic:celsius> ;
+    ses:hasCharacteristic <urn:infai:ses:characteristic:celsius> .
+
+<urn:infai:ses:measuring-function:temperature> a ses:MeasuringFunction ;
+    rdfs:label "Get Temperature" ;
+    ses:hasConcept <urn:infai:ses:concept:temperature> .
+
+<urn:infai:ses:aspect:air> a ses:Aspect ;
+    rdfs:label "Air" ;
+    ses:hasSubAspect [ ] , <urn:infai:ses:aspect:inside-air> .
+
+<urn:infai:ses:aspect:inside-air> a ses:Aspect ;
+    rdfs:label "Inside Air" .
+
+<urn:infai:ses:device-class:thermometer> a ses:DeviceClass ;
+    rdfs:label "Thermometer" .
+`
+
+func TestRdfImport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	read := func(doc string) *rdf.Graph {
+		graph, err := rdf.ReadTurtle(strings.NewReader(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return graph
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err, _ := c.ImportRdf(ctx, client.InternalAdminToken, read(rdfImportTestTurtle), true)
+		if err == nil {
+			t.Error("expected error for sub-aspect without iri")
+		}
+	})
+
+	doc := strings.Replace(rdfImportTestTurtle, "[ ] , ", "", 1)
+
+	t.Run("dry-run", func(t *testing.T) {
+		result, err, _ := c.ImportRdf(ctx, client.InternalAdminToken, read(doc), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.DryRun || len(result.Changes) != 5 {
+			t.Fatalf("%#v", result)
+		}
+		for _, change := range result.Changes {
+			if change.Action != model.ImportActionCreate {
+				t.Errorf("%#v", change)
+			}
+		}
+		_, err, _ = c.GetFunction(ctx, "urn:infai:ses:measuring-function:temperature")
+		if err == nil {
+			t.Error("dry-run should not import")
+		}
+	})
+
+	t.Run("import", func(t *testing.T) {
+		result, err, _ := c.ImportRdf(ctx, client.InternalAdminToken, read(doc), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.DryRun || len(result.Changes) != 5 {
+			t.Fatalf("%#v", result)
+		}
+		aspect, err, _ := c.GetAspect(ctx, "urn:infai:ses:aspect:air")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(aspect.SubAspects) != 1 || aspect.SubAspects[0].Name != "Inside Air" {
+			t.Errorf("%#v", aspect)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		result, err, _ := c.ImportRdf(ctx, client.InternalAdminToken, read(strings.Replace(doc, `"Thermometer"`, `"Thermometers"`, 1)), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, change := range result.Changes {
+			if change.Id == "urn:infai:ses:device-class:thermometer" {
+				if change.Action != model.ImportActionUpdate || len(change.Fields) != 1 || change.Fields[0] != "name" {
+					t.Errorf("%#v", change)
+				}
+			} else if change.Action != model.ImportActionUnchanged {
+				t.Errorf("%#v", change)
+			}
+		}
+	})
+}
@@ -217,7 +217,7 @@ func TestSubAspectMoveSNRGY2202(t *testing.T) {
 		return
 	}
 
-	_, err, _ = ctrl.SetAspect(testenv.AdminToken, initialAspect)
+	_, err, _ = ctrl.SetAspect(ctx, testenv.AdminToken, initialAspect)
 	if err != nil {
 		t.Error(err)
 		return
@@ -248,7 +248,7 @@ func TestSubAspectMoveSNRGY2202(t *testing.T) {
 			},
 		},
 	}
-	_, err, _ = ctrl.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = ctrl.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -262,7 +262,7 @@ func TestSubAspectMoveSNRGY2202(t *testing.T) {
 	}
 
 	t.Run("validate", func(t *testing.T) {
-		err, _ = ctrl.ValidateAspect(changedAspect)
+		err, _ = ctrl.ValidateAspect(ctx, changedAspect)
 		if err != nil {
 			t.Error(err)
 			return
@@ -308,7 +308,7 @@ func TestSubAspectMovePartial(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "a1",
 		Name: "a1",
 		SubAspects: []models.Aspect{
@@ -329,7 +329,7 @@ func TestSubAspectMovePartial(t *testing.T) {
 		return
 	}
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "a2",
 		Name: "a2",
 		SubAspects: []models.Aspect{
@@ -407,7 +407,7 @@ func TestSubAspectMovePartial(t *testing.T) {
 	}))
 
 	t.Run("aspect-nodes move", func(t *testing.T) {
-		_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+		_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 			Id:   "a1",
 			Name: "a1",
 			SubAspects: []models.Aspect{
@@ -509,7 +509,7 @@ func TestSubAspectMoveRoot(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "a1",
 		Name: "a1",
 		SubAspects: []models.Aspect{
@@ -530,7 +530,7 @@ func TestSubAspectMoveRoot(t *testing.T) {
 		return
 	}
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "a2",
 		Name: "a2",
 		SubAspects: []models.Aspect{
@@ -608,7 +608,7 @@ func TestSubAspectMoveRoot(t *testing.T) {
 	}))
 
 	t.Run("aspect-nodes move", func(t *testing.T) {
-		_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+		_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 			Id:   "a1",
 			Name: "a1",
 			SubAspects: []models.Aspect{
@@ -716,7 +716,7 @@ func TestAspectFunctions(t *testing.T) {
 	}
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetFunction(testenv.AdminToken, models.Function{
+	_, err, _ = c.SetFunction(ctx, testenv.AdminToken, models.Function{
 		Id:        "fid",
 		Name:      "fid",
 		ConceptId: "concept_1",
@@ -726,7 +726,7 @@ func TestAspectFunctions(t *testing.T) {
 		return
 	}
 
-	_, err, _ = c.SetFunction(testenv.AdminToken, models.Function{
+	_, err, _ = c.SetFunction(ctx, testenv.AdminToken, models.Function{
 		Id:        "fid_2",
 		Name:      "fid_2",
 		ConceptId: "concept_2",
@@ -736,7 +736,7 @@ func TestAspectFunctions(t *testing.T) {
 		return
 	}
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "parent_2",
 		Name: "parent_2",
 		SubAspects: []models.Aspect{
@@ -773,7 +773,7 @@ func TestAspectFunctions(t *testing.T) {
 			},
 		},
 	}
-	_, err, _ = c.SetAspect(testenv.AdminToken, aspect)
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, aspect)
 	if err != nil {
 		t.Error(err)
 		return
@@ -804,7 +804,7 @@ func TestAspectFunctions(t *testing.T) {
 			},
 		},
 	}
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -1002,7 +1002,7 @@ func TestDeviceTypeFilterCriteria(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "parent",
 		Name: "parent",
 		SubAspects: []models.Aspect{
@@ -1048,7 +1048,7 @@ func TestDeviceTypeFilterCriteria(t *testing.T) {
 			},
 		},
 	}
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
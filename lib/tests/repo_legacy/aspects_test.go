@@ -17,6 +17,7 @@
 package repo_legacy
 
 import (
+	"context"
 	"github.com/SENERGY-Platform/device-repository/lib/client"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/models/go/models"
@@ -97,7 +98,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 	t.Run("create aspects", func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 		for _, aspect := range aspects {
-			_, err, _ := c.SetAspect(AdminToken, aspect)
+			_, err, _ := c.SetAspect(context.Background(), AdminToken, aspect)
 			if err != nil {
 				t.Error(err)
 				return
@@ -106,7 +107,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 	})
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 	t.Run("list all aspects", func(t *testing.T) {
-		list, total, err, _ := c.ListAspects(client.AspectListOptions{})
+		list, total, err, _ := c.ListAspects(context.Background(), client.AspectListOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -121,7 +122,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 		}
 	})
 	t.Run("list b aspects", func(t *testing.T) {
-		list, total, err, _ := c.ListAspects(client.AspectListOptions{Search: "b"})
+		list, total, err, _ := c.ListAspects(context.Background(), client.AspectListOptions{Search: "b"})
 		if err != nil {
 			t.Error(err)
 			return
@@ -136,7 +137,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 		}
 	})
 	t.Run("list a1,b3 aspects", func(t *testing.T) {
-		list, total, err, _ := c.ListAspects(client.AspectListOptions{Ids: []string{"a1", "b3"}})
+		list, total, err, _ := c.ListAspects(context.Background(), client.AspectListOptions{Ids: []string{"a1", "b3"}})
 		if err != nil {
 			t.Error(err)
 			return
@@ -152,7 +153,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 	})
 
 	t.Run("list all aspect-nodes", func(t *testing.T) {
-		list, total, err, _ := c.ListAspectNodes(client.AspectListOptions{SortBy: "name.asc"})
+		list, total, err, _ := c.ListAspectNodes(context.Background(), client.AspectListOptions{SortBy: "name.asc"})
 		if err != nil {
 			t.Error(err)
 			return
@@ -168,7 +169,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 	})
 
 	t.Run("list b aspects-nodes", func(t *testing.T) {
-		list, total, err, _ := c.ListAspectNodes(client.AspectListOptions{Search: "b"})
+		list, total, err, _ := c.ListAspectNodes(context.Background(), client.AspectListOptions{Search: "b"})
 		if err != nil {
 			t.Error(err)
 			return
@@ -183,7 +184,7 @@ func testAspectList(t *testing.T, conf configuration.Config) {
 		}
 	})
 	t.Run("list a1,a2.2.1,b3, aspects-nodes", func(t *testing.T) {
-		list, total, err, _ := c.ListAspectNodes(client.AspectListOptions{Ids: []string{"a1", "a2.2.1", "b3"}})
+		list, total, err, _ := c.ListAspectNodes(context.Background(), client.AspectListOptions{Ids: []string{"a1", "a2.2.1", "b3"}})
 		if err != nil {
 			t.Error(err)
 			return
@@ -17,6 +17,7 @@
 package repo_legacy
 
 import (
+	"context"
 	"github.com/SENERGY-Platform/device-repository/lib/client"
 	"github.com/SENERGY-Platform/device-repository/lib/configuration"
 	"github.com/SENERGY-Platform/device-repository/lib/controller"
@@ -68,7 +69,7 @@ func testCharacteristicList(t *testing.T, conf configuration.Config) {
 	t.Run("create characteristics", func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 		for _, characteristic := range characteristics {
-			_, err, _ := c.SetCharacteristic(AdminToken, characteristic)
+			_, err, _ := c.SetCharacteristic(context.Background(), AdminToken, characteristic)
 			if err != nil {
 				t.Error(err)
 				return
@@ -77,7 +78,7 @@ func testCharacteristicList(t *testing.T, conf configuration.Config) {
 	})
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 	t.Run("list all characteristics", func(t *testing.T) {
-		list, total, err, _ := c.ListCharacteristics(client.CharacteristicListOptions{})
+		list, total, err, _ := c.ListCharacteristics(context.Background(), client.CharacteristicListOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -92,7 +93,7 @@ func testCharacteristicList(t *testing.T, conf configuration.Config) {
 		}
 	})
 	t.Run("list b characteristics", func(t *testing.T) {
-		list, total, err, _ := c.ListCharacteristics(client.CharacteristicListOptions{Search: "b"})
+		list, total, err, _ := c.ListCharacteristics(context.Background(), client.CharacteristicListOptions{Search: "b"})
 		if err != nil {
 			t.Error(err)
 			return
@@ -107,7 +108,7 @@ func testCharacteristicList(t *testing.T, conf configuration.Config) {
 		}
 	})
 	t.Run("list a1,b3 characteristics", func(t *testing.T) {
-		list, total, err, _ := c.ListCharacteristics(client.CharacteristicListOptions{Ids: []string{"a1", "b3"}})
+		list, total, err, _ := c.ListCharacteristics(context.Background(), client.CharacteristicListOptions{Ids: []string{"a1", "b3"}})
 		if err != nil {
 			t.Error(err)
 			return
@@ -159,6 +160,60 @@ func TestCharacteristicValidation(t *testing.T) {
 			},
 		},
 	}))
+
+	t.Run("allowed values", testValidateCharacteristic(false, models.Characteristic{
+		Id:            "mode",
+		Name:          "mode",
+		Type:          models.String,
+		AllowedValues: []interface{}{"rgb", "hsb"},
+		Value:         "rgb",
+	}))
+
+	t.Run("allowed values type mismatch", testValidateCharacteristic(true, models.Characteristic{
+		Id:            "mode",
+		Name:          "mode",
+		Type:          models.String,
+		AllowedValues: []interface{}{"rgb", 1},
+	}))
+
+	t.Run("value not in allowed values", testValidateCharacteristic(true, models.Characteristic{
+		Id:            "mode",
+		Name:          "mode",
+		Type:          models.String,
+		AllowedValues: []interface{}{"rgb", "hsb"},
+		Value:         "cmyk",
+	}))
+
+	t.Run("allowed values of structure", testValidateCharacteristic(true, models.Characteristic{
+		Id:            "root",
+		Name:          "root",
+		Type:          models.Structure,
+		AllowedValues: []interface{}{"a"},
+	}))
+
+	t.Run("min greater than max", testValidateCharacteristic(true, models.Characteristic{
+		Id:       "v",
+		Name:     "v",
+		Type:     models.Integer,
+		MinValue: 10,
+		MaxValue: 1,
+	}))
+
+	t.Run("min of string", testValidateCharacteristic(true, models.Characteristic{
+		Id:       "v",
+		Name:     "v",
+		Type:     models.String,
+		MinValue: 1,
+	}))
+
+	t.Run("value out of range", testValidateCharacteristic(true, models.Characteristic{
+		Id:       "v",
+		Name:     "v",
+		Type:     models.Float,
+		MinValue: 0,
+		MaxValue: 1,
+		Value:    1.5,
+	}))
 }
 
 func testValidateCharacteristic(expectError bool, characteristic models.Characteristic) func(t *testing.T) {
@@ -959,7 +959,7 @@ func createTestConfigurableMetadataBase(config configuration.Config, aspects []m
 	return func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+config.ServerPort, nil)
 		for _, aspect := range aspects {
-			_, err, _ := c.SetAspect(AdminToken, aspect)
+			_, err, _ := c.SetAspect(context.Background(), AdminToken, aspect)
 			if err != nil {
 				t.Error(err)
 				return
@@ -967,7 +967,7 @@ func createTestConfigurableMetadataBase(config configuration.Config, aspects []m
 		}
 
 		for _, function := range functions {
-			_, err, _ := c.SetFunction(AdminToken, function)
+			_, err, _ := c.SetFunction(context.Background(), AdminToken, function)
 			if err != nil {
 				t.Error(err)
 				return
@@ -975,7 +975,7 @@ func createTestConfigurableMetadataBase(config configuration.Config, aspects []m
 		}
 
 		for _, dt := range devicetypes {
-			_, err, _ := c.SetDeviceType(AdminToken, dt, client.DeviceTypeUpdateOptions{})
+			_, err, _ := c.SetDeviceType(context.Background(), AdminToken, dt, client.DeviceTypeUpdateOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -58,7 +58,7 @@ func TestListDeviceGroups(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 	for _, dg := range resources.DeviceGroupExamples {
-		_, err, _ := c.SetDeviceGroup(Userjwt, dg)
+		_, err, _ := c.SetDeviceGroup(ctx, Userjwt, dg)
 		if err != nil {
 			t.Error(err)
 			return
@@ -98,7 +98,7 @@ func TestListDeviceGroups(t *testing.T) {
 	f := func(options model.DeviceGroupListOptions, expectedTotal int64, expectedGroups []models.DeviceGroup) func(t *testing.T) {
 		return func(t *testing.T) {
 			t.Helper()
-			actualGroups, actualTotal, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ListDeviceGroups(Userjwt, options)
+			actualGroups, actualTotal, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ListDeviceGroups(ctx, Userjwt, options)
 			if err != nil {
 				t.Error(err)
 				return
@@ -120,7 +120,7 @@ func TestListDeviceGroups(t *testing.T) {
 	}, 3, expectedResultOfDeviceFilter))
 
 	t.Run("other user may not access", func(t *testing.T) {
-		actual, total, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ListDeviceGroups(SecondOwnerToken, client.DeviceGroupListOptions{})
+		actual, total, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ListDeviceGroups(ctx, SecondOwnerToken, client.DeviceGroupListOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -213,7 +213,7 @@ func (this *aspectNodeProviderMock) ListAspectNodesByIdList(ctx context.Context,
 }
 
 func TestFilterGenericDuplicateCriteria(t *testing.T) {
-	result, err := controller.DeviceGroupFilterGenericDuplicateCriteria(models.DeviceGroup{}, &aspectNodeProviderMock{result: []models.AspectNode{}})
+	result, err := controller.DeviceGroupFilterGenericDuplicateCriteria(context.Background(), models.DeviceGroup{}, &aspectNodeProviderMock{result: []models.AspectNode{}})
 	if err != nil {
 		t.Error(err)
 		return
@@ -222,7 +222,7 @@ func TestFilterGenericDuplicateCriteria(t *testing.T) {
 		t.Errorf("%#v", result)
 	}
 
-	result, err = controller.DeviceGroupFilterGenericDuplicateCriteria(models.DeviceGroup{
+	result, err = controller.DeviceGroupFilterGenericDuplicateCriteria(context.Background(), models.DeviceGroup{
 		Id:        "id",
 		Name:      "name",
 		Image:     "image",
@@ -331,7 +331,7 @@ func TestFilterGenericDuplicateCriteria(t *testing.T) {
 		t.Errorf("%#v", result)
 	}
 
-	result, err = controller.DeviceGroupFilterGenericDuplicateCriteria(models.DeviceGroup{
+	result, err = controller.DeviceGroupFilterGenericDuplicateCriteria(context.Background(), models.DeviceGroup{
 		Id:        "id",
 		Name:      "name",
 		Image:     "image",
@@ -524,7 +524,7 @@ func TestDeviceGroupsValidation(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	_, err, _ = ctrl.SetAspect(AdminToken, models.Aspect{
+	_, err, _ = ctrl.SetAspect(ctx, AdminToken, models.Aspect{
 		Id:   "parent",
 		Name: "parent",
 		SubAspects: []models.Aspect{
@@ -544,7 +544,7 @@ func TestDeviceGroupsValidation(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	_, err, _ = ctrl.SetDeviceType(AdminToken, models.DeviceType{
+	_, err, _ = ctrl.SetDeviceType(ctx, AdminToken, models.DeviceType{
 		Id:            "dt1",
 		DeviceClassId: "dcid",
 		Services: []models.Service{{
@@ -564,7 +564,7 @@ func TestDeviceGroupsValidation(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	_, err, _ = ctrl.SetDevice(Userjwt, models.Device{
+	_, err, _ = ctrl.SetDevice(ctx, Userjwt, models.Device{
 		Id:           "did",
 		DeviceTypeId: "dt1",
 	}, client.DeviceUpdateOptions{})
@@ -717,7 +717,7 @@ func testDeviceGroupValidation(ctrl *controller.Controller, group models.DeviceG
 				t.Error(r, "\n", string(debug.Stack()))
 			}
 		}()
-		err, code := ctrl.ValidateDeviceGroup(adminjwt, group)
+		err, code := ctrl.ValidateDeviceGroup(context.Background(), adminjwt, group)
 		if (err != nil) != expectError {
 			t.Error(expectError, err)
 			return
@@ -894,7 +894,7 @@ func TestDeviceGroupsIntegration(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceType(AdminToken, models.DeviceType{
+	_, err, _ = c.SetDeviceType(ctx, AdminToken, models.DeviceType{
 		Id:            devicetype1id,
 		Name:          devicetype1name,
 		DeviceClassId: "dcid",
@@ -921,7 +921,7 @@ func TestDeviceGroupsIntegration(t *testing.T) {
 		DeviceTypeId: devicetype1id,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d1, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d1, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -938,7 +938,7 @@ func TestDeviceGroupsIntegration(t *testing.T) {
 		DeviceIds: []string{device1id},
 	}
 
-	_, err, _ = c.SetDeviceGroup(userjwt, dg1)
+	_, err, _ = c.SetDeviceGroup(ctx, userjwt, dg1)
 	if err != nil {
 		t.Error(err)
 		return
@@ -987,7 +987,7 @@ func TestDeviceGroupsAttributes(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceGroup(userjwt, dg1)
+	_, err, _ = c.SetDeviceGroup(ctx, userjwt, dg1)
 	if err != nil {
 		t.Error(err)
 		return
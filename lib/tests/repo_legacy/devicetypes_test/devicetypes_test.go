@@ -55,7 +55,7 @@ func TestDeviceTypeSubAspectValidation(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetAspect(testenv.AdminToken, models.Aspect{
+	_, err, _ = c.SetAspect(ctx, testenv.AdminToken, models.Aspect{
 		Id:   "parent_2",
 		Name: "parent_2",
 		SubAspects: []models.Aspect{
@@ -76,7 +76,7 @@ func TestDeviceTypeSubAspectValidation(t *testing.T) {
 		return
 	}
 
-	_, err, _ = c.SetProtocol(testenv.AdminToken, models.Protocol{
+	_, err, _ = c.SetProtocol(ctx, testenv.AdminToken, models.Protocol{
 		Id:      "p",
 		Name:    "p",
 		Handler: "p",
@@ -165,7 +165,7 @@ func TestServiceQuery(t *testing.T) {
 	}
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name, Services: []models.Service{{Id: "service_42", Name: "foo"}}}, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name, Services: []models.Service{{Id: "service_42", Name: "foo"}}}, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -213,7 +213,7 @@ func TestSubContentVarUpdate(t *testing.T) {
 		}},
 	}
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -245,7 +245,7 @@ func TestSubContentVarUpdate(t *testing.T) {
 		}},
 	}
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dtUpdated, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dtUpdated, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -272,7 +272,7 @@ func TestSubContentVarUpdate(t *testing.T) {
 		}},
 	}
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dtSubVarDeleted, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dtSubVarDeleted, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -294,13 +294,13 @@ func TestDeviceTypeQuery(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name}, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name}, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
 	}
 	for i := 0; i < 20; i++ {
-		_, err, _ = c.SetDeviceType(testenv.AdminToken, models.DeviceType{Id: uuid.NewString(), Name: uuid.NewString()}, client.DeviceTypeUpdateOptions{})
+		_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, models.DeviceType{Id: uuid.NewString(), Name: uuid.NewString()}, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -326,7 +326,7 @@ func TestDeviceTypeQuery(t *testing.T) {
 		testDeviceTypeListSort(t, conf)
 	})
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name, Services: []models.Service{{Id: "service_42", Name: "foo"}}}, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, models.DeviceType{Id: devicetype1id, Name: devicetype1name, Services: []models.Service{{Id: "service_42", Name: "foo"}}}, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -395,7 +395,7 @@ func TestDeviceTypeWithServiceGroups(t *testing.T) {
 		}
 	})
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -422,7 +422,7 @@ func TestDeviceTypeWithAttribute(t *testing.T) {
 
 	dt := models.DeviceType{Id: devicetype1id, Name: devicetype1name, Attributes: []models.Attribute{{Key: "foo", Value: "bar"}}}
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -466,7 +466,7 @@ func TestServiceWithAttribute(t *testing.T) {
 		},
 	}}
 
-	_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+	_, err, _ = c.SetDeviceType(ctx, testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -2596,7 +2596,7 @@ func createTestMetadata(config configuration.Config, interaction models.Interact
 		c := client.NewClient("http://localhost:"+config.ServerPort, nil)
 
 		for _, aspect := range aspects {
-			_, err, _ := c.SetAspect(testenv.AdminToken, aspect)
+			_, err, _ := c.SetAspect(context.Background(), testenv.AdminToken, aspect)
 			if err != nil {
 				t.Error(err)
 				return
@@ -2604,7 +2604,7 @@ func createTestMetadata(config configuration.Config, interaction models.Interact
 		}
 
 		for _, function := range functions {
-			_, err, _ := c.SetFunction(testenv.AdminToken, function)
+			_, err, _ := c.SetFunction(context.Background(), testenv.AdminToken, function)
 			if err != nil {
 				t.Error(err)
 				return
@@ -2612,7 +2612,7 @@ func createTestMetadata(config configuration.Config, interaction models.Interact
 		}
 
 		for _, dt := range devicetypes {
-			_, err, _ := c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+			_, err, _ := c.SetDeviceType(context.Background(), testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 			if err != nil {
 				t.Error(err)
 				return
@@ -2648,7 +2648,7 @@ func createTestMetadataFromString(config configuration.Config, deviceTypesStr st
 		c := client.NewClient("http://localhost:"+config.ServerPort, nil)
 
 		for _, aspect := range aspects {
-			_, err, _ = c.SetAspect(testenv.AdminToken, aspect)
+			_, err, _ = c.SetAspect(context.Background(), testenv.AdminToken, aspect)
 			if err != nil {
 				t.Error(err)
 				return
@@ -2656,7 +2656,7 @@ func createTestMetadataFromString(config configuration.Config, deviceTypesStr st
 		}
 
 		for _, function := range functions {
-			_, err, _ = c.SetFunction(testenv.AdminToken, function)
+			_, err, _ = c.SetFunction(context.Background(), testenv.AdminToken, function)
 			if err != nil {
 				t.Error(err)
 				return
@@ -2664,7 +2664,7 @@ func createTestMetadataFromString(config configuration.Config, deviceTypesStr st
 		}
 
 		for _, dt := range devicetypes {
-			_, err, _ = c.SetDeviceType(testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
+			_, err, _ = c.SetDeviceType(context.Background(), testenv.AdminToken, dt, client.DeviceTypeUpdateOptions{})
 			if err != nil {
 				t.Error(err)
 				return
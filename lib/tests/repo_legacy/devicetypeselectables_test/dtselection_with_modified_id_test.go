@@ -34,6 +34,7 @@ import (
 	"net/url"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -218,6 +219,11 @@ func TestDeviceTypeSelectablesWithModifiedId(t *testing.T) {
 			},
 		}...)
 
+		//plugs uses getPlugStates, so the getPlugState function_filter variant selects the same services as plug-strip
+		functionFilterSelectable := expectedSelectables[0]
+		functionFilterSelectable.DeviceTypeId = "plug-strip" + idmodifier.Seperator + idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getPlugState"}})
+		expectedSeletablesWithModifiedIds = slices.Insert(expectedSeletablesWithModifiedIds, 1, functionFilterSelectable)
+
 		t.Run("nil", testDeviceTypeSelectablesWithoutConfigurables(conf, criteria, "prefix.", nil, expectedSelectables))
 		t.Run("empty", testDeviceTypeSelectablesWithoutConfigurables(conf, criteria, "prefix.", []models.Interaction{}, expectedSelectables))
 		t.Run("event", testDeviceTypeSelectablesWithoutConfigurables(conf, criteria, "prefix.", []models.Interaction{models.EVENT}, []model.DeviceTypeSelectable{}))
@@ -403,6 +409,11 @@ func TestDeviceTypeSelectablesWithModifiedId(t *testing.T) {
 			},
 		}...)
 
+		//plugs uses getPlugStates, so the getPlugState function_filter variant selects the same services as plug-strip
+		functionFilterSelectable := expectedSelectables[0]
+		functionFilterSelectable.DeviceTypeId = "plug-strip" + idmodifier.Seperator + idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getPlugState"}})
+		expectedSeletablesWithModifiedIds = slices.Insert(expectedSeletablesWithModifiedIds, 1, functionFilterSelectable)
+
 		t.Run("nil", testDeviceTypeSelectablesWithoutConfigurablesV2(conf, criteria, "prefix.", expectedSelectables))
 		t.Run("empty", testDeviceTypeSelectablesWithoutConfigurablesV2(conf, criteria, "prefix.", expectedSelectables))
 		t.Run("event", testDeviceTypeSelectablesWithoutConfigurablesV2(conf, testAddInteractionToCriterias(criteria, []models.Interaction{models.EVENT}), "prefix.", []model.DeviceTypeSelectable{}))
@@ -652,6 +663,10 @@ func TestDeviceTypeFilterWithModifiedId(t *testing.T) {
 		},
 	}
 
+	dtFn := dt
+	dtFn.Id = "plug-strip" + idmodifier.Seperator + idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getPlugState"}})
+	dtFn.Services = dt.Services[:2]
+
 	criteria := []model.FilterCriteria{{
 		FunctionId: model.MEASURING_FUNCTION_PREFIX + "getPlugState",
 		AspectId:   "plug",
@@ -662,24 +677,40 @@ func TestDeviceTypeFilterWithModifiedId(t *testing.T) {
 		return
 	}
 
+	//function_filter variants of the other device-types of createTestMetadata, ordered by name
+	functionFilterVariants, err := getDeviceTypesById(testenv.Userjwt, conf,
+		"pc_cooling_controller"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.CONTROLLING_FUNCTION_PREFIX + "setFanSpeed"}}),
+		"pc_cooling_controller"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getFanSpeed"}}),
+		"pc_cooling_controller"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getTemperature"}}),
+		"thermostat"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.CONTROLLING_FUNCTION_PREFIX + "setTemperature"}}),
+		"thermostat"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getTemperature"}}),
+		"plug-strip"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getPlugState"}}),
+		"plug-strip"+idmodifier.Seperator+idmodifier.EncodeModifierParameter(map[string][]string{"function_filter": {model.MEASURING_FUNCTION_PREFIX + "getPlugStates"}}),
+	)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	unfilteredModified := append(functionFilterVariants, dtSg1, dtSg2)
+
 	t.Run("without modify", testGetRequest(testenv.Userjwt, conf, "/device-types?filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt}))
-	t.Run("with modify", testGetRequest(testenv.Userjwt, conf, "/device-types?interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtSg1, dtSg2}))
-	t.Run("with modify v2", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtSg1, dtSg2}))
+	t.Run("with modify", testGetRequest(testenv.Userjwt, conf, "/device-types?interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtFn, dtSg1, dtSg2}))
+	t.Run("with modify v2", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtFn, dtSg1, dtSg2}))
 
-	t.Run("modified only", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&include_id_unmodified=false&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg1, dtSg2}))
-	t.Run("unfiltered modified only", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&include_id_unmodified=false", []models.DeviceType{dtSg1, dtSg2}))
+	t.Run("modified only", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&include_id_unmodified=false&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtFn, dtSg1, dtSg2}))
+	t.Run("unfiltered modified only", testGetRequest(testenv.Userjwt, conf, "/device-types?include_id_modified=true&include_id_unmodified=false", unfilteredModified))
 
-	t.Run("sort name asc", testGetRequest(testenv.Userjwt, conf, "/device-types?sort=name.asc&interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtSg1, dtSg2}))
-	t.Run("sort name desc", testGetRequest(testenv.Userjwt, conf, "/device-types?sort=name.desc&interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg2, dtSg1, dt}))
+	t.Run("sort name asc", testGetRequest(testenv.Userjwt, conf, "/device-types?sort=name.asc&interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtFn, dtSg1, dtSg2}))
+	t.Run("sort name desc", testGetRequest(testenv.Userjwt, conf, "/device-types?sort=name.desc&interactions-filter=request&include_id_modified=true&filter="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg2, dtSg1, dtFn, dt}))
 
 	t.Run("v3 without modify", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt}))
-	t.Run("v3 with modify v2", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtSg1, dtSg2}))
+	t.Run("v3 with modify v2", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtFn, dtSg1, dtSg2}))
 
-	t.Run("v3 modified only", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&ignore-unmodified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg1, dtSg2}))
-	t.Run("v3 unfiltered modified only", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&ignore-unmodified=true", []models.DeviceType{dtSg1, dtSg2}))
+	t.Run("v3 modified only", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&ignore-unmodified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtFn, dtSg1, dtSg2}))
+	t.Run("v3 unfiltered modified only", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?include-modified=true&ignore-unmodified=true", unfilteredModified))
 
-	t.Run("v3 sort name asc", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?sort=name.asc&include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtSg1, dtSg2}))
-	t.Run("v3 sort name desc", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?sort=name.desc&include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg2, dtSg1, dt}))
+	t.Run("v3 sort name asc", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?sort=name.asc&include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dt, dtFn, dtSg1, dtSg2}))
+	t.Run("v3 sort name desc", testGetRequest(testenv.Userjwt, conf, "/v3/device-types?sort=name.desc&include-modified=true&criteria="+url.QueryEscape(string(criteriaJson)), []models.DeviceType{dtSg2, dtSg1, dtFn, dt}))
 
 }
 
@@ -821,7 +852,7 @@ func testDeviceTypeSelectablesWithoutConfigurablesV2IncludeModified(config confi
 
 func clientTestDeviceTypeSelectables(config configuration.Config, criteria []model.FilterCriteria, pathPrefix string, includeModified bool, servicesMustMatchAllCriteria bool, expectedResult []model.DeviceTypeSelectable) func(t *testing.T) {
 	return func(t *testing.T) {
-		result, err, _ := client.NewClient("http://localhost:"+config.ServerPort, nil).GetDeviceTypeSelectablesV2(criteria, pathPrefix, includeModified, servicesMustMatchAllCriteria)
+		result, err, _ := client.NewClient("http://localhost:"+config.ServerPort, nil).GetDeviceTypeSelectablesV2(context.Background(), criteria, pathPrefix, includeModified, servicesMustMatchAllCriteria)
 		if err != nil {
 			t.Error(err)
 			return
@@ -872,6 +903,33 @@ func testGetRequest(token string, conf configuration.Config, path string, expect
 	}
 }
 
+func getDeviceTypesById(token string, conf configuration.Config, ids ...string) (result []models.DeviceType, err error) {
+	for _, id := range ids {
+		req, err := http.NewRequest("GET", "http://localhost:"+conf.ServerPort+"/device-types/"+url.PathEscape(id), nil)
+		if err != nil {
+			return result, err
+		}
+		req.Header.Set("Authorization", token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return result, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			b, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return result, errors.New("unexpected response: " + resp.Status + " " + string(b))
+		}
+		dt := models.DeviceType{}
+		err = json.NewDecoder(resp.Body).Decode(&dt)
+		resp.Body.Close()
+		if err != nil {
+			return result, err
+		}
+		result = append(result, dt)
+	}
+	return result, nil
+}
+
 func normalize(expected interface{}) (result interface{}) {
 	temp, _ := json.Marshal(expected)
 	json.Unmarshal(temp, &result)
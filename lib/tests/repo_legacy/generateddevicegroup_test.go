@@ -39,7 +39,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceType(AdminToken, models.DeviceType{
+	_, err, _ = c.SetDeviceType(ctx, AdminToken, models.DeviceType{
 		Id:   devicetype1id,
 		Name: devicetype1name,
 		Services: []models.Service{
@@ -80,7 +80,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		OwnerId:      userid,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d1, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d1, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -94,7 +94,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		OwnerId:      userid,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d2, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d2, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -112,7 +112,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		OwnerId:      userid,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d3, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d3, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -122,7 +122,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 
 	var dg1 models.DeviceGroup
 	t.Run("check dg1", func(t *testing.T) {
-		dg1, err, _ = c.ReadDeviceGroup(generatedDeviceGroup1Id, userjwt, false)
+		dg1, err, _ = c.ReadDeviceGroup(ctx, generatedDeviceGroup1Id, userjwt, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -155,7 +155,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 
 	var dg2 models.DeviceGroup
 	t.Run("check dg2", func(t *testing.T) {
-		dg2, err, _ = c.ReadDeviceGroup(generatedDeviceGroup2Id, userjwt, false)
+		dg2, err, _ = c.ReadDeviceGroup(ctx, generatedDeviceGroup2Id, userjwt, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -176,7 +176,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 
 	var dg3 models.DeviceGroup
 	t.Run("check dg3", func(t *testing.T) {
-		dg3, err, _ = c.ReadDeviceGroup(generatedDeviceGroup3Id, userjwt, false)
+		dg3, err, _ = c.ReadDeviceGroup(ctx, generatedDeviceGroup3Id, userjwt, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -195,7 +195,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("validate dg1 delete", func(t *testing.T) {
-		err, code := c.ValidateDeviceGroupDelete(userjwt, generatedDeviceGroup1Id)
+		err, code := c.ValidateDeviceGroupDelete(ctx, userjwt, generatedDeviceGroup1Id)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -216,7 +216,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 			Attributes:            dg1.Attributes,
 			AutoGeneratedByDevice: "",
 		}
-		err, code := c.ValidateDeviceGroup(userjwt, dg)
+		err, code := c.ValidateDeviceGroup(ctx, userjwt, dg)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -237,7 +237,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 			Attributes:            dg1.Attributes,
 			AutoGeneratedByDevice: device2id,
 		}
-		err, code := c.ValidateDeviceGroup(userjwt, dg)
+		err, code := c.ValidateDeviceGroup(ctx, userjwt, dg)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -257,7 +257,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 			CriteriaShort: dg1.CriteriaShort,
 			Attributes:    dg1.Attributes,
 		}
-		err, code := c.ValidateDeviceGroup(userjwt, dg)
+		err, code := c.ValidateDeviceGroup(ctx, userjwt, dg)
 		if err != nil {
 			t.Error(err)
 			return
@@ -268,14 +268,14 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("delete d1", func(t *testing.T) {
-		err, _ = c.DeleteDevice(userjwt, d1.Id)
+		err, _ = c.DeleteDevice(ctx, userjwt, d1.Id)
 		if err != nil {
 			t.Error(err)
 			return
 		}
 	})
 	t.Run("check dg1", func(t *testing.T) {
-		_, err, code := c.ReadDeviceGroup(generatedDeviceGroup1Id, userjwt, false)
+		_, err, code := c.ReadDeviceGroup(ctx, generatedDeviceGroup1Id, userjwt, false)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -296,7 +296,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 			Attributes:            dg2.Attributes,
 			AutoGeneratedByDevice: dg2.AutoGeneratedByDevice,
 		}
-		err, code := c.ValidateDeviceGroup(userjwt, dg)
+		err, code := c.ValidateDeviceGroup(ctx, userjwt, dg)
 		if err != nil {
 			t.Error(err)
 			return
@@ -307,7 +307,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("add d3 to dg2", func(t *testing.T) {
-		_, err, _ = c.SetDeviceGroup(userjwt, models.DeviceGroup{
+		_, err, _ = c.SetDeviceGroup(ctx, userjwt, models.DeviceGroup{
 			Id:                    dg2.Id,
 			Name:                  dg2.Name,
 			Image:                 dg2.Image,
@@ -323,7 +323,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("validate dg2 delete", func(t *testing.T) {
-		err, code := c.ValidateDeviceGroupDelete(userjwt, generatedDeviceGroup2Id)
+		err, code := c.ValidateDeviceGroupDelete(ctx, userjwt, generatedDeviceGroup2Id)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -334,14 +334,14 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("delete d2", func(t *testing.T) {
-		err, _ = c.DeleteDevice(userjwt, d2.Id)
+		err, _ = c.DeleteDevice(ctx, userjwt, d2.Id)
 		if err != nil {
 			t.Error(err)
 			return
 		}
 	})
 	t.Run("check dg2", func(t *testing.T) {
-		dg, err, _ := c.ReadDeviceGroup(generatedDeviceGroup2Id, userjwt, false)
+		dg, err, _ := c.ReadDeviceGroup(ctx, generatedDeviceGroup2Id, userjwt, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -360,7 +360,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("validate dg2 delete", func(t *testing.T) {
-		err, code := c.ValidateDeviceGroupDelete(userjwt, generatedDeviceGroup2Id)
+		err, code := c.ValidateDeviceGroupDelete(ctx, userjwt, generatedDeviceGroup2Id)
 		if err != nil {
 			t.Error(err)
 			return
@@ -371,14 +371,14 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("delete dg2", func(t *testing.T) {
-		err, _ = c.DeleteDeviceGroup(userjwt, dg2.Id)
+		err, _ = c.DeleteDeviceGroup(ctx, userjwt, dg2.Id)
 		if err != nil {
 			t.Error(err)
 			return
 		}
 	})
 	t.Run("check dg2", func(t *testing.T) {
-		_, err, code := c.ReadDeviceGroup(generatedDeviceGroup2Id, userjwt, false)
+		_, err, code := c.ReadDeviceGroup(ctx, generatedDeviceGroup2Id, userjwt, false)
 		if err == nil {
 			t.Error("expected error")
 			return
@@ -389,7 +389,7 @@ func TestGeneratedDeviceGroups(t *testing.T) {
 		}
 	})
 	t.Run("check dg3", func(t *testing.T) {
-		dg, err, _ := c.ReadDeviceGroup(generatedDeviceGroup3Id, userjwt, false)
+		dg, err, _ := c.ReadDeviceGroup(ctx, generatedDeviceGroup3Id, userjwt, false)
 		if err != nil {
 			t.Error(err)
 			return
@@ -41,7 +41,7 @@ func TestModifiedDevice(t *testing.T) {
 
 	c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
 
-	_, err, _ = c.SetDeviceType(AdminToken, models.DeviceType{
+	_, err, _ = c.SetDeviceType(ctx, AdminToken, models.DeviceType{
 		Id: devicetype1id, Name: devicetype1name, ServiceGroups: []models.ServiceGroup{
 			{
 				Key:         sgKey,
@@ -87,7 +87,7 @@ func TestModifiedDevice(t *testing.T) {
 		OwnerId:      userid,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d1, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d1, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -107,7 +107,7 @@ func TestModifiedDevice(t *testing.T) {
 		OwnerId:      userid,
 	}
 
-	_, err, _ = c.SetDevice(userjwt, d2, client.DeviceUpdateOptions{})
+	_, err, _ = c.SetDevice(ctx, userjwt, d2, client.DeviceUpdateOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -227,7 +227,7 @@ func TestModifiedDevice(t *testing.T) {
 	})
 
 	t.Run("extended modified device 1", func(t *testing.T) {
-		actual, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ReadExtendedDevice(d1Modified.Id, userjwt, client.READ, true)
+		actual, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ReadExtendedDevice(ctx, d1Modified.Id, userjwt, client.READ, true)
 		if err != nil {
 			t.Error(err)
 			return
@@ -267,7 +267,7 @@ func TestModifiedDevice(t *testing.T) {
 	})
 
 	t.Run("extended modified device 2", func(t *testing.T) {
-		actual, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ReadExtendedDevice(d2Modified.Id, userjwt, client.READ, true)
+		actual, err, _ := client.NewClient("http://localhost:"+conf.ServerPort, nil).ReadExtendedDevice(ctx, d2Modified.Id, userjwt, client.READ, true)
 		if err != nil {
 			t.Error(err)
 			return
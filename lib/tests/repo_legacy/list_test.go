@@ -58,7 +58,7 @@ func TestResourceList(t *testing.T) {
 
 	t.Run("EOF /aspect-nodes/", func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, _, code := c.GetAspectNode("")
+		_, _, code := c.GetAspectNode(ctx, "")
 		if code != http.StatusNotFound {
 			t.Error(err, code)
 			return
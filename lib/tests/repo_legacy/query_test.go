@@ -111,7 +111,7 @@ func TestQueryDeviceTypeReferences(t *testing.T) {
 	}
 
 	t.Run("query default", func(t *testing.T) {
-		result, err, _ := c.GetUsedInDeviceType(query)
+		result, err, _ := c.GetUsedInDeviceType(ctx, query)
 		if err != nil {
 			t.Error(err)
 			return
@@ -251,7 +251,7 @@ func TestQueryDeviceTypeReferences(t *testing.T) {
 
 	t.Run("query count device-type", func(t *testing.T) {
 		query.CountBy = "device-type"
-		result, err, _ := c.GetUsedInDeviceType(query)
+		result, err, _ := c.GetUsedInDeviceType(ctx, query)
 		if err != nil {
 			t.Error(err)
 			return
@@ -388,7 +388,7 @@ func TestQueryDeviceTypeReferences(t *testing.T) {
 
 	t.Run("query count service", func(t *testing.T) {
 		query.CountBy = "service"
-		result, err, _ := c.GetUsedInDeviceType(query)
+		result, err, _ := c.GetUsedInDeviceType(ctx, query)
 		if err != nil {
 			t.Error(err)
 			return
@@ -526,7 +526,7 @@ func TestQueryDeviceTypeReferences(t *testing.T) {
 	t.Run("query with service", func(t *testing.T) {
 		query.CountBy = "service"
 		query.With = "service"
-		result, err, _ := c.GetUsedInDeviceType(query)
+		result, err, _ := c.GetUsedInDeviceType(ctx, query)
 		if err != nil {
 			t.Error(err)
 			return
@@ -17,6 +17,8 @@
 package testenv
 
 import (
+	"context"
+
 	"github.com/SENERGY-Platform/device-repository/lib/model"
 	"github.com/SENERGY-Platform/models/go/models"
 	"github.com/SENERGY-Platform/service-commons/pkg/donewait"
@@ -24,7 +26,11 @@ import (
 
 type VoidProducerMock struct{}
 
-func (v VoidProducerMock) PublishDevice(device models.Device) (err error) {
+func (v VoidProducerMock) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (v VoidProducerMock) PublishDevice(device models.Device, configuration []model.DeviceConfigurationValue) (err error) {
 	return nil
 }
 
@@ -85,7 +85,7 @@ func TestVariableValidation(t *testing.T) {
 		return
 	}
 
-	_, err, _ = ctrl.SetConcept(AdminToken, models.Concept{
+	_, err, _ = ctrl.SetConcept(ctx, AdminToken, models.Concept{
 		Id:                   "concept",
 		Name:                 "concept",
 		CharacteristicIds:    []string{"c1", "c2"},
@@ -96,7 +96,7 @@ func TestVariableValidation(t *testing.T) {
 		return
 	}
 
-	_, err, _ = ctrl.SetFunction(AdminToken, models.Function{
+	_, err, _ = ctrl.SetFunction(ctx, AdminToken, models.Function{
 		Id:        "f1",
 		Name:      "f1",
 		ConceptId: "",
@@ -106,7 +106,7 @@ func TestVariableValidation(t *testing.T) {
 		return
 	}
 
-	_, err, _ = ctrl.SetFunction(AdminToken, models.Function{
+	_, err, _ = ctrl.SetFunction(ctx, AdminToken, models.Function{
 		Id:        "f2",
 		Name:      "f2",
 		ConceptId: "concept",
@@ -575,7 +575,7 @@ func TestVariableValidation(t *testing.T) {
 
 func testValidateVariable(ctrl *controller.Controller, expectError bool, variable models.ContentVariable) func(t *testing.T) {
 	return func(t *testing.T) {
-		err, _ := ctrl.ValidateVariable(variable, "json", model.ValidationOptions{})
+		err, _ := ctrl.ValidateVariable(context.Background(), variable, "json", model.ValidationOptions{})
 		if (err != nil) != expectError {
 			t.Error(expectError, err)
 		}
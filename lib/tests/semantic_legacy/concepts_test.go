@@ -59,7 +59,7 @@ func testProduceValidConcept1withCharIdAndBaseCharId(conf configuration.Config)
 		concept.BaseCharacteristicId = "urn:ses:infai:characteristic:544433333"
 		concept.CharacteristicIds = []string{"urn:ses:infai:characteristic:544433333"}
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetConcept(testenv.AdminToken, concept)
+		_, err, _ := c.SetConcept(context.Background(), testenv.AdminToken, concept)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -73,7 +73,7 @@ func testProduceValidConcept1withNoCharId(conf configuration.Config) func(t *tes
 		concept.Name = "color1"
 		concept.CharacteristicIds = nil
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetConcept(testenv.AdminToken, concept)
+		_, err, _ := c.SetConcept(context.Background(), testenv.AdminToken, concept)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -88,7 +88,7 @@ func testProduceValidConcept1withCharId(conf configuration.Config) func(t *testi
 		concept.CharacteristicIds = []string{"urn:ses:infai:characteristic:544433333"}
 		concept.BaseCharacteristicId = "urn:ses:infai:characteristic:544433333"
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetConcept(testenv.AdminToken, concept)
+		_, err, _ := c.SetConcept(context.Background(), testenv.AdminToken, concept)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -111,7 +111,7 @@ func testProduceValidCharacteristicDependencie(conf configuration.Config) func(t
 			SubCharacteristics: nil,
 		}}
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetCharacteristic(testenv.AdminToken, characteristic)
+		_, err, _ := c.SetCharacteristic(context.Background(), testenv.AdminToken, characteristic)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -120,7 +120,7 @@ func testProduceValidCharacteristicDependencie(conf configuration.Config) func(t
 
 func testReadConcept1WithoutSubClass(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
-		concept, err, _ := con.GetConceptWithoutCharacteristics("urn:ses:infai:concept:1a1a1a")
+		concept, err, _ := con.GetConceptWithoutCharacteristics(context.Background(), "urn:ses:infai:concept:1a1a1a")
 
 		if err == nil {
 			if concept.Id != "urn:ses:infai:concept:1a1a1a" {
@@ -141,7 +141,7 @@ func testReadConcept1WithoutSubClass(con *controller.Controller) func(t *testing
 
 func testReadConcept1WithSubClass(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
-		concept, err, _ := con.GetConceptWithCharacteristics("urn:ses:infai:concept:1a1a1a")
+		concept, err, _ := con.GetConceptWithCharacteristics(context.Background(), "urn:ses:infai:concept:1a1a1a")
 		if err == nil {
 			if concept.Id != "urn:ses:infai:concept:1a1a1a" {
 				t.Fatal("wrong id")
@@ -171,7 +171,7 @@ func testReadConcept1WithSubClass(con *controller.Controller) func(t *testing.T)
 func testDeleteConcept1(conf configuration.Config) func(t *testing.T) {
 	return func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		err, _ := c.DeleteConcept(testenv.AdminToken, "urn:ses:infai:concept:1a1a1a")
+		err, _ := c.DeleteConcept(context.Background(), testenv.AdminToken, "urn:ses:infai:concept:1a1a1a")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -80,7 +80,7 @@ func testProduceValidDeviceTypes(conf configuration.Config) func(t *testing.T) {
 		})
 
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetDeviceType(testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
+		_, err, _ := c.SetDeviceType(context.Background(), testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -136,7 +136,7 @@ func testProduceValidDeviceTypes(conf configuration.Config) func(t *testing.T) {
 			},
 		})
 
-		_, err, _ = c.SetDeviceType(testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
+		_, err, _ = c.SetDeviceType(context.Background(), testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -171,7 +171,7 @@ func testProduceValidDeviceTypes(conf configuration.Config) func(t *testing.T) {
 			},
 		})
 
-		_, err, _ = c.SetDeviceType(testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
+		_, err, _ = c.SetDeviceType(context.Background(), testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -226,7 +226,7 @@ func testProduceValidDeviceTypes(conf configuration.Config) func(t *testing.T) {
 			},
 		})
 
-		_, err, _ = c.SetDeviceType(testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
+		_, err, _ = c.SetDeviceType(context.Background(), testenv.AdminToken, devicetype, client.DeviceTypeUpdateOptions{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -235,7 +235,7 @@ func testProduceValidDeviceTypes(conf configuration.Config) func(t *testing.T) {
 
 func testReadDeviceType(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
-		deviceType, err, code := con.ReadDeviceType("urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef", "")
+		deviceType, err, code := con.ReadDeviceType(context.Background(), "urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef", "")
 
 		deviceTypeStringified := `{"id":"urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef","name":"Philips-Extended-Color-Light","description":"Philips Hue Extended Color Light","service_groups":null,"services":[{"id":"urn:infai:ses:service:1b0ef253-16f7-4b65-8a15-fe79fccf7e70","local_id":"setColor","name":"setColorService","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:controlling-function:c54e2a89-1fb8-4ecb-8993-a7b40b355599","aspect_id":"urn:infai:ses:aspect:a7470d73-dde3-41fc-92bd-f16bb28f2da6"},"serialization":"json","protocol_segment_id":""}],"outputs":null,"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:14e56881-16f9-4120-bb41-270a43070c86","attributes":null}`
 		if err != nil {
@@ -256,7 +256,7 @@ func testReadDeviceType(con *controller.Controller) func(t *testing.T) {
 
 func testReadDeviceTypeCF(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
-		deviceType, err, code := con.ListDeviceTypes("", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:controlling-function:c54e2a89-1fb8-4ecb-8993-a7b40b355599", DeviceClassId: "urn:infai:ses:device-class:14e56881-16f9-4120-bb41-270a43070c86", AspectId: ""}}, nil, false, true)
+		deviceType, err, code := con.ListDeviceTypes(context.Background(), "", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:controlling-function:c54e2a89-1fb8-4ecb-8993-a7b40b355599", DeviceClassId: "urn:infai:ses:device-class:14e56881-16f9-4120-bb41-270a43070c86", AspectId: ""}}, nil, false, true)
 
 		deviceTypeStringified := `[{"id":"urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef","name":"Philips-Extended-Color-Light","description":"Philips Hue Extended Color Light","service_groups":null,"services":[{"id":"urn:infai:ses:service:1b0ef253-16f7-4b65-8a15-fe79fccf7e70","local_id":"setColor","name":"setColorService","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:controlling-function:c54e2a89-1fb8-4ecb-8993-a7b40b355599","aspect_id":"urn:infai:ses:aspect:a7470d73-dde3-41fc-92bd-f16bb28f2da6"},"serialization":"json","protocol_segment_id":""}],"outputs":null,"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:14e56881-16f9-4120-bb41-270a43070c86","attributes":null}]`
 
@@ -279,7 +279,7 @@ func testReadDeviceTypeCF(con *controller.Controller) func(t *testing.T) {
 func testReadDeviceType_1MF(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
 		// 1 MeasuringFunctionId + Aspect
-		deviceType, err, code := con.ListDeviceTypes("", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
+		deviceType, err, code := con.ListDeviceTypes(context.Background(), "", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
 
 		deviceTypeStringified := `[{"id":"urn:infai:ses:device-type:a8cbd322-9d8c-4f4c-afec-ae4b7986b6ed","name":"Blebox-Air-Sensor","description":"","service_groups":null,"services":[{"id":"urn:infai:ses:service:422fd899-a2cc-4e43-8d81-4e330a7ca8ab","local_id":"reading_pm10","name":"getParticleAmountPM10Service","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""},{"id":"urn:infai:ses:service:1d20a68b-7136-456c-ace5-c3adb66866bf","local_id":"reading_pm1","name":"getParticleAmountPM1Service","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:0e19d094-70c6-402c-8523-3aaff2ce6dd9","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:8bd38ea2-1835-4a1e-ac02-6b3169513fd3","attributes":null}]`
 		if err != nil {
@@ -302,7 +302,7 @@ func testReadDeviceType_1MF_variante2(con *controller.Controller) func(t *testin
 	return func(t *testing.T) {
 		// 1 MeasuringFunctionId + Aspect
 		//deviceType, err, code := con.GetDeviceTypesFiltered("", []string{"urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0", "urn:infai:ses:measuring-function:0e19d094-70c6-402c-8523-3aaff2ce6dd9"}, []string{})
-		deviceType, err, code := con.ListDeviceTypes("", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
+		deviceType, err, code := con.ListDeviceTypes(context.Background(), "", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
 
 		deviceTypeStringified := `[{"id":"urn:infai:ses:device-type:3cc09a10-1feb-4f8b-9390-8d08bf3ba22d","name":"Cyrus 4-in-1 Multisensor","description":"","service_groups":null,"services":[{"id":"urn:infai:ses:service:d3dba284-ef6d-4f12-81df-ed11506702b2","local_id":"get_level:49-1","name":"getTemperatureService","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:ff64280a-58e6-4cf9-9a44-e70d3831a79d","attributes":null},{"id":"urn:infai:ses:device-type:662d9c9f-949d-4577-9485-9cb7255f547f","name":"Danfoss Radiator Thermostat","description":"","service_groups":null,"services":[{"id":"urn:infai:ses:service:de9252b9-5492-4fe5-8c9c-b4b8460f65f6","local_id":"exact:67-1","name":"setTemperatureService","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:controlling-function:99240d90-02dd-4d4f-a47c-069cfe77629c","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"outputs":null,"attributes":null,"service_group_key":""},{"id":"urn:infai:ses:service:f306de41-a55b-45ed-afc9-039bbe53db1b","local_id":"exact:67-1","name":"setTemperatureService","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:997937d6-c5f3-4486-b67c-114675038393","attributes":null}]`
 		if err != nil {
@@ -324,7 +324,7 @@ func testReadDeviceType_1MF_variante2(con *controller.Controller) func(t *testin
 func testReadDeviceType_2MF_sameAspect(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
 		// 2 MeasuringFunctionId + same Aspect
-		deviceType, err, code := con.ListDeviceTypes("", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"},
+		deviceType, err, code := con.ListDeviceTypes(context.Background(), "", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"},
 			{FunctionId: "urn:infai:ses:measuring-function:0e19d094-70c6-402c-8523-3aaff2ce6dd9", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
 
 		deviceTypeStringified := `[{"id":"urn:infai:ses:device-type:a8cbd322-9d8c-4f4c-afec-ae4b7986b6ed","name":"Blebox-Air-Sensor","description":"","service_groups":null,"services":[{"id":"urn:infai:ses:service:422fd899-a2cc-4e43-8d81-4e330a7ca8ab","local_id":"reading_pm10","name":"getParticleAmountPM10Service","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:f2c1a22f-a49e-4549-9833-62f0994afec0","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""},{"id":"urn:infai:ses:service:1d20a68b-7136-456c-ace5-c3adb66866bf","local_id":"reading_pm1","name":"getParticleAmountPM1Service","description":"","interaction":"","protocol_id":"urn:infai:ses:protocol:f3a63aeb-187e-4dd9-9ef5-d97a6eb6292b","inputs":null,"outputs":[{"id":"","content_variable":{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":[{"id":"","name":"","is_void":false,"omit_empty":false,"type":"","sub_content_variables":null,"characteristic_id":"","value":null,"serialization_options":null,"function_id":"urn:infai:ses:measuring-function:0e19d094-70c6-402c-8523-3aaff2ce6dd9","aspect_id":"urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}],"characteristic_id":"","value":null,"serialization_options":null},"serialization":"json","protocol_segment_id":""}],"attributes":null,"service_group_key":""}],"device_class_id":"urn:infai:ses:device-class:8bd38ea2-1835-4a1e-ac02-6b3169513fd3","attributes":null}]`
@@ -348,7 +348,7 @@ func testReadDeviceType_2MF_sameAspect(con *controller.Controller) func(t *testi
 func testReadDeviceType_2MF_sameAspect_DifferentDeviceClasses(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
 		// 2 MeasuringFunctionId + same Aspect + 2 different DeviceClasses
-		deviceType, err, code := con.ListDeviceTypes("", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"},
+		deviceType, err, code := con.ListDeviceTypes(context.Background(), "", 100, 0, "", []model.FilterCriteria{{FunctionId: "urn:infai:ses:measuring-function:f2769eb9-b6ad-4f7e-bd28-e4ea043d2f8b", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"},
 			{FunctionId: "urn:infai:ses:measuring-function:0e19d094-70c6-402c-8523-3aaff2ce6dd9", DeviceClassId: "", AspectId: "urn:infai:ses:aspect:a14c5efb-b0b6-46c3-982e-9fded75b5ab6"}}, nil, false, true)
 
 		if err != nil {
@@ -369,7 +369,7 @@ func testReadDeviceType_2MF_sameAspect_DifferentDeviceClasses(con *controller.Co
 
 func testReadDeviceTypeWithId1(con *controller.Controller) func(t *testing.T) {
 	return func(t *testing.T) {
-		deviceType, err, code := con.ReadDeviceType("urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef", "")
+		deviceType, err, code := con.ReadDeviceType(context.Background(), "urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef", "")
 
 		if deviceType.Id != "urn:infai:ses:device-type:eb4a3337-01a1-4434-9dcc-064b3955eeef" {
 			t.Fatal("error id")
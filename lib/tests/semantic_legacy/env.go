@@ -69,6 +69,7 @@ func NewPartialMockEnv(baseCtx context.Context, wg *sync.WaitGroup, startConfig
 	if err != nil {
 		return config, ctrl, err
 	}
+	ctrl.SetStartupFinished()
 
 	err = api.Start(ctx, config, ctrl)
 	if err != nil {
@@ -43,7 +43,7 @@ func TestLocation(t *testing.T) {
 func testProduceLocation(conf configuration.Config, location models.Location) func(t *testing.T) {
 	return func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		_, err, _ := c.SetLocation(testenv.AdminToken, location)
+		_, err, _ := c.SetLocation(context.Background(), testenv.AdminToken, location)
 		if err != nil {
 			t.Error(err)
 			return
@@ -53,7 +53,7 @@ func testProduceLocation(conf configuration.Config, location models.Location) fu
 
 func testLocationRead(con *controller.Controller, id string, expectedLocation *models.Location) func(t *testing.T) {
 	return func(t *testing.T) {
-		result, err, code := con.GetLocation(id, testenv.AdminToken)
+		result, err, code := con.GetLocation(context.Background(), id, testenv.AdminToken)
 		if err != nil {
 			if expectedLocation != nil {
 				t.Error(code, err)
@@ -85,7 +85,7 @@ func testLocationRead(con *controller.Controller, id string, expectedLocation *m
 func testLocationDelete(conf configuration.Config, id string) func(t *testing.T) {
 	return func(t *testing.T) {
 		c := client.NewClient("http://localhost:"+conf.ServerPort, nil)
-		err, _ := c.DeleteLocation(testenv.AdminToken, id)
+		err, _ := c.DeleteLocation(context.Background(), testenv.AdminToken, id)
 		if err != nil {
 			t.Error(err)
 			return
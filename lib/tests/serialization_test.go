@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestSerializations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const percent = "urn:infai:ses:characteristic:serialization-percent"
+	_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, models.Characteristic{Id: percent, Name: "percent", Type: models.Integer, MinValue: 0, MaxValue: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:serialization",
+		Name:             "modbus",
+		Handler:          "modbus",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:serialization-registers", Name: "registers"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deviceType := func(s models.Serialization, variable models.ContentVariable) models.DeviceType {
+		return models.DeviceType{
+			Name: "serialization meter",
+			Services: []models.Service{{
+				LocalId:     "getLevel",
+				Name:        "Get Level",
+				Interaction: models.REQUEST,
+				ProtocolId:  "urn:infai:ses:protocol:serialization",
+				Outputs: []models.Content{{
+					Serialization:     s,
+					ProtocolSegmentId: "urn:infai:ses:segment:serialization-registers",
+					ContentVariable:   variable,
+				}},
+			}},
+		}
+	}
+	frame := models.ContentVariable{Name: "registers", Type: models.Structure, SubContentVariables: []models.ContentVariable{
+		{Name: "level", Type: models.Integer, CharacteristicId: percent, SerializationOptions: []string{"offset=0", "length=2"}},
+		{Name: "on", Type: models.Boolean, SerializationOptions: []string{"offset=2"}},
+	}}
+
+	t.Run("list", func(t *testing.T) {
+		list, err, _ := c.ListSerializations(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list) != len(serialization.Registered()) {
+			t.Errorf("%#v", list)
+		}
+	})
+
+	t.Run("unknown serialization", func(t *testing.T) {
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType("yaml", frame), model.DeviceTypeUpdateOptions{})
+		if !errors.Is(err, model.ErrDeviceTypeUnknownSerialization) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("plain-text structure", func(t *testing.T) {
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(models.PlainText, frame), model.DeviceTypeUpdateOptions{})
+		if !errors.Is(err, model.ErrDeviceTypeSerializationMismatch) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("binary without offset", func(t *testing.T) {
+		_, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(serialization.Binary, models.ContentVariable{Name: "level", Type: models.Integer}), model.DeviceTypeUpdateOptions{})
+		if !errors.Is(err, model.ErrDeviceTypeInvalidSerializationOption) {
+			t.Error(err)
+		}
+	})
+
+	dt, err, _ := c.SetDeviceType(ctx, client.InternalAdminToken, deviceType(serialization.Binary, frame), model.DeviceTypeUpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceId := dt.Services[0].Id
+
+	t.Run("binary payload", func(t *testing.T) {
+		valid, _ := hex.DecodeString("002a01")
+		result, err, _ := c.ValidateServicePayload(ctx, serviceId, model.PayloadValidationOptions{}, valid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Valid {
+			t.Errorf("%#v", result)
+		}
+		outOfRange, _ := hex.DecodeString("00c801")
+		result, err, _ = c.ValidateServicePayload(ctx, serviceId, model.PayloadValidationOptions{}, outOfRange)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Errors) != 1 || result.Errors[0].Code != model.ErrPayloadOutOfRange || result.Errors[0].Field != "registers.level" {
+			t.Errorf("%#v", result)
+		}
+		result, err, _ = c.ValidateServicePayload(ctx, serviceId, model.PayloadValidationOptions{}, valid[:2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Errors) != 1 || result.Errors[0].Code != model.ErrPayloadInvalidSerialization {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("binary example", func(t *testing.T) {
+		example, err, _ := c.GetServiceExamples(ctx, serviceId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(example.Outputs) != 1 || example.Outputs[0].PayloadEncoding != "base64" {
+			t.Fatalf("%#v", example)
+		}
+		message, err := base64.StdEncoding.DecodeString(example.Outputs[0].Payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(message) != "003200" {
+			t.Errorf("%x", message)
+		}
+	})
+}
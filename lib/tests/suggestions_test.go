@@ -0,0 +1,151 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func TestModellingSuggestions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	const temperature = model.MEASURING_FUNCTION_PREFIX + "suggest-temperature"
+	const aspect = "urn:infai:ses:aspect:suggest-air"
+	const celsius = "urn:infai:ses:characteristic:suggest-celsius"
+	const fahrenheit = "urn:infai:ses:characteristic:suggest-fahrenheit"
+	for _, characteristic := range []models.Characteristic{
+		{Id: celsius, Name: "Celsius", DisplayUnit: "°C", Type: models.Float},
+		{Id: fahrenheit, Name: "Fahrenheit", DisplayUnit: "°F", Type: models.Float},
+	} {
+		_, err, _ = c.SetCharacteristic(ctx, client.InternalAdminToken, characteristic)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetConcept(ctx, client.InternalAdminToken, models.Concept{Id: "urn:infai:ses:concept:suggest-temperature", Name: "Temperature", CharacteristicIds: []string{celsius, fahrenheit}, BaseCharacteristicId: celsius})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, models.Function{Id: temperature, Name: "Get Temperature", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION, ConceptId: "urn:infai:ses:concept:suggest-temperature"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: aspect, Name: "Air"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:suggest",
+		Name:             "suggest",
+		Handler:          "suggest",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:suggest-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor := func(name string, variable models.ContentVariable) models.DeviceType {
+		return models.DeviceType{
+			Name: name,
+			Services: []models.Service{{
+				LocalId:     "getTemperature",
+				Name:        "Get Temperature",
+				Interaction: models.EVENT,
+				ProtocolId:  "urn:infai:ses:protocol:suggest",
+				Outputs: []models.Content{{
+					Serialization:     models.JSON,
+					ProtocolSegmentId: "urn:infai:ses:segment:suggest-payload",
+					ContentVariable:   models.ContentVariable{Name: "payload", Type: models.Structure, SubContentVariables: []models.ContentVariable{variable}},
+				}},
+			}},
+		}
+	}
+	for _, dt := range []models.DeviceType{
+		sensor("suggest a", models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: temperature, AspectId: aspect, CharacteristicId: celsius}),
+		sensor("suggest b", models.ContentVariable{Name: "currentTemperature", Type: models.Float, FunctionId: temperature, AspectId: aspect, CharacteristicId: celsius}),
+		sensor("suggest c", models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: temperature, AspectId: aspect, CharacteristicId: fahrenheit}),
+	} {
+		_, err, _ = c.SetDeviceType(ctx, client.InternalAdminToken, dt, model.DeviceTypeUpdateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	draft := sensor("suggest draft", models.ContentVariable{})
+	draft.Services[0].Outputs[0].ContentVariable.SubContentVariables = []models.ContentVariable{
+		{Name: "temp", Type: models.Float},
+		{Name: "room_temperature", Type: models.Float, UnitReference: "unit"},
+		{Name: "unit", Type: models.String, Value: "°C"},
+		{Name: "humidity", Type: models.Float},
+	}
+
+	t.Run("suggestions", func(t *testing.T) {
+		result, err, _ := c.SuggestDeviceTypeModelling(ctx, draft, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 1 {
+			t.Fatalf("%#v", result)
+		}
+		suggestion := result[0]
+		if suggestion.ContentVariablePath != "payload.room_temperature" || suggestion.ServiceLocalId != "getTemperature" || suggestion.IsInput || suggestion.Support != 3 {
+			t.Fatalf("%#v", suggestion)
+		}
+		if len(suggestion.Functions) != 1 || suggestion.Functions[0].Id != temperature || suggestion.Functions[0].Support != 3 {
+			t.Errorf("%#v", suggestion.Functions)
+		}
+		if len(suggestion.Aspects) != 1 || suggestion.Aspects[0].Id != aspect {
+			t.Errorf("%#v", suggestion.Aspects)
+		}
+		if len(suggestion.Characteristics) != 2 || suggestion.Characteristics[0].Id != celsius || suggestion.Characteristics[0].Support != 2 {
+			t.Errorf("%#v", suggestion.Characteristics)
+		}
+		if suggestion.Functions[0].Confidence <= suggestion.Characteristics[0].Confidence || suggestion.Characteristics[0].Confidence <= suggestion.Characteristics[1].Confidence {
+			t.Errorf("%#v %#v", suggestion.Functions, suggestion.Characteristics)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		result, err, _ := c.SuggestDeviceTypeModelling(ctx, draft, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 1 || len(result[0].Characteristics) != 1 {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("annotated variables are skipped", func(t *testing.T) {
+		result, err, _ := c.SuggestDeviceTypeModelling(ctx, sensor("suggest annotated", models.ContentVariable{Name: "temperature", Type: models.Float, FunctionId: temperature}), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 0 {
+			t.Errorf("%#v", result)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/wot"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+const wotImportTestThingModel = `{
+	"@context": ["https://www.w3.org/2022/wot/td/v1.1"],
+	"@type": "tm:ThingModel",
+	"title": "Air Thermostat",
+	"properties": {
+		"temperature": {"type": "number", "readOnly": true, "observable": true},
+		"targetTemperature": {"type": "number"}
+	},
+	"actions": {
+		"reset": {}
+	},
+	"events": {
+		"overheating": {"data": {"type": "object", "properties": {"message": {"type": "string"}, "time": {"type": "integer"}}, "required": ["message"]}}
+	}
+}`
+
+func TestWotImport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _, err := client.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	thing := wot.Thing{}
+	err = json.Unmarshal([]byte(wotImportTestThingModel), &thing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err, _ = c.SetProtocol(ctx, client.InternalAdminToken, models.Protocol{
+		Id:               "urn:infai:ses:protocol:wot-test",
+		Name:             "wot-test",
+		Handler:          "wot-test",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:wot-test-payload", Name: "payload"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []models.Function{
+		{Id: model.MEASURING_FUNCTION_PREFIX + "wot-test-temperature", Name: "Get Temperature", RdfType: model.SES_ONTOLOGY_MEASURING_FUNCTION},
+		{Id: model.CONTROLLING_FUNCTION_PREFIX + "wot-test-target-temperature", Name: "Set Target Temperature", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION},
+		{Id: model.CONTROLLING_FUNCTION_PREFIX + "wot-test-temperature", Name: "Set Temperature", RdfType: model.SES_ONTOLOGY_CONTROLLING_FUNCTION},
+	} {
+		_, err, _ = c.SetFunction(ctx, client.InternalAdminToken, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err, _ = c.SetAspect(ctx, client.InternalAdminToken, models.Aspect{Id: "urn:infai:ses:aspect:wot-test-air", Name: "Air"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("unknown protocol", func(t *testing.T) {
+		_, err, _ := c.ImportWotThingModel(ctx, client.InternalAdminToken, thing, model.WotImportOptions{ProtocolId: "urn:infai:ses:protocol:unknown"})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("draft", func(t *testing.T) {
+		result, err, _ := c.ImportWotThingModel(ctx, client.InternalAdminToken, thing, model.WotImportOptions{ProtocolId: "urn:infai:ses:protocol:wot-test"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Saved || result.ValidationProblem != nil || result.DeviceType.Id == "" || result.DeviceType.Name != "Air Thermostat" {
+			t.Fatalf("%#v", result)
+		}
+		localIds := []string{}
+		for _, service := range result.DeviceType.Services {
+			localIds = append(localIds, service.LocalId)
+		}
+		expectedLocalIds := []string{"targetTemperature", "set_targetTemperature", "temperature", "reset", "overheating"}
+		if !reflect.DeepEqual(localIds, expectedLocalIds) {
+			t.Error(localIds)
+		}
+		expectedSuggestions := []model.WotImportSuggestion{
+			{ServiceLocalId: "targetTemperature", ContentVariablePath: "targetTemperature", FunctionId: model.MEASURING_FUNCTION_PREFIX + "wot-test-temperature", AspectId: "urn:infai:ses:aspect:wot-test-air"},
+			{ServiceLocalId: "set_targetTemperature", ContentVariablePath: "targetTemperature", FunctionId: model.CONTROLLING_FUNCTION_PREFIX + "wot-test-target-temperature", AspectId: "urn:infai:ses:aspect:wot-test-air"},
+			{ServiceLocalId: "temperature", ContentVariablePath: "temperature", FunctionId: model.MEASURING_FUNCTION_PREFIX + "wot-test-temperature", AspectId: "urn:infai:ses:aspect:wot-test-air"},
+		}
+		if !reflect.DeepEqual(result.Suggestions, expectedSuggestions) {
+			t.Errorf("%#v", result.Suggestions)
+		}
+		if result.DeviceType.Services[1].Inputs[0].ContentVariable.FunctionId != expectedSuggestions[1].FunctionId {
+			t.Errorf("%#v", result.DeviceType.Services[1])
+		}
+		if _, err, _ := c.ReadDeviceType(ctx, result.DeviceType.Id, client.InternalAdminToken); err == nil {
+			t.Error("draft should not be saved")
+		}
+	})
+
+	t.Run("save", func(t *testing.T) {
+		result, err, _ := c.ImportWotThingModel(ctx, client.InternalAdminToken, thing, model.WotImportOptions{ProtocolId: "urn:infai:ses:protocol:wot-test", Save: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Saved {
+			t.Fatalf("%#v", result)
+		}
+		deviceType, err, _ := c.ReadDeviceType(ctx, result.DeviceType.Id, client.InternalAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deviceType.Services) != 5 {
+			t.Errorf("%#v", deviceType)
+		}
+	})
+}
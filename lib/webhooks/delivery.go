@@ -0,0 +1,75 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+)
+
+const signaturePrefix = "sha256="
+
+// Sign returns the value of the model.WebhookHeaderSignature header
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify may be used by webhook receivers to check the model.WebhookHeaderSignature header
+func Verify(secret string, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+func (this *Publisher) send(webhook model.Webhook, delivery model.WebhookDelivery, body []byte) (statusCode int, err error) {
+	ctx, cancel := this.getTimeoutContext(configuration.TimeoutWebhook)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(model.WebhookHeaderTimestamp, timestamp)
+	req.Header.Set(model.WebhookHeaderId, webhook.Id)
+	req.Header.Set(model.WebhookHeaderEvent, delivery.Event)
+	req.Header.Set(model.WebhookHeaderDelivery, delivery.Id)
+	if webhook.Secret != "" {
+		req.Header.Set(model.WebhookHeaderSignature, Sign(webhook.Secret, timestamp, body))
+	}
+	resp, err := this.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16)) //allow connection reuse
+	if resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected statuscode %v", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+)
+
+// OwnerIdentity resolves the current roles and groups of webhook owners, so that revoked rights are respected on delivery
+type OwnerIdentity interface {
+	GetRolesAndGroups(ctx context.Context, userId string) (roles []string, groups []string, err error)
+}
+
+// KeycloakOwnerIdentity reads roles and groups from the keycloak admin api, authenticated with client credentials
+type KeycloakOwnerIdentity struct {
+	endpoint     string
+	realm        string
+	clientId     string
+	clientSecret string
+	httpClient   *http.Client
+	mux          sync.Mutex
+	token        string
+	tokenExpiry  time.Time
+}
+
+func NewKeycloakOwnerIdentity(config configuration.Config) *KeycloakOwnerIdentity {
+	realm := config.WebhookAuthRealm
+	if realm == "" {
+		realm = "master"
+	}
+	return &KeycloakOwnerIdentity{
+		endpoint:     strings.TrimSuffix(config.WebhookAuthEndpoint, "/"),
+		realm:        realm,
+		clientId:     config.WebhookAuthClientId,
+		clientSecret: config.WebhookAuthClientSecret,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GetRolesAndGroups returns the effective realm roles and the group paths (as used in the groups claim of user tokens)
+func (this *KeycloakOwnerIdentity) GetRolesAndGroups(ctx context.Context, userId string) (roles []string, groups []string, err error) {
+	userPath := this.endpoint + "/admin/realms/" + url.PathEscape(this.realm) + "/users/" + url.PathEscape(userId)
+	roleMappings := []struct {
+		Name string `json:"name"`
+	}{}
+	err = this.get(ctx, userPath+"/role-mappings/realm/composite", &roleMappings)
+	if err != nil {
+		return nil, nil, err
+	}
+	groupMappings := []struct {
+		Path string `json:"path"`
+	}{}
+	err = this.get(ctx, userPath+"/groups?briefRepresentation=true", &groupMappings)
+	if err != nil {
+		return nil, nil, err
+	}
+	roles = []string{}
+	for _, role := range roleMappings {
+		roles = append(roles, role.Name)
+	}
+	groups = []string{}
+	for _, group := range groupMappings {
+		groups = append(groups, group.Path)
+	}
+	return roles, groups, nil
+}
+
+func (this *KeycloakOwnerIdentity) get(ctx context.Context, endpoint string, result interface{}) error {
+	token, err := this.getToken(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return this.do(req, result)
+}
+
+func (this *KeycloakOwnerIdentity) getToken(ctx context.Context) (string, error) {
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	if this.token != "" && time.Now().Before(this.tokenExpiry) {
+		return this.token, nil
+	}
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", this.clientId)
+	form.Set("client_secret", this.clientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, this.endpoint+"/realms/"+url.PathEscape(this.realm)+"/protocol/openid-connect/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	token := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	err = this.do(req, &token)
+	if err != nil {
+		return "", err
+	}
+	//renew shortly before the token expires
+	this.token = token.AccessToken
+	this.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - 10*time.Second)
+	return this.token, nil
+}
+
+func (this *KeycloakOwnerIdentity) do(req *http.Request, result interface{}) error {
+	resp, err := this.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected keycloak response %v: %v", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+)
+
+// permissionTopic returns the permissions-v2 topic of resource types with access control
+// resources of other types may be read by every user
+func (this *Publisher) permissionTopic(resourceType string) (topic string, restricted bool) {
+	switch resourceType {
+	case model.WebhookResourceDevices:
+		return this.config.DeviceTopic, true
+	case model.WebhookResourceDeviceGroups:
+		return this.config.DeviceGroupTopic, true
+	case model.WebhookResourceHubs:
+		return this.config.HubTopic, true
+	case model.WebhookResourceLocations:
+		return this.config.LocationTopic, true
+	default:
+		return "", false
+	}
+}
+
+// mayRead checks the read permission of the webhook owner with the roles and groups the owner has at delivery time
+// if no OwnerIdentity is configured, only the user permissions of the owner are checked
+func (this *Publisher) mayRead(webhook model.Webhook, n notification) (bool, error) {
+	topic, restricted := this.permissionTopic(n.resourceType)
+	if !restricted {
+		return true, nil
+	}
+	roles, groups := []string{}, []string{}
+	if this.owners != nil {
+		ctx, cancel := this.getTimeoutContext(configuration.TimeoutDefault)
+		defer cancel()
+		var err error
+		roles, groups, err = this.owners.GetRolesAndGroups(ctx, webhook.OwnerId)
+		if err != nil {
+			return false, err
+		}
+	}
+	if slices.Contains(roles, "admin") {
+		return true, nil
+	}
+	resource, err, code := this.perm.GetResource(client.InternalAdminToken, topic, n.resourceId)
+	if code == http.StatusNotFound {
+		//permissions of deleted resources may already be removed, only the owner is notified
+		return n.event == model.WebhookEventDelete && n.ownerId != "" && n.ownerId == webhook.OwnerId, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if resource.UserPermissions[webhook.OwnerId].Read {
+		return true, nil
+	}
+	for _, role := range roles {
+		if resource.RolePermissions[role].Read {
+			return true, nil
+		}
+	}
+	for _, group := range groups {
+		if resource.GroupPermissions[group].Read {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"context"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+func (this *Publisher) Ping(ctx context.Context) error {
+	return this.inner.Ping(ctx)
+}
+
+func (this *Publisher) PublishDevice(device models.Device, configuration []model.DeviceConfigurationValue) error {
+	err := this.inner.PublishDevice(device, configuration)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceDevices, device.Id, model.DeviceWithConfiguration{Device: device, Configuration: configuration}, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceDelete(device models.Device) error {
+	err := this.inner.PublishDeviceDelete(device)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceDevices, device.Id, device, device.OwnerId)
+	return nil
+}
+
+func (this *Publisher) PublishDeviceType(deviceType models.DeviceType) error {
+	err := this.inner.PublishDeviceType(deviceType)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceDeviceTypes, deviceType.Id, deviceType, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceTypeDelete(id string) error {
+	err := this.inner.PublishDeviceTypeDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceDeviceTypes, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceGroup(dg models.DeviceGroup) error {
+	err := this.inner.PublishDeviceGroup(dg)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceDeviceGroups, dg.Id, dg, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceGroupDelete(id string) error {
+	err := this.inner.PublishDeviceGroupDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceDeviceGroups, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishProtocol(protocol models.Protocol) error {
+	err := this.inner.PublishProtocol(protocol)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceProtocols, protocol.Id, protocol, "")
+	return nil
+}
+
+func (this *Publisher) PublishProtocolDelete(id string) error {
+	err := this.inner.PublishProtocolDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceProtocols, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishHub(hub models.Hub) error {
+	err := this.inner.PublishHub(hub)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceHubs, hub.Id, hub, "")
+	return nil
+}
+
+func (this *Publisher) PublishHubDelete(hub models.Hub) error {
+	err := this.inner.PublishHubDelete(hub)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceHubs, hub.Id, hub, hub.OwnerId)
+	return nil
+}
+
+func (this *Publisher) PublishConcept(concept models.Concept) error {
+	err := this.inner.PublishConcept(concept)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceConcepts, concept.Id, concept, "")
+	return nil
+}
+
+func (this *Publisher) PublishConceptDelete(id string) error {
+	err := this.inner.PublishConceptDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceConcepts, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishCharacteristic(characteristic models.Characteristic) error {
+	err := this.inner.PublishCharacteristic(characteristic)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceCharacteristics, characteristic.Id, characteristic, "")
+	return nil
+}
+
+func (this *Publisher) PublishCharacteristicDelete(id string) error {
+	err := this.inner.PublishCharacteristicDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceCharacteristics, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishAspect(aspect models.Aspect) error {
+	err := this.inner.PublishAspect(aspect)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceAspects, aspect.Id, aspect, "")
+	return nil
+}
+
+func (this *Publisher) PublishAspectDelete(id string) error {
+	err := this.inner.PublishAspectDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceAspects, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishFunction(function models.Function) error {
+	err := this.inner.PublishFunction(function)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceFunctions, function.Id, function, "")
+	return nil
+}
+
+func (this *Publisher) PublishFunctionDelete(id string) error {
+	err := this.inner.PublishFunctionDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceFunctions, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceClass(deviceClass models.DeviceClass) error {
+	err := this.inner.PublishDeviceClass(deviceClass)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceDeviceClasses, deviceClass.Id, deviceClass, "")
+	return nil
+}
+
+func (this *Publisher) PublishDeviceClassDelete(id string) error {
+	err := this.inner.PublishDeviceClassDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceDeviceClasses, id, nil, "")
+	return nil
+}
+
+func (this *Publisher) PublishLocation(location models.Location) error {
+	err := this.inner.PublishLocation(location)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventSet, model.WebhookResourceLocations, location.Id, location, "")
+	return nil
+}
+
+func (this *Publisher) PublishLocationDelete(id string) error {
+	err := this.inner.PublishLocationDelete(id)
+	if err != nil {
+		return err
+	}
+	this.notify(model.WebhookEventDelete, model.WebhookResourceLocations, id, nil, "")
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/controller"
+	"github.com/SENERGY-Platform/device-repository/lib/database"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	"github.com/google/uuid"
+)
+
+// Publisher wraps a controller.Publisher and notifies registered webhooks about every successfully published change.
+// notifications are delivered asynchronously; the order of notifications for the same resource is not guaranteed.
+type Publisher struct {
+	inner          controller.Publisher
+	ctx            context.Context
+	config         configuration.Config
+	db             database.Database
+	perm           client.Client
+	owners         OwnerIdentity //nil if webhook_auth_endpoint is not configured
+	httpClient     *http.Client
+	queue          chan notification
+	workers        chan struct{}
+	maxAttempts    int64
+	initialBackoff time.Duration
+	disableAfter   int64
+}
+
+type notification struct {
+	event        string
+	resourceType string
+	resourceId   string
+	resource     interface{}
+	ownerId      string //owner of deleted resources, if known; used if the permissions of the resource are already removed
+	time         time.Time
+}
+
+func New(ctx context.Context, config configuration.Config, db database.Database, perm client.Client, inner controller.Publisher) (result *Publisher, err error) {
+	result = &Publisher{
+		inner:  inner,
+		ctx:    ctx,
+		config: config,
+		db:     db,
+		perm:   perm,
+		httpClient: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse //redirects count as failed delivery
+			},
+			Transport: newTransport(config.WebhookAllowedNetworks),
+		},
+		queue:          make(chan notification, defaultInt(config.WebhookQueueSize, 1000)),
+		workers:        make(chan struct{}, defaultInt(config.WebhookWorkerCount, 10)),
+		maxAttempts:    defaultInt(config.WebhookMaxAttempts, 5),
+		initialBackoff: time.Second,
+		disableAfter:   defaultInt(config.WebhookDisableAfterFailures, 10),
+	}
+	for _, network := range config.WebhookAllowedNetworks {
+		_, err = netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook_allowed_networks: %w", err)
+		}
+	}
+	if config.WebhookAuthEndpoint != "" && config.WebhookAuthEndpoint != "-" {
+		result.owners = NewKeycloakOwnerIdentity(config)
+	}
+	if config.WebhookInitialBackoff != "" && config.WebhookInitialBackoff != "-" {
+		result.initialBackoff, err = time.ParseDuration(config.WebhookInitialBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook_initial_backoff: %w", err)
+		}
+	}
+	go result.run()
+	return result, nil
+}
+
+// newTransport checks every connected address with controller.CheckWebhookAddress, so that webhook hosts
+// can not be resolved to forbidden addresses after their registration; proxies are not used, because they would hide the address
+func newTransport(allowedNetworks []string) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, conn syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			return controller.CheckWebhookAddress(addr, allowedNetworks)
+		},
+	}
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+func defaultInt(value int64, defaultValue int64) int64 {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// notify never blocks the publishing sync handler; notifications are dropped if the queue is full
+func (this *Publisher) notify(event string, resourceType string, resourceId string, resource interface{}, ownerId string) {
+	n := notification{
+		event:        event,
+		resourceType: resourceType,
+		resourceId:   resourceId,
+		resource:     resource,
+		ownerId:      ownerId,
+		time:         time.Now(),
+	}
+	select {
+	case this.queue <- n:
+	default:
+		this.config.GetLogger().Warn("webhook queue is full, drop notification", "event", event, "resourceType", resourceType, "resourceId", resourceId)
+	}
+}
+
+func (this *Publisher) run() {
+	for {
+		select {
+		case <-this.ctx.Done():
+			return
+		case n := <-this.queue:
+			this.dispatch(n)
+		}
+	}
+}
+
+func (this *Publisher) dispatch(n notification) {
+	ctx, cancel := this.getTimeoutContext(configuration.TimeoutDefault)
+	webhooks, _, err := this.db.ListWebhooks(ctx, model.WebhookListOptions{ResourceType: n.resourceType, ExcludeDisabled: true})
+	cancel()
+	if err != nil {
+		this.config.GetLogger().Error("unable to list webhooks", "error", err, "resourceType", n.resourceType)
+		return
+	}
+	for _, webhook := range webhooks {
+		if !webhook.Matches(n.event, n.resourceType, n.resourceId) {
+			continue
+		}
+		ok, err := this.mayRead(webhook, n)
+		if err != nil {
+			this.config.GetLogger().Error("unable to check webhook permissions", "error", err, "webhookId", webhook.Id, "resourceType", n.resourceType, "resourceId", n.resourceId)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		select {
+		case <-this.ctx.Done():
+			return
+		case this.workers <- struct{}{}:
+		}
+		go func() {
+			defer func() { <-this.workers }()
+			this.deliver(webhook, n)
+		}()
+	}
+}
+
+func (this *Publisher) deliver(webhook model.Webhook, n notification) {
+	delivery := model.WebhookDelivery{
+		Id:           uuid.NewString(),
+		WebhookId:    webhook.Id,
+		Event:        n.event,
+		ResourceType: n.resourceType,
+		ResourceId:   n.resourceId,
+	}
+	body, err := json.Marshal(model.WebhookNotification{
+		Id:           delivery.Id,
+		WebhookId:    webhook.Id,
+		Event:        n.event,
+		ResourceType: n.resourceType,
+		ResourceId:   n.resourceId,
+		Time:         n.time,
+		Resource:     n.resource,
+	})
+	if err != nil {
+		this.config.GetLogger().Error("unable to marshal webhook notification", "error", err, "webhookId", webhook.Id)
+		return
+	}
+	backoff := this.initialBackoff
+	for attempt := int64(1); attempt <= this.maxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		delivery.Time = time.Now()
+		delivery.StatusCode, err = this.send(webhook, delivery, body)
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+		if attempt == this.maxAttempts || !isRetryable(delivery.StatusCode) {
+			break
+		}
+		select {
+		case <-this.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = backoff * 2
+	}
+	this.logDelivery(webhook, delivery)
+}
+
+func (this *Publisher) logDelivery(webhook model.Webhook, delivery model.WebhookDelivery) {
+	ctx, cancel := this.getTimeoutContext(configuration.TimeoutDefault)
+	defer cancel()
+	err := this.db.AddWebhookDelivery(ctx, delivery)
+	if err != nil {
+		this.config.GetLogger().Error("unable to store webhook delivery", "error", err, "webhookId", webhook.Id, "deliveryId", delivery.Id)
+	}
+	failures, err := this.db.RecordWebhookDeliveryResult(ctx, webhook.Id, delivery.Success)
+	if err != nil {
+		this.config.GetLogger().Error("unable to update webhook failure count", "error", err, "webhookId", webhook.Id)
+		return
+	}
+	if !delivery.Success && failures >= this.disableAfter {
+		this.config.GetLogger().Warn("disable webhook after repeated failed deliveries", "webhookId", webhook.Id, "failures", failures)
+		err = this.db.DisableWebhook(ctx, webhook.Id, fmt.Sprintf("disabled after %v consecutive failed deliveries; last error: %v", failures, delivery.Error))
+		if err != nil {
+			this.config.GetLogger().Error("unable to disable webhook", "error", err, "webhookId", webhook.Id)
+		}
+	}
+}
+
+// network errors, timeouts, rate limits and server errors are retried; other responses will not change on retry
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= 500
+}
+
+func (this *Publisher) getTimeoutContext(operation string) (context.Context, context.CancelFunc) {
+	timeout := this.config.GetTimeout(operation)
+	if timeout <= 0 {
+		return context.WithCancel(this.ctx)
+	}
+	return context.WithTimeout(this.ctx, timeout)
+}
@@ -0,0 +1,362 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/device-repository/lib/configuration"
+	"github.com/SENERGY-Platform/device-repository/lib/controller"
+	"github.com/SENERGY-Platform/device-repository/lib/controller/publisher"
+	"github.com/SENERGY-Platform/device-repository/lib/database"
+	"github.com/SENERGY-Platform/device-repository/lib/database/testdb"
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := sync.Mutex{}
+	received := []*http.Request{}
+	bodies := [][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		body, _ := io.ReadAll(request.Body)
+		received = append(received, request)
+		bodies = append(bodies, body)
+	}))
+	defer server.Close()
+
+	p, db := newTestPublisher(t, ctx, configuration.Config{})
+	err := db.SetWebhook(ctx, model.Webhook{
+		Id:            "w1",
+		OwnerId:       "user",
+		Url:           server.URL,
+		ResourceTypes: []string{model.WebhookResourceDeviceTypes},
+		Filter:        model.WebhookFilter{Events: []string{model.WebhookEventSet}},
+		Secret:        "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.PublishDeviceType(models.DeviceType{Id: "dt1", Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.PublishDeviceTypeDelete("dt1") //filtered by event
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.PublishProtocol(models.Protocol{Id: "p1"}) //filtered by resource type
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries := waitForDeliveries(t, db, "w1", 1)
+	time.Sleep(100 * time.Millisecond)
+
+	mux.Lock()
+	defer mux.Unlock()
+	if len(received) != 1 {
+		t.Fatalf("expected 1 request, got %v", len(received))
+	}
+	request := received[0]
+	body := bodies[0]
+	if !Verify("secret", request.Header.Get(model.WebhookHeaderTimestamp), body, request.Header.Get(model.WebhookHeaderSignature)) {
+		t.Error("invalid signature", request.Header.Get(model.WebhookHeaderSignature))
+	}
+	if request.Header.Get(model.WebhookHeaderEvent) != model.WebhookEventSet || request.Header.Get(model.WebhookHeaderId) != "w1" {
+		t.Error(request.Header)
+	}
+	notification := model.WebhookNotification{}
+	err = json.Unmarshal(body, &notification)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notification.ResourceType != model.WebhookResourceDeviceTypes || notification.ResourceId != "dt1" || notification.Id != deliveries[0].Id {
+		t.Errorf("%#v", notification)
+	}
+	if !deliveries[0].Success || deliveries[0].Attempts != 1 || deliveries[0].StatusCode != http.StatusOK {
+		t.Errorf("%#v", deliveries[0])
+	}
+}
+
+func TestWebhookRetryAndDisable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	p, db := newTestPublisher(t, ctx, configuration.Config{
+		WebhookMaxAttempts:          3,
+		WebhookInitialBackoff:       "10ms",
+		WebhookDisableAfterFailures: 2,
+	})
+	err := db.SetWebhook(ctx, model.Webhook{
+		Id:            "w1",
+		OwnerId:       "user",
+		Url:           server.URL,
+		ResourceTypes: []string{model.WebhookResourceAspects},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.PublishAspectDelete("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries := waitForDeliveries(t, db, "w1", 1)
+	if deliveries[0].Success || deliveries[0].Attempts != 3 || deliveries[0].StatusCode != http.StatusInternalServerError {
+		t.Errorf("%#v", deliveries[0])
+	}
+	webhook, _, _ := db.GetWebhook(ctx, "w1")
+	if webhook.Disabled || webhook.ConsecutiveFailures != 1 {
+		t.Errorf("%#v", webhook)
+	}
+
+	err = p.PublishAspectDelete("a2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForDeliveries(t, db, "w1", 2)
+	webhook, _, _ = db.GetWebhook(ctx, "w1")
+	if !webhook.Disabled || webhook.ConsecutiveFailures != 2 || webhook.DisabledReason == "" {
+		t.Errorf("%#v", webhook)
+	}
+
+	//disabled webhooks receive no notifications
+	err = p.PublishAspectDelete("a3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	_, total, _ := db.ListWebhookDeliveries(ctx, "w1", model.WebhookDeliveryListOptions{})
+	if total != 2 {
+		t.Errorf("expected 2 deliveries, got %v", total)
+	}
+}
+
+func TestWebhookForbiddenAddress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		received <- struct{}{}
+	}))
+	defer server.Close()
+
+	p, db := newTestPublisher(t, ctx, configuration.Config{
+		WebhookMaxAttempts:     1,
+		WebhookAllowedNetworks: []string{},
+	})
+	err := db.SetWebhook(ctx, model.Webhook{
+		Id:            "w1",
+		OwnerId:       "user",
+		Url:           server.URL,
+		ResourceTypes: []string{model.WebhookResourceAspects},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.PublishAspectDelete("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries := waitForDeliveries(t, db, "w1", 1)
+	if deliveries[0].Success || len(received) != 0 || !strings.Contains(deliveries[0].Error, "not allowed") {
+		t.Errorf("%#v", deliveries[0])
+	}
+}
+
+type testOwnerIdentity map[string][]string
+
+func (this testOwnerIdentity) GetRolesAndGroups(_ context.Context, userId string) (roles []string, groups []string, err error) {
+	return this[userId], nil, nil
+}
+
+func TestWebhookPermissionsOfDeletedResources(t *testing.T) {
+	owners := testOwnerIdentity{"user": {"admin"}}
+	p := &Publisher{ctx: context.Background(), config: configuration.Config{DeviceTopic: "devices"}, owners: owners}
+	webhook := model.Webhook{OwnerId: "user"}
+	ok, err := p.mayRead(webhook, notification{event: model.WebhookEventSet, resourceType: model.WebhookResourceDevices, resourceId: "d1"})
+	if err != nil || !ok {
+		t.Error("admins may read all devices", ok, err)
+	}
+	owners["user"] = nil
+	ok, err = p.mayRead(model.Webhook{OwnerId: "user"}, notification{event: model.WebhookEventSet, resourceType: model.WebhookResourceFunctions, resourceId: "f1"})
+	if err != nil || !ok {
+		t.Error("functions are public", ok, err)
+	}
+
+	p.perm, err = client.NewTestClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted := notification{event: model.WebhookEventDelete, resourceType: model.WebhookResourceDevices, resourceId: "d1", ownerId: "owner"}
+	ok, err = p.mayRead(model.Webhook{OwnerId: "owner"}, deleted)
+	if err != nil || !ok {
+		t.Error("owners are notified about deleted devices", ok, err)
+	}
+	ok, err = p.mayRead(model.Webhook{OwnerId: "user", Filter: model.WebhookFilter{Ids: []string{"d1"}}}, deleted)
+	if err != nil || ok {
+		t.Error("id filters grant no access to deleted devices", ok, err)
+	}
+}
+
+func TestWebhookPermissionsOfRevokedRoles(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	owners := testOwnerIdentity{"user": {"operator"}}
+	p := &Publisher{ctx: ctx, config: configuration.Config{DeviceTopic: "devices"}, owners: owners}
+	var err error
+	p.perm, err = client.NewTestClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = p.perm.SetTopic(client.InternalAdminToken, client.Topic{Id: "devices"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err, _ = p.perm.SetPermission(client.InternalAdminToken, "devices", "d1", client.ResourcePermissions{
+		UserPermissions: map[string]client.PermissionsMap{"owner": {Read: true, Write: true, Execute: true, Administrate: true}},
+		RolePermissions: map[string]client.PermissionsMap{"operator": {Read: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := notification{event: model.WebhookEventSet, resourceType: model.WebhookResourceDevices, resourceId: "d1"}
+	ok, err := p.mayRead(model.Webhook{OwnerId: "user"}, set)
+	if err != nil || !ok {
+		t.Error("operators may read d1", ok, err)
+	}
+	owners["user"] = nil
+	ok, err = p.mayRead(model.Webhook{OwnerId: "user"}, set)
+	if err != nil || ok {
+		t.Error("revoked role grants no access", ok, err)
+	}
+}
+
+func TestKeycloakOwnerIdentity(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/realms/test/protocol/openid-connect/token":
+			tokenRequests++
+			if request.FormValue("client_id") != "client" || request.FormValue("client_secret") != "secret" {
+				writer.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(writer).Encode(map[string]interface{}{"access_token": "token", "expires_in": 60})
+		case "/admin/realms/test/users/user/role-mappings/realm/composite":
+			if request.Header.Get("Authorization") != "Bearer token" {
+				writer.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(writer).Encode([]map[string]string{{"name": "user"}, {"name": "operator"}})
+		case "/admin/realms/test/users/user/groups":
+			if request.Header.Get("Authorization") != "Bearer token" {
+				writer.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(writer).Encode([]map[string]string{{"name": "team", "path": "/org/team"}})
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	owners := NewKeycloakOwnerIdentity(configuration.Config{WebhookAuthEndpoint: server.URL + "/", WebhookAuthRealm: "test", WebhookAuthClientId: "client", WebhookAuthClientSecret: "secret"})
+	for range 2 {
+		roles, groups, err := owners.GetRolesAndGroups(context.Background(), "user")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(roles, []string{"user", "operator"}) || !slices.Equal(groups, []string{"/org/team"}) {
+			t.Error(roles, groups)
+		}
+	}
+	if tokenRequests != 1 {
+		t.Error("token should be reused", tokenRequests)
+	}
+	_, _, err := owners.GetRolesAndGroups(context.Background(), "unknown")
+	if err == nil {
+		t.Error("missing error for unknown user")
+	}
+}
+
+func TestCheckWebhookAddress(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "100.127.255.254", "0.1.2.3", "192.0.0.8", "198.18.0.1", "224.0.0.1", "255.255.255.255", "::", "::1", "fe80::1", "fc00::1", "ff02::1", "::ffff:10.0.0.1", "64:ff9b::a00:1", "2002:a00:1::1", "2001:db8::1"} {
+		if controller.CheckWebhookAddress(netip.MustParseAddr(addr), nil) == nil {
+			t.Error("expected rejection of", addr)
+		}
+	}
+	for _, addr := range []string{"8.8.8.8", "100.128.0.1", "2606:4700::1111", "64:ff9b::808:808"} {
+		if err := controller.CheckWebhookAddress(netip.MustParseAddr(addr), nil); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := controller.CheckWebhookAddress(netip.MustParseAddr("100.64.0.1"), []string{"100.64.0.0/10"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func newTestPublisher(t *testing.T, ctx context.Context, config configuration.Config) (*Publisher, database.Database) {
+	if config.WebhookAllowedNetworks == nil {
+		config.WebhookAllowedNetworks = []string{"127.0.0.0/8", "::1/128"} //httptest servers
+	}
+	db := testdb.NewTestDB(config)
+	p, err := New(ctx, config, db, nil, publisher.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p, db
+}
+
+func waitForDeliveries(t *testing.T, db database.Database, webhookId string, count int64) []model.WebhookDelivery {
+	for range 100 {
+		deliveries, total, err := db.ListWebhookDeliveries(context.Background(), webhookId, model.WebhookDeliveryListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total >= count {
+			return deliveries
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("missing webhook deliveries for %v", webhookId)
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wot
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// DeviceLocalIdPlaceholder is used in the form hrefs of Thing Models
+const DeviceLocalIdPlaceholder = "{{DEVICE_LOCAL_ID}}"
+
+// NewThingModel renders a device-type as Thing Model
+// services with inputs or controlling functions become actions; other services become
+// properties (request), events (event) or observable properties (event+request)
+// protocols are used to name content segments and to build form hrefs like "<protocol-handler>://{{DEVICE_LOCAL_ID}}/<service-local-id>"
+func NewThingModel(deviceType models.DeviceType, protocols map[string]models.Protocol) Thing {
+	thing := newThing(deviceType, protocols, DeviceLocalIdPlaceholder)
+	thing.Type = ThingModelType
+	thing.Title = deviceType.Name
+	thing.Description = deviceType.Description
+	return thing
+}
+
+// NewThingDescription renders a device as Thing Description of its device-type (see NewThingModel)
+// the device connection is handled by the protocol connectors, which is why no additional security is declared
+func NewThingDescription(device models.Device, deviceType models.DeviceType, protocols map[string]models.Protocol) Thing {
+	thing := newThing(deviceType, protocols, device.LocalId)
+	thing.Id = device.Id
+	thing.Title = device.Name
+	thing.Description = deviceType.Description
+	thing.Links = []Link{{
+		Rel:  "type",
+		Href: "/device-types/" + url.PathEscape(deviceType.Id) + "/wot-tm",
+		Type: TmContentType,
+	}}
+	thing.SecurityDefinitions = map[string]SecurityScheme{"nosec_sc": {Scheme: "nosec"}}
+	thing.Security = []string{"nosec_sc"}
+	return thing
+}
+
+func newThing(deviceType models.DeviceType, protocols map[string]models.Protocol, deviceLocalId string) Thing {
+	thing := Thing{
+		Context:       []interface{}{TdContext, map[string]string{"ses": SesContext}},
+		DeviceTypeId:  deviceType.Id,
+		DeviceClassId: deviceType.DeviceClassId,
+	}
+	for _, service := range deviceType.Services {
+		protocol := protocols[service.ProtocolId]
+		name := service.LocalId
+		if name == "" {
+			name = service.Id
+		}
+		form := func(op ...string) []Form {
+			return []Form{newForm(service, protocol, deviceLocalId, op...)}
+		}
+		input := contentSchema(service.Inputs, protocol)
+		output := contentSchema(service.Outputs, protocol)
+		if input != nil || usesControllingFunction(service.Inputs) {
+			if thing.Actions == nil {
+				thing.Actions = map[string]Action{}
+			}
+			thing.Actions[uniqueName(name, thing.Actions)] = Action{
+				Title:       service.Name,
+				Description: service.Description,
+				Input:       input,
+				Output:      output,
+				Forms:       form("invokeaction"),
+				ServiceId:   service.Id,
+			}
+			continue
+		}
+		if service.Interaction == models.EVENT {
+			if thing.Events == nil {
+				thing.Events = map[string]Event{}
+			}
+			thing.Events[uniqueName(name, thing.Events)] = Event{
+				Title:       service.Name,
+				Description: service.Description,
+				Data:        output,
+				Forms:       form("subscribeevent"),
+				ServiceId:   service.Id,
+			}
+			continue
+		}
+		if thing.Properties == nil {
+			thing.Properties = map[string]Property{}
+		}
+		property := Property{ServiceId: service.Id, Forms: form("readproperty")}
+		if output != nil {
+			property.DataSchema = *output
+		}
+		property.Title = service.Name
+		property.Description = service.Description
+		property.ReadOnly = true
+		if service.Interaction == models.EVENT_AND_REQUEST {
+			property.Observable = true
+			property.Forms = form("readproperty", "observeproperty")
+		}
+		thing.Properties[uniqueName(name, thing.Properties)] = property
+	}
+	return thing
+}
+
+func uniqueName[T any](name string, existing map[string]T) string {
+	result := name
+	for i := 2; ; i++ {
+		if _, used := existing[result]; !used {
+			return result
+		}
+		result = fmt.Sprintf("%v_%v", name, i)
+	}
+}
+
+func newForm(service models.Service, protocol models.Protocol, deviceLocalId string, op ...string) Form {
+	scheme := protocol.Handler
+	if scheme == "" {
+		scheme = "senergy"
+	}
+	result := Form{
+		Href:       scheme + "://" + escapePathSegment(deviceLocalId) + "/" + escapePathSegment(service.LocalId),
+		Op:         op,
+		ProtocolId: service.ProtocolId,
+	}
+	contents := service.Outputs
+	if len(service.Inputs) > 0 {
+		contents = service.Inputs
+	}
+	if len(contents) > 0 {
+		result.ContentType = serializationContentType(contents[0].Serialization)
+	}
+	return result
+}
+
+// escapePathSegment keeps placeholders like DeviceLocalIdPlaceholder readable
+func escapePathSegment(segment string) string {
+	if segment == DeviceLocalIdPlaceholder {
+		return segment
+	}
+	return url.PathEscape(segment)
+}
+
+func serializationContentType(key models.Serialization) string {
+	s, ok := serialization.Get(key)
+	if !ok {
+		return ""
+	}
+	return s.Info().MediaType
+}
+
+func usesControllingFunction(contents []models.Content) bool {
+	var check func(variable models.ContentVariable) bool
+	check = func(variable models.ContentVariable) bool {
+		if strings.HasPrefix(variable.FunctionId, model.CONTROLLING_FUNCTION_PREFIX) {
+			return true
+		}
+		for _, sub := range variable.SubContentVariables {
+			if check(sub) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, content := range contents {
+		if check(content.ContentVariable) {
+			return true
+		}
+	}
+	return false
+}
+
+// contentSchema returns the schema of the content variable of a single content
+// multiple contents (e.g. header and payload) are combined in an object with the protocol segment names as keys
+// void contents are ignored; returns nil if no content remains
+func contentSchema(contents []models.Content, protocol models.Protocol) *DataSchema {
+	relevant := []models.Content{}
+	for _, content := range contents {
+		if !content.ContentVariable.IsVoid {
+			relevant = append(relevant, content)
+		}
+	}
+	switch len(relevant) {
+	case 0:
+		return nil
+	case 1:
+		schema := variableSchema(relevant[0].ContentVariable)
+		return &schema
+	}
+	result := DataSchema{Type: "object", Properties: map[string]DataSchema{}}
+	for _, content := range relevant {
+		name := content.ContentVariable.Name
+		for _, segment := range protocol.ProtocolSegments {
+			if segment.Id == content.ProtocolSegmentId {
+				name = segment.Name
+			}
+		}
+		result.Properties[name] = variableSchema(content.ContentVariable)
+		result.Required = append(result.Required, name)
+	}
+	return &result
+}
+
+func variableSchema(variable models.ContentVariable) DataSchema {
+	result := DataSchema{
+		Title:            variable.Name,
+		Const:            variable.Value,
+		FunctionId:       variable.FunctionId,
+		AspectId:         variable.AspectId,
+		CharacteristicId: variable.CharacteristicId,
+	}
+	switch variable.Type {
+	case models.String:
+		result.Type = "string"
+	case models.Integer:
+		result.Type = "integer"
+	case models.Float:
+		result.Type = "number"
+	case models.Boolean:
+		result.Type = "boolean"
+	case models.Structure:
+		result.Type = "object"
+		for _, sub := range variable.SubContentVariables {
+			if sub.IsVoid || sub.Name == "*" {
+				continue
+			}
+			if result.Properties == nil {
+				result.Properties = map[string]DataSchema{}
+			}
+			result.Properties[sub.Name] = variableSchema(sub)
+			if !sub.OmitEmpty {
+				result.Required = append(result.Required, sub.Name)
+			}
+		}
+	case models.List:
+		result.Type = "array"
+		if len(variable.SubContentVariables) == 1 && variable.SubContentVariables[0].Name == "*" {
+			result.Items = &Items{Schemas: []DataSchema{variableSchema(variable.SubContentVariables[0])}}
+		} else if len(variable.SubContentVariables) > 0 {
+			result.Items = &Items{Tuple: true}
+			for _, sub := range variable.SubContentVariables {
+				result.Items.Schemas = append(result.Items.Schemas, variableSchema(sub))
+			}
+		}
+	}
+	return result
+}
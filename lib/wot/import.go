@@ -0,0 +1,286 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wot
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/device-repository/lib/serialization"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+// NewDeviceType creates a draft device-type from a Thing Model (or Thing Description)
+// all services use the given protocol; ids are left empty and must be generated (models.DeviceType.GenerateId) before the draft is saved
+// properties become services with the interaction request (observable: event+request); writable properties get an additional
+// service "set_<name>"; actions become request services; events become event services
+// object schemas with one property per protocol segment name are split into one content per segment, other schemas use the
+// segment named "payload" or the first protocol segment
+// SENERGY terms (ses:function, ses:aspect, ses:characteristic, ses:deviceClass) are kept; service and device-type ids are not
+// to prevent the draft from overwriting existing resources
+func NewDeviceType(thing Thing, protocol models.Protocol) (result models.DeviceType, err error) {
+	if len(protocol.ProtocolSegments) == 0 {
+		return result, errors.New("protocol has no protocol segments")
+	}
+	result = models.DeviceType{
+		Name:          thing.Title,
+		Description:   thing.Description,
+		DeviceClassId: thing.DeviceClassId,
+		Services:      []models.Service{},
+	}
+	usedLocalIds := map[string]bool{}
+	newService := func(name string, title string, description string, interaction models.Interaction) models.Service {
+		localId := uniqueName(strings.NewReplacer("+", "_", "#", "_", "/", "_").Replace(name), usedLocalIds)
+		usedLocalIds[localId] = true
+		if title == "" {
+			title = name
+		}
+		return models.Service{
+			LocalId:     localId,
+			Name:        title,
+			Description: description,
+			Interaction: interaction,
+			ProtocolId:  protocol.Id,
+		}
+	}
+
+	for _, name := range sortedKeys(thing.Properties) {
+		property := thing.Properties[name]
+		serialization := formSerialization(property.Forms)
+		if !property.WriteOnly {
+			interaction := models.REQUEST
+			if property.Observable {
+				interaction = models.EVENT_AND_REQUEST
+			}
+			service := newService(name, property.Title, property.Description, interaction)
+			service.Outputs, err = schemaContents(property.DataSchema, name, serialization, protocol)
+			if err != nil {
+				return result, fmt.Errorf("properties.%v: %w", name, err)
+			}
+			result.Services = append(result.Services, service)
+		}
+		if !property.ReadOnly {
+			title := ""
+			if property.Title != "" {
+				title = "Set " + property.Title
+			}
+			service := newService("set_"+name, title, property.Description, models.REQUEST)
+			service.Inputs, err = schemaContents(property.DataSchema, name, serialization, protocol)
+			if err != nil {
+				return result, fmt.Errorf("properties.%v: %w", name, err)
+			}
+			result.Services = append(result.Services, service)
+		}
+	}
+
+	for _, name := range sortedKeys(thing.Actions) {
+		action := thing.Actions[name]
+		serialization := formSerialization(action.Forms)
+		service := newService(name, action.Title, action.Description, models.REQUEST)
+		if action.Input != nil {
+			service.Inputs, err = schemaContents(*action.Input, "input", serialization, protocol)
+			if err != nil {
+				return result, fmt.Errorf("actions.%v.input: %w", name, err)
+			}
+		}
+		if action.Output != nil {
+			service.Outputs, err = schemaContents(*action.Output, "output", serialization, protocol)
+			if err != nil {
+				return result, fmt.Errorf("actions.%v.output: %w", name, err)
+			}
+		}
+		result.Services = append(result.Services, service)
+	}
+
+	for _, name := range sortedKeys(thing.Events) {
+		event := thing.Events[name]
+		service := newService(name, event.Title, event.Description, models.EVENT)
+		if event.Data != nil {
+			service.Outputs, err = schemaContents(*event.Data, "data", formSerialization(event.Forms), protocol)
+			if err != nil {
+				return result, fmt.Errorf("events.%v.data: %w", name, err)
+			}
+		}
+		result.Services = append(result.Services, service)
+	}
+	return result, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func formSerialization(forms []Form) models.Serialization {
+	for _, form := range forms {
+		contentType, _, _ := strings.Cut(form.ContentType, ";")
+		switch strings.TrimSpace(strings.ToLower(contentType)) {
+		case "application/xml", "text/xml":
+			return models.XML
+		case "text/plain":
+			return models.PlainText
+		case "application/json":
+			return models.JSON
+		case "application/cbor":
+			return serialization.CBOR
+		}
+	}
+	return models.JSON
+}
+
+// schemaContents is the inverse of contentSchema
+func schemaContents(schema DataSchema, defaultName string, serialization models.Serialization, protocol models.Protocol) (result []models.Content, err error) {
+	if schema.Type == "object" && len(schema.Properties) > 1 && len(schema.Properties) <= len(protocol.ProtocolSegments) {
+		segments := map[string]models.ProtocolSegment{}
+		for _, segment := range protocol.ProtocolSegments {
+			segments[segment.Name] = segment
+		}
+		split := true
+		for name := range schema.Properties {
+			if _, ok := segments[name]; !ok {
+				split = false
+			}
+		}
+		if split {
+			for _, name := range sortedKeys(schema.Properties) {
+				content, err := schemaContent(schema.Properties[name], variableName(schema.Properties[name], name), segments[name], serialization)
+				if err != nil {
+					return result, err
+				}
+				result = append(result, content)
+			}
+			return result, nil
+		}
+	}
+	segment := protocol.ProtocolSegments[0]
+	for _, s := range protocol.ProtocolSegments {
+		if strings.EqualFold(s.Name, "payload") {
+			segment = s
+			break
+		}
+	}
+	content, err := schemaContent(schema, variableName(schema, defaultName), segment, serialization)
+	if err != nil {
+		return result, err
+	}
+	return []models.Content{content}, nil
+}
+
+var invalidVariableNameChars = regexp.MustCompile(`[^A-Za-z0-9-_]`)
+
+// variableName uses the schema title (as set by NewThingModel) if it is a valid content-variable name
+// and otherwise the fallback with invalid characters replaced by '_'
+func variableName(schema DataSchema, fallback string) string {
+	if schema.Title != "" && !invalidVariableNameChars.MatchString(schema.Title) {
+		return schema.Title
+	}
+	return invalidVariableNameChars.ReplaceAllString(fallback, "_")
+}
+
+func schemaContent(schema DataSchema, name string, segment models.ProtocolSegment, serialization models.Serialization) (result models.Content, err error) {
+	variable, err := schemaVariable(schema, name)
+	if err != nil {
+		return result, err
+	}
+	if serialization == models.PlainText && variable.Type != models.String {
+		serialization = models.JSON
+	}
+	return models.Content{
+		ContentVariable:   variable,
+		ProtocolSegmentId: segment.Id,
+		Serialization:     serialization,
+	}, nil
+}
+
+// schemaVariable is the inverse of variableSchema
+// object schemas without properties become maps of strings; array schemas without items become lists of strings
+func schemaVariable(schema DataSchema, name string) (result models.ContentVariable, err error) {
+	result = models.ContentVariable{
+		Name:             name,
+		Value:            schema.Const,
+		FunctionId:       schema.FunctionId,
+		AspectId:         schema.AspectId,
+		CharacteristicId: schema.CharacteristicId,
+	}
+	schemaType := schema.Type
+	if schemaType == "" {
+		switch {
+		case schema.Properties != nil:
+			schemaType = "object"
+		case schema.Items != nil:
+			schemaType = "array"
+		default:
+			schemaType = "string"
+		}
+	}
+	switch schemaType {
+	case "string":
+		result.Type = models.String
+	case "integer":
+		result.Type = models.Integer
+	case "number":
+		result.Type = models.Float
+	case "boolean":
+		result.Type = models.Boolean
+	case "object":
+		result.Type = models.Structure
+		if len(schema.Properties) == 0 {
+			result.SubContentVariables = []models.ContentVariable{{Name: "*", Type: models.String}}
+			break
+		}
+		for _, key := range sortedKeys(schema.Properties) {
+			sub, err := schemaVariable(schema.Properties[key], key)
+			if err != nil {
+				return result, fmt.Errorf("%v.%w", name, err)
+			}
+			sub.OmitEmpty = !slices.Contains(schema.Required, key) && sub.Value == nil
+			result.SubContentVariables = append(result.SubContentVariables, sub)
+		}
+	case "array":
+		result.Type = models.List
+		if schema.Items == nil || len(schema.Items.Schemas) == 0 {
+			result.SubContentVariables = []models.ContentVariable{{Name: "*", Type: models.String}}
+			break
+		}
+		if !schema.Items.Tuple {
+			sub, err := schemaVariable(schema.Items.Schemas[0], "*")
+			if err != nil {
+				return result, fmt.Errorf("%v.%w", name, err)
+			}
+			result.SubContentVariables = []models.ContentVariable{sub}
+			break
+		}
+		for i, item := range schema.Items.Schemas {
+			sub, err := schemaVariable(item, strconv.Itoa(i))
+			if err != nil {
+				return result, fmt.Errorf("%v.%w", name, err)
+			}
+			result.SubContentVariables = append(result.SubContentVariables, sub)
+		}
+	default:
+		return result, fmt.Errorf("%v: unsupported data schema type %v", name, schema.Type)
+	}
+	return result, nil
+}
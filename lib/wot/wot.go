@@ -0,0 +1,131 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wot
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const (
+	TdContext      = "https://www.w3.org/2022/wot/td/v1.1"
+	SesContext     = "https://senergy.infai.org/ontology/"
+	ThingModelType = "tm:ThingModel"
+
+	TdContentType = "application/td+json"
+	TmContentType = "application/tm+json"
+)
+
+// Thing is a W3C WoT Thing Description (https://www.w3.org/TR/wot-thing-description11/) or, with Type ThingModelType, a Thing Model
+// SENERGY specific terms use the 'ses' prefix
+type Thing struct {
+	Context             []interface{}             `json:"@context"`
+	Type                string                    `json:"@type,omitempty"`
+	Id                  string                    `json:"id,omitempty"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description,omitempty"`
+	Links               []Link                    `json:"links,omitempty"`
+	SecurityDefinitions map[string]SecurityScheme `json:"securityDefinitions,omitempty"`
+	Security            []string                  `json:"security,omitempty"`
+	Properties          map[string]Property       `json:"properties,omitempty"`
+	Actions             map[string]Action         `json:"actions,omitempty"`
+	Events              map[string]Event          `json:"events,omitempty"`
+	DeviceTypeId        string                    `json:"ses:deviceType,omitempty"`
+	DeviceClassId       string                    `json:"ses:deviceClass,omitempty"`
+}
+
+type Link struct {
+	Rel  string `json:"rel,omitempty"`
+	Href string `json:"href"`
+	Type string `json:"type,omitempty"`
+}
+
+type SecurityScheme struct {
+	Scheme string `json:"scheme"`
+}
+
+type Form struct {
+	Href        string   `json:"href"`
+	ContentType string   `json:"contentType,omitempty"`
+	Op          []string `json:"op,omitempty"`
+	ProtocolId  string   `json:"ses:protocol,omitempty"`
+}
+
+type Property struct {
+	DataSchema
+	Observable bool   `json:"observable,omitempty"`
+	Forms      []Form `json:"forms,omitempty"`
+	ServiceId  string `json:"ses:service,omitempty"`
+}
+
+type Action struct {
+	Title       string      `json:"title,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Input       *DataSchema `json:"input,omitempty"`
+	Output      *DataSchema `json:"output,omitempty"`
+	Forms       []Form      `json:"forms,omitempty"`
+	ServiceId   string      `json:"ses:service,omitempty"`
+}
+
+type Event struct {
+	Title       string      `json:"title,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Data        *DataSchema `json:"data,omitempty"`
+	Forms       []Form      `json:"forms,omitempty"`
+	ServiceId   string      `json:"ses:service,omitempty"`
+}
+
+type DataSchema struct {
+	Type             string                `json:"type,omitempty"`
+	Title            string                `json:"title,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	Const            interface{}           `json:"const,omitempty"`
+	Unit             string                `json:"unit,omitempty"`
+	ReadOnly         bool                  `json:"readOnly,omitempty"`
+	WriteOnly        bool                  `json:"writeOnly,omitempty"`
+	Properties       map[string]DataSchema `json:"properties,omitempty"`
+	Required         []string              `json:"required,omitempty"`
+	Items            *Items                `json:"items,omitempty"`
+	FunctionId       string                `json:"ses:function,omitempty"`
+	AspectId         string                `json:"ses:aspect,omitempty"`
+	CharacteristicId string                `json:"ses:characteristic,omitempty"`
+}
+
+// Items of an array schema are encoded as single schema, or as list of schemas for tuples
+type Items struct {
+	Schemas []DataSchema
+	Tuple   bool
+}
+
+func (this Items) MarshalJSON() ([]byte, error) {
+	if !this.Tuple && len(this.Schemas) == 1 {
+		return json.Marshal(this.Schemas[0])
+	}
+	return json.Marshal(this.Schemas)
+}
+
+func (this *Items) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("[")) {
+		this.Tuple = true
+		return json.Unmarshal(b, &this.Schemas)
+	}
+	schema := DataSchema{}
+	err := json.Unmarshal(b, &schema)
+	this.Tuple = false
+	this.Schemas = []DataSchema{schema}
+	return err
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+var testProtocols = map[string]models.Protocol{
+	"urn:infai:ses:protocol:mqtt": {
+		Id:               "urn:infai:ses:protocol:mqtt",
+		Handler:          "mqtt",
+		ProtocolSegments: []models.ProtocolSegment{{Id: "urn:infai:ses:segment:payload", Name: "payload"}, {Id: "urn:infai:ses:segment:header", Name: "header"}},
+	},
+}
+
+var testDeviceType = models.DeviceType{
+	Id:            "urn:infai:ses:device-type:lamp",
+	Name:          "Lamp",
+	DeviceClassId: "urn:infai:ses:device-class:lamp",
+	Services: []models.Service{
+		{
+			Id:          "urn:infai:ses:service:set-on",
+			LocalId:     "setOn",
+			Name:        "Set On",
+			Interaction: models.REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:mqtt",
+			Inputs: []models.Content{{
+				ProtocolSegmentId: "urn:infai:ses:segment:payload",
+				Serialization:     models.JSON,
+				ContentVariable: models.ContentVariable{
+					Name:       "on",
+					Type:       models.Boolean,
+					FunctionId: model.CONTROLLING_FUNCTION_PREFIX + "on",
+					Value:      true,
+				},
+			}},
+		},
+		{
+			Id:          "urn:infai:ses:service:get-state",
+			LocalId:     "getState",
+			Name:        "Get State",
+			Interaction: models.EVENT_AND_REQUEST,
+			ProtocolId:  "urn:infai:ses:protocol:mqtt",
+			Outputs: []models.Content{
+				{
+					ProtocolSegmentId: "urn:infai:ses:segment:payload",
+					Serialization:     models.JSON,
+					ContentVariable: models.ContentVariable{
+						Name: "state",
+						Type: models.Structure,
+						SubContentVariables: []models.ContentVariable{
+							{Name: "colors", Type: models.List, OmitEmpty: true, SubContentVariables: []models.ContentVariable{{Name: "*", Type: models.String}}},
+							{Name: "on", Type: models.Boolean, FunctionId: model.MEASURING_FUNCTION_PREFIX + "on", AspectId: "urn:infai:ses:aspect:light"},
+						},
+					},
+				},
+				{
+					ProtocolSegmentId: "urn:infai:ses:segment:header",
+					Serialization:     models.JSON,
+					ContentVariable:   models.ContentVariable{Name: "time", Type: models.Integer},
+				},
+			},
+		},
+		{
+			Id:          "urn:infai:ses:service:power",
+			LocalId:     "power",
+			Interaction: models.EVENT,
+			ProtocolId:  "urn:infai:ses:protocol:unknown",
+			Outputs:     []models.Content{{Serialization: models.PlainText, ContentVariable: models.ContentVariable{Name: "watt", Type: models.Float}}},
+		},
+	},
+}
+
+func TestNewThingModel(t *testing.T) {
+	thing := NewThingModel(testDeviceType, testProtocols)
+	if thing.Type != ThingModelType || thing.Title != "Lamp" || thing.Id != "" {
+		t.Errorf("%#v", thing)
+	}
+	if len(thing.Actions) != 1 || len(thing.Properties) != 1 || len(thing.Events) != 1 {
+		t.Fatalf("%#v", thing)
+	}
+
+	action := thing.Actions["setOn"]
+	if action.Input == nil || action.Input.Type != "boolean" || action.Input.Const != true || action.Output != nil {
+		t.Errorf("%#v", action)
+	}
+	expectedForm := Form{Href: "mqtt://{{DEVICE_LOCAL_ID}}/setOn", ContentType: "application/json", Op: []string{"invokeaction"}, ProtocolId: "urn:infai:ses:protocol:mqtt"}
+	if !reflect.DeepEqual(action.Forms, []Form{expectedForm}) {
+		t.Errorf("%#v", action.Forms)
+	}
+
+	property := thing.Properties["getState"]
+	if !property.ReadOnly || !property.Observable || property.Type != "object" || !reflect.DeepEqual(property.Forms[0].Op, []string{"readproperty", "observeproperty"}) {
+		t.Errorf("%#v", property)
+	}
+	payload := property.Properties["payload"]
+	if payload.Type != "object" || !reflect.DeepEqual(payload.Required, []string{"on"}) || payload.Properties["on"].AspectId != "urn:infai:ses:aspect:light" {
+		t.Errorf("%#v", payload)
+	}
+	colors := payload.Properties["colors"]
+	if colors.Type != "array" || colors.Items == nil || colors.Items.Tuple || colors.Items.Schemas[0].Type != "string" {
+		t.Errorf("%#v", colors)
+	}
+	if property.Properties["header"].Type != "integer" {
+		t.Errorf("%#v", property.Properties["header"])
+	}
+
+	event := thing.Events["power"]
+	if event.Data == nil || event.Data.Type != "number" || event.Forms[0].Href != "senergy://{{DEVICE_LOCAL_ID}}/power" || event.Forms[0].ContentType != "text/plain" {
+		t.Errorf("%#v", event)
+	}
+
+	b, err := json.Marshal(thing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Thing
+	err = json.Unmarshal(b, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, _ := json.Marshal(decoded)
+	if string(b) != string(b2) {
+		t.Errorf("\n%v\n%v", string(b), string(b2))
+	}
+}
+
+func TestNewThingDescription(t *testing.T) {
+	thing := NewThingDescription(models.Device{Id: "urn:infai:ses:device:1", LocalId: "lamp 1", Name: "Kitchen Lamp", DeviceTypeId: testDeviceType.Id}, testDeviceType, testProtocols)
+	if thing.Type != "" || thing.Id != "urn:infai:ses:device:1" || thing.Title != "Kitchen Lamp" || len(thing.Security) != 1 {
+		t.Errorf("%#v", thing)
+	}
+	if len(thing.Links) != 1 || thing.Links[0].Href != "/device-types/urn:infai:ses:device-type:lamp/wot-tm" {
+		t.Errorf("%#v", thing.Links)
+	}
+	if href := thing.Actions["setOn"].Forms[0].Href; href != "mqtt://lamp%201/setOn" {
+		t.Error(href)
+	}
+}
+
+func TestNewDeviceType(t *testing.T) {
+	protocol := testProtocols["urn:infai:ses:protocol:mqtt"]
+	deviceType, err := NewDeviceType(NewThingModel(testDeviceType, testProtocols), protocol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deviceType.Id != "" || deviceType.Name != "Lamp" || deviceType.DeviceClassId != testDeviceType.DeviceClassId || len(deviceType.Services) != 3 {
+		t.Fatalf("%#v", deviceType)
+	}
+	getState, setOn, power := deviceType.Services[0], deviceType.Services[1], deviceType.Services[2]
+
+	if getState.LocalId != "getState" || getState.Id != "" || getState.Interaction != models.EVENT_AND_REQUEST || len(getState.Inputs) != 0 || len(getState.Outputs) != 2 {
+		t.Errorf("%#v", getState)
+	}
+	expectedOutputs := []models.Content{testDeviceType.Services[1].Outputs[1], testDeviceType.Services[1].Outputs[0]} //sorted by segment name
+	if !reflect.DeepEqual(getState.Outputs, expectedOutputs) {
+		t.Errorf("\n%#v\n%#v", getState.Outputs, expectedOutputs)
+	}
+
+	expectedSetOn := testDeviceType.Services[0]
+	expectedSetOn.Id = ""
+	if !reflect.DeepEqual(setOn, expectedSetOn) {
+		t.Errorf("\n%#v\n%#v", setOn, expectedSetOn)
+	}
+
+	if power.Interaction != models.EVENT || power.ProtocolId != protocol.Id || len(power.Outputs) != 1 || power.Outputs[0].Serialization != models.JSON || power.Outputs[0].ProtocolSegmentId != "urn:infai:ses:segment:payload" || power.Outputs[0].ContentVariable.Type != models.Float {
+		t.Errorf("%#v", power)
+	}
+}
+
+func TestNewDeviceTypeWritableProperty(t *testing.T) {
+	thing := Thing{}
+	err := json.Unmarshal([]byte(`{
+		"@context": ["https://www.w3.org/2022/wot/td/v1.1"],
+		"@type": "tm:ThingModel",
+		"title": "Thermostat",
+		"properties": {
+			"target/temperature": {"type": "number", "unit": "celsius", "forms": [{"href": "http://{{IP}}/target", "contentType": "text/plain"}]},
+			"mode": {"type": "string", "writeOnly": true},
+			"schedule": {"type": "array", "items": [{"type": "integer"}, {"type": "object"}]}
+		}
+	}`), &thing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceType, err := NewDeviceType(thing, testProtocols["urn:infai:ses:protocol:mqtt"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	localIds := []string{}
+	for _, service := range deviceType.Services {
+		localIds = append(localIds, service.LocalId)
+	}
+	if !reflect.DeepEqual(localIds, []string{"set_mode", "schedule", "set_schedule", "target_temperature", "set_target_temperature"}) {
+		t.Error(localIds)
+	}
+	schedule := deviceType.Services[1].Outputs[0].ContentVariable
+	if schedule.Name != "schedule" || schedule.Type != models.List || len(schedule.SubContentVariables) != 2 || schedule.SubContentVariables[1].Name != "1" || schedule.SubContentVariables[1].SubContentVariables[0].Name != "*" {
+		t.Errorf("%#v", schedule)
+	}
+	target := deviceType.Services[4].Inputs[0]
+	if target.Serialization != models.JSON || target.ContentVariable.Name != "target_temperature" || target.ContentVariable.Type != models.Float {
+		t.Errorf("%#v", target)
+	}
+}